@@ -5,4 +5,25 @@ Expand the name of the chart.
 */}}
 {{- define "kube-arangodb-crd.name" -}}
 {{- printf "%s" .Chart.Name | trunc 63 | trimSuffix "-" -}}
-{{- end -}}
\ No newline at end of file
+{{- end -}}
+
+{{/*
+Conversion webhook of the CRDs served at multiple versions.
+*/}}
+{{- define "kube-arangodb-crd.conversion" -}}
+{{- if .Values.conversion.enabled }}
+  conversion:
+    strategy: Webhook
+    webhook:
+      conversionReviewVersions: ["v1"]
+      clientConfig:
+        service:
+          name: {{ .Values.conversion.serviceName }}
+          namespace: {{ .Values.conversion.serviceNamespace }}
+          path: /webhook/convert
+          port: 8528
+{{- if .Values.conversion.caBundle }}
+        caBundle: {{ .Values.conversion.caBundle }}
+{{- end }}
+{{- end }}
+{{- end -}}
@@ -45,3 +45,16 @@ Create the name of the Operator Cluster resources
 {{- printf "%s-%s-rbac" (include "kube-arangodb.operatorName" .) .Release.Namespace | trunc 63 | trimSuffix "-" -}}
 {{- end -}}
 {{- end -}}
+
+{{/*
+Namespaces in which ArangoDeployments are managed by the Operator.
+*/}}
+{{- define "kube-arangodb.deployment.namespaces" -}}
+{{- $namespaces := list .Release.Namespace -}}
+{{- range .Values.operator.watchNamespaces -}}
+{{- if and (ne . "*") (not (has . $namespaces)) -}}
+{{- $namespaces = append $namespaces . -}}
+{{- end -}}
+{{- end -}}
+{{- join "," $namespaces -}}
+{{- end -}}
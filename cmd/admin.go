@@ -115,7 +115,7 @@ func cmdGetAgencyState(cmd *cobra.Command, _ []string) {
 	}
 
 	dnsName := k8sutil.CreatePodDNSName(d.GetObjectMeta(), api.ServerGroupAgents.AsRole(), d.Status.Members.Agents[0].ID)
-	endpoint := getArangoEndpoint(d.Spec.IsSecure(), dnsName)
+	endpoint := getArangoEndpoint(d.Spec.IsSecure(), dnsName, d.Spec.GetServerGroupPort(api.ServerGroupAgents))
 	conn := createClient([]string{endpoint}, certCA, auth, connection.ApplicationJSON)
 	leaderID, err := getAgencyLeader(ctx, conn)
 	if err != nil {
@@ -123,7 +123,7 @@ func cmdGetAgencyState(cmd *cobra.Command, _ []string) {
 	}
 
 	dnsLeaderName := k8sutil.CreatePodDNSName(d.GetObjectMeta(), api.ServerGroupAgents.AsRole(), leaderID)
-	leaderEndpoint := getArangoEndpoint(d.Spec.IsSecure(), dnsLeaderName)
+	leaderEndpoint := getArangoEndpoint(d.Spec.IsSecure(), dnsLeaderName, d.Spec.GetServerGroupPort(api.ServerGroupAgents))
 	conn = createClient([]string{leaderEndpoint}, certCA, auth, connection.PlainText)
 	body, err := getAgencyState(ctx, conn)
 	if body != nil {
@@ -150,7 +150,7 @@ func cmdGetAgencyDump(cmd *cobra.Command, _ []string) {
 			d.GetName())
 	}
 
-	endpoint := getArangoEndpoint(d.Spec.IsSecure(), k8sutil.CreateDatabaseClientServiceDNSName(d.GetObjectMeta()))
+	endpoint := getArangoEndpoint(d.Spec.IsSecure(), k8sutil.CreateDatabaseClientServiceDNSName(d.GetObjectMeta()), d.Spec.GetDatabasePort())
 	conn := createClient([]string{endpoint}, certCA, auth, connection.ApplicationJSON)
 	body, err := getAgencyDump(ctx, conn)
 	if body != nil {
@@ -220,12 +220,12 @@ func getDeploymentAndCredentials(ctx context.Context,
 }
 
 // getArangoEndpoint returns ArangoDB endpoint with scheme and port for the given dnsName.
-func getArangoEndpoint(secure bool, dnsName string) string {
+func getArangoEndpoint(secure bool, dnsName string, port int) string {
 	if secure {
-		return "https://" + net.JoinHostPort(dnsName, strconv.Itoa(k8sutil.ArangoPort))
+		return "https://" + net.JoinHostPort(dnsName, strconv.Itoa(port))
 	}
 
-	return "http://" + net.JoinHostPort(dnsName, strconv.Itoa(k8sutil.ArangoPort))
+	return "http://" + net.JoinHostPort(dnsName, strconv.Itoa(port))
 }
 
 // getAgencyLeader returns the leader ID of the agency.
@@ -0,0 +1,313 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+var debugOptions struct {
+	deploymentName string
+	output         string
+	since          time.Duration
+	agencyDump     bool
+}
+
+func init() {
+	cmdMain.AddCommand(cmdDebug)
+	cmdDebug.AddCommand(cmdDebugCollect)
+
+	f := cmdDebugCollect.Flags()
+	f.StringVarP(&debugOptions.deploymentName, ArgDeploymentName, "d", "", "Collect data only of the given deployment, all deployments in the namespace are collected by default")
+	f.StringVarP(&debugOptions.output, "output", "o", "-", "Path of the tar.gz file, \"-\" writes it to the stdout")
+	f.DurationVar(&debugOptions.since, "since", time.Hour, "Collect only logs and events newer than the given duration")
+	f.BoolVar(&debugOptions.agencyDump, "agency-dump", true, "Collect the agency dump of cluster deployments")
+}
+
+var cmdDebug = &cobra.Command{
+	Use:   "debug",
+	Short: "Debug operations",
+	Run:   executeUsage,
+}
+
+var cmdDebugCollect = &cobra.Command{
+	Use:   "collect",
+	Short: "Collect diagnostics bundle",
+	Long: "It collects deployment CRs, member statuses, pod logs, recent events, operator logs and the agency dump into a tar.gz file.\n" +
+		"Example: kubectl exec -n <namespace> <operator-pod> -- /usr/bin/arangodb_operator debug collect > debug.tar.gz",
+	Run: cmdDebugCollectRun,
+}
+
+func cmdDebugCollectRun(_ *cobra.Command, _ []string) {
+	namespace := os.Getenv(constants.EnvOperatorPodNamespace)
+	if len(namespace) == 0 {
+		cliLog.Fatal().Msgf("\"%s\" environment variable missing", constants.EnvOperatorPodNamespace)
+	}
+
+	client, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		cliLog.Fatal().Msg("Client not initialised")
+	}
+
+	var out io.Writer = os.Stdout
+	if debugOptions.output != "-" {
+		f, err := os.Create(debugOptions.output)
+		if err != nil {
+			cliLog.Fatal().Err(err).Msg("failed to create output file")
+		}
+		defer f.Close()
+		out = f
+	}
+
+	collector := &debugCollector{
+		client:     client,
+		namespace:  namespace,
+		since:      debugOptions.since,
+		agencyDump: debugOptions.agencyDump,
+		now:        time.Now(),
+	}
+
+	if err := collector.Collect(getInterruptionContext(), out, debugOptions.deploymentName, os.Getenv(constants.EnvOperatorPodName)); err != nil {
+		cliLog.Fatal().Err(err).Msg("failed to collect diagnostics bundle")
+	}
+}
+
+// debugCollector writes diagnostics data into the tar archive.
+// Failures of single items are recorded in the errors.txt file, so the bundle is created even if some data is missing.
+type debugCollector struct {
+	client     kclient.Client
+	namespace  string
+	since      time.Duration
+	agencyDump bool
+	now        time.Time
+
+	archive *tar.Writer
+	errors  []string
+}
+
+// Collect writes the gzipped tar archive with diagnostics of the deployments and the operator pod
+func (d *debugCollector) Collect(ctx context.Context, out io.Writer, deploymentName, operatorPodName string) error {
+	gz := gzip.NewWriter(out)
+	d.archive = tar.NewWriter(gz)
+
+	deployments, err := d.client.Arango().DatabaseV1().ArangoDeployments(d.namespace).List(ctx, meta.ListOptions{})
+	if err != nil {
+		d.failed("deployments", err)
+	} else {
+		for _, depl := range deployments.Items {
+			if deploymentName != "" && depl.GetName() != deploymentName {
+				continue
+			}
+
+			if err := d.collectDeployment(ctx, depl); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := d.collectEvents(ctx); err != nil {
+		return err
+	}
+
+	if operatorPodName != "" {
+		if err := d.collectPodLogs(ctx, "operator", operatorPodName); err != nil {
+			return err
+		}
+	}
+
+	if len(d.errors) > 0 {
+		if err := d.add("errors.txt", []byte(strings.Join(d.errors, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+
+	if err := d.archive.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(gz.Close())
+}
+
+func (d *debugCollector) collectDeployment(ctx context.Context, depl api.ArangoDeployment) error {
+	dir := fmt.Sprintf("deployments/%s", depl.GetName())
+
+	if err := d.addYAML(dir+"/deployment.yaml", depl); err != nil {
+		return err
+	}
+
+	if err := d.addYAML(dir+"/members.yaml", depl.Status.Members); err != nil {
+		return err
+	}
+
+	pods, err := d.client.Kubernetes().CoreV1().Pods(d.namespace).List(ctx, meta.ListOptions{
+		LabelSelector: labels.SelectorFromSet(k8sutil.LabelsForDeployment(depl.GetName(), "")).String(),
+	})
+	if err != nil {
+		d.failed(dir+"/pods", err)
+	} else {
+		for _, pod := range pods.Items {
+			if err := d.addYAML(fmt.Sprintf("%s/pods/%s.yaml", dir, pod.GetName()), pod); err != nil {
+				return err
+			}
+
+			if err := d.collectPodLogs(ctx, dir+"/pods", pod.GetName()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.agencyDump && depl.Spec.GetMode() == api.DeploymentModeCluster {
+		if err := d.collectAgencyDump(ctx, dir, depl.GetName()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *debugCollector) collectPodLogs(ctx context.Context, dir, podName string) error {
+	pod, err := d.client.Kubernetes().CoreV1().Pods(d.namespace).Get(ctx, podName, meta.GetOptions{})
+	if err != nil {
+		d.failed(fmt.Sprintf("%s/%s", dir, podName), err)
+		return nil
+	}
+
+	sinceSeconds := int64(d.since.Seconds())
+
+	for _, container := range pod.Spec.Containers {
+		name := fmt.Sprintf("%s/%s/%s.log", dir, podName, container.Name)
+
+		logs, err := d.client.Kubernetes().CoreV1().Pods(d.namespace).GetLogs(podName, &core.PodLogOptions{
+			Container:    container.Name,
+			SinceSeconds: &sinceSeconds,
+		}).DoRaw(ctx)
+		if err != nil {
+			d.failed(name, err)
+			continue
+		}
+
+		if err := d.add(name, logs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *debugCollector) collectEvents(ctx context.Context) error {
+	events, err := d.client.Kubernetes().CoreV1().Events(d.namespace).List(ctx, meta.ListOptions{})
+	if err != nil {
+		d.failed("events.yaml", err)
+		return nil
+	}
+
+	since := d.now.Add(-d.since)
+	recent := make([]core.Event, 0, len(events.Items))
+	for _, event := range events.Items {
+		if event.LastTimestamp.IsZero() || event.LastTimestamp.Time.After(since) {
+			recent = append(recent, event)
+		}
+	}
+
+	return d.addYAML("events.yaml", recent)
+}
+
+func (d *debugCollector) collectAgencyDump(ctx context.Context, dir, deploymentName string) error {
+	name := dir + "/agency-dump.json"
+
+	depl, certCA, auth, err := getDeploymentAndCredentials(ctx, deploymentName)
+	if err != nil {
+		d.failed(name, err)
+		return nil
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+
+	endpoint := getArangoEndpoint(depl.Spec.IsSecure(), k8sutil.CreateDatabaseClientServiceDNSName(depl.GetObjectMeta()), depl.Spec.GetDatabasePort())
+	conn := createClient([]string{endpoint}, certCA, auth, connection.ApplicationJSON)
+	body, err := getAgencyDump(ctxChild, conn)
+	if body != nil {
+		defer body.Close()
+	}
+	if err != nil {
+		d.failed(name, err)
+		return nil
+	}
+
+	var data bytes.Buffer
+	if _, err := io.Copy(&data, body); err != nil {
+		d.failed(name, err)
+		return nil
+	}
+
+	return d.add(name, data.Bytes())
+}
+
+func (d *debugCollector) addYAML(name string, obj interface{}) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		d.failed(name, err)
+		return nil
+	}
+
+	return d.add(name, data)
+}
+
+func (d *debugCollector) add(name string, data []byte) error {
+	if err := d.archive.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: d.now,
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err := d.archive.Write(data)
+	return errors.WithStack(err)
+}
+
+func (d *debugCollector) failed(name string, err error) {
+	d.errors = append(d.errors, fmt.Sprintf("%s: %s", name, err.Error()))
+}
@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+func Test_DebugCollector(t *testing.T) {
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{Name: "cluster", Namespace: "test"},
+	}
+	other := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{Name: "other", Namespace: "test"},
+	}
+	pod := &core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "cluster-sngl",
+			Namespace: "test",
+			Labels:    k8sutil.LabelsForDeployment("cluster", "single"),
+		},
+		Spec: core.PodSpec{Containers: []core.Container{{Name: "server"}}},
+	}
+	operatorPod := &core.Pod{
+		ObjectMeta: meta.ObjectMeta{Name: "operator", Namespace: "test"},
+		Spec:       core.PodSpec{Containers: []core.Container{{Name: "operator"}}},
+	}
+	event := &core.Event{
+		ObjectMeta:    meta.ObjectMeta{Name: "event", Namespace: "test"},
+		LastTimestamp: meta.Now(),
+	}
+	oldEvent := &core.Event{
+		ObjectMeta:    meta.ObjectMeta{Name: "old-event", Namespace: "test"},
+		LastTimestamp: meta.NewTime(time.Now().Add(-2 * time.Hour)),
+	}
+
+	client := kclient.NewFakeClientBuilder().Arango(depl, other).Kubernetes(pod, operatorPod, event, oldEvent).Client()
+
+	collector := &debugCollector{
+		client:    client,
+		namespace: "test",
+		since:     time.Hour,
+		now:       time.Now(),
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, collector.Collect(context.Background(), &out, "cluster", "operator"))
+
+	files := readDebugBundle(t, &out)
+
+	require.Contains(t, files, "deployments/cluster/deployment.yaml")
+	require.Contains(t, files, "deployments/cluster/members.yaml")
+	require.Contains(t, files, "deployments/cluster/pods/cluster-sngl.yaml")
+	require.Contains(t, files, "deployments/cluster/pods/cluster-sngl/server.log")
+	require.Contains(t, files, "operator/operator/operator.log")
+	require.NotContains(t, files, "deployments/other/deployment.yaml")
+	require.NotContains(t, files, "errors.txt")
+
+	require.Contains(t, files, "events.yaml")
+	require.Contains(t, files["events.yaml"], "name: event")
+	require.NotContains(t, files["events.yaml"], "old-event")
+}
+
+func readDebugBundle(t *testing.T, in io.Reader) map[string]string {
+	gz, err := gzip.NewReader(in)
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(archive)
+		require.NoError(t, err)
+
+		files[header.Name] = string(data)
+	}
+}
@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"github.com/rs/zerolog"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+)
+
+// watchFeatures enables or disables features from the ConfigMap with the given name whenever it changes.
+// Keys of the ConfigMap are names of features, values are booleans.
+// Values set with flags are restored when the ConfigMap is removed.
+func watchFeatures(log zerolog.Logger, client kubernetes.Interface, namespace, name string, stopCh <-chan struct{}) {
+	apply := func(obj interface{}) {
+		var values map[string]string
+		if cm, ok := obj.(*core.ConfigMap); ok {
+			values = cm.Data
+		}
+
+		if err := features.ApplyOverrides(values); err != nil {
+			log.Error().Err(err).Str("config-map", name).Msg("Unable to apply features")
+			return
+		}
+
+		log.Info().Str("config-map", name).Strs("enabled", features.EnabledFeatures()).Msg("Features applied")
+	}
+
+	lw := cache.NewListWatchFromClient(client.CoreV1().RESTClient(), "configmaps", namespace,
+		fields.OneTermEqualSelector("metadata.name", name))
+
+	_, informer := cache.NewInformer(lw, &core.ConfigMap{}, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc: apply,
+		UpdateFunc: func(_, obj interface{}) {
+			apply(obj)
+		},
+		DeleteFunc: func(interface{}) {
+			apply(nil)
+		},
+	})
+
+	go informer.Run(stopCh)
+}
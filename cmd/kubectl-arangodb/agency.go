@@ -0,0 +1,178 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	defaultOperatorServiceName = "arango-deployment-operator"
+	defaultAdminSecretName     = "arangodb-operator-dashboard"
+	operatorServerPort         = "8528"
+)
+
+var operatorOptions struct {
+	namespace       string
+	serviceName     string
+	adminSecretName string
+	anonymous       bool
+}
+
+func init() {
+	cmdRoot.AddCommand(cmdAgency)
+	cmdAgency.AddCommand(cmdAgencyDump)
+
+	f := cmdAgency.PersistentFlags()
+	f.StringVar(&operatorOptions.namespace, "operator-namespace", "", "Namespace of the operator, defaults to the namespace of the deployment")
+	f.StringVar(&operatorOptions.serviceName, "operator-service", defaultOperatorServiceName, "Name of the operator service")
+	f.StringVar(&operatorOptions.adminSecretName, "operator-admin-secret", defaultAdminSecretName, "Name of the secret with username and password of the operator dashboard")
+	f.BoolVar(&operatorOptions.anonymous, "anonymous", false, "Do not authenticate, the operator has to allow anonymous access")
+}
+
+var cmdAgency = &cobra.Command{
+	Use:   "agency",
+	Short: "ArangoDeployment agency operations",
+}
+
+var cmdAgencyDump = &cobra.Command{
+	Use:   "dump <deployment>",
+	Short: "Print the sanitized agency dump of the ArangoDeployment",
+	Long:  "Fetches the agency dump through the operator, values of keys which may hold credentials are redacted",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, namespace, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		operatorNamespace := operatorOptions.namespace
+		if operatorNamespace == "" {
+			operatorNamespace = namespace
+		}
+
+		operator := &operatorProxy{
+			client:      client,
+			namespace:   operatorNamespace,
+			serviceName: operatorOptions.serviceName,
+		}
+
+		if !operatorOptions.anonymous {
+			if err := operator.login(cmd.Context(), operatorOptions.adminSecretName); err != nil {
+				return err
+			}
+		}
+
+		dump, err := operator.get(cmd.Context(), "api", "deployment", args[0], "agency-dump")
+		if err != nil {
+			return err
+		}
+
+		var out bytes.Buffer
+		if err := json.Indent(&out, dump, "", "  "); err != nil {
+			return errors.WithStack(err)
+		}
+		out.WriteString("\n")
+
+		_, err = io.Copy(cmd.OutOrStdout(), &out)
+		return errors.WithStack(err)
+	},
+}
+
+// operatorProxy sends requests to the operator server through the Kubernetes API service proxy
+type operatorProxy struct {
+	client      kclient.Client
+	namespace   string
+	serviceName string
+	token       string
+}
+
+func (o *operatorProxy) request(r *rest.Request, path ...string) *rest.Request {
+	r = r.Namespace(o.namespace).
+		Resource("services").
+		Name(fmt.Sprintf("https:%s:%s", o.serviceName, operatorServerPort)).
+		SubResource("proxy").
+		Suffix(path...)
+
+	if o.token != "" {
+		r = r.SetHeader("Authorization", "Bearer "+o.token)
+	}
+
+	return r
+}
+
+// login fetches the dashboard credentials from the secret and exchanges them for the operator token
+func (o *operatorProxy) login(ctx context.Context, secretName string) error {
+	secret, err := o.client.Kubernetes().CoreV1().Secrets(o.namespace).Get(ctx, secretName, meta.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get operator admin secret %s", secretName)
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"username": string(secret.Data[core.BasicAuthUsernameKey]),
+		"password": string(secret.Data[core.BasicAuthPasswordKey]),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	data, err := o.request(o.client.Kubernetes().CoreV1().RESTClient().Post(), "login").
+		SetHeader("Content-Type", "application/json").
+		Body(body).
+		DoRaw(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to login to the operator")
+	}
+
+	var response struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return errors.Wrapf(err, "Unable to parse login response")
+	}
+
+	o.token = response.Token
+	return nil
+}
+
+func (o *operatorProxy) get(ctx context.Context, path ...string) ([]byte, error) {
+	data, err := o.request(o.client.Kubernetes().CoreV1().RESTClient().Get(), path...).DoRaw(ctx)
+	if err != nil {
+		if len(data) > 0 {
+			return nil, errors.Wrapf(err, "Request to the operator failed: %s", string(data))
+		}
+		return nil, errors.Wrapf(err, "Request to the operator failed")
+	}
+
+	return data, nil
+}
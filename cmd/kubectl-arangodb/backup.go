@@ -0,0 +1,168 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+
+	"github.com/spf13/cobra"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var backupOptions struct {
+	name              string
+	deployment        string
+	allowInconsistent bool
+	uploadURL         string
+	uploadSecret      string
+}
+
+func init() {
+	cmdRoot.AddCommand(cmdBackup)
+	cmdBackup.AddCommand(cmdBackupCreate)
+	cmdBackup.AddCommand(cmdBackupList)
+
+	f := cmdBackupCreate.Flags()
+	f.StringVar(&backupOptions.name, "name", "", "Name of the ArangoBackup, generated from the deployment name when empty")
+	f.BoolVar(&backupOptions.allowInconsistent, "allow-inconsistent", false, "Create the backup even if it can not be consistent")
+	f.StringVar(&backupOptions.uploadURL, "upload-url", "", "Repository URL to which the backup is uploaded")
+	f.StringVar(&backupOptions.uploadSecret, "upload-secret", "", "Name of the secret with credentials of the upload repository")
+
+	cmdBackupList.Flags().StringVarP(&backupOptions.deployment, "deployment", "d", "", "List only backups of the ArangoDeployment")
+}
+
+var cmdBackup = &cobra.Command{
+	Use:   "backup",
+	Short: "ArangoBackup operations",
+}
+
+var cmdBackupCreate = &cobra.Command{
+	Use:   "create <deployment>",
+	Short: "Create the ArangoBackup of the ArangoDeployment",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, namespace, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		backup, err := createBackup(cmd.Context(), client, namespace, args[0])
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "ArangoBackup %s/%s created\n", namespace, backup.GetName())
+		return nil
+	},
+}
+
+var cmdBackupList = &cobra.Command{
+	Use:   "list",
+	Short: "List ArangoBackups",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, namespace, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		backups, err := client.Arango().BackupV1().ArangoBackups(namespace).List(cmd.Context(), meta.ListOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "Unable to list ArangoBackups")
+		}
+
+		return printBackups(cmd.OutOrStdout(), backups.Items, backupOptions.deployment)
+	},
+}
+
+// createBackup creates the ArangoBackup of the deployment using the command options
+func createBackup(ctx context.Context, client kclient.Client, namespace, deploymentName string) (*backupApi.ArangoBackup, error) {
+	backup := &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      backupOptions.name,
+			Namespace: namespace,
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: deploymentName,
+			},
+		},
+	}
+
+	if backup.Name == "" {
+		backup.GenerateName = fmt.Sprintf("%s-", deploymentName)
+	}
+
+	if backupOptions.allowInconsistent {
+		allowInconsistent := true
+		backup.Spec.Options = &backupApi.ArangoBackupSpecOptions{
+			AllowInconsistent: &allowInconsistent,
+		}
+	}
+
+	if backupOptions.uploadURL != "" {
+		backup.Spec.Upload = &backupApi.ArangoBackupSpecOperation{
+			RepositoryURL:         backupOptions.uploadURL,
+			CredentialsSecretName: backupOptions.uploadSecret,
+		}
+	}
+
+	created, err := client.Arango().BackupV1().ArangoBackups(namespace).Create(ctx, backup, meta.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create ArangoBackup")
+	}
+
+	return created, nil
+}
+
+// printBackups prints backups, optionally filtered by the deployment name
+func printBackups(out io.Writer, backups []backupApi.ArangoBackup, deploymentName string) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NAME\tDEPLOYMENT\tSTATE\tBACKUP ID\tCREATED\tMESSAGE")
+
+	for _, backup := range backups {
+		if deploymentName != "" && backup.Spec.Deployment.Name != deploymentName {
+			continue
+		}
+
+		id, created := "-", "-"
+		if b := backup.Status.Backup; b != nil {
+			id = valueOrDash(b.ID)
+			if !b.CreationTimestamp.IsZero() {
+				created = b.CreationTimestamp.Time.UTC().Format(time.RFC3339)
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", backup.GetName(), backup.Spec.Deployment.Name,
+			valueOrDash(string(backup.Status.State)), id, created, valueOrDash(backup.Status.Message))
+	}
+
+	return w.Flush()
+}
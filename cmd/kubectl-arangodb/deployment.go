@@ -0,0 +1,110 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+
+	"github.com/spf13/cobra"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	cmdRoot.AddCommand(cmdDeployment)
+	cmdDeployment.AddCommand(cmdDeploymentPause)
+	cmdDeployment.AddCommand(cmdDeploymentResume)
+}
+
+var cmdDeployment = &cobra.Command{
+	Use:   "deployment",
+	Short: "ArangoDeployment operations",
+}
+
+var cmdDeploymentPause = &cobra.Command{
+	Use:   "pause <deployment>",
+	Short: "Pause reconciliation of the ArangoDeployment",
+	Long:  "Sets the maintenance annotation on the ArangoDeployment, so the operator stops reconciling it until it is resumed",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDeploymentSetPaused(cmd, args[0], true)
+	},
+}
+
+var cmdDeploymentResume = &cobra.Command{
+	Use:   "resume <deployment>",
+	Short: "Resume reconciliation of the ArangoDeployment",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDeploymentSetPaused(cmd, args[0], false)
+	},
+}
+
+func runDeploymentSetPaused(cmd *cobra.Command, name string, paused bool) error {
+	client, namespace, err := getClient()
+	if err != nil {
+		return err
+	}
+
+	if err := setDeploymentPaused(cmd.Context(), client, namespace, name, paused); err != nil {
+		return err
+	}
+
+	if paused {
+		fmt.Fprintf(cmd.OutOrStdout(), "ArangoDeployment %s/%s paused\n", namespace, name)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "ArangoDeployment %s/%s resumed\n", namespace, name)
+	}
+
+	return nil
+}
+
+// setDeploymentPaused sets or removes the maintenance annotation of the ArangoDeployment
+func setDeploymentPaused(ctx context.Context, client kclient.Client, namespace, name string, paused bool) error {
+	deployments := client.Arango().DatabaseV1().ArangoDeployments(namespace)
+
+	depl, err := deployments.Get(ctx, name, meta.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get ArangoDeployment %s", name)
+	}
+
+	if paused {
+		if depl.Annotations == nil {
+			depl.Annotations = map[string]string{}
+		}
+		depl.Annotations[deployment.ArangoDeploymentPodMaintenanceAnnotation] = "true"
+	} else {
+		if _, ok := depl.Annotations[deployment.ArangoDeploymentPodMaintenanceAnnotation]; !ok {
+			return nil
+		}
+		delete(depl.Annotations, deployment.ArangoDeploymentPodMaintenanceAnnotation)
+	}
+
+	if _, err := deployments.Update(ctx, depl, meta.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "Unable to update ArangoDeployment %s", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package main
+
+import (
+	"os"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var (
+	cmdRoot = &cobra.Command{
+		Use:           "kubectl-arangodb",
+		Short:         "Operational commands for ArangoDB deployments managed by kube-arangodb",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	kubeConfigLoadingRules = clientcmd.NewDefaultClientConfigLoadingRules()
+	kubeConfigOverrides    = &clientcmd.ConfigOverrides{}
+
+	// getClient returns the Kubernetes client and the namespace in which commands are executed
+	getClient = newClient
+)
+
+func init() {
+	f := cmdRoot.PersistentFlags()
+
+	f.StringVar(&kubeConfigLoadingRules.ExplicitPath, "kubeconfig", "", "Path to the kubeconfig file")
+	clientcmd.BindOverrideFlags(kubeConfigOverrides, f, clientcmd.RecommendedConfigOverrideFlags(""))
+}
+
+func main() {
+	if err := cmdRoot.Execute(); err != nil {
+		cmdRoot.PrintErrln("Error:", err.Error())
+		os.Exit(1)
+	}
+}
+
+func newClient() (kclient.Client, string, error) {
+	config := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(kubeConfigLoadingRules, kubeConfigOverrides)
+
+	namespace, _, err := config.Namespace()
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "Unable to get namespace")
+	}
+
+	factory := kclient.GetFactory("kubectl-arangodb")
+	factory.SetKubeConfigGetter(kclient.NewStaticConfigGetter(func() (*rest.Config, error) {
+		return config.ClientConfig()
+	}))
+
+	if err := factory.Refresh(); err != nil {
+		return nil, "", errors.Wrapf(err, "Unable to create Kubernetes client")
+	}
+
+	client, ok := factory.Client()
+	if !ok {
+		return nil, "", errors.Newf("Client not initialised")
+	}
+
+	return client, namespace, nil
+}
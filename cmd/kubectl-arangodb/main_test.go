@@ -0,0 +1,184 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testNamespace = "test"
+
+func newTestDeployment() *api.ArangoDeployment {
+	return &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "cluster",
+			Namespace: testNamespace,
+		},
+		Status: api.DeploymentStatus{
+			Members: api.DeploymentStatusMembers{
+				DBServers: api.MemberStatusList{
+					{ID: "PRMR-1", PodName: "cluster-prmr-1"},
+				},
+			},
+		},
+	}
+}
+
+func Test_Deployment_PauseResume(t *testing.T) {
+	ctx := context.Background()
+	client := kclient.NewFakeClientBuilder().Arango(newTestDeployment()).Client()
+
+	require.NoError(t, setDeploymentPaused(ctx, client, testNamespace, "cluster", true))
+
+	depl, err := client.Arango().DatabaseV1().ArangoDeployments(testNamespace).Get(ctx, "cluster", meta.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "true", depl.Annotations[deployment.ArangoDeploymentPodMaintenanceAnnotation])
+
+	require.NoError(t, setDeploymentPaused(ctx, client, testNamespace, "cluster", false))
+
+	depl, err = client.Arango().DatabaseV1().ArangoDeployments(testNamespace).Get(ctx, "cluster", meta.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, depl.Annotations, deployment.ArangoDeploymentPodMaintenanceAnnotation)
+
+	require.Error(t, setDeploymentPaused(ctx, client, testNamespace, "missing", true))
+}
+
+func Test_Member_Restart(t *testing.T) {
+	ctx := context.Background()
+	pod := &core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "cluster-prmr-1",
+			Namespace: testNamespace,
+		},
+	}
+	client := kclient.NewFakeClientBuilder().Arango(newTestDeployment()).Kubernetes(pod).Client()
+
+	podName, err := restartMember(ctx, client, testNamespace, "cluster", "PRMR-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cluster-prmr-1", podName)
+
+	pod, err = client.Kubernetes().CoreV1().Pods(testNamespace).Get(ctx, podName, meta.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, pod.Annotations, deployment.ArangoDeploymentPodRotateAnnotation)
+
+	_, err = restartMember(ctx, client, testNamespace, "cluster", "PRMR-2")
+	require.Error(t, err)
+}
+
+func Test_Backup_CreateList(t *testing.T) {
+	ctx := context.Background()
+	client := kclient.NewFakeClientBuilder().Client()
+
+	backupOptions.name = "cluster-backup"
+	backupOptions.uploadURL = "s3://bucket"
+	defer func() {
+		backupOptions.name = ""
+		backupOptions.uploadURL = ""
+	}()
+
+	backup, err := createBackup(ctx, client, testNamespace, "cluster")
+	require.NoError(t, err)
+	assert.Equal(t, "cluster", backup.Spec.Deployment.Name)
+	require.NotNil(t, backup.Spec.Upload)
+	assert.Equal(t, "s3://bucket", backup.Spec.Upload.RepositoryURL)
+
+	backup.Status.State = backupApi.ArangoBackupStateReady
+	other := backupApi.ArangoBackup{ObjectMeta: meta.ObjectMeta{Name: "other-backup"}}
+	other.Spec.Deployment.Name = "other"
+
+	var out bytes.Buffer
+	require.NoError(t, printBackups(&out, []backupApi.ArangoBackup{*backup, other}, "cluster"))
+	assert.Contains(t, out.String(), "cluster-backup")
+	assert.Contains(t, out.String(), string(backupApi.ArangoBackupStateReady))
+	assert.NotContains(t, out.String(), "other-backup")
+}
+
+func Test_Plan_Show(t *testing.T) {
+	status := api.DeploymentStatus{
+		HighPriorityPlan: api.Plan{
+			{ID: "high-1", Type: api.ActionTypeSetMemberCondition, Group: api.ServerGroupDBServers, MemberID: "PRMR-1"},
+		},
+		Plan: api.Plan{
+			{ID: "normal-1", Type: api.ActionTypeRotateMember, Group: api.ServerGroupDBServers, MemberID: "PRMR-1", Reason: "Pod needs rotation"},
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printPlan(&out, status))
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+	assert.Contains(t, string(lines[1]), "high-1")
+	assert.Contains(t, string(lines[2]), "normal-1")
+	assert.Contains(t, string(lines[2]), "Pod needs rotation")
+}
+
+func Test_Render_Member(t *testing.T) {
+	ctx := context.Background()
+
+	pod := &core.PodTemplateSpec{
+		Spec: core.PodSpec{
+			Containers: []core.Container{
+				{Name: "server", Command: []string{"/usr/sbin/arangod", "--server.authentication=true"}},
+			},
+		},
+	}
+	template, err := api.GetArangoMemberPodTemplate(pod, "")
+	require.NoError(t, err)
+
+	depl := newTestDeployment()
+	member := &api.ArangoMember{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      depl.Status.Members.DBServers[0].ArangoMemberName(depl.GetName(), api.ServerGroupDBServers),
+			Namespace: testNamespace,
+		},
+		Spec: api.ArangoMemberSpec{Template: template},
+	}
+	client := kclient.NewFakeClientBuilder().Arango(depl, member).Client()
+
+	rendered, err := getRenderedMember(ctx, client, testNamespace, "cluster", "PRMR-1")
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, printMemberArgs(&out, rendered.Spec.Template.PodSpec))
+	assert.Equal(t, "/usr/sbin/arangod\n--server.authentication=true\n", out.String())
+
+	out.Reset()
+	require.NoError(t, printMemberPodSpec(&out, rendered.Spec.Template.PodSpec, "yaml"))
+	assert.Contains(t, out.String(), "- --server.authentication=true")
+
+	require.Error(t, printMemberPodSpec(&out, rendered.Spec.Template.PodSpec, "xml"))
+
+	_, err = getRenderedMember(ctx, client, testNamespace, "cluster", "PRMR-2")
+	require.Error(t, err)
+}
@@ -0,0 +1,99 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+
+	"github.com/spf13/cobra"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	cmdRoot.AddCommand(cmdMember)
+	cmdMember.AddCommand(cmdMemberRestart)
+}
+
+var cmdMember = &cobra.Command{
+	Use:   "member",
+	Short: "ArangoDeployment member operations",
+}
+
+var cmdMemberRestart = &cobra.Command{
+	Use:   "restart <deployment> <member-id>",
+	Short: "Restart the member of the ArangoDeployment",
+	Long:  "Marks the pod of the member for rotation, so the operator restarts it gracefully",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, namespace, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		podName, err := restartMember(cmd.Context(), client, namespace, args[0], args[1])
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Pod %s/%s of member %s marked for restart\n", namespace, podName, args[1])
+		return nil
+	},
+}
+
+// restartMember sets the rotation annotation on the pod of the member and returns the name of the pod
+func restartMember(ctx context.Context, client kclient.Client, namespace, name, memberID string) (string, error) {
+	depl, err := client.Arango().DatabaseV1().ArangoDeployments(namespace).Get(ctx, name, meta.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to get ArangoDeployment %s", name)
+	}
+
+	member, _, ok := depl.Status.Members.ElementByID(memberID)
+	if !ok {
+		return "", errors.Newf("Member %s not found in ArangoDeployment %s", memberID, name)
+	}
+
+	if member.PodName == "" {
+		return "", errors.Newf("Member %s does not have a pod", memberID)
+	}
+
+	pods := client.Kubernetes().CoreV1().Pods(namespace)
+
+	pod, err := pods.Get(ctx, member.PodName, meta.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to get pod %s", member.PodName)
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[deployment.ArangoDeploymentPodRotateAnnotation] = "true"
+
+	if _, err := pods.Update(ctx, pod, meta.UpdateOptions{}); err != nil {
+		return "", errors.Wrapf(err, "Unable to update pod %s", member.PodName)
+	}
+
+	return pod.GetName(), nil
+}
@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	"github.com/spf13/cobra"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	cmdRoot.AddCommand(cmdPlan)
+	cmdPlan.AddCommand(cmdPlanShow)
+}
+
+var cmdPlan = &cobra.Command{
+	Use:   "plan",
+	Short: "ArangoDeployment plan operations",
+}
+
+var cmdPlanShow = &cobra.Command{
+	Use:   "show <deployment>",
+	Short: "Show actions planned for the ArangoDeployment",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, namespace, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		depl, err := client.Arango().DatabaseV1().ArangoDeployments(namespace).Get(cmd.Context(), args[0], meta.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "Unable to get ArangoDeployment %s", args[0])
+		}
+
+		return printPlan(cmd.OutOrStdout(), depl.Status)
+	},
+}
+
+// printPlan prints high priority and normal plan actions in order of execution
+func printPlan(out io.Writer, status api.DeploymentStatus) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "PLAN\tID\tTYPE\tGROUP\tMEMBER\tSTARTED\tREASON")
+
+	for _, plan := range []struct {
+		name    string
+		actions api.Plan
+	}{
+		{"high", status.HighPriorityPlan},
+		{"normal", status.Plan},
+	} {
+		for _, action := range plan.actions {
+			started := "-"
+			if action.StartTime != nil {
+				started = action.StartTime.Time.UTC().Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", plan.name, action.ID, action.Type, action.Group.AsRole(),
+				valueOrDash(action.MemberID), started, valueOrDash(action.Reason))
+		}
+	}
+
+	return w.Flush()
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
@@ -0,0 +1,148 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var renderOptions struct {
+	args   bool
+	output string
+}
+
+func init() {
+	cmdRoot.AddCommand(cmdRender)
+	cmdRender.AddCommand(cmdRenderMember)
+
+	f := cmdRenderMember.Flags()
+	f.BoolVar(&renderOptions.args, "args", false, "Print only the command and arguments of the server container, one per line")
+	f.StringVarP(&renderOptions.output, "output", "o", "yaml", "Output format of the pod spec, one of yaml or json")
+}
+
+var cmdRender = &cobra.Command{
+	Use:   "render",
+	Short: "Render resources generated by the operator",
+}
+
+var cmdRenderMember = &cobra.Command{
+	Use:   "member <deployment> <member-id>",
+	Short: "Print the pod spec generated by the operator for the member",
+	Long: "Prints the pod spec which the operator rendered for the member from the current ArangoDeployment spec. " +
+		"A warning is printed when the running pod was created from a different spec and the member is pending rotation",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, namespace, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		member, err := getRenderedMember(cmd.Context(), client, namespace, args[0], args[1])
+		if err != nil {
+			return err
+		}
+
+		if !member.Spec.Template.Equals(member.Status.Template) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: pod of member %s was not created from the rendered spec yet, rotation is pending\n", args[1])
+		}
+
+		if renderOptions.args {
+			return printMemberArgs(cmd.OutOrStdout(), member.Spec.Template.PodSpec)
+		}
+
+		return printMemberPodSpec(cmd.OutOrStdout(), member.Spec.Template.PodSpec, renderOptions.output)
+	},
+}
+
+// getRenderedMember returns the ArangoMember of the deployment member with the rendered pod template
+func getRenderedMember(ctx context.Context, client kclient.Client, namespace, name, memberID string) (*api.ArangoMember, error) {
+	depl, err := client.Arango().DatabaseV1().ArangoDeployments(namespace).Get(ctx, name, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get ArangoDeployment %s", name)
+	}
+
+	status, group, ok := depl.Status.Members.ElementByID(memberID)
+	if !ok {
+		return nil, errors.Newf("Member %s not found in ArangoDeployment %s", memberID, name)
+	}
+
+	memberName := status.ArangoMemberName(name, group)
+
+	member, err := client.Arango().DatabaseV1().ArangoMembers(namespace).Get(ctx, memberName, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get ArangoMember %s", memberName)
+	}
+
+	if member.Spec.Template == nil || member.Spec.Template.PodSpec == nil {
+		return nil, errors.Newf("Pod spec of member %s is not rendered yet", memberID)
+	}
+
+	return member, nil
+}
+
+func printMemberPodSpec(out io.Writer, pod *core.PodTemplateSpec, output string) error {
+	var data []byte
+	var err error
+
+	switch output {
+	case "yaml":
+		data, err = yaml.Marshal(pod)
+	case "json":
+		data, err = json.MarshalIndent(pod, "", "  ")
+		data = append(data, '\n')
+	default:
+		return errors.Newf("Output format %s is not supported", output)
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = out.Write(data)
+	return errors.WithStack(err)
+}
+
+func printMemberArgs(out io.Writer, pod *core.PodTemplateSpec) error {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != k8sutil.ServerContainerName {
+			continue
+		}
+
+		_, err := fmt.Fprintln(out, strings.Join(append(container.Command, container.Args...), "\n"))
+		return errors.WithStack(err)
+	}
+
+	return errors.Newf("Container %s not found in the pod spec", k8sutil.ServerContainerName)
+}
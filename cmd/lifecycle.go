@@ -84,6 +84,7 @@ func init() {
 	f := cmdLifecyclePreStopPort.Flags()
 
 	f.DurationVar(&preStopPort.timeout, "timeout", 6*60*time.Minute, "PreStopTimeout")
+	f.IntVar(&preStopPort.port, "port", k8sutil.ArangoPort, "Port of the ArangoDB server")
 
 	cmdLifecyclePreStop.AddCommand(cmdLifecyclePreStopFinalizers, cmdLifecyclePreStopPort)
 
@@ -183,11 +184,12 @@ func cmdLifecycleCopyRun(cmd *cobra.Command, args []string) {
 
 type cmdLifecyclePreStopRunPort struct {
 	timeout time.Duration
+	port    int
 }
 
-// Wait until port 8529 is closed.
+// Wait until server port is closed.
 func (c *cmdLifecyclePreStopRunPort) run(cmd *cobra.Command, args []string) error {
-	address := net.JoinHostPort("127.0.0.1", strconv.Itoa(k8sutil.ArangoPort))
+	address := net.JoinHostPort("127.0.0.1", strconv.Itoa(c.port))
 
 	// Get environment
 	namespace := os.Getenv(constants.EnvOperatorPodNamespace)
@@ -47,6 +47,7 @@ var (
 		SSL      bool
 		Auth     bool
 		Endpoint string
+		Port     int
 		JWTPath  string
 		CMD      string
 		TestPath string
@@ -61,6 +62,7 @@ func init() {
 	f.BoolVarP(&probeInput.SSL, "ssl", "", false, "Determines if SSL is enabled")
 	f.BoolVarP(&probeInput.Auth, "auth", "", false, "Determines if authentication is enabled")
 	f.StringVarP(&probeInput.Endpoint, "endpoint", "", "/_api/version", "Endpoint (path) to call for lifecycle probe")
+	f.IntVarP(&probeInput.Port, "port", "", k8sutil.ArangoPort, "Port of the ArangoDB server")
 	f.StringVarP(&probeInput.JWTPath, "jwt", "", k8sutil.ClusterJWTSecretVolumeMountDir, "Path to the JWT tokens")
 	f.StringVarP(&probeInput.CMD, "cmd", "", "ls", "Command name")
 	f.StringVarP(&probeInput.TestPath, "testpath", "", "/data/lost+found", "Mount Path to test volume liveness")
@@ -86,7 +88,7 @@ func probeEndpoint(endpoint string) string {
 		proto = "https"
 	}
 
-	return fmt.Sprintf("%s://%s:%d%s", proto, "127.0.0.1", k8sutil.ArangoPort, endpoint)
+	return fmt.Sprintf("%s://%s:%d%s", proto, "127.0.0.1", probeInput.Port, endpoint)
 }
 
 func readJWTFile(file string) ([]byte, error) {
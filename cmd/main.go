@@ -39,6 +39,8 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/version"
 
 	"github.com/arangodb/kube-arangodb/pkg/operator/scope"
+	operatorV2 "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
 
@@ -56,6 +58,7 @@ import (
 	flag "github.com/spf13/pflag"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -67,10 +70,12 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/operator"
 	"github.com/arangodb/kube-arangodb/pkg/server"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/dryrun"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
 	"github.com/arangodb/kube-arangodb/pkg/util/probe"
 	"github.com/arangodb/kube-arangodb/pkg/util/retry"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog"
 )
@@ -84,6 +89,8 @@ const (
 	defaultMetricsExporterImage = "arangodb/arangodb-exporter:0.1.6"
 	defaultArangoImage          = "arangodb/arangodb:latest"
 
+	defaultMemberStateRefreshInterval = 10 * time.Second
+
 	UBIImageEnv             util.EnvironmentVariable = "RELATED_IMAGE_UBI"
 	ArangoImageEnv          util.EnvironmentVariable = "RELATED_IMAGE_DATABASE"
 	MetricsExporterImageEnv util.EnvironmentVariable = "RELATED_IMAGE_METRICSEXPORTER"
@@ -95,9 +102,13 @@ var (
 		Run: executeMain,
 	}
 
-	logLevels     []string
-	cliLog        = logging.NewRootLogger()
-	logService    logging.Service
+	logLevels    []string
+	cliLog       = logging.NewRootLogger()
+	logService   logging.Service
+	logConfigMap string
+
+	featuresConfigMap string
+
 	serverOptions struct {
 		host            string
 		port            int
@@ -113,6 +124,7 @@ var (
 		enableApps                  bool // Run apps operator
 		versionOnly                 bool // Run only version endpoint, explicitly disabled with other
 		enableK2KClusterSync        bool // Run k2kClusterSync operator
+		dryRun                      bool // Do not mutate Kubernetes and ArangoDB resources
 
 		scalingIntegrationEnabled bool
 
@@ -120,12 +132,36 @@ var (
 
 		singleMode bool
 		scope      string
+
+		watchNamespaces        []string
+		watchNamespaceSelector string
+		deploymentSharding     bool
+
+		memberStateRefreshInterval time.Duration
+
+		eventsQPS   float32
+		eventsBurst int
+
+		handlerWorkers map[string]int
+
+		rateLimiter  operatorV2.RateLimiter
+		handlerQPS   map[string]string
+		handlerBurst map[string]int
+
+		shutdownTimeout time.Duration
+	}
+	tracingOptions struct {
+		otlpEndpoint string
+		serviceName  string
 	}
 	crdOptions struct {
 		install bool
 	}
 	operatorKubernetesOptions struct {
-		maxBatchSize int64
+		maxBatchSize         int64
+		clusterDomain        string
+		inspectorParallelism int
+		inspectorSelector    string
 
 		qps   float32
 		burst int
@@ -160,6 +196,8 @@ func init() {
 	f.StringVar(&serverOptions.adminSecretName, "server.admin-secret-name", defaultAdminSecretName, "Name of secret containing username + password for login to the dashboard")
 	f.BoolVar(&serverOptions.allowAnonymous, "server.allow-anonymous-access", false, "Allow anonymous access to the dashboard")
 	f.StringArrayVar(&logLevels, "log.level", []string{defaultLogLevel}, fmt.Sprintf("Set log levels in format <level> or <logger>=<level>. Possible loggers: %s", strings.Join(logging.LoggerNames(), ", ")))
+	f.StringVar(&featuresConfigMap, "features.config-map", "", "Name of the ConfigMap in the operator namespace with features (<feature>: <true|false>) enabled or disabled at runtime, overriding --deployment.feature flags")
+	f.StringVar(&logConfigMap, "log.config-map", "", "Name of the ConfigMap in the operator namespace with log levels of loggers (<logger>: <level>, \"default\" for the default level), applied at runtime")
 	f.BoolVar(&operatorOptions.enableDeployment, "operator.deployment", false, "Enable to run the ArangoDeployment operator")
 	f.BoolVar(&operatorOptions.enableDeploymentReplication, "operator.deployment-replication", false, "Enable to run the ArangoDeploymentReplication operator")
 	f.BoolVar(&operatorOptions.enableStorage, "operator.storage", false, "Enable to run the ArangoLocalStorage operator")
@@ -174,14 +212,34 @@ func init() {
 	f.BoolVar(&chaosOptions.allowed, "chaos.allowed", false, "Set to allow chaos in deployments. Only activated when allowed and enabled in deployment")
 	f.BoolVar(&operatorOptions.singleMode, "mode.single", false, "Enable single mode in Operator. WARNING: There should be only one replica of Operator, otherwise Operator can take unexpected actions")
 	f.StringVar(&operatorOptions.scope, "scope", scope.DefaultScope.String(), "Define scope on which Operator works. Legacy - pre 1.1.0 scope with limited cluster access")
+	f.StringSliceVar(&operatorOptions.watchNamespaces, "deployment.watch-namespace", nil, "Namespaces in which ArangoDeployments are managed, '*' for all namespaces. Defaults to the namespace of the Operator")
+	f.StringVar(&operatorOptions.watchNamespaceSelector, "deployment.watch-namespace-selector", "", "Label selector of namespaces in which ArangoDeployments are managed. All namespaces are watched when --deployment.watch-namespace is not set")
+	f.BoolVar(&operatorOptions.deploymentSharding, "deployment.sharding", false, "Share ArangoDeployments between all Operator replicas using consistent hashing instead of managing them by a single leader")
+	f.DurationVar(&operatorOptions.memberStateRefreshInterval, "deployment.member-state-refresh-interval", defaultMemberStateRefreshInterval, "Interval of the background refresh of the state of deployment members. State is refreshed in each inspection when set to 0")
+	f.Float32Var(&operatorOptions.eventsQPS, "events.qps", event.DefaultQPS, "Number of events per second sent by each operator handler, identical events are aggregated")
+	f.IntVar(&operatorOptions.eventsBurst, "events.burst", event.DefaultBurst, "Burst of events sent by each operator handler")
+	f.BoolVar(&operatorOptions.dryRun, "dry-run", false, "Compute and log changes without mutating Kubernetes and ArangoDB resources. Kubernetes mutations are sent as server side dry-run requests")
+	f.StringVar(&tracingOptions.otlpEndpoint, "tracing.otlp-endpoint", "", "OTLP/HTTP endpoint of the OpenTelemetry collector, e.g. http://otel-collector:4318. Tracing is disabled if empty")
+	f.StringVar(&tracingOptions.serviceName, "tracing.service-name", "arangodb-operator", "Service name reported in traces")
+	f.StringToIntVar(&operatorOptions.handlerWorkers, "operator.handler-workers", nil, "Number of dedicated workers per handler, e.g. ArangoJob=8. Objects are sharded between workers by namespace and name")
+	f.DurationVar(&operatorOptions.rateLimiter.BaseDelay, "operator.rate-limiter.base-delay", operatorV2.DefaultRateLimiterBaseDelay, "Delay of the first retry of a failed object in operator handlers")
+	f.DurationVar(&operatorOptions.rateLimiter.MaxDelay, "operator.rate-limiter.max-delay", operatorV2.DefaultRateLimiterMaxDelay, "Maximum delay of retries of a failed object in operator handlers")
+	f.Float64Var(&operatorOptions.rateLimiter.QPS, "operator.rate-limiter.qps", operatorV2.DefaultRateLimiterQPS, "Number of retries per second in operator handlers")
+	f.IntVar(&operatorOptions.rateLimiter.Burst, "operator.rate-limiter.burst", operatorV2.DefaultRateLimiterBurst, "Burst of retries in operator handlers")
+	f.StringToStringVar(&operatorOptions.handlerQPS, "operator.handler-qps", nil, "Number of retries per second per handler with dedicated workers, e.g. ArangoJob=5")
+	f.StringToIntVar(&operatorOptions.handlerBurst, "operator.handler-burst", nil, "Burst of retries per handler with dedicated workers, e.g. ArangoJob=50")
+	f.DurationVar(&operatorOptions.shutdownTimeout, "operator.shutdown-timeout", operator.DefaultShutdownTimeout, "Time given to the Operator on termination to hand over actions in progress and release leadership. Should be lower than the termination grace period of the Operator Pod")
 	f.DurationVar(&operatorTimeouts.k8s, "timeout.k8s", globals.DefaultKubernetesTimeout, "The request timeout to the kubernetes")
 	f.DurationVar(&operatorTimeouts.arangoD, "timeout.arangod", globals.DefaultArangoDTimeout, "The request timeout to the ArangoDB")
 	f.DurationVar(&operatorTimeouts.arangoDCheck, "timeout.arangod-check", globals.DefaultArangoDCheckTimeout, "The version check request timeout to the ArangoDB")
 	f.DurationVar(&operatorTimeouts.reconciliation, "timeout.reconciliation", globals.DefaultReconciliationTimeout, "The reconciliation timeout to the ArangoDB CR")
 	f.BoolVar(&operatorOptions.scalingIntegrationEnabled, "internal.scaling-integration", true, "Enable Scaling Integration")
 	f.Int64Var(&operatorKubernetesOptions.maxBatchSize, "kubernetes.max-batch-size", globals.DefaultKubernetesRequestBatchSize, "Size of batch during objects read")
+	f.StringVar(&operatorKubernetesOptions.clusterDomain, "kubernetes.cluster-domain", globals.DefaultKubernetesClusterDomain, "Default cluster domain appended to generated DNS names (e.g. cluster.local), can be overridden with spec.clusterDomain of deployment")
 	f.Float32Var(&operatorKubernetesOptions.qps, "kubernetes.qps", kclient.DefaultQPS, "Number of queries per second for k8s API")
 	f.IntVar(&operatorKubernetesOptions.burst, "kubernetes.burst", kclient.DefaultBurst, "Burst for the k8s API")
+	f.IntVar(&operatorKubernetesOptions.inspectorParallelism, "kubernetes.inspector-parallelism", globals.DefaultKubernetesInspectorParallelism, "Number of resource kinds loaded in parallel from the k8s API during inspection")
+	f.StringVar(&operatorKubernetesOptions.inspectorSelector, "kubernetes.inspector-selector", globals.DefaultKubernetesInspectorSelector, "Label selector used to scope secrets, services, service accounts and pod disruption budgets loaded during inspection. Resources created by the operator are loaded regardless of the selector, all other resources referenced by deployments need to match it")
 	f.BoolVar(&crdOptions.install, "crd.install", true, "Install missing CRD if access is possible")
 	f.IntVar(&operatorBackup.concurrentUploads, "backup-concurrent-uploads", globals.DefaultBackupConcurrentUploads, "Number of concurrent uploads per deployment")
 	features.Init(&cmdMain)
@@ -219,10 +277,31 @@ func executeMain(cmd *cobra.Command, args []string) {
 	globals.GetGlobalTimeouts().ArangoDCheck().Set(operatorTimeouts.arangoDCheck)
 	globals.GetGlobalTimeouts().Reconciliation().Set(operatorTimeouts.reconciliation)
 	globals.GetGlobals().Kubernetes().RequestBatchSize().Set(operatorKubernetesOptions.maxBatchSize)
+	globals.GetGlobals().Kubernetes().ClusterDomain().Set(operatorKubernetesOptions.clusterDomain)
+	if operatorKubernetesOptions.inspectorParallelism < 1 {
+		cliLog.Fatal().Int("value", operatorKubernetesOptions.inspectorParallelism).Msg("kubernetes.inspector-parallelism needs to be greater than 0")
+	}
+	globals.GetGlobals().Kubernetes().InspectorParallelism().Set(operatorKubernetesOptions.inspectorParallelism)
+	if operatorOptions.rateLimiter.QPS <= 0 || operatorOptions.rateLimiter.Burst < 1 {
+		cliLog.Fatal().Float64("qps", operatorOptions.rateLimiter.QPS).Int("burst", operatorOptions.rateLimiter.Burst).Msg("operator.rate-limiter.qps and operator.rate-limiter.burst need to be greater than 0")
+	}
+	if _, err := labels.Parse(operatorKubernetesOptions.inspectorSelector); err != nil {
+		cliLog.Fatal().Err(err).Str("value", operatorKubernetesOptions.inspectorSelector).Msg("kubernetes.inspector-selector is not a valid label selector")
+	}
+	globals.GetGlobals().Kubernetes().InspectorSelector().Set(operatorKubernetesOptions.inspectorSelector)
 	globals.GetGlobals().Backup().ConcurrentUploads().Set(operatorBackup.concurrentUploads)
 
+	if operatorKubernetesOptions.qps <= 0 || operatorKubernetesOptions.burst < 1 {
+		cliLog.Fatal().Float32("qps", operatorKubernetesOptions.qps).Int("burst", operatorKubernetesOptions.burst).Msg("kubernetes.qps and kubernetes.burst need to be greater than 0")
+	}
 	kclient.SetDefaultQPS(operatorKubernetesOptions.qps)
 	kclient.SetDefaultBurst(operatorKubernetesOptions.burst)
+	dryrun.SetEnabled(operatorOptions.dryRun)
+	if tracingOptions.otlpEndpoint != "" {
+		if err := tracing.Enable(tracingOptions.otlpEndpoint, tracingOptions.serviceName); err != nil {
+			cliLog.Fatal().Err(err).Msg("Failed to enable tracing")
+		}
+	}
 
 	// Prepare log service
 	var err error
@@ -257,6 +336,10 @@ func executeMain(cmd *cobra.Command, args []string) {
 		Str("pod-namespace", namespace).
 		Msgf("Starting arangodb-operator (%s), version %s build %s", version.GetVersionV1().Edition.Title(), version.GetVersionV1().Version, version.GetVersionV1().Build)
 
+	if operatorOptions.dryRun {
+		cliLog.Warn().Msg("Dry-run mode enabled, Kubernetes and ArangoDB resources are not modified")
+	}
+
 	// Check environment
 	if !operatorOptions.versionOnly {
 		if len(namespace) == 0 {
@@ -287,6 +370,14 @@ func executeMain(cmd *cobra.Command, args []string) {
 			crd.EnsureCRD(ctx, logService.MustGetLogger("crd"), client)
 		}
 
+		if logConfigMap != "" {
+			watchLogLevels(cliLog, client.Kubernetes(), namespace, logConfigMap, logService, make(chan struct{}))
+		}
+
+		if featuresConfigMap != "" {
+			watchFeatures(cliLog, client.Kubernetes(), namespace, featuresConfigMap, make(chan struct{}))
+		}
+
 		secrets := client.Kubernetes().CoreV1().Secrets(namespace)
 
 		// Create operator
@@ -347,8 +438,16 @@ func executeMain(cmd *cobra.Command, args []string) {
 
 		//	startChaos(context.Background(), cfg.KubeCli, cfg.Namespace, chaosLevel)
 
-		// Start operator
-		o.Run()
+		// Start operator, deployments are handed over on termination
+		o.Run(util.CreateSignalContext(context.Background()))
+
+		// Export spans which are not exported yet
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := tracing.Shutdown(ctx); err != nil {
+			cliLog.Warn().Err(err).Msg("Failed to shut down tracing")
+		}
 	} else {
 		if err := startVersionProcess(); err != nil {
 			cliLog.Fatal().Err(err).Msg("Failed to create HTTP server")
@@ -399,6 +498,19 @@ func newOperatorConfigAndDeps(id, namespace, name string) (operator.Config, oper
 		return operator.Config{}, operator.Dependencies{}, errors.WithStack(fmt.Errorf("Scope %s is not known by Operator", operatorOptions.scope))
 	}
 
+	var watchNamespaceSelector labels.Selector
+	if operatorOptions.watchNamespaceSelector != "" {
+		watchNamespaceSelector, err = labels.Parse(operatorOptions.watchNamespaceSelector)
+		if err != nil {
+			return operator.Config{}, operator.Dependencies{}, errors.WithStack(fmt.Errorf("deployment.watch-namespace-selector is not a valid label selector: %s", err))
+		}
+	}
+
+	handlerRateLimiters, err := newHandlerRateLimiters(operatorOptions.rateLimiter, operatorOptions.handlerQPS, operatorOptions.handlerBurst)
+	if err != nil {
+		return operator.Config{}, operator.Dependencies{}, errors.WithStack(err)
+	}
+
 	cfg := operator.Config{
 		ID:                          id,
 		Namespace:                   namespace,
@@ -414,8 +526,18 @@ func newOperatorConfigAndDeps(id, namespace, name string) (operator.Config, oper
 		AllowChaos:                  chaosOptions.allowed,
 		ScalingIntegrationEnabled:   operatorOptions.scalingIntegrationEnabled,
 		ArangoImage:                 operatorOptions.arangoImage,
-		SingleMode:                  operatorOptions.singleMode,
+		SingleMode:                  operatorOptions.singleMode || operatorOptions.dryRun,
 		Scope:                       scope,
+		WatchNamespaces:             operatorOptions.watchNamespaces,
+		WatchNamespaceSelector:      watchNamespaceSelector,
+		DeploymentSharding:          operatorOptions.deploymentSharding && !operatorOptions.singleMode && !operatorOptions.dryRun,
+		MemberStateRefreshInterval:  operatorOptions.memberStateRefreshInterval,
+		EventsQPS:                   operatorOptions.eventsQPS,
+		EventsBurst:                 operatorOptions.eventsBurst,
+		HandlerWorkers:              operatorOptions.handlerWorkers,
+		RateLimiter:                 operatorOptions.rateLimiter,
+		HandlerRateLimiters:         handlerRateLimiters,
+		ShutdownTimeout:             operatorOptions.shutdownTimeout,
 	}
 	deps := operator.Dependencies{
 		LogService:                 logService,
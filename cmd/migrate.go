@@ -0,0 +1,242 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/equality"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+// deprecatedStorageVersion is the API version which is not supported by the operator anymore
+const deprecatedStorageVersion = "v1alpha"
+
+var migrateOptions struct {
+	namespace string
+	dryRun    bool
+}
+
+func init() {
+	cmdMain.AddCommand(cmdMigrate)
+
+	f := cmdMigrate.Flags()
+	f.StringVarP(&migrateOptions.namespace, "namespace", "n", "", "Migrate only resources in the namespace, all namespaces are migrated by default")
+	f.BoolVar(&migrateOptions.dryRun, "dry-run", false, "Print changes and send updates as server side dry-run requests")
+}
+
+var cmdMigrate = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate stored resources from deprecated API versions",
+	Long: "It rewrites ArangoDeployments and ArangoBackups stored in the v1alpha version as v1, renames deprecated fields and applies defaults. " +
+		"When all resources in the cluster are migrated, v1alpha is removed from the stored versions of the CRDs.",
+	Run: cmdMigrateRun,
+}
+
+func cmdMigrateRun(_ *cobra.Command, _ []string) {
+	client, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		cliLog.Fatal().Msg("Client not initialised")
+	}
+
+	m := &resourceMigrator{
+		client: client,
+		out:    os.Stdout,
+		dryRun: migrateOptions.dryRun,
+	}
+
+	if err := m.Migrate(getInterruptionContext(), migrateOptions.namespace); err != nil {
+		cliLog.Fatal().Err(err).Msg("migration failed")
+	}
+}
+
+// resourceMigrator rewrites resources, so they are stored in the v1 version
+type resourceMigrator struct {
+	client kclient.Client
+	out    io.Writer
+	dryRun bool
+}
+
+func (m *resourceMigrator) updateOptions() meta.UpdateOptions {
+	if m.dryRun {
+		return meta.UpdateOptions{DryRun: []string{meta.DryRunAll}}
+	}
+
+	return meta.UpdateOptions{}
+}
+
+// Migrate rewrites all ArangoDeployments and ArangoBackups in the namespace
+func (m *resourceMigrator) Migrate(ctx context.Context, namespace string) error {
+	failed := 0
+
+	deployments, err := m.client.Arango().DatabaseV1().ArangoDeployments(namespace).List(ctx, meta.ListOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Unable to list ArangoDeployments")
+	}
+
+	for _, depl := range deployments.Items {
+		if err := m.migrateDeployment(ctx, depl.DeepCopy()); err != nil {
+			fmt.Fprintf(m.out, "%s %s/%s: error: %s\n", deployment.ArangoDeploymentResourceKind, depl.GetNamespace(), depl.GetName(), err.Error())
+			failed++
+		}
+	}
+
+	backups, err := m.client.Arango().BackupV1().ArangoBackups(namespace).List(ctx, meta.ListOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Unable to list ArangoBackups")
+	}
+
+	for _, b := range backups.Items {
+		obj := b.DeepCopy()
+		if _, err := m.client.Arango().BackupV1().ArangoBackups(obj.GetNamespace()).Update(ctx, obj, m.updateOptions()); err != nil {
+			fmt.Fprintf(m.out, "%s %s/%s: error: %s\n", backup.ArangoBackupResourceKind, obj.GetNamespace(), obj.GetName(), err.Error())
+			failed++
+			continue
+		}
+
+		fmt.Fprintf(m.out, "%s %s/%s: migrated\n", backup.ArangoBackupResourceKind, obj.GetNamespace(), obj.GetName())
+	}
+
+	if failed > 0 {
+		return errors.Newf("%d resources were not migrated", failed)
+	}
+
+	if namespace != "" {
+		fmt.Fprintf(m.out, "Resources in the namespace %s migrated, run migration for all namespaces to remove %s from stored versions of the CRDs\n", namespace, deprecatedStorageVersion)
+		return nil
+	}
+
+	for _, crd := range []string{deployment.ArangoDeploymentCRDName, backup.ArangoBackupCRDName} {
+		if err := m.removeDeprecatedStoredVersion(ctx, crd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *resourceMigrator) migrateDeployment(ctx context.Context, depl *api.ArangoDeployment) error {
+	original := depl.Spec.DeepCopy()
+
+	renamed := migrateDeploymentSpec(&depl.Spec)
+	for _, field := range renamed {
+		fmt.Fprintf(m.out, "%s %s/%s: renamed %s\n", deployment.ArangoDeploymentResourceKind, depl.GetNamespace(), depl.GetName(), field)
+	}
+
+	if accepted := depl.Status.AcceptedSpec; accepted != nil {
+		depl.Spec.SetDefaultsFrom(*accepted)
+	}
+	depl.Spec.SetDefaults(depl.GetName())
+
+	if err := depl.Spec.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation failed")
+	}
+
+	if !equality.Semantic.DeepEqual(original, &depl.Spec) {
+		fmt.Fprintf(m.out, "%s %s/%s: spec updated\n", deployment.ArangoDeploymentResourceKind, depl.GetNamespace(), depl.GetName())
+	}
+
+	if _, err := m.client.Arango().DatabaseV1().ArangoDeployments(depl.GetNamespace()).Update(ctx, depl, m.updateOptions()); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(m.out, "%s %s/%s: migrated\n", deployment.ArangoDeploymentResourceKind, depl.GetNamespace(), depl.GetName())
+	return nil
+}
+
+// serverGroupSpecFields maps server groups to their fields in the ArangoDeployment spec
+var serverGroupSpecFields = map[api.ServerGroup]string{
+	api.ServerGroupSingle:       "single",
+	api.ServerGroupAgents:       "agents",
+	api.ServerGroupDBServers:    "dbservers",
+	api.ServerGroupCoordinators: "coordinators",
+	api.ServerGroupSyncMasters:  "syncmasters",
+	api.ServerGroupSyncWorkers:  "syncworkers",
+}
+
+// migrateDeploymentSpec moves values of renamed fields to their current names and returns the renamed fields
+func migrateDeploymentSpec(spec *api.DeploymentSpec) []string {
+	var renamed []string
+
+	for _, group := range api.AllServerGroups {
+		groupSpec := spec.GetServerGroupSpec(group)
+
+		probes := groupSpec.Probes
+		if probes == nil || probes.OldReadinessProbeDisabled == nil {
+			continue
+		}
+
+		if probes.ReadinessProbeDisabled == nil {
+			probes.ReadinessProbeDisabled = probes.OldReadinessProbeDisabled
+		}
+		probes.OldReadinessProbeDisabled = nil
+
+		spec.UpdateServerGroupSpec(group, groupSpec)
+
+		renamed = append(renamed, fmt.Sprintf("spec.%s.probes.ReadinessProbeDisabled to spec.%s.probes.readinessProbeDisabled", serverGroupSpecFields[group], serverGroupSpecFields[group]))
+	}
+
+	return renamed
+}
+
+// removeDeprecatedStoredVersion removes the deprecated version from the stored versions of the CRD,
+// so the version can be removed from the CRD
+func (m *resourceMigrator) removeDeprecatedStoredVersion(ctx context.Context, name string) error {
+	crds := m.client.KubernetesExtensions().ApiextensionsV1().CustomResourceDefinitions()
+
+	crd, err := crds.Get(ctx, name, meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "Unable to get CRD %s", name)
+	}
+
+	versions := make([]string, 0, len(crd.Status.StoredVersions))
+	for _, v := range crd.Status.StoredVersions {
+		if v != deprecatedStorageVersion {
+			versions = append(versions, v)
+		}
+	}
+
+	if len(versions) == len(crd.Status.StoredVersions) {
+		return nil
+	}
+
+	crd.Status.StoredVersions = versions
+	if _, err := crds.UpdateStatus(ctx, crd, m.updateOptions()); err != nil {
+		return errors.Wrapf(err, "Unable to update stored versions of CRD %s", name)
+	}
+
+	fmt.Fprintf(m.out, "CRD %s: %s removed from stored versions\n", name, deprecatedStorageVersion)
+	return nil
+}
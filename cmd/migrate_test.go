@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+func Test_MigrateDeploymentSpec(t *testing.T) {
+	spec := api.DeploymentSpec{
+		DBServers: api.ServerGroupSpec{
+			Probes: &api.ServerGroupProbesSpec{
+				OldReadinessProbeDisabled: util.NewBool(true),
+			},
+		},
+	}
+
+	renamed := migrateDeploymentSpec(&spec)
+	require.Len(t, renamed, 1)
+	require.Nil(t, spec.DBServers.Probes.OldReadinessProbeDisabled)
+	require.True(t, *spec.DBServers.Probes.ReadinessProbeDisabled)
+
+	require.Empty(t, migrateDeploymentSpec(&spec))
+}
+
+func Test_Migrate(t *testing.T) {
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{Name: "example", Namespace: "ns"},
+		Spec: api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeCluster),
+			Coordinators: api.ServerGroupSpec{
+				Probes: &api.ServerGroupProbesSpec{
+					OldReadinessProbeDisabled: util.NewBool(true),
+				},
+			},
+		},
+	}
+	b := &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{Name: "backup", Namespace: "ns"},
+		Spec:       backupApi.ArangoBackupSpec{Deployment: backupApi.ArangoBackupSpecDeployment{Name: "example"}},
+	}
+	crd := &apiextensions.CustomResourceDefinition{
+		ObjectMeta: meta.ObjectMeta{Name: deployment.ArangoDeploymentCRDName},
+		Status:     apiextensions.CustomResourceDefinitionStatus{StoredVersions: []string{"v1alpha", "v1"}},
+	}
+
+	run := func(t *testing.T, dryRun bool) (kclient.Client, string) {
+		client := kclient.NewFakeClientBuilder().Arango(depl, b).KubernetesExtensions(crd).Client()
+		var out bytes.Buffer
+
+		m := &resourceMigrator{client: client, out: &out, dryRun: dryRun}
+		require.NoError(t, m.Migrate(context.Background(), ""))
+
+		return client, out.String()
+	}
+
+	t.Run("Migrate", func(t *testing.T) {
+		client, out := run(t, false)
+		require.Contains(t, out, "ArangoDeployment ns/example: renamed spec.coordinators.probes.ReadinessProbeDisabled")
+		require.Contains(t, out, "ArangoBackup ns/backup: migrated")
+
+		d, err := client.Arango().DatabaseV1().ArangoDeployments("ns").Get(context.Background(), "example", meta.GetOptions{})
+		require.NoError(t, err)
+		require.Nil(t, d.Spec.Coordinators.Probes.OldReadinessProbeDisabled)
+		require.True(t, *d.Spec.Coordinators.Probes.ReadinessProbeDisabled)
+		require.NotNil(t, d.Spec.Image)
+
+		c, err := client.KubernetesExtensions().ApiextensionsV1().CustomResourceDefinitions().Get(context.Background(), deployment.ArangoDeploymentCRDName, meta.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1"}, c.Status.StoredVersions)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		_, out := run(t, true)
+		require.Contains(t, out, "ArangoDeployment ns/example: migrated")
+	})
+}
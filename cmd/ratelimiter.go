@@ -0,0 +1,62 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"strconv"
+
+	operatorV2 "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// newHandlerRateLimiters returns rate limits per handler, with values not overridden taken from the default limiter.
+func newHandlerRateLimiters(limiter operatorV2.RateLimiter, qps map[string]string, burst map[string]int) (map[string]operatorV2.RateLimiter, error) {
+	limiters := map[string]operatorV2.RateLimiter{}
+
+	for handler, value := range qps {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || v <= 0 {
+			return nil, errors.Newf("operator.handler-qps of %s needs to be a positive number, got %s", handler, value)
+		}
+
+		l, ok := limiters[handler]
+		if !ok {
+			l = limiter
+		}
+		l.QPS = v
+		limiters[handler] = l
+	}
+
+	for handler, value := range burst {
+		if value <= 0 {
+			return nil, errors.Newf("operator.handler-burst of %s needs to be greater than 0, got %d", handler, value)
+		}
+
+		l, ok := limiters[handler]
+		if !ok {
+			l = limiter
+		}
+		l.Burst = value
+		limiters[handler] = l
+	}
+
+	return limiters, nil
+}
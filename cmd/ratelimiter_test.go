@@ -0,0 +1,59 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	operatorV2 "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+)
+
+func Test_HandlerRateLimiters(t *testing.T) {
+	limiter := operatorV2.RateLimiter{BaseDelay: time.Second, MaxDelay: time.Minute, QPS: 10, Burst: 100}
+
+	t.Run("Empty", func(t *testing.T) {
+		limiters, err := newHandlerRateLimiters(limiter, nil, nil)
+		require.NoError(t, err)
+		require.Empty(t, limiters)
+	})
+
+	t.Run("Overrides", func(t *testing.T) {
+		limiters, err := newHandlerRateLimiters(limiter, map[string]string{"ArangoJob": "0.5", "ArangoBackup": "2"}, map[string]int{"ArangoJob": 5, "ArangoTask": 20})
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]operatorV2.RateLimiter{
+			"ArangoJob":    {BaseDelay: time.Second, MaxDelay: time.Minute, QPS: 0.5, Burst: 5},
+			"ArangoBackup": {BaseDelay: time.Second, MaxDelay: time.Minute, QPS: 2, Burst: 100},
+			"ArangoTask":   {BaseDelay: time.Second, MaxDelay: time.Minute, QPS: 10, Burst: 20},
+		}, limiters)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := newHandlerRateLimiters(limiter, map[string]string{"ArangoJob": "fast"}, nil)
+		require.Error(t, err)
+
+		_, err = newHandlerRateLimiters(limiter, nil, map[string]int{"ArangoJob": 0})
+		require.Error(t, err)
+	})
+}
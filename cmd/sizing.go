@@ -0,0 +1,314 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	gi = int64(1024 * 1024 * 1024)
+
+	// sizingVolumeOverhead is the ratio of the volume size to the data stored on the server.
+	// Free space is required for compaction, WAL files and indexes.
+	sizingVolumeOverhead = 2
+	// sizingMemoryRatio is the ratio of the data stored on the server to its memory
+	sizingMemoryRatio = 4
+	// sizingMemoryPerCPU is the amount of memory for which one CPU is recommended
+	sizingMemoryPerCPU = 4 * gi
+	// sizingLargeClusterDBServers is the number of DBServers from which 5 agents are recommended
+	sizingLargeClusterDBServers = 12
+
+	sizingMinVolume         = 8 * gi
+	sizingMinMemory         = 2 * gi
+	sizingMaxMemory         = 64 * gi
+	sizingAgentMemory       = 1 * gi
+	sizingCoordinatorCPU    = 2
+	sizingCoordinatorMemory = 4 * gi
+)
+
+var sizingOptions struct {
+	name              string
+	mode              string
+	dbservers         int
+	coordinators      int
+	replicationFactor int
+	datasetSize       string
+	output            string
+}
+
+func init() {
+	cmdMain.AddCommand(cmdSizing)
+
+	f := cmdSizing.Flags()
+	f.StringVar(&sizingOptions.name, "name", "example", "Name of the generated ArangoDeployment")
+	f.StringVar(&sizingOptions.mode, "mode", string(api.DeploymentModeCluster), "Deployment mode, one of Single, ActiveFailover or Cluster")
+	f.IntVar(&sizingOptions.dbservers, "dbservers", 3, "Number of DBServers")
+	f.IntVar(&sizingOptions.coordinators, "coordinators", 0, "Number of Coordinators, the number of DBServers is used by default")
+	f.IntVar(&sizingOptions.replicationFactor, "replication-factor", 2, "Expected replication factor of collections")
+	f.StringVar(&sizingOptions.datasetSize, "dataset-size", "", "Expected size of the dataset, e.g. 100Gi")
+	f.StringVarP(&sizingOptions.output, "output", "o", "yaml", "Output format, one of yaml or json")
+}
+
+var cmdSizing = &cobra.Command{
+	Use:   "sizing",
+	Short: "Recommend resources for a deployment",
+	Long: "It prints an ArangoDeployment with recommended resources, volume sizes and agency size " +
+		"calculated from the deployment mode, number of servers and the expected dataset size.",
+	Run: cmdSizingRun,
+}
+
+func cmdSizingRun(cmd *cobra.Command, _ []string) {
+	if sizingOptions.datasetSize == "" {
+		cmd.Usage()
+		os.Exit(1)
+	}
+
+	datasetSize, err := resource.ParseQuantity(sizingOptions.datasetSize)
+	if err != nil {
+		cliLog.Fatal().Err(err).Msg("invalid dataset size")
+	}
+
+	depl, err := recommendSizing(sizingInput{
+		name:              sizingOptions.name,
+		mode:              api.DeploymentMode(sizingOptions.mode),
+		dbservers:         sizingOptions.dbservers,
+		coordinators:      sizingOptions.coordinators,
+		replicationFactor: sizingOptions.replicationFactor,
+		datasetSize:       datasetSize,
+	})
+	if err != nil {
+		cliLog.Fatal().Err(err).Msg("unable to recommend sizing")
+	}
+
+	if err := printSizing(os.Stdout, depl, sizingOptions.output); err != nil {
+		cliLog.Fatal().Err(err).Msg("unable to print sizing")
+	}
+}
+
+// sizingInput describes the deployment for which the sizing is recommended
+type sizingInput struct {
+	name              string
+	mode              api.DeploymentMode
+	dbservers         int
+	coordinators      int
+	replicationFactor int
+	datasetSize       resource.Quantity
+}
+
+// recommendSizing returns ArangoDeployment with resources calculated with built-in heuristics
+func recommendSizing(in sizingInput) (*api.ArangoDeployment, error) {
+	if err := in.mode.Validate(); err != nil {
+		return nil, err
+	}
+
+	dataset := in.datasetSize.Value()
+	if dataset <= 0 {
+		return nil, errors.Newf("Dataset size must be greater than 0")
+	}
+
+	depl := &api.ArangoDeployment{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoDeploymentResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name: in.name,
+		},
+		Spec: api.DeploymentSpec{
+			Mode: api.NewMode(in.mode),
+		},
+	}
+
+	if in.mode.HasSingleServers() {
+		// Each single server keeps the whole dataset
+		depl.Spec.Single = sizingServerGroup(dataset)
+		if in.mode == api.DeploymentModeActiveFailover {
+			depl.Spec.Single.Count = util.NewInt(2)
+		}
+	}
+
+	if in.mode.HasDBServers() {
+		if in.dbservers < 1 {
+			return nil, errors.Newf("Number of DBServers must be greater than 0")
+		}
+		if in.replicationFactor < 1 || in.replicationFactor > in.dbservers {
+			return nil, errors.Newf("Replication factor must be between 1 and the number of DBServers (%d)", in.dbservers)
+		}
+
+		// Replicas are distributed evenly across DBServers
+		perServer := divCeil(dataset*int64(in.replicationFactor), int64(in.dbservers))
+
+		depl.Spec.DBServers = sizingServerGroup(perServer)
+		depl.Spec.DBServers.Count = util.NewInt(in.dbservers)
+	}
+
+	if in.mode.HasCoordinators() {
+		coordinators := in.coordinators
+		if coordinators < 1 {
+			coordinators = in.dbservers
+		}
+
+		depl.Spec.Coordinators = api.ServerGroupSpec{
+			Count:     util.NewInt(coordinators),
+			Resources: sizingResources(sizingCoordinatorCPU, sizingCoordinatorMemory),
+		}
+	}
+
+	if in.mode.HasAgents() {
+		agents := 3
+		if in.dbservers >= sizingLargeClusterDBServers {
+			agents = 5
+		}
+
+		depl.Spec.Agents = api.ServerGroupSpec{
+			Count:               util.NewInt(agents),
+			Resources:           sizingResources(0, sizingAgentMemory),
+			VolumeClaimTemplate: sizingVolumeClaimTemplate(sizingMinVolume),
+		}
+		depl.Spec.Agents.Resources.Requests[core.ResourceCPU] = resource.MustParse("500m")
+	}
+
+	return depl, nil
+}
+
+// sizingServerGroup returns spec of the group which keeps the given amount of data on each server
+func sizingServerGroup(data int64) api.ServerGroupSpec {
+	volume := roundUpGi(data * sizingVolumeOverhead)
+	if volume < sizingMinVolume {
+		volume = sizingMinVolume
+	}
+
+	memory := roundUpGi(divCeil(data, sizingMemoryRatio))
+	if memory < sizingMinMemory {
+		memory = sizingMinMemory
+	} else if memory > sizingMaxMemory {
+		memory = sizingMaxMemory
+	}
+
+	return api.ServerGroupSpec{
+		Resources:           sizingResources(divCeil(memory, sizingMemoryPerCPU), memory),
+		VolumeClaimTemplate: sizingVolumeClaimTemplate(volume),
+	}
+}
+
+// sizingResources returns requirements with the memory limit equal to the request, so the server is not evicted
+func sizingResources(cpu, memory int64) core.ResourceRequirements {
+	r := core.ResourceRequirements{
+		Requests: core.ResourceList{
+			core.ResourceMemory: *resource.NewQuantity(memory, resource.BinarySI),
+		},
+		Limits: core.ResourceList{
+			core.ResourceMemory: *resource.NewQuantity(memory, resource.BinarySI),
+		},
+	}
+
+	if cpu > 0 {
+		r.Requests[core.ResourceCPU] = *resource.NewQuantity(cpu, resource.DecimalSI)
+	}
+
+	return r
+}
+
+func sizingVolumeClaimTemplate(size int64) *core.PersistentVolumeClaim {
+	return &core.PersistentVolumeClaim{
+		Spec: core.PersistentVolumeClaimSpec{
+			AccessModes: []core.PersistentVolumeAccessMode{core.ReadWriteOnce},
+			Resources: core.ResourceRequirements{
+				Requests: core.ResourceList{
+					core.ResourceStorage: *resource.NewQuantity(size, resource.BinarySI),
+				},
+			},
+		},
+	}
+}
+
+// printSizing prints the deployment without status and empty fields, so it can be used as a template
+func printSizing(out io.Writer, depl *api.ArangoDeployment, output string) error {
+	data, err := json.Marshal(depl)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return errors.WithStack(err)
+	}
+	delete(obj, "status")
+	pruneEmpty(obj)
+
+	switch output {
+	case "yaml":
+		data, err = yaml.Marshal(obj)
+	case "json":
+		data, err = json.MarshalIndent(obj, "", "  ")
+	default:
+		return errors.Newf("Output format %s is not supported", output)
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = out.Write(data)
+	return err
+}
+
+// pruneEmpty removes nulls and empty objects, returns true if the object is empty after pruning
+func pruneEmpty(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		for k, e := range t {
+			if pruneEmpty(e) {
+				delete(t, k)
+			}
+		}
+		return len(t) == 0
+	case []interface{}:
+		for _, e := range t {
+			pruneEmpty(e)
+		}
+	}
+
+	return false
+}
+
+func divCeil(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+func roundUpGi(v int64) int64 {
+	return divCeil(v, gi) * gi
+}
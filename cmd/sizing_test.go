@@ -0,0 +1,110 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func Test_RecommendSizing(t *testing.T) {
+	t.Run("Cluster", func(t *testing.T) {
+		depl, err := recommendSizing(sizingInput{
+			name:              "example",
+			mode:              api.DeploymentModeCluster,
+			dbservers:         3,
+			replicationFactor: 2,
+			datasetSize:       resource.MustParse("100Gi"),
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 3, depl.Spec.DBServers.GetCount())
+		require.Equal(t, 3, depl.Spec.Coordinators.GetCount())
+		require.Equal(t, 3, depl.Spec.Agents.GetCount())
+
+		volume := depl.Spec.DBServers.VolumeClaimTemplate.Spec.Resources.Requests[core.ResourceStorage]
+		require.Equal(t, "134Gi", volume.String())
+		memory := depl.Spec.DBServers.Resources.Limits[core.ResourceMemory]
+		require.Equal(t, "17Gi", memory.String())
+
+		depl.Spec.SetDefaults(depl.GetName())
+		require.NoError(t, depl.Spec.Validate())
+	})
+
+	t.Run("Large cluster", func(t *testing.T) {
+		depl, err := recommendSizing(sizingInput{
+			mode:              api.DeploymentModeCluster,
+			dbservers:         12,
+			coordinators:      4,
+			replicationFactor: 3,
+			datasetSize:       resource.MustParse("10Ti"),
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 5, depl.Spec.Agents.GetCount())
+		require.Equal(t, 4, depl.Spec.Coordinators.GetCount())
+		memory := depl.Spec.DBServers.Resources.Limits[core.ResourceMemory]
+		require.Equal(t, "64Gi", memory.String())
+	})
+
+	t.Run("ActiveFailover", func(t *testing.T) {
+		depl, err := recommendSizing(sizingInput{
+			mode:        api.DeploymentModeActiveFailover,
+			datasetSize: resource.MustParse("1Gi"),
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 2, depl.Spec.Single.GetCount())
+		require.Equal(t, 3, depl.Spec.Agents.GetCount())
+		volume := depl.Spec.Single.VolumeClaimTemplate.Spec.Resources.Requests[core.ResourceStorage]
+		require.Equal(t, "8Gi", volume.String())
+	})
+
+	t.Run("Invalid replication factor", func(t *testing.T) {
+		_, err := recommendSizing(sizingInput{
+			mode:              api.DeploymentModeCluster,
+			dbservers:         2,
+			replicationFactor: 3,
+			datasetSize:       resource.MustParse("1Gi"),
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("Print", func(t *testing.T) {
+		depl, err := recommendSizing(sizingInput{
+			name:        "example",
+			mode:        api.DeploymentModeSingle,
+			datasetSize: resource.MustParse("1Gi"),
+		})
+		require.NoError(t, err)
+
+		var out bytes.Buffer
+		require.NoError(t, printSizing(&out, depl, "yaml"))
+		require.NotContains(t, out.String(), "status")
+		require.Contains(t, out.String(), "mode: Single")
+	})
+}
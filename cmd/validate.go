@@ -0,0 +1,250 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilYaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/apps"
+	appsApi "github.com/arangodb/kube-arangodb/pkg/apis/apps/v1"
+	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/apis/replication"
+	replicationApi "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	storageApi "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+var validateOptions struct {
+	files []string
+}
+
+func init() {
+	cmdMain.AddCommand(cmdValidate)
+
+	cmdValidate.Flags().StringArrayVarP(&validateOptions.files, "file", "f", nil, "File with resources to validate, \"-\" reads from the stdin. Can be repeated")
+}
+
+var cmdValidate = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate resource specifications",
+	Long:  "It applies defaults and validates specifications of the resources the same way as the operator does. Exits with non-zero code if any resource is invalid.",
+	Run:   cmdValidateRun,
+}
+
+func cmdValidateRun(cmd *cobra.Command, _ []string) {
+	if len(validateOptions.files) == 0 {
+		cmd.Usage()
+		os.Exit(1)
+	}
+
+	invalid := 0
+	for _, file := range validateOptions.files {
+		in := io.Reader(os.Stdin)
+		if file != "-" {
+			f, err := os.Open(file)
+			if err != nil {
+				cliLog.Fatal().Err(err).Msgf("failed to open file %s", file)
+			}
+			defer f.Close()
+			in = f
+		}
+
+		count, err := validateSpecs(os.Stdout, file, in)
+		if err != nil {
+			cliLog.Fatal().Err(err).Msgf("failed to read file %s", file)
+		}
+
+		invalid += count
+	}
+
+	if invalid > 0 {
+		os.Exit(1)
+	}
+}
+
+// specValidator decodes the resource, applies defaults and validates it. Returns the name of the resource and warnings.
+type specValidator func(data []byte) (string, []string, error)
+
+var specValidators = map[schema.GroupKind]specValidator{
+	{Group: deploymentApi.SchemeGroupVersion.Group, Kind: deployment.ArangoDeploymentResourceKind}: func(data []byte) (string, []string, error) {
+		var obj deploymentApi.ArangoDeployment
+		return validateSpec(data, &obj, func() error {
+			obj.Spec.SetDefaults(obj.GetName())
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: deploymentApi.SchemeGroupVersion.Group, Kind: deployment.ArangoClusterSynchronizationResourceKind}: func(data []byte) (string, []string, error) {
+		var obj deploymentApi.ArangoClusterSynchronization
+		return validateSpec(data, &obj, func() error {
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: deploymentApi.SchemeGroupVersion.Group, Kind: deployment.ArangoCollectionResourceKind}: func(data []byte) (string, []string, error) {
+		var obj deploymentApi.ArangoCollection
+		return validateSpec(data, &obj, func() error {
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: deploymentApi.SchemeGroupVersion.Group, Kind: deployment.ArangoDatabaseResourceKind}: func(data []byte) (string, []string, error) {
+		var obj deploymentApi.ArangoDatabase
+		return validateSpec(data, &obj, func() error {
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: deploymentApi.SchemeGroupVersion.Group, Kind: deployment.ArangoFoxxServiceResourceKind}: func(data []byte) (string, []string, error) {
+		var obj deploymentApi.ArangoFoxxService
+		return validateSpec(data, &obj, func() error {
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: deploymentApi.SchemeGroupVersion.Group, Kind: deployment.ArangoUserResourceKind}: func(data []byte) (string, []string, error) {
+		var obj deploymentApi.ArangoUser
+		return validateSpec(data, &obj, func() error {
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: replicationApi.SchemeGroupVersion.Group, Kind: replication.ArangoDeploymentReplicationResourceKind}: func(data []byte) (string, []string, error) {
+		var obj replicationApi.ArangoDeploymentReplication
+		return validateSpec(data, &obj, func() error {
+			obj.Spec.SetDefaults()
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: storageApi.SchemeGroupVersion.Group, Kind: storageApi.ArangoLocalStorageResourceKind}: func(data []byte) (string, []string, error) {
+		var obj storageApi.ArangoLocalStorage
+		return validateSpec(data, &obj, func() error {
+			obj.Spec.SetDefaults(obj.GetName())
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: backupApi.SchemeGroupVersion.Group, Kind: backup.ArangoBackupResourceKind}: func(data []byte) (string, []string, error) {
+		var obj backupApi.ArangoBackup
+		return validateSpec(data, &obj, func() error {
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: backupApi.SchemeGroupVersion.Group, Kind: backup.ArangoBackupPolicyResourceKind}: func(data []byte) (string, []string, error) {
+		var obj backupApi.ArangoBackupPolicy
+		return validateSpec(data, &obj, func() error {
+			return obj.Spec.Validate()
+		})
+	},
+	{Group: appsApi.SchemeGroupVersion.Group, Kind: apps.ArangoJobResourceKind}: func(data []byte) (string, []string, error) {
+		var obj appsApi.ArangoJob
+		return validateSpec(data, &obj, func() error {
+			return obj.Spec.Validate()
+		})
+	},
+}
+
+// validateSpec decodes data into the object and runs the validation.
+// Fields unknown to the operator are reported as warnings, as they are ignored by the operator.
+func validateSpec(data []byte, obj meta.Object, validate func() error) (string, []string, error) {
+	var warnings []string
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(obj); err != nil {
+		warnings = append(warnings, err.Error())
+
+		if err := json.Unmarshal(data, obj); err != nil {
+			return "", warnings, errors.WithStack(err)
+		}
+	}
+
+	return obj.GetName(), warnings, validate()
+}
+
+// validateSpecs validates all resources from the YAML or JSON stream and prints the results.
+// Returns the number of invalid resources.
+func validateSpecs(out io.Writer, source string, in io.Reader) (int, error) {
+	reader := utilYaml.NewYAMLReader(bufio.NewReader(in))
+
+	invalid := 0
+	for id := 0; ; id++ {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return invalid, nil
+		} else if err != nil {
+			return invalid, errors.WithStack(err)
+		}
+
+		data, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			fmt.Fprintf(out, "%s[%d]: error: %s\n", source, id, err.Error())
+			invalid++
+			continue
+		}
+
+		if len(bytes.TrimSpace(data)) == 0 || string(bytes.TrimSpace(data)) == "null" {
+			continue
+		}
+
+		var typeMeta meta.TypeMeta
+		if err := json.Unmarshal(data, &typeMeta); err != nil {
+			fmt.Fprintf(out, "%s[%d]: error: %s\n", source, id, err.Error())
+			invalid++
+			continue
+		}
+
+		gv, err := schema.ParseGroupVersion(typeMeta.APIVersion)
+		if err != nil {
+			fmt.Fprintf(out, "%s[%d]: error: %s\n", source, id, err.Error())
+			invalid++
+			continue
+		}
+
+		validator, ok := specValidators[gv.WithKind(typeMeta.Kind).GroupKind()]
+		if !ok {
+			fmt.Fprintf(out, "%s[%d]: warning: %s %s is not managed by the operator, skipped\n", source, id, typeMeta.APIVersion, typeMeta.Kind)
+			continue
+		}
+
+		name, warnings, err := validator(data)
+		resource := fmt.Sprintf("%s[%d]: %s/%s", source, id, typeMeta.Kind, name)
+
+		for _, warning := range warnings {
+			fmt.Fprintf(out, "%s: warning: %s\n", resource, warning)
+		}
+
+		if err != nil {
+			fmt.Fprintf(out, "%s: error: %s\n", resource, err.Error())
+			invalid++
+			continue
+		}
+
+		fmt.Fprintf(out, "%s: valid\n", resource)
+	}
+}
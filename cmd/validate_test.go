@@ -0,0 +1,86 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateSpecs(t *testing.T) {
+	in := `---
+apiVersion: database.arangodb.com/v1
+kind: ArangoDeployment
+metadata:
+  name: valid
+spec:
+  mode: Cluster
+  image: arangodb/arangodb:3.8.5
+---
+apiVersion: database.arangodb.com/v1
+kind: ArangoDeployment
+metadata:
+  name: invalid
+spec:
+  mode: Unknown
+  image: arangodb/arangodb:3.8.5
+---
+apiVersion: database.arangodb.com/v1
+kind: ArangoDeployment
+metadata:
+  name: typo
+spec:
+  mode: Single
+  image: arangodb/arangodb:3.8.5
+  extrnalAccess:
+    type: None
+---
+apiVersion: database.arangodb.com/v1
+kind: ArangoDatabase
+metadata:
+  name: database
+spec:
+  deploymentName: ""
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`
+
+	var out bytes.Buffer
+	invalid, err := validateSpecs(&out, "test.yaml", strings.NewReader(in))
+	require.NoError(t, err)
+	require.Equal(t, 2, invalid)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Equal(t, []string{
+		"test.yaml[0]: ArangoDeployment/valid: valid",
+		"test.yaml[1]: ArangoDeployment/invalid: error: spec.mode: Unknown deployment mode: 'Unknown': validation failed",
+		"test.yaml[2]: ArangoDeployment/typo: warning: json: unknown field \"extrnalAccess\"",
+		"test.yaml[2]: ArangoDeployment/typo: valid",
+		"test.yaml[3]: ArangoDatabase/database: error: deploymentName can not be empty",
+		"test.yaml[4]: warning: v1 ConfigMap is not managed by the operator, skipped",
+	}, lines)
+}
@@ -20,9 +20,57 @@
 
 package v1
 
-import batchv1 "k8s.io/api/batch/v1"
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+)
 
 type ArangoJobSpec struct {
 	ArangoDeploymentName string           `json:"arangoDeploymentName"`
 	JobTemplate          *batchv1.JobSpec `json:"jobTemplate,omitempty"`
+
+	// ExternalEndpoint defines the ArangoDB endpoint which is used instead of the ArangoDeployment.
+	ExternalEndpoint *ArangoJobExternalEndpoint `json:"externalEndpoint,omitempty"`
+
+	// Schedule in Cron format, when set CronJob is created instead of the Job.
+	// Status of the CronJob (active jobs, last schedule and last successful time) is reported in the ArangoJob status.
+	Schedule string `json:"schedule,omitempty"`
+
+	// BackoffLimit specifies the number of retries before marking the job as failed. Overrides the value from the jobTemplate.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds specifies the duration in seconds after which the job is terminated. Overrides the value from the jobTemplate.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// TTLSecondsAfterFinished specifies the time after which finished job is removed. Overrides the value from the jobTemplate.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Resources are applied to the job containers which do not define resources in the jobTemplate.
+	Resources *core.ResourceRequirements `json:"resources,omitempty"`
+	// NodeSelector is merged into the node selector of the job pod, values from the spec take precedence.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are added to the tolerations of the job pod.
+	Tolerations []core.Toleration `json:"tolerations,omitempty"`
+	// Env is added to all job containers, variables defined in the jobTemplate take precedence.
+	Env []core.EnvVar `json:"env,omitempty"`
+	// Volumes are added to the volumes of the job pod.
+	Volumes []core.Volume `json:"volumes,omitempty"`
+}
+
+// ArangoJobExternalEndpoint defines the ArangoDB database which is not managed by the operator
+type ArangoJobExternalEndpoint struct {
+	// Endpoint holds the URL of the ArangoDB server, e.g. https://example.arangodb.cloud:8529
+	Endpoint string `json:"endpoint"`
+	// CredentialsSecretName holds the name of the Secret with the username and password keys
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+	// CASecretName holds the name of the Secret with the ca.crt key used to verify the certificate of the endpoint
+	CASecretName string `json:"caSecretName,omitempty"`
+}
+
+// IsExternal returns true when job targets the external endpoint instead of the ArangoDeployment
+func (a *ArangoJobSpec) IsExternal() bool {
+	return a.ExternalEndpoint != nil
+}
+
+// IsScheduled returns true when job is executed periodically by the CronJob
+func (a *ArangoJobSpec) IsScheduled() bool {
+	return a.Schedule != ""
 }
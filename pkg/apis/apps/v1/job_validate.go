@@ -20,7 +20,13 @@
 
 package v1
 
-import "github.com/arangodb/kube-arangodb/pkg/util/errors"
+import (
+	"net/url"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/robfig/cron"
+)
 
 func (a *ArangoJob) Validate() error {
 	if err := a.Spec.Validate(); err != nil {
@@ -31,7 +37,15 @@ func (a *ArangoJob) Validate() error {
 }
 
 func (a *ArangoJobSpec) Validate() error {
-	if a.ArangoDeploymentName == "" {
+	if a.IsExternal() {
+		if a.ArangoDeploymentName != "" {
+			return errors.Newf("deployment name and external endpoint can not be set together")
+		}
+
+		if err := a.ExternalEndpoint.Validate(); err != nil {
+			return err
+		}
+	} else if a.ArangoDeploymentName == "" {
 		return errors.Newf("deployment name can not be empty")
 	}
 
@@ -39,5 +53,41 @@ func (a *ArangoJobSpec) Validate() error {
 		return errors.Newf("jobTemplate name can not be empty")
 	}
 
+	if v := a.BackoffLimit; v != nil && *v < 0 {
+		return errors.Newf("backoffLimit can not be negative")
+	}
+
+	if v := a.ActiveDeadlineSeconds; v != nil && *v <= 0 {
+		return errors.Newf("activeDeadlineSeconds has to be positive")
+	}
+
+	if v := a.TTLSecondsAfterFinished; v != nil && *v < 0 {
+		return errors.Newf("ttlSecondsAfterFinished can not be negative")
+	}
+
+	if a.IsScheduled() {
+		if expr, err := cron.ParseStandard(a.Schedule); err != nil {
+			return errors.Newf("error while parsing schedule: %s", err.Error())
+		} else if expr.Next(time.Now()).IsZero() {
+			return errors.Newf("invalid schedule format")
+		}
+	}
+
+	return nil
+}
+
+func (a *ArangoJobExternalEndpoint) Validate() error {
+	if a.Endpoint == "" {
+		return errors.Newf("external endpoint can not be empty")
+	}
+
+	if u, err := url.Parse(a.Endpoint); err != nil {
+		return errors.Newf("invalid external endpoint: %s", err.Error())
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Newf("external endpoint scheme has to be http or https")
+	} else if u.Host == "" {
+		return errors.Newf("external endpoint host can not be empty")
+	}
+
 	return nil
 }
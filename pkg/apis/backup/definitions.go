@@ -29,6 +29,10 @@ const (
 	ArangoBackupPolicyResourceKind   = "ArangoBackupPolicy"
 	ArangoBackupPolicyResourcePlural = "arangobackuppolicies"
 
+	ArangoVolumeSnapshotSetCRDName        = ArangoVolumeSnapshotSetResourcePlural + "." + ArangoBackupGroupName
+	ArangoVolumeSnapshotSetResourceKind   = "ArangoVolumeSnapshotSet"
+	ArangoVolumeSnapshotSetResourcePlural = "arangovolumesnapshotsets"
+
 	ArangoBackupGroupName = "backup.arangodb.com"
 )
 
@@ -36,4 +40,6 @@ var (
 	ArangoBackupShortNames = []string{"arangobackup"}
 
 	ArangoBackupPolicyShortNames = []string{"arangobackuppolicy"}
+
+	ArangoVolumeSnapshotSetShortNames = []string{"arangosnapshotset"}
 )
@@ -56,3 +56,15 @@ type ArangoBackup struct {
 	Spec   ArangoBackupSpec   `json:"spec"`
 	Status ArangoBackupStatus `json:"status"`
 }
+
+// AsOwner creates an OwnerReference for the given backup
+func (a *ArangoBackup) AsOwner() metav1.OwnerReference {
+	trueVar := true
+	return metav1.OwnerReference{
+		APIVersion: SchemeGroupVersion.String(),
+		Kind:       backup.ArangoBackupResourceKind,
+		Name:       a.Name,
+		UID:        a.UID,
+		Controller: &trueVar,
+	}
+}
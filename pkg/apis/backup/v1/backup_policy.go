@@ -58,9 +58,10 @@ func (a *ArangoBackupPolicy) NewBackup(d *deployment.ArangoDeployment) *ArangoBa
 		Deployment: ArangoBackupSpecDeployment{
 			Name: d.Name,
 		},
-		Upload:     a.Spec.BackupTemplate.Upload.DeepCopy(),
-		Options:    a.Spec.BackupTemplate.Options.DeepCopy(),
-		PolicyName: &policyName,
+		Upload:         a.Spec.BackupTemplate.Upload.DeepCopy(),
+		Options:        a.Spec.BackupTemplate.Options.DeepCopy(),
+		VolumeSnapshot: a.Spec.BackupTemplate.VolumeSnapshot.DeepCopy(),
+		PolicyName:     &policyName,
 	}
 
 	return &ArangoBackup{
@@ -36,4 +36,6 @@ type ArangoBackupTemplate struct {
 	Options *ArangoBackupSpecOptions `json:"options,omitempty"`
 
 	Upload *ArangoBackupSpecOperation `json:"upload,omitempty"`
+
+	VolumeSnapshot *ArangoBackupSpecVolumeSnapshot `json:"volumeSnapshot,omitempty"`
 }
@@ -35,6 +35,9 @@ type ArangoBackupSpec struct {
 	PolicyName *string `json:"policyName,omitempty"`
 
 	Backoff *ArangoBackupSpecBackOff `json:"backoff,omitempty"`
+
+	// VolumeSnapshot enables CSI VolumeSnapshots of member volumes taken after the backup is created
+	VolumeSnapshot *ArangoBackupSpecVolumeSnapshot `json:"volumeSnapshot,omitempty"`
 }
 
 type ArangoBackupSpecDeployment struct {
@@ -56,3 +59,16 @@ type ArangoBackupSpecDownload struct {
 
 	ID string `json:"id"`
 }
+
+type ArangoBackupSpecVolumeSnapshot struct {
+	// ClassName is the name of the VolumeSnapshotClass. Default class of the CSI driver is used when empty.
+	ClassName *string `json:"className,omitempty"`
+}
+
+func (a *ArangoBackupSpecVolumeSnapshot) GetClassName() *string {
+	if a == nil || a.ClassName == nil || *a.ClassName == "" {
+		return nil
+	}
+
+	return a.ClassName
+}
@@ -32,6 +32,8 @@ type ArangoBackupStatus struct {
 	Backup            *ArangoBackupDetails       `json:"backup,omitempty"`
 	Available         bool                       `json:"available"`
 	Backoff           *ArangoBackupStatusBackOff `json:"backoff,omitempty"`
+	// VolumeSnapshots holds the state of CSI VolumeSnapshots of the backup
+	VolumeSnapshots *ArangoBackupStatusVolumeSnapshots `json:"volumeSnapshots,omitempty"`
 }
 
 func (a *ArangoBackupStatus) Equal(b *ArangoBackupStatus) bool {
@@ -45,7 +47,24 @@ func (a *ArangoBackupStatus) Equal(b *ArangoBackupStatus) bool {
 
 	return a.ArangoBackupState.Equal(&b.ArangoBackupState) &&
 		a.Backup.Equal(b.Backup) &&
-		a.Available == b.Available
+		a.Available == b.Available &&
+		a.VolumeSnapshots.Equal(b.VolumeSnapshots)
+}
+
+// ArangoBackupStatusVolumeSnapshots refers to the ArangoVolumeSnapshotSet created for the backup.
+type ArangoBackupStatusVolumeSnapshots struct {
+	// Name of the ArangoVolumeSnapshotSet
+	Name string `json:"name"`
+	// Ready is true when all VolumeSnapshots are ready to use
+	Ready bool `json:"ready"`
+}
+
+func (a *ArangoBackupStatusVolumeSnapshots) Equal(b *ArangoBackupStatusVolumeSnapshots) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
 }
 
 type ArangoBackupDetails struct {
@@ -20,7 +20,11 @@
 
 package v1
 
-import "github.com/arangodb/kube-arangodb/pkg/util/errors"
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
 
 func (a *ArangoBackup) Validate() error {
 	if err := a.Spec.Validate(); err != nil {
@@ -51,6 +55,46 @@ func (a *ArangoBackupSpec) Validate() error {
 		}
 	}
 
+	if a.VolumeSnapshot != nil {
+		if err := a.VolumeSnapshot.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateUpdate returns an error when a field which can not be changed after the creation differs from the old spec.
+// Upload and backoff settings can be changed at any time.
+func (a *ArangoBackupSpec) ValidateUpdate(old ArangoBackupSpec) error {
+	if a.Deployment.Name != old.Deployment.Name {
+		return errors.Newf("deployment name can not be changed")
+	}
+
+	if !equality.Semantic.DeepEqual(a.Download, old.Download) {
+		return errors.Newf("download can not be changed")
+	}
+
+	if !equality.Semantic.DeepEqual(a.Options, old.Options) {
+		return errors.Newf("options can not be changed")
+	}
+
+	if !equality.Semantic.DeepEqual(a.PolicyName, old.PolicyName) {
+		return errors.Newf("policy name can not be changed")
+	}
+
+	if !equality.Semantic.DeepEqual(a.VolumeSnapshot, old.VolumeSnapshot) {
+		return errors.Newf("volume snapshot can not be changed")
+	}
+
+	return nil
+}
+
+func (a *ArangoBackupSpecVolumeSnapshot) Validate() error {
+	if a.ClassName != nil && *a.ClassName == "" {
+		return errors.Newf("ClassName can not be empty")
+	}
+
 	return nil
 }
 
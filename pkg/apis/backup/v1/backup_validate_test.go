@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func TestArangoBackupSpec_ValidateUpdate(t *testing.T) {
+	old := ArangoBackupSpec{
+		Deployment: ArangoBackupSpecDeployment{Name: "example"},
+		Download: &ArangoBackupSpecDownload{
+			ArangoBackupSpecOperation: ArangoBackupSpecOperation{RepositoryURL: "s3://backups"},
+			ID:                        "backup-id",
+		},
+	}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		spec := old.DeepCopy()
+		assert.NoError(t, spec.ValidateUpdate(old))
+	})
+
+	t.Run("Upload and backoff", func(t *testing.T) {
+		spec := old.DeepCopy()
+		spec.Upload = &ArangoBackupSpecOperation{RepositoryURL: "s3://backups"}
+		spec.Backoff = &ArangoBackupSpecBackOff{Iterations: util.NewInt(3)}
+		assert.NoError(t, spec.ValidateUpdate(old))
+	})
+
+	t.Run("Deployment name", func(t *testing.T) {
+		spec := old.DeepCopy()
+		spec.Deployment.Name = "other"
+		assert.EqualError(t, spec.ValidateUpdate(old), "deployment name can not be changed")
+	})
+
+	t.Run("Download ID", func(t *testing.T) {
+		spec := old.DeepCopy()
+		spec.Download.ID = "other-id"
+		assert.EqualError(t, spec.ValidateUpdate(old), "download can not be changed")
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		spec := old.DeepCopy()
+		spec.Options = &ArangoBackupSpecOptions{AllowInconsistent: util.NewBool(true)}
+		assert.EqualError(t, spec.ValidateUpdate(old), "options can not be changed")
+	})
+
+	t.Run("Policy name", func(t *testing.T) {
+		spec := old.DeepCopy()
+		spec.PolicyName = util.NewString("policy")
+		assert.EqualError(t, spec.ValidateUpdate(old), "policy name can not be changed")
+	})
+
+	t.Run("Volume snapshot", func(t *testing.T) {
+		spec := old.DeepCopy()
+		spec.VolumeSnapshot = &ArangoBackupSpecVolumeSnapshot{}
+		assert.EqualError(t, spec.ValidateUpdate(old), "volume snapshot can not be changed")
+	})
+}
@@ -50,6 +50,8 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&ArangoBackupList{},
 		&ArangoBackupPolicy{},
 		&ArangoBackupPolicyList{},
+		&ArangoVolumeSnapshotSet{},
+		&ArangoVolumeSnapshotSetList{},
 	)
 	metav1.AddToGroupVersion(s, SchemeGroupVersion)
 	return nil
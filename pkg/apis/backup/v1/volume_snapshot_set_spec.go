@@ -0,0 +1,49 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+type ArangoVolumeSnapshotSetSpec struct {
+	// Deployment the snapshots are taken from
+	Deployment ArangoBackupSpecDeployment `json:"deployment"`
+
+	// Backup is the name of the ArangoBackup
+	Backup string `json:"backup"`
+
+	// BackupID is the ID of the hot backup contained in the snapshots
+	BackupID string `json:"backupID"`
+
+	// ClassName is the name of the VolumeSnapshotClass
+	ClassName *string `json:"className,omitempty"`
+
+	// Snapshots holds a VolumeSnapshot for each member volume
+	Snapshots []ArangoVolumeSnapshotSetSnapshot `json:"snapshots,omitempty"`
+}
+
+type ArangoVolumeSnapshotSetSnapshot struct {
+	// Group of the member (role)
+	Group string `json:"group"`
+	// ID of the member
+	ID string `json:"id"`
+	// PersistentVolumeClaimName is the name of the snapshotted PVC
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName"`
+	// VolumeSnapshotName is the name of the VolumeSnapshot
+	VolumeSnapshotName string `json:"volumeSnapshotName"`
+}
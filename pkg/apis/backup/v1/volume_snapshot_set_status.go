@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+type ArangoVolumeSnapshotSetStatus struct {
+	// Ready is true when all VolumeSnapshots are ready to use
+	Ready bool `json:"ready"`
+
+	// Snapshots holds the state of VolumeSnapshots
+	Snapshots []ArangoVolumeSnapshotSetSnapshotStatus `json:"snapshots,omitempty"`
+}
+
+type ArangoVolumeSnapshotSetSnapshotStatus struct {
+	// Name of the VolumeSnapshot
+	Name string `json:"name"`
+	// Ready is true when the VolumeSnapshot is ready to use
+	Ready bool `json:"ready"`
+	// Error reported by the snapshot controller
+	Error string `json:"error,omitempty"`
+}
+
+func (a *ArangoVolumeSnapshotSetStatus) Equal(b *ArangoVolumeSnapshotSetStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if a.Ready != b.Ready || len(a.Snapshots) != len(b.Snapshots) {
+		return false
+	}
+
+	for id := range a.Snapshots {
+		if a.Snapshots[id] != b.Snapshots[id] {
+			return false
+		}
+	}
+
+	return true
+}
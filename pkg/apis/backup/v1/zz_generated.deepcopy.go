@@ -279,6 +279,11 @@ func (in *ArangoBackupSpec) DeepCopyInto(out *ArangoBackupSpec) {
 		*out = new(ArangoBackupSpecBackOff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VolumeSnapshot != nil {
+		in, out := &in.VolumeSnapshot, &out.VolumeSnapshot
+		*out = new(ArangoBackupSpecVolumeSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -398,6 +403,27 @@ func (in *ArangoBackupSpecOptions) DeepCopy() *ArangoBackupSpecOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupSpecVolumeSnapshot) DeepCopyInto(out *ArangoBackupSpecVolumeSnapshot) {
+	*out = *in
+	if in.ClassName != nil {
+		in, out := &in.ClassName, &out.ClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupSpecVolumeSnapshot.
+func (in *ArangoBackupSpecVolumeSnapshot) DeepCopy() *ArangoBackupSpecVolumeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupSpecVolumeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupState) DeepCopyInto(out *ArangoBackupState) {
 	*out = *in
@@ -434,6 +460,11 @@ func (in *ArangoBackupStatus) DeepCopyInto(out *ArangoBackupStatus) {
 		*out = new(ArangoBackupStatusBackOff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VolumeSnapshots != nil {
+		in, out := &in.VolumeSnapshots, &out.VolumeSnapshots
+		*out = new(ArangoBackupStatusVolumeSnapshots)
+		**out = **in
+	}
 	return
 }
 
@@ -464,6 +495,22 @@ func (in *ArangoBackupStatusBackOff) DeepCopy() *ArangoBackupStatusBackOff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupStatusVolumeSnapshots) DeepCopyInto(out *ArangoBackupStatusVolumeSnapshots) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupStatusVolumeSnapshots.
+func (in *ArangoBackupStatusVolumeSnapshots) DeepCopy() *ArangoBackupStatusVolumeSnapshots {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupStatusVolumeSnapshots)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupTemplate) DeepCopyInto(out *ArangoBackupTemplate) {
 	*out = *in
@@ -477,6 +524,11 @@ func (in *ArangoBackupTemplate) DeepCopyInto(out *ArangoBackupTemplate) {
 		*out = new(ArangoBackupSpecOperation)
 		**out = **in
 	}
+	if in.VolumeSnapshot != nil {
+		in, out := &in.VolumeSnapshot, &out.VolumeSnapshot
+		*out = new(ArangoBackupSpecVolumeSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -489,3 +541,144 @@ func (in *ArangoBackupTemplate) DeepCopy() *ArangoBackupTemplate {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoVolumeSnapshotSet) DeepCopyInto(out *ArangoVolumeSnapshotSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoVolumeSnapshotSet.
+func (in *ArangoVolumeSnapshotSet) DeepCopy() *ArangoVolumeSnapshotSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoVolumeSnapshotSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoVolumeSnapshotSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoVolumeSnapshotSetList) DeepCopyInto(out *ArangoVolumeSnapshotSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArangoVolumeSnapshotSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoVolumeSnapshotSetList.
+func (in *ArangoVolumeSnapshotSetList) DeepCopy() *ArangoVolumeSnapshotSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoVolumeSnapshotSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoVolumeSnapshotSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoVolumeSnapshotSetSnapshot) DeepCopyInto(out *ArangoVolumeSnapshotSetSnapshot) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoVolumeSnapshotSetSnapshot.
+func (in *ArangoVolumeSnapshotSetSnapshot) DeepCopy() *ArangoVolumeSnapshotSetSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoVolumeSnapshotSetSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoVolumeSnapshotSetSnapshotStatus) DeepCopyInto(out *ArangoVolumeSnapshotSetSnapshotStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoVolumeSnapshotSetSnapshotStatus.
+func (in *ArangoVolumeSnapshotSetSnapshotStatus) DeepCopy() *ArangoVolumeSnapshotSetSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoVolumeSnapshotSetSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoVolumeSnapshotSetSpec) DeepCopyInto(out *ArangoVolumeSnapshotSetSpec) {
+	*out = *in
+	out.Deployment = in.Deployment
+	if in.ClassName != nil {
+		in, out := &in.ClassName, &out.ClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]ArangoVolumeSnapshotSetSnapshot, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoVolumeSnapshotSetSpec.
+func (in *ArangoVolumeSnapshotSetSpec) DeepCopy() *ArangoVolumeSnapshotSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoVolumeSnapshotSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoVolumeSnapshotSetStatus) DeepCopyInto(out *ArangoVolumeSnapshotSetStatus) {
+	*out = *in
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]ArangoVolumeSnapshotSetSnapshotStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoVolumeSnapshotSetStatus.
+func (in *ArangoVolumeSnapshotSetStatus) DeepCopy() *ArangoVolumeSnapshotSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoVolumeSnapshotSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
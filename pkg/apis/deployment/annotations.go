@@ -27,4 +27,19 @@ const (
 	ArangoDeploymentPodReplaceAnnotation     = ArangoDeploymentAnnotationPrefix + "/replace"
 	ArangoDeploymentPodDeleteNow             = ArangoDeploymentAnnotationPrefix + "/delete_now"
 	ArangoDeploymentPlanCleanAnnotation      = "plan." + ArangoDeploymentAnnotationPrefix + "/clean"
+
+	// ArangoDeploymentImageDiscoveryChecksumAnnotation holds checksum of spec.id used to create the image discovery pod
+	ArangoDeploymentImageDiscoveryChecksumAnnotation = ArangoDeploymentAnnotationPrefix + "/image-discovery-checksum"
+
+	// ArangoDeploymentJWTRotateAnnotation triggers JWT secret rotation when its value changes
+	ArangoDeploymentJWTRotateAnnotation = ArangoDeploymentAnnotationPrefix + "/rotate-jwt"
+	// ArangoDeploymentJWTRotatedAtAnnotation holds time of the last JWT secret rotation
+	ArangoDeploymentJWTRotatedAtAnnotation = ArangoDeploymentAnnotationPrefix + "/jwt-rotated-at"
+	// ArangoDeploymentJWTRotationTriggerAnnotation holds value of the rotate-jwt annotation handled by the last rotation
+	ArangoDeploymentJWTRotationTriggerAnnotation = ArangoDeploymentAnnotationPrefix + "/jwt-rotation-trigger"
+
+	// ArangoDeploymentKubernetesQPSAnnotation defines QPS of the dedicated Kubernetes client of the deployment
+	ArangoDeploymentKubernetesQPSAnnotation = ArangoDeploymentAnnotationPrefix + "/kubernetes-qps"
+	// ArangoDeploymentKubernetesBurstAnnotation defines burst of the dedicated Kubernetes client of the deployment
+	ArangoDeploymentKubernetesBurstAnnotation = ArangoDeploymentAnnotationPrefix + "/kubernetes-burst"
 )
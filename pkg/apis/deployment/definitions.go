@@ -33,6 +33,26 @@ const (
 	ArangoClusterSynchronizationResourceKind   = "ArangoClusterSynchronization"
 	ArangoClusterSynchronizationResourcePlural = "arangoclustersynchronizations"
 
+	ArangoCollectionCRDName        = ArangoCollectionResourcePlural + "." + ArangoDeploymentGroupName
+	ArangoCollectionResourceKind   = "ArangoCollection"
+	ArangoCollectionResourcePlural = "arangocollections"
+
+	ArangoDatabaseCRDName        = ArangoDatabaseResourcePlural + "." + ArangoDeploymentGroupName
+	ArangoDatabaseResourceKind   = "ArangoDatabase"
+	ArangoDatabaseResourcePlural = "arangodatabases"
+
+	ArangoFoxxServiceCRDName        = ArangoFoxxServiceResourcePlural + "." + ArangoDeploymentGroupName
+	ArangoFoxxServiceResourceKind   = "ArangoFoxxService"
+	ArangoFoxxServiceResourcePlural = "arangofoxxservices"
+
+	ArangoTaskCRDName        = ArangoTaskResourcePlural + "." + ArangoDeploymentGroupName
+	ArangoTaskResourceKind   = "ArangoTask"
+	ArangoTaskResourcePlural = "arangotasks"
+
+	ArangoUserCRDName        = ArangoUserResourcePlural + "." + ArangoDeploymentGroupName
+	ArangoUserResourceKind   = "ArangoUser"
+	ArangoUserResourcePlural = "arangousers"
+
 	ArangoDeploymentGroupName = "database.arangodb.com"
 )
 
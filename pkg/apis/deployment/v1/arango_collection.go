@@ -0,0 +1,69 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArangoCollectionList is a list of ArangoDB collections.
+type ArangoCollectionList struct {
+	meta.TypeMeta `json:",inline"`
+	meta.ListMeta `json:"metadata,omitempty"`
+
+	Items []ArangoCollection `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArangoCollection contains definition and status of the ArangoDB collection.
+type ArangoCollection struct {
+	meta.TypeMeta   `json:",inline"`
+	meta.ObjectMeta `json:"metadata,omitempty"`
+	Spec            ArangoCollectionSpec   `json:"spec,omitempty"`
+	Status          ArangoCollectionStatus `json:"status,omitempty"`
+}
+
+// AsOwner creates an OwnerReference for the given collection
+func (a *ArangoCollection) AsOwner() meta.OwnerReference {
+	trueVar := true
+	return meta.OwnerReference{
+		APIVersion: SchemeGroupVersion.String(),
+		Kind:       deployment.ArangoCollectionResourceKind,
+		Name:       a.Name,
+		UID:        a.UID,
+		Controller: &trueVar,
+	}
+}
+
+// GetCollectionName returns the name of the collection, defaults to the name of the ArangoCollection
+func (a *ArangoCollection) GetCollectionName() string {
+	if n := a.Spec.Name; n != nil && *n != "" {
+		return *n
+	}
+
+	return a.Name
+}
@@ -0,0 +1,195 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type ArangoCollectionSpec struct {
+	// DeploymentName holds the name of the ArangoDeployment in which the collection is managed
+	DeploymentName string `json:"deploymentName"`
+	// Database holds the name of the database in which the collection is managed
+	Database string `json:"database"`
+	// Name of the collection, defaults to the name of the ArangoCollection
+	Name *string `json:"name,omitempty"`
+	// Type of the collection, one of document or edge, defaults to document. Used only when collection is created.
+	Type *ArangoCollectionType `json:"type,omitempty"`
+	// NumberOfShards of the collection. Used only when collection is created.
+	NumberOfShards *int `json:"numberOfShards,omitempty"`
+	// ReplicationFactor of the collection. Used only when collection is created.
+	ReplicationFactor *int `json:"replicationFactor,omitempty"`
+	// Indexes managed on the collection
+	Indexes []ArangoCollectionIndex `json:"indexes,omitempty"`
+}
+
+// AsCreateOptions returns the driver options used to create the collection
+func (a ArangoCollectionSpec) AsCreateOptions() *driver.CreateCollectionOptions {
+	var options driver.CreateCollectionOptions
+
+	if a.Type.Get() == ArangoCollectionTypeEdge {
+		options.Type = driver.CollectionTypeEdge
+	}
+
+	if v := a.NumberOfShards; v != nil {
+		options.NumberOfShards = *v
+	}
+
+	if v := a.ReplicationFactor; v != nil {
+		options.ReplicationFactor = *v
+	}
+
+	return &options
+}
+
+func (a ArangoCollectionSpec) Validate() error {
+	if a.DeploymentName == "" {
+		return errors.Newf("deploymentName can not be empty")
+	}
+
+	if a.Database == "" {
+		return errors.Newf("database can not be empty")
+	}
+
+	if a.Name != nil && *a.Name == "" {
+		return errors.Newf("name can not be empty")
+	}
+
+	if err := a.Type.Validate(); err != nil {
+		return errors.Wrapf(err, "type")
+	}
+
+	if v := a.NumberOfShards; v != nil && *v < 1 {
+		return errors.Newf("numberOfShards has to be positive")
+	}
+
+	if v := a.ReplicationFactor; v != nil && *v < 1 {
+		return errors.Newf("replicationFactor has to be positive")
+	}
+
+	names := map[string]bool{}
+	for id, index := range a.Indexes {
+		if err := index.Validate(); err != nil {
+			return errors.Wrapf(err, "indexes[%d]", id)
+		}
+
+		if names[index.Name] {
+			return errors.Newf("indexes[%d]: index %s is defined more than once", id, index.Name)
+		}
+		names[index.Name] = true
+	}
+
+	return nil
+}
+
+type ArangoCollectionType string
+
+const (
+	// ArangoCollectionTypeDocument defines document collection
+	ArangoCollectionTypeDocument ArangoCollectionType = "document"
+	// ArangoCollectionTypeEdge defines edge collection
+	ArangoCollectionTypeEdge ArangoCollectionType = "edge"
+
+	// ArangoCollectionTypeDefault defines default collection type
+	ArangoCollectionTypeDefault = ArangoCollectionTypeDocument
+)
+
+func (a *ArangoCollectionType) Get() ArangoCollectionType {
+	if a == nil {
+		return ArangoCollectionTypeDefault
+	}
+
+	return *a
+}
+
+func (a ArangoCollectionType) New() *ArangoCollectionType {
+	return &a
+}
+
+func (a *ArangoCollectionType) Validate() error {
+	switch v := a.Get(); v {
+	case ArangoCollectionTypeDocument, ArangoCollectionTypeEdge:
+		return nil
+	default:
+		return errors.Newf("type %s is not supported", v)
+	}
+}
+
+// ArangoCollectionIndex defines an index of the collection. Indexes are identified by name,
+// so changing the definition of an existing index requires a new name.
+type ArangoCollectionIndex struct {
+	// Name of the index
+	Name string `json:"name"`
+	// Type of the index, one of persistent, ttl or geo
+	Type ArangoCollectionIndexType `json:"type"`
+	// Fields covered by the index. TTL index requires exactly one field.
+	Fields []string `json:"fields"`
+	// Unique defines if the persistent index is unique
+	Unique *bool `json:"unique,omitempty"`
+	// Sparse defines if the persistent index is sparse
+	Sparse *bool `json:"sparse,omitempty"`
+	// ExpireAfter defines the number of seconds after which documents expire, used by ttl index
+	ExpireAfter *int `json:"expireAfter,omitempty"`
+	// GeoJSON defines if the geo index uses GeoJSON format
+	GeoJSON *bool `json:"geoJson,omitempty"`
+}
+
+func (a ArangoCollectionIndex) Validate() error {
+	if a.Name == "" {
+		return errors.Newf("name can not be empty")
+	}
+
+	if len(a.Fields) == 0 {
+		return errors.Newf("fields can not be empty")
+	}
+
+	switch a.Type {
+	case ArangoCollectionIndexTypePersistent:
+	case ArangoCollectionIndexTypeTTL:
+		if len(a.Fields) != 1 {
+			return errors.Newf("ttl index requires exactly one field")
+		}
+
+		if a.ExpireAfter == nil || *a.ExpireAfter < 0 {
+			return errors.Newf("ttl index requires non-negative expireAfter")
+		}
+	case ArangoCollectionIndexTypeGeo:
+		if len(a.Fields) > 2 {
+			return errors.Newf("geo index supports at most two fields")
+		}
+	default:
+		return errors.Newf("index type %s is not supported", a.Type)
+	}
+
+	return nil
+}
+
+type ArangoCollectionIndexType string
+
+const (
+	// ArangoCollectionIndexTypePersistent defines persistent index
+	ArangoCollectionIndexTypePersistent ArangoCollectionIndexType = "persistent"
+	// ArangoCollectionIndexTypeTTL defines ttl index
+	ArangoCollectionIndexTypeTTL ArangoCollectionIndexType = "ttl"
+	// ArangoCollectionIndexTypeGeo defines geo index
+	ArangoCollectionIndexTypeGeo ArangoCollectionIndexType = "geo"
+)
@@ -30,4 +30,7 @@ type ArangoMemberSpec struct {
 	DeploymentUID types.UID   `json:"deploymentUID,omitempty"`
 
 	Template *ArangoMemberPodTemplate `json:"template,omitempty"`
+
+	// Overrides define adjustments of the pod applied only to this member
+	Overrides *ArangoMemberSpecOverrides `json:"overrides,omitempty"`
 }
@@ -0,0 +1,83 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import "encoding/json"
+
+// ArangoMemberPodTemplatePatch keeps the strategic merge patch of the pod template as raw JSON.
+type ArangoMemberPodTemplatePatch []byte
+
+func (a ArangoMemberPodTemplatePatch) MarshalJSON() ([]byte, error) {
+	d := make([]byte, len(a))
+
+	copy(d, a)
+
+	return d, nil
+}
+
+func (a *ArangoMemberPodTemplatePatch) UnmarshalJSON(bytes []byte) error {
+	var i map[string]interface{}
+
+	if err := json.Unmarshal(bytes, &i); err != nil {
+		return err
+	}
+
+	d := make([]byte, len(bytes))
+
+	copy(d, bytes)
+
+	*a = d
+
+	return nil
+}
+
+var _ json.Unmarshaler = &ArangoMemberPodTemplatePatch{}
+var _ json.Marshaler = ArangoMemberPodTemplatePatch{}
+
+// ArangoMemberSpecOverrides defines adjustments applied only to the pod of a single member.
+type ArangoMemberSpecOverrides struct {
+	// EntrypointDebug starts the server container with a sleep entrypoint instead of the server.
+	// Volumes stay mounted, so the data directory can be inspected without the pod crash-looping.
+	// Probes are removed from the container in this mode.
+	EntrypointDebug *bool `json:"entrypointDebug,omitempty"`
+
+	// PodTemplatePatch is a strategic merge patch applied on top of the operator generated pod template
+	// (metadata and spec) of this member, e.g. to add tolerations or to change the image of a single member.
+	PodTemplatePatch ArangoMemberPodTemplatePatch `json:"podTemplatePatch,omitempty"`
+}
+
+// GetEntrypointDebug returns true if the member should be started in the debug mode.
+func (a *ArangoMemberSpecOverrides) GetEntrypointDebug() bool {
+	if a == nil || a.EntrypointDebug == nil {
+		return false
+	}
+
+	return *a.EntrypointDebug
+}
+
+// GetPodTemplatePatch returns the strategic merge patch of the pod template, nil if not defined.
+func (a *ArangoMemberSpecOverrides) GetPodTemplatePatch() ArangoMemberPodTemplatePatch {
+	if a == nil || len(a.PodTemplatePatch) == 0 {
+		return nil
+	}
+
+	return a.PodTemplatePatch
+}
@@ -21,6 +21,8 @@
 package v1
 
 import (
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -45,3 +47,15 @@ type ArangoTask struct {
 	Spec            ArangoTaskSpec   `json:"spec,omitempty"`
 	Status          ArangoTaskStatus `json:"status,omitempty"`
 }
+
+// AsOwner creates an OwnerReference for the given task
+func (a *ArangoTask) AsOwner() meta.OwnerReference {
+	trueVar := true
+	return meta.OwnerReference{
+		APIVersion: SchemeGroupVersion.String(),
+		Kind:       deployment.ArangoTaskResourceKind,
+		Name:       a.Name,
+		UID:        a.UID,
+		Controller: &trueVar,
+	}
+}
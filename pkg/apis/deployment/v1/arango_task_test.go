@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
 )
 
 func Test_ArangoTask_Details(t *testing.T) {
@@ -58,3 +59,22 @@ func arangoTaskDetailsExp(t *testing.T, obj, exp interface{}) {
 		require.EqualValues(t, obj, exp)
 	})
 }
+
+func Test_ArangoTask_DumpDetails(t *testing.T) {
+	var spec ArangoTaskSpec
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"Dump","details":{"deploymentName":"deployment","database":"app","volume":{"persistentVolumeClaim":{"claimName":"dump"}}}}`), &spec))
+	require.True(t, spec.Type.IsDumpOrRestore())
+
+	var details ArangoTaskDumpDetails
+	require.NoError(t, spec.Details.Get(&details))
+	require.NoError(t, details.Validate())
+	require.Equal(t, "app", details.Database)
+	require.Equal(t, "dump", details.Volume.PersistentVolumeClaim.ClaimName)
+
+	details.Volume = core.VolumeSource{}
+	require.Error(t, details.Validate())
+
+	details.Database = ""
+	details.Collections = []string{"col"}
+	require.Error(t, details.Validate())
+}
@@ -0,0 +1,88 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// FinalizerArangoUser removes the user from the database before the ArangoUser is removed
+	FinalizerArangoUser = deployment.ArangoUserCRDName + "/cleanup"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArangoUserList is a list of ArangoDB users.
+type ArangoUserList struct {
+	meta.TypeMeta `json:",inline"`
+	meta.ListMeta `json:"metadata,omitempty"`
+
+	Items []ArangoUser `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArangoUser contains definition and status of the ArangoDB user.
+type ArangoUser struct {
+	meta.TypeMeta   `json:",inline"`
+	meta.ObjectMeta `json:"metadata,omitempty"`
+	Spec            ArangoUserSpec   `json:"spec,omitempty"`
+	Status          ArangoUserStatus `json:"status,omitempty"`
+}
+
+// AsOwner creates an OwnerReference for the given user
+func (a *ArangoUser) AsOwner() meta.OwnerReference {
+	trueVar := true
+	return meta.OwnerReference{
+		APIVersion: SchemeGroupVersion.String(),
+		Kind:       deployment.ArangoUserResourceKind,
+		Name:       a.Name,
+		UID:        a.UID,
+		Controller: &trueVar,
+	}
+}
+
+// GetUserName returns the name of the user in the database
+func (a *ArangoUser) GetUserName() string {
+	if n := a.Spec.Name; n != nil && *n != "" {
+		return *n
+	}
+
+	return a.Name
+}
+
+// Validate the user
+func (a *ArangoUser) Validate() error {
+	if err := a.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if a.GetUserName() == UserNameRoot {
+		return errors.Newf("user %s can not be managed", UserNameRoot)
+	}
+
+	return nil
+}
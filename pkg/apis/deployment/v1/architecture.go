@@ -38,27 +38,72 @@ func (a ArangoDeploymentArchitecture) GetDefault() ArangoDeploymentArchitectureT
 	return a[0]
 }
 
-func (a ArangoDeploymentArchitecture) Validate() error {
-	if len(a) > 1 {
-		return errors.Errorf("Only one architecture type is supported currently")
+// GetAll returns all architectures which are requested. When list is empty default architecture is returned.
+func (a ArangoDeploymentArchitecture) GetAll() ArangoDeploymentArchitecture {
+	if len(a) == 0 {
+		return ArangoDeploymentArchitecture{ArangoDeploymentArchitectureDefault}
+	}
+
+	return a
+}
+
+// Contains returns true if architecture is requested.
+func (a ArangoDeploymentArchitecture) Contains(arch ArangoDeploymentArchitectureType) bool {
+	for _, v := range a.GetAll() {
+		if v == arch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Equal compares two architecture lists.
+func (a ArangoDeploymentArchitecture) Equal(b ArangoDeploymentArchitecture) bool {
+	if len(a) != len(b) {
+		return false
 	}
 
+	for id := range a {
+		if a[id] != b[id] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a ArangoDeploymentArchitecture) Validate() error {
 	for id := range a {
 		if err := a[id].Validate(); err != nil {
 			return errors.WithStack(errors.Wrapf(err, "%d", id))
 		}
+
+		for prev := 0; prev < id; prev++ {
+			if a[prev] == a[id] {
+				return errors.WithStack(errors.Errorf("%d: Architecture %s is defined more than once", id, a[id]))
+			}
+		}
 	}
 
 	return nil
 }
 
+// AsNodeSelectorRequirement returns node selector term which allows scheduling on all requested architectures.
 func (a ArangoDeploymentArchitecture) AsNodeSelectorRequirement() core.NodeSelectorTerm {
+	all := a.GetAll()
+	values := make([]string, len(all))
+
+	for id, arch := range all {
+		values[id] = string(arch)
+	}
+
 	return core.NodeSelectorTerm{
 		MatchExpressions: []core.NodeSelectorRequirement{
 			{
 				Key:      k8sutil.NodeArchAffinityLabel,
 				Operator: "In",
-				Values:   []string{string(a.GetDefault())},
+				Values:   values,
 			},
 		},
 	}
@@ -79,6 +124,11 @@ const (
 	ArangoDeploymentArchitectureCurrent = ArangoDeploymentArchitectureType(runtime.GOARCH)
 )
 
+// AsArchitecture returns architecture list which contains only this architecture.
+func (a ArangoDeploymentArchitectureType) AsArchitecture() ArangoDeploymentArchitecture {
+	return ArangoDeploymentArchitecture{a}
+}
+
 func (a ArangoDeploymentArchitectureType) Validate() error {
 	switch q := a; q {
 	case ArangoDeploymentArchitectureAMD64, ArangoDeploymentArchitectureARM64:
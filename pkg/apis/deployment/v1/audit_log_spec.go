@@ -0,0 +1,165 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
+	core "k8s.io/api/core/v1"
+)
+
+type AuditLogOutput string
+
+func (a *AuditLogOutput) Get() AuditLogOutput {
+	if a == nil {
+		return AuditLogOutputFile
+	}
+
+	return *a
+}
+
+func (a AuditLogOutput) New() *AuditLogOutput {
+	return &a
+}
+
+// Validate the given output
+func (a *AuditLogOutput) Validate() error {
+	switch o := a.Get(); o {
+	case AuditLogOutputFile, AuditLogOutputStdout:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown audit log output: '%s'", string(o)))
+	}
+}
+
+const (
+	// AuditLogOutputFile writes audit events into the file on the shared volume, collected by the sidecar
+	AuditLogOutputFile AuditLogOutput = "file"
+	// AuditLogOutputStdout writes audit events to the standard output of the server container
+	AuditLogOutputStdout AuditLogOutput = "stdout"
+)
+
+// IsAuditLogTopic returns true when topic is one of the audit topics supported by ArangoDB
+func IsAuditLogTopic(topic string) bool {
+	switch topic {
+	case "audit-authentication", "audit-authorization", "audit-collection", "audit-database",
+		"audit-document", "audit-hotbackup", "audit-service", "audit-view":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsAuditLogLevel returns true when level can be set on the audit topic
+func IsAuditLogLevel(level string) bool {
+	switch level {
+	case "fatal", "error", "warning", "info", "debug", "trace":
+		return true
+	default:
+		return false
+	}
+}
+
+// AuditLogSpec holds the audit log configuration, Enterprise only
+type AuditLogSpec struct {
+	// Enabled turns on audit logging of the ArangoDB servers
+	Enabled *bool `json:"enabled,omitempty"`
+	// Output define where audit events are written, "file" (default) or "stdout"
+	Output *AuditLogOutput `json:"output,omitempty"`
+	// Topics define log level per audit topic (e.g. audit-document: info). Topics which are not set keep ArangoDB defaults.
+	Topics map[string]string `json:"topics,omitempty"`
+	// Hostname define hostname reported in the audit events
+	Hostname *string `json:"hostname,omitempty"`
+	// Sidecar define container which collects the audit log file. Audit log volume is mounted read-only in the container.
+	Sidecar *core.Container `json:"sidecar,omitempty"`
+}
+
+// IsEnabled returns true when audit log is enabled
+func (a *AuditLogSpec) IsEnabled() bool {
+	if a == nil {
+		return false
+	}
+
+	return util.BoolOrDefault(a.Enabled)
+}
+
+// GetOutput returns the audit log output
+func (a *AuditLogSpec) GetOutput() AuditLogOutput {
+	if a == nil {
+		return AuditLogOutputFile
+	}
+
+	return a.Output.Get()
+}
+
+// GetHostname returns the hostname reported in the audit events
+func (a *AuditLogSpec) GetHostname() string {
+	if a == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(a.Hostname)
+}
+
+// Validate the given spec
+func (a *AuditLogSpec) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	if err := a.Output.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "output"))
+	}
+
+	for topic, level := range a.Topics {
+		if !IsAuditLogTopic(topic) {
+			return errors.WithStack(errors.Wrapf(ValidationError, "topics: Unknown audit topic '%s'", topic))
+		}
+
+		if !IsAuditLogLevel(level) {
+			return errors.WithStack(errors.Wrapf(ValidationError, "topics.%s: Unknown log level '%s'", topic, level))
+		}
+	}
+
+	if s := a.Sidecar; s != nil {
+		if a.GetOutput() != AuditLogOutputFile {
+			return errors.WithStack(errors.Wrapf(ValidationError, "sidecar: can be used only with %s output", AuditLogOutputFile))
+		}
+
+		if s.Name != "" {
+			if IsReservedServerGroupContainerName(s.Name) {
+				return errors.WithStack(errors.Wrapf(ValidationError, "sidecar: name %s is restricted", s.Name))
+			}
+
+			if err := k8sutil.ValidateResourceName(s.Name); err != nil {
+				return errors.WithStack(errors.Wrap(err, "sidecar.name"))
+			}
+		}
+
+		if s.Image == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "sidecar.image: needs to be set"))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+)
+
+func TestAuditLogSpecValidate(t *testing.T) {
+	// Valid
+	assert.NoError(t, (*AuditLogSpec)(nil).Validate())
+	assert.NoError(t, (&AuditLogSpec{Enabled: util.NewBool(true)}).Validate())
+	assert.NoError(t, (&AuditLogSpec{Output: AuditLogOutputStdout.New()}).Validate())
+	assert.NoError(t, (&AuditLogSpec{Topics: map[string]string{"audit-document": "info", "audit-authentication": "error"}}).Validate())
+	assert.NoError(t, (&AuditLogSpec{Sidecar: &core.Container{Image: "fluent/fluent-bit"}}).Validate())
+
+	// Not valid
+	assert.Error(t, (&AuditLogSpec{Output: AuditLogOutput("syslog").New()}).Validate())
+	assert.Error(t, (&AuditLogSpec{Topics: map[string]string{"requests": "info"}}).Validate())
+	assert.Error(t, (&AuditLogSpec{Topics: map[string]string{"audit-document": "verbose"}}).Validate())
+	assert.Error(t, (&AuditLogSpec{Output: AuditLogOutputStdout.New(), Sidecar: &core.Container{Image: "fluent/fluent-bit"}}).Validate())
+	assert.Error(t, (&AuditLogSpec{Sidecar: &core.Container{Name: ServerGroupReservedContainerNameServer, Image: "fluent/fluent-bit"}}).Validate())
+	assert.Error(t, (&AuditLogSpec{Sidecar: &core.Container{}}).Validate())
+}
+
+func TestAuditLogSpecDefaults(t *testing.T) {
+	var nilSpec *AuditLogSpec
+	assert.False(t, nilSpec.IsEnabled())
+	assert.Equal(t, AuditLogOutputFile, nilSpec.GetOutput())
+	assert.Equal(t, "", nilSpec.GetHostname())
+
+	spec := &AuditLogSpec{Enabled: util.NewBool(true), Output: AuditLogOutputStdout.New(), Hostname: util.NewString("arangodb")}
+	assert.True(t, spec.IsEnabled())
+	assert.Equal(t, AuditLogOutputStdout, spec.GetOutput())
+	assert.Equal(t, "arangodb", spec.GetHostname())
+}
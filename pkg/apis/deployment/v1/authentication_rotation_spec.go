@@ -0,0 +1,60 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// AuthenticationRotationSpec holds JWT secret rotation policy
+type AuthenticationRotationSpec struct {
+	// Period define how often JWT secret is rotated by the operator. Rotation is disabled when not set.
+	// On rotation, operator generates new token in the JWT secret.
+	Period *Duration `json:"period,omitempty"`
+}
+
+// GetPeriod returns the JWT rotation period, 0 when automatic rotation is disabled.
+func (s *AuthenticationRotationSpec) GetPeriod() time.Duration {
+	if s == nil {
+		return 0
+	}
+
+	return DurationOrDefault(s.Period).AsDuration()
+}
+
+// Validate the given spec
+func (s *AuthenticationRotationSpec) Validate() error {
+	if s == nil || s.Period == nil {
+		return nil
+	}
+
+	if err := s.Period.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "period"))
+	}
+
+	if s.GetPeriod() < 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "period: needs to be positive"))
+	}
+
+	return nil
+}
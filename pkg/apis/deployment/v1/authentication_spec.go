@@ -29,6 +29,8 @@ import (
 // AuthenticationSpec holds authentication specific configuration settings
 type AuthenticationSpec struct {
 	JWTSecretName *string `json:"jwtSecretName,omitempty"`
+	// Rotation define JWT secret rotation policy
+	Rotation *AuthenticationRotationSpec `json:"rotation,omitempty"`
 }
 
 const (
@@ -56,6 +58,9 @@ func (s AuthenticationSpec) Validate(required bool) error {
 		if err := k8sutil.ValidateResourceName(s.GetJWTSecretName()); err != nil {
 			return errors.WithStack(err)
 		}
+		if err := s.Rotation.Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, "rotation"))
+		}
 	}
 	return nil
 }
@@ -74,6 +79,9 @@ func (s *AuthenticationSpec) SetDefaultsFrom(source AuthenticationSpec) {
 	if s.JWTSecretName == nil {
 		s.JWTSecretName = util.NewStringOrNil(source.JWTSecretName)
 	}
+	if s.Rotation == nil {
+		s.Rotation = source.Rotation.DeepCopy()
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
@@ -22,6 +22,7 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +38,20 @@ func TestAuthenticationSpecValidate(t *testing.T) {
 	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewString("Foo")}.Validate(false))
 }
 
+func TestAuthenticationSpecRotation(t *testing.T) {
+	// Valid
+	assert.Nil(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), Rotation: &AuthenticationRotationSpec{}}.Validate(true))
+	assert.Nil(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), Rotation: &AuthenticationRotationSpec{Period: NewDuration("720h")}}.Validate(true))
+
+	// Not valid
+	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), Rotation: &AuthenticationRotationSpec{Period: NewDuration("invalid")}}.Validate(true))
+	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), Rotation: &AuthenticationRotationSpec{Period: NewDuration("-1h")}}.Validate(true))
+
+	var nilRotation *AuthenticationRotationSpec
+	assert.Equal(t, time.Duration(0), nilRotation.GetPeriod())
+	assert.Equal(t, 720*time.Hour, (&AuthenticationRotationSpec{Period: NewDuration("720h")}).GetPeriod())
+}
+
 func TestAuthenticationSpecIsAuthenticated(t *testing.T) {
 	assert.False(t, AuthenticationSpec{JWTSecretName: util.NewString("None")}.IsAuthenticated())
 	assert.True(t, AuthenticationSpec{JWTSecretName: util.NewString("foo")}.IsAuthenticated())
@@ -52,6 +52,8 @@ type PasswordSecretNameList map[string]PasswordSecretName
 type BootstrapSpec struct {
 	// PasswordSecretNames contains a map of username to password-secret-name
 	PasswordSecretNames PasswordSecretNameList `json:"passwordSecretNames,omitempty"`
+	// Databases contains databases created on the first start of the deployment
+	Databases []BootstrapDatabase `json:"databases,omitempty"`
 }
 
 // IsNone returns true if p is None or p is empty
@@ -82,9 +84,8 @@ func getSecretNameForUserPassword(deploymentname, username string) PasswordSecre
 // Validate the specification.
 func (b *BootstrapSpec) Validate() error {
 	for username, secretname := range b.PasswordSecretNames {
-		// Remove this restriction as soon as we can bootstrap databases
-		if username != UserNameRoot {
-			return errors.Newf("only username `root` allowed in passwordSecretNames")
+		if username == "" {
+			return errors.Newf("username can not be empty in passwordSecretNames")
 		}
 
 		if secretname.IsNone() {
@@ -98,6 +99,18 @@ func (b *BootstrapSpec) Validate() error {
 		}
 	}
 
+	databases := map[string]bool{}
+	for _, database := range b.Databases {
+		if databases[database.Name] {
+			return errors.Newf("database %s is defined multiple times", database.Name)
+		}
+		databases[database.Name] = true
+
+		if err := database.Validate(b.PasswordSecretNames); err != nil {
+			return errors.Wrapf(err, "invalid database %s", database.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -137,4 +150,10 @@ func (b *BootstrapSpec) SetDefaultsFrom(source BootstrapSpec) {
 	if b.PasswordSecretNames == nil {
 		b.PasswordSecretNames = NewPasswordSecretNameListOrNil(source.PasswordSecretNames)
 	}
+	if b.Databases == nil && source.Databases != nil {
+		b.Databases = make([]BootstrapDatabase, len(source.Databases))
+		for id := range source.Databases {
+			source.Databases[id].DeepCopyInto(&b.Databases[id])
+		}
+	}
 }
@@ -20,6 +20,11 @@
 
 package v1
 
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
 type ArangoClusterSynchronizationSpec struct {
 	DeploymentName string                                      `json:"deploymentName,omitempty"`
 	KubeConfig     *ArangoClusterSynchronizationKubeConfigSpec `json:"kubeconfig,omitempty"`
@@ -30,3 +35,37 @@ type ArangoClusterSynchronizationKubeConfigSpec struct {
 	SecretKey  string `json:"secretKey"`
 	Namespace  string `json:"namespace"`
 }
+
+// Validate the given spec
+func (s ArangoClusterSynchronizationSpec) Validate() error {
+	if err := k8sutil.ValidateOptionalResourceName(s.DeploymentName); err != nil {
+		return errors.Wrapf(err, "deploymentName")
+	}
+
+	if err := s.KubeConfig.Validate(); err != nil {
+		return errors.Wrapf(err, "kubeconfig")
+	}
+
+	return nil
+}
+
+// Validate the given spec
+func (s *ArangoClusterSynchronizationKubeConfigSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if err := k8sutil.ValidateResourceName(s.SecretName); err != nil {
+		return errors.Wrapf(err, "secretName")
+	}
+
+	if s.SecretKey == "" {
+		return errors.Newf("secretKey can not be empty")
+	}
+
+	if err := k8sutil.ValidateOptionalResourceName(s.Namespace); err != nil {
+		return errors.Wrapf(err, "namespace")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArangoClusterSynchronizationSpecValidate(t *testing.T) {
+	// Valid
+	assert.Nil(t, ArangoClusterSynchronizationSpec{}.Validate())
+	assert.Nil(t, ArangoClusterSynchronizationSpec{DeploymentName: "example"}.Validate())
+	assert.Nil(t, ArangoClusterSynchronizationSpec{KubeConfig: &ArangoClusterSynchronizationKubeConfigSpec{SecretName: "kubeconfig", SecretKey: "config"}}.Validate())
+	assert.Nil(t, ArangoClusterSynchronizationSpec{KubeConfig: &ArangoClusterSynchronizationKubeConfigSpec{SecretName: "kubeconfig", SecretKey: "config", Namespace: "remote"}}.Validate())
+
+	// Not valid
+	assert.Error(t, ArangoClusterSynchronizationSpec{DeploymentName: "Example"}.Validate())
+	assert.Error(t, ArangoClusterSynchronizationSpec{KubeConfig: &ArangoClusterSynchronizationKubeConfigSpec{SecretKey: "config"}}.Validate())
+	assert.Error(t, ArangoClusterSynchronizationSpec{KubeConfig: &ArangoClusterSynchronizationKubeConfigSpec{SecretName: "kubeconfig"}}.Validate())
+	assert.Error(t, ArangoClusterSynchronizationSpec{KubeConfig: &ArangoClusterSynchronizationKubeConfigSpec{SecretName: "kubeconfig", SecretKey: "config", Namespace: "Remote"}}.Validate())
+}
@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// DeploymentInitFromSpec defines the data with which a new deployment is initialized
+type DeploymentInitFromSpec struct {
+	// Backup is the name of the ArangoBackup in the namespace of the deployment. Backups of other deployments
+	// need to be uploaded. When Download is set, it is the ID of the backup in the remote repository.
+	Backup *string `json:"backup,omitempty"`
+	// Download specifies the remote repository from which the backup is downloaded
+	Download *DeploymentInitFromDownloadSpec `json:"download,omitempty"`
+}
+
+// DeploymentInitFromDownloadSpec defines the remote repository of the backup
+type DeploymentInitFromDownloadSpec struct {
+	RepositoryURL         string `json:"repositoryURL"`
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+}
+
+// GetBackup returns the name or the remote ID of the backup
+func (s *DeploymentInitFromSpec) GetBackup() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.Backup)
+}
+
+// IsDownload returns true if the backup is downloaded from a remote repository
+func (s *DeploymentInitFromSpec) IsDownload() bool {
+	return s != nil && s.Download != nil
+}
+
+// Equal compares two specs
+func (s *DeploymentInitFromSpec) Equal(other *DeploymentInitFromSpec) bool {
+	if s == nil || other == nil {
+		return s == nil && other == nil
+	}
+
+	if s.GetBackup() != other.GetBackup() || s.IsDownload() != other.IsDownload() {
+		return false
+	}
+
+	return !s.IsDownload() || *s.Download == *other.Download
+}
+
+// Validate the given spec
+func (s *DeploymentInitFromSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if s.GetBackup() == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "backup: Value is required"))
+	}
+
+	if s.Download == nil {
+		if err := k8sutil.ValidateResourceName(s.GetBackup()); err != nil {
+			return errors.WithStack(errors.Wrap(err, "backup"))
+		}
+
+		return nil
+	}
+
+	if s.Download.RepositoryURL == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "download.repositoryURL: Value is required"))
+	}
+
+	if err := k8sutil.ValidateOptionalResourceName(s.Download.CredentialsSecretName); err != nil {
+		return errors.WithStack(errors.Wrap(err, "download.credentialsSecretName"))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentMaintenanceWindowSpec(t *testing.T) {
+	var nilWindow *DeploymentMaintenanceWindowSpec
+	require.NoError(t, nilWindow.Validate())
+	require.True(t, nilWindow.IsActive(time.Now()))
+
+	require.Error(t, (&DeploymentMaintenanceWindowSpec{Schedule: "invalid", Duration: "1h"}).Validate())
+	require.Error(t, (&DeploymentMaintenanceWindowSpec{Schedule: "0 2 * * *"}).Validate())
+	require.Error(t, (&DeploymentMaintenanceWindowSpec{Schedule: "0 2 * * *", Duration: "-1h"}).Validate())
+
+	w := &DeploymentMaintenanceWindowSpec{Schedule: "0 2 * * *", Duration: "2h"}
+	require.NoError(t, w.Validate())
+
+	day := time.Date(2022, 5, 10, 0, 0, 0, 0, time.Local)
+	require.False(t, w.IsActive(day.Add(time.Hour+59*time.Minute)))
+	require.True(t, w.IsActive(day.Add(2*time.Hour)))
+	require.True(t, w.IsActive(day.Add(3*time.Hour+30*time.Minute)))
+	require.False(t, w.IsActive(day.Add(4*time.Hour+time.Minute)))
+}
+
+func TestDeploymentMaintenanceWindowList(t *testing.T) {
+	now := time.Date(2022, 1, 10, 3, 0, 0, 0, time.UTC)
+
+	var empty DeploymentMaintenanceWindowList
+	require.True(t, empty.IsActive(now))
+	require.NoError(t, empty.Validate())
+
+	l := DeploymentMaintenanceWindowList{
+		{Schedule: "0 22 * * *", Duration: "1h"},
+		{Schedule: "0 2 * * *", Duration: "2h"},
+	}
+	require.NoError(t, l.Validate())
+	require.True(t, l.IsActive(now))
+	require.True(t, l.IsActive(now.Add(19*time.Hour+30*time.Minute)))
+	require.False(t, l.IsActive(now.Add(2*time.Hour)))
+
+	require.Error(t, append(l, DeploymentMaintenanceWindowSpec{Schedule: "invalid", Duration: "1h"}).Validate())
+}
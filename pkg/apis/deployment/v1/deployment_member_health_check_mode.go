@@ -0,0 +1,66 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+type DeploymentMemberHealthCheckMode string
+
+func (d *DeploymentMemberHealthCheckMode) Get() DeploymentMemberHealthCheckMode {
+	if d == nil {
+		return DeploymentMemberHealthCheckModeDefault
+	}
+
+	return *d
+}
+
+func (d DeploymentMemberHealthCheckMode) New() *DeploymentMemberHealthCheckMode {
+	return &d
+}
+
+func (d DeploymentMemberHealthCheckMode) String() string {
+	return string(d)
+}
+
+func (d *DeploymentMemberHealthCheckMode) Equal(b *DeploymentMemberHealthCheckMode) bool {
+	if d == nil && b == nil {
+		return true
+	}
+
+	if d == nil || b == nil {
+		return false
+	}
+
+	return *d == *b
+}
+
+// IsDeep returns true when members are checked with the deep health probe
+func (d *DeploymentMemberHealthCheckMode) IsDeep() bool {
+	return d.Get() == DeploymentMemberHealthCheckModeDeep
+}
+
+const (
+	// DeploymentMemberHealthCheckModeDefault Define default member health check mode
+	DeploymentMemberHealthCheckModeDefault = DeploymentMemberHealthCheckModeBasic
+	// DeploymentMemberHealthCheckModeBasic define mode which checks only if member responds to the version request
+	DeploymentMemberHealthCheckModeBasic DeploymentMemberHealthCheckMode = "basic"
+	// DeploymentMemberHealthCheckModeDeep define mode which additionally checks if member is able to serve requests
+	// (scheduler queue length and a read of the _system database)
+	DeploymentMemberHealthCheckModeDeep DeploymentMemberHealthCheckMode = "deep"
+)
@@ -20,9 +20,69 @@
 
 package v1
 
+import (
+	"regexp"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// MetricsServiceMonitorKind defines kind of the Prometheus Operator object used to scrape metrics
+type MetricsServiceMonitorKind string
+
+const (
+	// MetricsServiceMonitorKindServiceMonitor scrapes metrics with a ServiceMonitor selecting the exporter service
+	MetricsServiceMonitorKindServiceMonitor MetricsServiceMonitorKind = "ServiceMonitor"
+	// MetricsServiceMonitorKindPodMonitor scrapes metrics with a PodMonitor selecting the member pods
+	MetricsServiceMonitorKindPodMonitor MetricsServiceMonitorKind = "PodMonitor"
+)
+
+// Validate the kind
+func (k MetricsServiceMonitorKind) Validate() error {
+	switch k {
+	case MetricsServiceMonitorKindServiceMonitor, MetricsServiceMonitorKindPodMonitor:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown monitor kind: '%s'", string(k)))
+	}
+}
+
+// Get kind or default value
+func (k *MetricsServiceMonitorKind) Get() MetricsServiceMonitorKind {
+	if k == nil {
+		return MetricsServiceMonitorKindServiceMonitor
+	}
+
+	return *k
+}
+
+// DefaultMetricsServiceMonitorInterval is the default interval between scrapes
+const DefaultMetricsServiceMonitorInterval = "10s"
+
+var metricsServiceMonitorIntervalRegex = regexp.MustCompile(`^([0-9]+(ms|s|m|h|d|w|y))+$`)
+
+// MetricsRelabelConfig is a Prometheus relabeling rule applied to the scraped targets or samples
+type MetricsRelabelConfig struct {
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+	Separator    string   `json:"separator,omitempty"`
+	TargetLabel  string   `json:"targetLabel,omitempty"`
+	Regex        string   `json:"regex,omitempty"`
+	Modulus      uint64   `json:"modulus,omitempty"`
+	Replacement  string   `json:"replacement,omitempty"`
+	Action       string   `json:"action,omitempty"`
+}
+
 type MetricsServiceMonitorSpec struct {
 	Enabled *bool             `json:"enabled,omitempty"`
 	Labels  map[string]string `json:"labels,omitempty"`
+
+	// Kind of the created object, ServiceMonitor (default) or PodMonitor
+	Kind *MetricsServiceMonitorKind `json:"kind,omitempty"`
+	// Interval between scrapes, defaults to 10s
+	Interval *string `json:"interval,omitempty"`
+	// Relabelings applied to the targets before scraping
+	Relabelings []MetricsRelabelConfig `json:"relabelings,omitempty"`
+	// MetricRelabelings applied to the samples before ingestion
+	MetricRelabelings []MetricsRelabelConfig `json:"metricRelabelings,omitempty"`
 }
 
 func (m *MetricsServiceMonitorSpec) IsEnabled() bool {
@@ -33,6 +93,42 @@ func (m *MetricsServiceMonitorSpec) IsEnabled() bool {
 	return *m.Enabled
 }
 
+// GetKind returns the kind of the object used to scrape metrics
+func (m *MetricsServiceMonitorSpec) GetKind() MetricsServiceMonitorKind {
+	if m == nil {
+		return MetricsServiceMonitorKindServiceMonitor
+	}
+
+	return m.Kind.Get()
+}
+
+// GetInterval returns the interval between scrapes
+func (m *MetricsServiceMonitorSpec) GetInterval() string {
+	if m == nil || m.Interval == nil {
+		return DefaultMetricsServiceMonitorInterval
+	}
+
+	return *m.Interval
+}
+
+// GetRelabelings returns relabeling rules applied to the targets
+func (m *MetricsServiceMonitorSpec) GetRelabelings() []MetricsRelabelConfig {
+	if m == nil {
+		return nil
+	}
+
+	return m.Relabelings
+}
+
+// GetMetricRelabelings returns relabeling rules applied to the samples
+func (m *MetricsServiceMonitorSpec) GetMetricRelabelings() []MetricsRelabelConfig {
+	if m == nil {
+		return nil
+	}
+
+	return m.MetricRelabelings
+}
+
 func (m *MetricsServiceMonitorSpec) GetLabels(def map[string]string) map[string]string {
 	if m == nil {
 		return def
@@ -44,3 +140,22 @@ func (m *MetricsServiceMonitorSpec) GetLabels(def map[string]string) map[string]
 
 	return m.Labels
 }
+
+// Validate the given spec
+func (m *MetricsServiceMonitorSpec) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.Kind != nil {
+		if err := m.Kind.Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, "kind"))
+		}
+	}
+
+	if m.Interval != nil && !metricsServiceMonitorIntervalRegex.MatchString(*m.Interval) {
+		return errors.WithStack(errors.Wrapf(ValidationError, "interval '%s' is not a valid duration", *m.Interval))
+	}
+
+	return nil
+}
@@ -20,7 +20,20 @@
 
 package v1
 
-import "github.com/arangodb/kube-arangodb/pkg/util/errors"
+import (
+	"regexp"
+	"strings"
+
+	driver "github.com/arangodb/go-driver"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+var (
+	imageDigestRegex      = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+	imageHintVersionRegex = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+`)
+)
 
 type DeploymentImageDiscoveryModeSpec string
 
@@ -51,3 +64,69 @@ func (d *DeploymentImageDiscoveryModeSpec) Get() DeploymentImageDiscoveryModeSpe
 
 	return *d
 }
+
+// IsImageDigest returns true when the image is specified by digest (`<repository>@sha256:<hex>`)
+func IsImageDigest(image string) bool {
+	return strings.Contains(image, "@")
+}
+
+// ValidateImage validates the image reference. Images specified by digest need to use a valid sha256 digest.
+func ValidateImage(image string) error {
+	if image == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Image must be set"))
+	}
+
+	if id := strings.Index(image, "@"); id >= 0 {
+		if id == 0 {
+			return errors.WithStack(errors.Wrapf(ValidationError, "Image '%s' is missing repository", image))
+		}
+
+		if digest := image[id+1:]; !imageDigestRegex.MatchString(digest) {
+			return errors.WithStack(errors.Wrapf(ValidationError, "Image '%s' has invalid digest '%s'", image, digest))
+		}
+	}
+
+	return nil
+}
+
+// DeploymentImageHintSpec describes the ArangoDB image, so image discovery pod can be skipped
+// (e.g. in air-gapped environments where the discovery pod can not run)
+type DeploymentImageHintSpec struct {
+	// Version of ArangoDB in the image
+	Version driver.Version `json:"version"`
+	// Enterprise is true when the image contains Enterprise Edition of ArangoDB
+	Enterprise *bool `json:"enterprise,omitempty"`
+}
+
+// IsEnterprise returns true when the image contains Enterprise Edition of ArangoDB
+func (d *DeploymentImageHintSpec) IsEnterprise() bool {
+	if d == nil {
+		return false
+	}
+
+	return util.BoolOrDefault(d.Enterprise, false)
+}
+
+// Validate the given spec
+func (d *DeploymentImageHintSpec) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if !imageHintVersionRegex.MatchString(string(d.Version)) {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid version '%s', expected <major>.<minor>.<patch>", d.Version))
+	}
+
+	return nil
+}
+
+// ImageInfo returns the image info of the given image built from the hint
+func (d *DeploymentImageHintSpec) ImageInfo(image string, architectures ArangoDeploymentArchitecture) ImageInfo {
+	return ImageInfo{
+		Image:           image,
+		ImageID:         image,
+		ArangoDBVersion: d.Version,
+		Enterprise:      d.IsEnterprise(),
+		Architectures:   architectures.DeepCopy(),
+	}
+}
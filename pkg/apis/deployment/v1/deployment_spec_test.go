@@ -103,6 +103,34 @@ func TestDeploymentSpecResetImmutableFields(t *testing.T) {
 			false,
 			[]string{"disableIPv6"},
 		},
+		{
+			DeploymentSpec{},
+			DeploymentSpec{Port: util.NewInt(8530)},
+			DeploymentSpec{},
+			false,
+			[]string{"port"},
+		},
+		{
+			DeploymentSpec{Port: util.NewInt(8530)},
+			DeploymentSpec{Port: util.NewInt(8530), Coordinators: ServerGroupSpec{Port: util.NewInt(8531)}},
+			DeploymentSpec{Port: util.NewInt(8530)},
+			false,
+			[]string{"coordinators.port"},
+		},
+		{
+			DeploymentSpec{},
+			DeploymentSpec{InitFrom: &DeploymentInitFromSpec{Backup: util.NewString("backup")}},
+			DeploymentSpec{},
+			false,
+			[]string{"initFrom"},
+		},
+		{
+			DeploymentSpec{InitFrom: &DeploymentInitFromSpec{Backup: util.NewString("backup")}},
+			DeploymentSpec{},
+			DeploymentSpec{InitFrom: &DeploymentInitFromSpec{Backup: util.NewString("backup")}},
+			false,
+			[]string{"initFrom"},
+		},
 	}
 
 	for _, test := range tests {
@@ -199,3 +227,64 @@ func TestDeploymentSpec_GetCoreContainers(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploymentSpec_GetServerGroupPort(t *testing.T) {
+	s := DeploymentSpec{}
+	assert.Equal(t, 8529, s.GetPort())
+	assert.Equal(t, 8529, s.GetServerGroupPort(ServerGroupDBServers))
+	assert.Equal(t, 8529, s.GetDatabasePort())
+
+	s.Port = util.NewInt(8530)
+	s.Coordinators.Port = util.NewInt(8531)
+	assert.Equal(t, 8530, s.GetServerGroupPort(ServerGroupDBServers))
+	assert.Equal(t, 8531, s.GetServerGroupPort(ServerGroupCoordinators))
+	assert.Equal(t, 8531, s.GetDatabasePort())
+
+	s.Mode = NewMode(DeploymentModeSingle)
+	assert.Equal(t, 8530, s.GetDatabasePort())
+}
+
+func TestDeploymentSpec_GetTLSSNI(t *testing.T) {
+	s := DeploymentSpec{
+		TLS: TLSSpec{
+			SNI: &TLSSNISpec{
+				Mapping: map[string][]string{
+					"internal": {"internal.example.com"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, map[string][]string{"internal": {"internal.example.com"}}, s.GetTLSSNI().Mapping)
+
+	s.ExternalAccess = ExternalAccessSpec{
+		AdvertisedEndpoint: util.NewString("https://db.example.com:8529"),
+		AltNames:           []string{"lb.example.com", "10.0.0.1"},
+		TLS: &ExternalAccessTLSSpec{
+			SecretName: util.NewString("public"),
+		},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"internal": {"internal.example.com"},
+		"public":   {"lb.example.com", "db.example.com"},
+	}, s.GetTLSSNI().Mapping)
+	assert.Len(t, s.TLS.GetSNI().Mapping, 1)
+}
+
+func TestDeploymentSpec_GetDiskUsageWarningThreshold(t *testing.T) {
+	s := DeploymentSpec{}
+	s.SetDefaults("test")
+	assert.Equal(t, DefaultDiskUsageWarningThreshold, s.GetDiskUsageWarningThreshold())
+	assert.NoError(t, s.Validate())
+
+	s.DiskUsageWarningThreshold = util.NewInt(75)
+	assert.Equal(t, 75, s.GetDiskUsageWarningThreshold())
+	assert.NoError(t, s.Validate())
+
+	s.DiskUsageWarningThreshold = util.NewInt(0)
+	assert.Error(t, s.Validate())
+
+	s.DiskUsageWarningThreshold = util.NewInt(101)
+	assert.Error(t, s.Validate())
+}
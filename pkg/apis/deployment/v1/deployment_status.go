@@ -30,6 +30,13 @@ type DeploymentStatus struct {
 	Phase DeploymentPhase `json:"phase,omitempty"`
 	// Reason contains a human readable reason for reaching the current state (can be empty)
 	Reason string `json:"reason,omitempty"` // Reason for current state
+	// Summary contains a human readable summary of the operation in progress, e.g. "Upgrading dbservers 2/5"
+	Summary string `json:"summary,omitempty"`
+	// ReadyMembers contains the number of ready members out of all members, e.g. "8/9"
+	ReadyMembers string `json:"readyMembers,omitempty"`
+
+	// Operator keeps information about the operator instance which reconciled the deployment
+	Operator *DeploymentStatusOperator `json:"operator,omitempty"`
 
 	// AppliedVersion defines checksum of applied spec
 	AppliedVersion string `json:"appliedVersion"`
@@ -40,6 +47,8 @@ type DeploymentStatus struct {
 	// SyncServiceName holds the name of the Service a client can use (inside the k8s cluster)
 	// to access syncmasters (only set when dc2dc synchronization is enabled).
 	SyncServiceName string `json:"syncServiceName,omitempty"`
+	// AdvertisedEndpoint holds the endpoint advertised by the coordinators (or single servers in ActiveFailover mode)
+	AdvertisedEndpoint string `json:"advertisedEndpoint,omitempty"`
 
 	ExporterServiceName string `json:"exporterServiceName,omitempty"`
 
@@ -85,14 +94,27 @@ type DeploymentStatus struct {
 	Rebalancer *ArangoDeploymentRebalancerStatus `json:"rebalancer,omitempty"`
 
 	BackOff BackOff `json:"backoff,omitempty"`
+
+	// UpgradeChannel keeps the state of the upgrade channel checks
+	UpgradeChannel *DeploymentStatusUpgradeChannel `json:"upgradeChannel,omitempty"`
+
+	// Upgrade keeps the progress of the last version upgrade
+	Upgrade *DeploymentStatusUpgrade `json:"upgrade,omitempty"`
+
+	// License keeps the license installed in the deployment
+	License *DeploymentStatusLicense `json:"license,omitempty"`
 }
 
 // Equal checks for equality
 func (ds *DeploymentStatus) Equal(other DeploymentStatus) bool {
 	return ds.Phase == other.Phase &&
 		ds.Reason == other.Reason &&
+		ds.Summary == other.Summary &&
+		ds.ReadyMembers == other.ReadyMembers &&
+		ds.Operator.Equal(other.Operator) &&
 		ds.ServiceName == other.ServiceName &&
 		ds.SyncServiceName == other.SyncServiceName &&
+		ds.AdvertisedEndpoint == other.AdvertisedEndpoint &&
 		ds.ExporterServiceName == other.ExporterServiceName &&
 		ds.ExporterServiceMonitorName == other.ExporterServiceMonitorName &&
 		ds.Images.Equal(other.Images) &&
@@ -105,7 +127,10 @@ func (ds *DeploymentStatus) Equal(other DeploymentStatus) bool {
 		ds.SecretHashes.Equal(other.SecretHashes) &&
 		ds.Agency.Equal(other.Agency) &&
 		ds.Topology.Equal(other.Topology) &&
-		ds.BackOff.Equal(other.BackOff)
+		ds.BackOff.Equal(other.BackOff) &&
+		ds.UpgradeChannel.Equal(other.UpgradeChannel) &&
+		ds.Upgrade.Equal(other.Upgrade) &&
+		ds.License.Equal(other.License)
 }
 
 // IsForceReload returns true if ForceStatusReload is set to true
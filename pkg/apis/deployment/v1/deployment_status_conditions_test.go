@@ -0,0 +1,97 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentStatusUpdateStatusConditions(t *testing.T) {
+	readyMember := func(id string) MemberStatus {
+		m := MemberStatus{ID: id, Phase: MemberPhaseCreated}
+		m.Conditions.Update(ConditionTypeStarted, true, "", "")
+		m.Conditions.Update(ConditionTypeReady, true, "", "")
+		return m
+	}
+
+	t.Run("Healthy", func(t *testing.T) {
+		s := DeploymentStatus{}
+		s.Members.DBServers = MemberStatusList{readyMember("a")}
+		s.Conditions.Update(ConditionTypeUpToDate, true, "", "")
+
+		assert.True(t, s.UpdateStatusConditions(nil))
+		assert.False(t, s.Conditions.IsTrue(ConditionTypeProgressing))
+		assert.False(t, s.Conditions.IsTrue(ConditionTypeDegraded))
+		assert.False(t, s.Conditions.IsTrue(ConditionTypeBackupInProgress))
+
+		assert.False(t, s.UpdateStatusConditions(nil))
+	})
+
+	t.Run("Progressing", func(t *testing.T) {
+		s := DeploymentStatus{}
+		s.Conditions.Update(ConditionTypeUpToDate, false, "Spec Changed", "")
+
+		s.UpdateStatusConditions(nil)
+		c, _ := s.Conditions.Get(ConditionTypeProgressing)
+		assert.True(t, c.IsTrue())
+		assert.Equal(t, "Spec Changed", c.Reason)
+
+		s.Plan = Plan{{Type: ActionTypeRotateMember}}
+		s.UpdateStatusConditions(nil)
+		c, _ = s.Conditions.Get(ConditionTypeProgressing)
+		assert.Equal(t, "Plan in progress", c.Reason)
+		assert.Equal(t, "Executing RotateMember action", c.Message)
+	})
+
+	t.Run("Degraded", func(t *testing.T) {
+		s := DeploymentStatus{}
+		m := readyMember("a")
+		m.Conditions.Update(ConditionTypeReady, false, "", "")
+		s.Members.Coordinators = MemberStatusList{m}
+
+		s.Plan = Plan{{Type: ActionTypeRotateMember}}
+		s.UpdateStatusConditions(nil)
+		assert.False(t, s.Conditions.IsTrue(ConditionTypeDegraded))
+
+		s.Plan = nil
+		s.UpdateStatusConditions(nil)
+		c, _ := s.Conditions.Get(ConditionTypeDegraded)
+		assert.True(t, c.IsTrue())
+		assert.Equal(t, "Members not ready", c.Reason)
+
+		s.Members.Agents = MemberStatusList{{ID: "b", Phase: MemberPhaseFailed}}
+		s.UpdateStatusConditions(nil)
+		c, _ = s.Conditions.Get(ConditionTypeDegraded)
+		assert.Equal(t, "Members failed", c.Reason)
+		assert.Equal(t, "Members: b", c.Message)
+	})
+
+	t.Run("BackupInProgress", func(t *testing.T) {
+		s := DeploymentStatus{}
+
+		s.UpdateStatusConditions([]string{"backup"})
+		c, _ := s.Conditions.Get(ConditionTypeBackupInProgress)
+		assert.True(t, c.IsTrue())
+		assert.Equal(t, "Backups: backup", c.Message)
+	})
+}
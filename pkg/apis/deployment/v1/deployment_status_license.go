@@ -0,0 +1,121 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+// DeploymentStatusLicense keeps the license installed in the deployment, as reported by the members
+type DeploymentStatusLicense struct {
+	// Type of the license reported by the servers (community or enterprise)
+	Type string `json:"type,omitempty"`
+	// Expires holds the earliest expiration time of the license reported by the servers
+	Expires *meta.Time `json:"expires,omitempty"`
+}
+
+// Equal checks for equality
+func (d *DeploymentStatusLicense) Equal(other *DeploymentStatusLicense) bool {
+	if d == nil && other == nil {
+		return true
+	} else if d == nil || other == nil {
+		return false
+	}
+
+	return d.Type == other.Type &&
+		((d.Expires == nil && other.Expires == nil) || util.TimeCompareEqualPointer(d.Expires, other.Expires))
+}
+
+// UpdateLicenseStatus updates the license status and the LicenseExpiring condition based on the license
+// reported by the ArangoD members. Returns true when changes were made, false otherwise.
+func (ds *DeploymentStatus) UpdateLicenseStatus(now time.Time, warningPeriod time.Duration) bool {
+	var license *DeploymentStatusLicense
+
+	ds.Members.ForeachServerGroup(func(group ServerGroup, list MemberStatusList) error {
+		if !group.IsArangod() {
+			return nil
+		}
+
+		for _, m := range list {
+			s := m.Server
+			if s == nil || s.License == "" {
+				continue
+			}
+
+			if license == nil {
+				license = &DeploymentStatusLicense{}
+			}
+
+			if license.Type == "" {
+				license.Type = s.License
+			}
+
+			if s.LicenseExpires != nil && (license.Expires == nil || s.LicenseExpires.Before(license.Expires)) {
+				license.Expires = s.LicenseExpires.DeepCopy()
+			}
+		}
+		return nil
+	})
+
+	changed := false
+
+	if license == nil {
+		// Members did not report license yet, keep the last known one
+		license = ds.License
+	} else if !ds.License.Equal(license) {
+		ds.License = license
+		changed = true
+	}
+
+	if license == nil || license.Expires == nil {
+		if ds.Conditions.Remove(ConditionTypeLicenseExpiring) {
+			changed = true
+		}
+		return changed
+	}
+
+	expires := license.Expires.Time
+
+	switch {
+	case !now.Before(expires):
+		if ds.Conditions.Update(ConditionTypeLicenseExpiring, true, "License expired",
+			fmt.Sprintf("License expired at %s", expires.UTC().Format(time.RFC3339))) {
+			changed = true
+		}
+	case expires.Sub(now) <= warningPeriod:
+		if ds.Conditions.Update(ConditionTypeLicenseExpiring, true, "License expires soon",
+			fmt.Sprintf("License expires at %s", expires.UTC().Format(time.RFC3339))) {
+			changed = true
+		}
+	default:
+		if ds.Conditions.Update(ConditionTypeLicenseExpiring, false, "License is valid",
+			fmt.Sprintf("License expires at %s", expires.UTC().Format(time.RFC3339))) {
+			changed = true
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentStatusOperator keeps information about the operator instance which reconciled the deployment
+type DeploymentStatusOperator struct {
+	// PodName is the name of the operator pod
+	PodName string `json:"podName,omitempty"`
+	// Version is the version of the operator
+	Version string `json:"version,omitempty"`
+	// LastReconcile is the time of the last reconcile of the deployment
+	LastReconcile meta.Time `json:"lastReconcile,omitempty"`
+}
+
+// Equal checks for equality
+func (d *DeploymentStatusOperator) Equal(other *DeploymentStatusOperator) bool {
+	if d == nil || other == nil {
+		return d == nil && other == nil
+	}
+
+	return d.PodName == other.PodName &&
+		d.Version == other.Version &&
+		d.LastReconcile.Equal(&other.LastReconcile)
+}
+
+// UpdateOperator records the operator instance which reconciled the deployment.
+// Time of the last reconcile is refreshed only when it is older than the given interval, to limit status updates.
+// Returns true when changes were made, false otherwise.
+func (ds *DeploymentStatus) UpdateOperator(podName, version string, now time.Time, interval time.Duration) bool {
+	if o := ds.Operator; o != nil && o.PodName == podName && o.Version == version && now.Sub(o.LastReconcile.Time) < interval {
+		return false
+	}
+
+	ds.Operator = &DeploymentStatusOperator{
+		PodName:       podName,
+		Version:       version,
+		LastReconcile: meta.NewTime(now),
+	}
+
+	return true
+}
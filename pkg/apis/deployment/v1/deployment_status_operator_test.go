@@ -0,0 +1,47 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentStatusUpdateOperator(t *testing.T) {
+	now := time.Now()
+	s := DeploymentStatus{}
+
+	assert.True(t, s.UpdateOperator("operator-a", "1.2.9", now, time.Minute))
+	assert.Equal(t, "operator-a", s.Operator.PodName)
+	assert.Equal(t, "1.2.9", s.Operator.Version)
+
+	// Not refreshed within the interval
+	assert.False(t, s.UpdateOperator("operator-a", "1.2.9", now.Add(30*time.Second), time.Minute))
+
+	assert.True(t, s.UpdateOperator("operator-a", "1.2.9", now.Add(2*time.Minute), time.Minute))
+	assert.Equal(t, now.Add(2*time.Minute).Unix(), s.Operator.LastReconcile.Unix())
+
+	// Handover to another operator is recorded immediately
+	assert.True(t, s.UpdateOperator("operator-b", "1.2.9", now.Add(2*time.Minute), time.Minute))
+	assert.Equal(t, "operator-b", s.Operator.PodName)
+}
@@ -0,0 +1,142 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"fmt"
+)
+
+const (
+	// DeploymentSummaryReady is set as summary when all members are ready and no operation is in progress
+	DeploymentSummaryReady = "Ready"
+	// DeploymentSummaryFailed is set as summary when the deployment is in the failed phase
+	DeploymentSummaryFailed = "Failed"
+	// DeploymentSummaryWaitingForMembers is set as summary when some of the members are not ready
+	DeploymentSummaryWaitingForMembers = "Waiting for members to become ready"
+)
+
+// UpdateSummary updates the human-readable summary and the number of ready members of the deployment.
+// Summary of a backup operation is used when no plan is in progress.
+// Returns true when changes were made, false otherwise.
+func (ds *DeploymentStatus) UpdateSummary(backupSummary string) bool {
+	ready, total := 0, 0
+	ds.Members.ForeachServerGroup(func(group ServerGroup, list MemberStatusList) error {
+		for _, m := range list {
+			total++
+			if m.Conditions.IsTrue(ConditionTypeReady) {
+				ready++
+			}
+		}
+		return nil
+	})
+
+	readyMembers := fmt.Sprintf("%d/%d", ready, total)
+	summary := ds.getSummary(backupSummary, ready == total)
+
+	if ds.Summary == summary && ds.ReadyMembers == readyMembers {
+		return false
+	}
+
+	ds.Summary = summary
+	ds.ReadyMembers = readyMembers
+
+	return true
+}
+
+func (ds *DeploymentStatus) getSummary(backupSummary string, allReady bool) string {
+	if ds.Phase == DeploymentPhaseFailed {
+		return DeploymentSummaryFailed
+	}
+
+	if ds.Restore != nil && ds.Restore.State == DeploymentRestoreStateRestoring {
+		return fmt.Sprintf("Restoring backup %s", ds.Restore.RequestedFrom)
+	}
+
+	for _, plan := range []Plan{ds.HighPriorityPlan, ds.Plan} {
+		if !plan.IsEmpty() {
+			return ds.getPlanSummary(plan)
+		}
+	}
+
+	if backupSummary != "" {
+		return backupSummary
+	}
+
+	if !allReady {
+		return DeploymentSummaryWaitingForMembers
+	}
+
+	return DeploymentSummaryReady
+}
+
+func (ds *DeploymentStatus) getPlanSummary(plan Plan) string {
+	// Upgrade plan of the member sets the target image on the member first
+	for _, a := range plan {
+		image := a.Image
+		switch a.Type {
+		case ActionTypeSetMemberCurrentImage:
+		case ActionTypeUpgradeMember:
+			if ds.CurrentImage != nil {
+				image = ds.CurrentImage.Image
+			}
+		default:
+			continue
+		}
+
+		if image == "" {
+			continue
+		}
+
+		members := ds.Members.MembersOfGroup(a.Group)
+		current := 1
+		for _, m := range members {
+			if m.ID != a.MemberID && m.Image != nil && m.Image.Image == image {
+				current++
+			}
+		}
+
+		return fmt.Sprintf("Upgrading %s %d/%d", summaryGroupName(a.Group), current, len(members))
+	}
+
+	a := plan[0]
+	switch a.Type {
+	case ActionTypeAddMember:
+		return fmt.Sprintf("Scaling up %s", summaryGroupName(a.Group))
+	case ActionTypeCleanOutMember, ActionTypeRemoveMember:
+		return fmt.Sprintf("Scaling down %s", summaryGroupName(a.Group))
+	case ActionTypeRotateMember, ActionTypeRotateStartMember:
+		return fmt.Sprintf("Rotating %s", summaryGroupName(a.Group))
+	}
+
+	if a.Group == ServerGroupUnknown {
+		return fmt.Sprintf("Executing %s", a.Type)
+	}
+
+	return fmt.Sprintf("Executing %s on %s", a.Type, summaryGroupName(a.Group))
+}
+
+func summaryGroupName(group ServerGroup) string {
+	if group == ServerGroupSingle {
+		return group.AsRole()
+	}
+
+	return group.AsRole() + "s"
+}
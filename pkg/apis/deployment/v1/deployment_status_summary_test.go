@@ -0,0 +1,97 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentStatusUpdateSummary(t *testing.T) {
+	member := func(id, image string, ready bool) MemberStatus {
+		m := MemberStatus{ID: id, Image: &ImageInfo{Image: image}}
+		m.Conditions.Update(ConditionTypeReady, ready, "", "")
+		return m
+	}
+
+	t.Run("Ready", func(t *testing.T) {
+		s := DeploymentStatus{}
+		s.Members.DBServers = MemberStatusList{member("a", "old", true), member("b", "old", true)}
+
+		assert.True(t, s.UpdateSummary(""))
+		assert.Equal(t, DeploymentSummaryReady, s.Summary)
+		assert.Equal(t, "2/2", s.ReadyMembers)
+
+		assert.False(t, s.UpdateSummary(""))
+	})
+
+	t.Run("Not ready", func(t *testing.T) {
+		s := DeploymentStatus{}
+		s.Members.DBServers = MemberStatusList{member("a", "old", true), member("b", "old", false)}
+
+		s.UpdateSummary("")
+		assert.Equal(t, DeploymentSummaryWaitingForMembers, s.Summary)
+		assert.Equal(t, "1/2", s.ReadyMembers)
+	})
+
+	t.Run("Backup", func(t *testing.T) {
+		s := DeploymentStatus{}
+
+		s.UpdateSummary("Waiting for backup upload")
+		assert.Equal(t, "Waiting for backup upload", s.Summary)
+	})
+
+	t.Run("Upgrade", func(t *testing.T) {
+		s := DeploymentStatus{}
+		s.Members.DBServers = MemberStatusList{member("a", "new", true), member("b", "old", true), member("c", "old", true)}
+		s.Plan = Plan{
+			{Type: ActionTypeSetMemberCurrentImage, Group: ServerGroupDBServers, MemberID: "b", Image: "new"},
+			{Type: ActionTypeUpgradeMember, Group: ServerGroupDBServers, MemberID: "b"},
+		}
+
+		s.UpdateSummary("Waiting for backup upload")
+		assert.Equal(t, "Upgrading dbservers 2/3", s.Summary)
+
+		// Member image is already set
+		s.CurrentImage = &ImageInfo{Image: "new"}
+		s.Members.DBServers[1].Image.Image = "new"
+		s.Plan = s.Plan[1:]
+		s.UpdateSummary("")
+		assert.Equal(t, "Upgrading dbservers 2/3", s.Summary)
+	})
+
+	t.Run("Scaling", func(t *testing.T) {
+		s := DeploymentStatus{}
+		s.Plan = Plan{{Type: ActionTypeAddMember, Group: ServerGroupCoordinators}}
+
+		s.UpdateSummary("")
+		assert.Equal(t, "Scaling up coordinators", s.Summary)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		s := DeploymentStatus{Phase: DeploymentPhaseFailed}
+		s.Plan = Plan{{Type: ActionTypeAddMember, Group: ServerGroupCoordinators}}
+
+		s.UpdateSummary("")
+		assert.Equal(t, DeploymentSummaryFailed, s.Summary)
+	})
+}
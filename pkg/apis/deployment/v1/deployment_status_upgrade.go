@@ -0,0 +1,239 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentUpgradePhase is a strongly typed phase of the deployment version upgrade
+type DeploymentUpgradePhase string
+
+const (
+	// DeploymentUpgradePhaseInProgress indicates that members are being upgraded
+	DeploymentUpgradePhaseInProgress DeploymentUpgradePhase = "InProgress"
+	// DeploymentUpgradePhaseCompleted indicates that all members are running the new version
+	DeploymentUpgradePhaseCompleted DeploymentUpgradePhase = "Completed"
+	// DeploymentUpgradePhaseFailed indicates that upgrade of at least one member failed
+	DeploymentUpgradePhaseFailed DeploymentUpgradePhase = "Failed"
+	// DeploymentUpgradePhaseRolledBack indicates that failed upgrade was rolled back to the previous image
+	DeploymentUpgradePhaseRolledBack DeploymentUpgradePhase = "RolledBack"
+)
+
+// DeploymentStatusUpgrade keeps the progress of the last version upgrade of the deployment
+type DeploymentStatusUpgrade struct {
+	// Phase of the upgrade
+	Phase DeploymentUpgradePhase `json:"phase"`
+	// FromVersion is the version members are upgraded from
+	FromVersion driver.Version `json:"fromVersion,omitempty"`
+	// ToVersion is the version members are upgraded to
+	ToVersion driver.Version `json:"toVersion,omitempty"`
+	// FromImage is the image members are upgraded from
+	FromImage string `json:"fromImage,omitempty"`
+	// ToImage is the image members are upgraded to
+	ToImage string `json:"toImage,omitempty"`
+	// MembersUpgraded is the number of members already running the new version
+	MembersUpgraded int `json:"membersUpgraded"`
+	// MembersRemaining is the number of members which still need to be upgraded
+	MembersRemaining int `json:"membersRemaining"`
+	// CurrentMember is the ID of the member which is being upgraded
+	CurrentMember string `json:"currentMember,omitempty"`
+	// StartTime is the time when the upgrade was detected
+	StartTime meta.Time `json:"startTime"`
+	// FinishTime is the time when the upgrade completed
+	FinishTime *meta.Time `json:"finishTime,omitempty"`
+}
+
+// Equal checks for equality
+func (d *DeploymentStatusUpgrade) Equal(other *DeploymentStatusUpgrade) bool {
+	if d == nil && other == nil {
+		return true
+	} else if d == nil || other == nil {
+		return false
+	}
+
+	return d.Phase == other.Phase &&
+		d.FromVersion == other.FromVersion &&
+		d.ToVersion == other.ToVersion &&
+		d.FromImage == other.FromImage &&
+		d.ToImage == other.ToImage &&
+		d.MembersUpgraded == other.MembersUpgraded &&
+		d.MembersRemaining == other.MembersRemaining &&
+		d.CurrentMember == other.CurrentMember &&
+		d.StartTime.Equal(&other.StartTime) &&
+		d.FinishTime.Equal(other.FinishTime)
+}
+
+// UpdateUpgradeStatus updates the upgrade progress and the UpgradeCompleted & UpgradeFailed conditions
+// based on the images of the members and the current image of the deployment.
+// Returns true when changes were made, false otherwise.
+func (ds *DeploymentStatus) UpdateUpgradeStatus(now time.Time) bool {
+	target := ds.CurrentImage
+	if target == nil {
+		return false
+	}
+
+	if u := ds.Upgrade; u != nil && u.ToImage == target.Image && u.Phase == DeploymentUpgradePhaseCompleted {
+		// Completed upgrade is terminal, later operations on members are not part of it
+		return false
+	}
+
+	var upgraded, remaining int
+	var from *ImageInfo
+	var failed bool
+
+	inPlan := map[string]bool{}
+	currentMember := ""
+	for _, plan := range []Plan{ds.HighPriorityPlan, ds.Plan} {
+		for _, a := range plan {
+			if a.MemberID == "" {
+				continue
+			}
+
+			inPlan[a.MemberID] = true
+			if currentMember == "" {
+				currentMember = a.MemberID
+			}
+		}
+	}
+
+	ds.Members.ForeachServerGroup(func(group ServerGroup, list MemberStatusList) error {
+		if !group.IsArangod() {
+			return nil
+		}
+
+		for _, m := range list {
+			if m.Image == nil {
+				continue
+			}
+
+			if m.Conditions.IsTrue(ConditionTypeUpgradeFailed) {
+				failed = true
+			}
+
+			if m.Image.Image == target.Image && !inPlan[m.ID] && !m.Conditions.IsTrue(ConditionTypeUpgradeFailed) {
+				upgraded++
+				continue
+			}
+
+			remaining++
+
+			if from == nil {
+				if m.Image.Image != target.Image {
+					from = m.Image.DeepCopy()
+				} else if m.OldImage != nil && m.OldImage.Image != target.Image {
+					from = m.OldImage.DeepCopy()
+				}
+			}
+		}
+		return nil
+	})
+
+	upgrade := ds.Upgrade.DeepCopy()
+
+	if upgrade == nil || upgrade.ToImage != target.Image {
+		if remaining == 0 || from == nil {
+			// No upgrade in progress
+			return false
+		}
+
+		upgrade = &DeploymentStatusUpgrade{
+			FromVersion: from.ArangoDBVersion,
+			ToVersion:   target.ArangoDBVersion,
+			FromImage:   from.Image,
+			ToImage:     target.Image,
+			StartTime:   meta.NewTime(now),
+		}
+	}
+
+	upgrade.MembersUpgraded = upgraded
+	upgrade.MembersRemaining = remaining
+
+	changed := false
+
+	switch {
+	case failed || (remaining > 0 && upgrade.Phase == DeploymentUpgradePhaseFailed):
+		// Failure is kept until all members are upgraded
+		upgrade.Phase = DeploymentUpgradePhaseFailed
+		upgrade.CurrentMember = currentMember
+		upgrade.FinishTime = nil
+
+		if !ds.Conditions.IsTrue(ConditionTypeUpgradeFailed) && ds.Conditions.Update(ConditionTypeUpgradeFailed, true, "Upgrade failed",
+			fmt.Sprintf("Upgrade from %s to %s failed", upgrade.FromVersion, upgrade.ToVersion)) {
+			changed = true
+		}
+		if ds.Conditions.Update(ConditionTypeUpgradeCompleted, false, "Upgrade failed", "") {
+			changed = true
+		}
+	case remaining > 0:
+		upgrade.Phase = DeploymentUpgradePhaseInProgress
+		upgrade.CurrentMember = currentMember
+		upgrade.FinishTime = nil
+
+		if ds.Conditions.Remove(ConditionTypeUpgradeFailed) {
+			changed = true
+		}
+		if ds.Conditions.Update(ConditionTypeUpgradeCompleted, false, "Upgrade in progress",
+			fmt.Sprintf("Upgrading from %s to %s", upgrade.FromVersion, upgrade.ToVersion)) {
+			changed = true
+		}
+	default:
+		if upgrade.Phase != DeploymentUpgradePhaseCompleted {
+			upgrade.Phase = DeploymentUpgradePhaseCompleted
+			upgrade.FinishTime = &meta.Time{Time: now}
+		}
+		upgrade.CurrentMember = ""
+
+		if ds.Conditions.Remove(ConditionTypeUpgradeFailed) {
+			changed = true
+		}
+		if ds.Conditions.Update(ConditionTypeUpgradeCompleted, true, "Upgrade completed",
+			fmt.Sprintf("Upgraded from %s to %s", upgrade.FromVersion, upgrade.ToVersion)) {
+			changed = true
+		}
+	}
+
+	if !ds.Upgrade.Equal(upgrade) {
+		ds.Upgrade = upgrade
+		changed = true
+	}
+
+	return changed
+}
+
+// MarkUpgradeFailed marks the upgrade in progress as failed, e.g. when its plan was aborted.
+// Returns true when changes were made, false otherwise.
+func (ds *DeploymentStatus) MarkUpgradeFailed(message string) bool {
+	if ds.Upgrade == nil || ds.Upgrade.Phase != DeploymentUpgradePhaseInProgress {
+		return false
+	}
+
+	ds.Upgrade = ds.Upgrade.DeepCopy()
+	ds.Upgrade.Phase = DeploymentUpgradePhaseFailed
+	ds.Conditions.Update(ConditionTypeUpgradeFailed, true, "Upgrade failed", message)
+	ds.Conditions.Update(ConditionTypeUpgradeCompleted, false, "Upgrade failed", "")
+
+	return true
+}
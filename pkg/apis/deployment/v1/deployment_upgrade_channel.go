@@ -0,0 +1,104 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/arangodb/go-driver"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+var (
+	upgradeChannelRegex = regexp.MustCompile(`^([0-9]+)\.([0-9]+)-patch$`)
+	releaseTagRegex     = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`)
+)
+
+// UpgradeChannel defines stream of images the deployment follows, in format `<major>.<minor>-patch`
+type UpgradeChannel string
+
+func (u UpgradeChannel) String() string {
+	return string(u)
+}
+
+// Parse returns major and minor version of the channel
+func (u UpgradeChannel) Parse() (int, int, error) {
+	parts := upgradeChannelRegex.FindStringSubmatch(string(u))
+	if len(parts) != 3 {
+		return 0, 0, errors.WithStack(errors.Wrapf(ValidationError, "Invalid upgrade channel: '%s', expected format <major>.<minor>-patch", u.String()))
+	}
+
+	major, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, errors.WithStack(errors.Wrapf(ValidationError, "Invalid upgrade channel major version: '%s'", parts[1]))
+	}
+
+	minor, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, 0, errors.WithStack(errors.Wrapf(ValidationError, "Invalid upgrade channel minor version: '%s'", parts[2]))
+	}
+
+	return major, minor, nil
+}
+
+// Validate the channel
+func (u UpgradeChannel) Validate() error {
+	_, _, err := u.Parse()
+	return err
+}
+
+// Contains returns true when the given version is a release of the channel
+func (u UpgradeChannel) Contains(v driver.Version) bool {
+	major, minor, err := u.Parse()
+	if err != nil {
+		return false
+	}
+
+	if _, ok := v.SubInt(); !ok {
+		return false
+	}
+
+	return v.Major() == major && v.Minor() == minor
+}
+
+// SelectLatest returns the newest release tag of the channel from the given list of tags.
+// Only plain release tags (`<major>.<minor>.<patch>`) are taken into account.
+func (u UpgradeChannel) SelectLatest(tags []string) (string, bool) {
+	var latest string
+
+	for _, tag := range tags {
+		if !releaseTagRegex.MatchString(tag) {
+			continue
+		}
+
+		if !u.Contains(driver.Version(tag)) {
+			continue
+		}
+
+		if latest == "" || driver.Version(tag).CompareTo(driver.Version(latest)) > 0 {
+			latest = tag
+		}
+	}
+
+	return latest, latest != ""
+}
@@ -0,0 +1,43 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func TestExternalAccessIngressSpecValidate(t *testing.T) {
+	var empty *ExternalAccessIngressSpec
+	assert.NoError(t, empty.Validate())
+
+	assert.NoError(t, (&ExternalAccessIngressSpec{
+		Host:          util.NewString("arangodb.example.com"),
+		TLSSecretName: util.NewString("arangodb-tls"),
+	}).Validate())
+
+	assert.Error(t, (&ExternalAccessIngressSpec{Host: util.NewString("not a host")}).Validate())
+	assert.Error(t, (&ExternalAccessIngressSpec{TLSSecretName: util.NewString("arangodb-tls")}).Validate())
+	assert.Error(t, (&ExternalAccessIngressSpec{Host: util.NewString("arangodb.example.com"), TLSSecretName: util.NewString("Invalid_Name")}).Validate())
+}
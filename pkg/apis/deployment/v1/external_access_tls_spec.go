@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// ExternalAccessTLSSpec holds TLS configuration of the external access
+type ExternalAccessTLSSpec struct {
+	// SecretName define name of the secret with keyfile (tls.keyfile) presented to clients connecting via external access hostnames.
+	// Certificate is served with SNI, internal communication keeps operator managed certificates.
+	SecretName *string `json:"secretName,omitempty"`
+}
+
+// GetSecretName returns the name of the secret with external access keyfile.
+func (s *ExternalAccessTLSSpec) GetSecretName() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.SecretName)
+}
+
+// Validate the given spec
+func (s *ExternalAccessTLSSpec) Validate() error {
+	if s == nil || s.SecretName == nil {
+		return nil
+	}
+
+	if err := k8sutil.ValidateResourceName(s.GetSecretName()); err != nil {
+		return errors.WithStack(errors.Wrap(err, "secretName"))
+	}
+
+	return nil
+}
@@ -21,13 +21,23 @@
 package v1
 
 import (
+	"time"
+
 	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
+const (
+	// DefaultLicenseExpirationWarningPeriod is the default period before license expiration in which warnings are reported
+	DefaultLicenseExpirationWarningPeriod = 14 * 24 * time.Hour
+)
+
 // LicenseSpec holds the license related information
 type LicenseSpec struct {
 	SecretName *string `json:"secretName,omitempty"`
+	// ExpirationWarningPeriod defines how long before license expiration the LicenseExpiring condition and events are reported
+	ExpirationWarningPeriod *Duration `json:"expirationWarningPeriod,omitempty"`
 }
 
 // HasSecretName returns true if a license key secret name was set
@@ -40,6 +50,17 @@ func (s LicenseSpec) GetSecretName() string {
 	return util.StringOrDefault(s.SecretName)
 }
 
+// GetExpirationWarningPeriod returns the period before license expiration in which warnings are reported
+func (s LicenseSpec) GetExpirationWarningPeriod() time.Duration {
+	if s.ExpirationWarningPeriod != nil {
+		if v := s.ExpirationWarningPeriod.AsDuration(); v > 0 {
+			return v
+		}
+	}
+
+	return DefaultLicenseExpirationWarningPeriod
+}
+
 // Validate validates the LicenseSpec
 func (s LicenseSpec) Validate() error {
 	if s.HasSecretName() {
@@ -48,6 +69,12 @@ func (s LicenseSpec) Validate() error {
 		}
 	}
 
+	if s.ExpirationWarningPeriod != nil {
+		if err := s.ExpirationWarningPeriod.Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, "expirationWarningPeriod"))
+		}
+	}
+
 	return nil
 }
 
@@ -56,4 +83,7 @@ func (s *LicenseSpec) SetDefaultsFrom(other LicenseSpec) {
 	if !s.HasSecretName() {
 		s.SecretName = util.NewStringOrNil(other.SecretName)
 	}
+	if s.ExpirationWarningPeriod == nil {
+		s.ExpirationWarningPeriod = NewDurationOrNil(other.ExpirationWarningPeriod)
+	}
 }
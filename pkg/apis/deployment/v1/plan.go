@@ -21,6 +21,8 @@
 package v1
 
 import (
+	"time"
+
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/dchest/uniuri"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -145,6 +147,8 @@ const (
 	ActionTypeJWTRefresh ActionType = "JWTRefresh"
 	// ActionTypeJWTPropagated change propagated flag
 	ActionTypeJWTPropagated ActionType = "JWTPropagated"
+	// ActionTypeJWTGenerate generate new token in JWT secret
+	ActionTypeJWTGenerate ActionType = "JWTGenerate"
 	// ActionTypeClusterMemberCleanup removes member from cluster
 	ActionTypeClusterMemberCleanup ActionType = "ClusterMemberCleanup"
 	// ActionTypeEnableMaintenance enables maintenance on cluster.
@@ -157,6 +161,14 @@ const (
 	ActionTypeBootstrapUpdate ActionType = "BootstrapUpdate"
 	// ActionTypeBootstrapSetPassword set password to the bootstrapped user
 	ActionTypeBootstrapSetPassword ActionType = "BootstrapSetPassword"
+	// ActionTypeBootstrapDatabases creates bootstrapped databases, collections and grants of users
+	ActionTypeBootstrapDatabases ActionType = "BootstrapDatabases"
+	// ActionTypeRotateUserPassword sets password of the bootstrapped user from its changed secret
+	ActionTypeRotateUserPassword ActionType = "RotateUserPassword"
+	// ActionTypeInitFromBackupDownload creates the ArangoBackup which downloads the backup of spec.initFrom
+	ActionTypeInitFromBackupDownload ActionType = "InitFromBackupDownload"
+	// ActionTypeInitFromBackup restores the new deployment from the backup of spec.initFrom
+	ActionTypeInitFromBackup ActionType = "InitFromBackup"
 	// ActionTypeMemberPhaseUpdate updated member phase. High priority
 	ActionTypeMemberPhaseUpdate ActionType = "MemberPhaseUpdate"
 	// ActionTypeSetMemberCondition sets member condition. It is high priority action.
@@ -225,6 +237,12 @@ type Action struct {
 	Image string `json:"image,omitempty"`
 	// Params additional parameters used for action
 	Params map[string]string `json:"params,omitempty"`
+	// HandoverTime is set when the action was in progress while the operator was shutting down.
+	// The next operator resumes the action instead of starting it again.
+	HandoverTime *meta.Time `json:"handoverTime,omitempty"`
+	// PausedDuration is the time during which the action was not handled by any operator.
+	// It is excluded from the action timeout.
+	PausedDuration *meta.Duration `json:"pausedDuration,omitempty"`
 }
 
 // Equal compares two Actions
@@ -237,7 +255,9 @@ func (a Action) Equal(other Action) bool {
 		util.TimeCompareEqualPointer(a.StartTime, other.StartTime) &&
 		a.Reason == other.Reason &&
 		a.Image == other.Image &&
-		equality.Semantic.DeepEqual(a.Params, other.Params)
+		equality.Semantic.DeepEqual(a.Params, other.Params) &&
+		util.TimeCompareEqualPointer(a.HandoverTime, other.HandoverTime) &&
+		a.GetPausedDuration() == other.GetPausedDuration()
 }
 
 // AddParam returns copy of action with set parameter
@@ -321,6 +341,52 @@ func (a Action) IsStarted() bool {
 	return !a.StartTime.IsZero()
 }
 
+// IsHandedOver returns true if the action was handed over by a stopping operator and was not resumed yet.
+func (a Action) IsHandedOver() bool {
+	return a.HandoverTime != nil
+}
+
+// GetPausedDuration returns the time during which the action was not handled by any operator.
+func (a Action) GetPausedDuration() time.Duration {
+	if a.PausedDuration == nil {
+		return 0
+	}
+
+	return a.PausedDuration.Duration
+}
+
+// Handover returns copy of the action marked as handed over at the given time.
+// Actions which are not started or are already handed over are returned unchanged.
+func (a Action) Handover(now meta.Time) Action {
+	if !a.IsStarted() || a.IsHandedOver() {
+		return a
+	}
+
+	a.HandoverTime = &now
+	return a
+}
+
+// Resume returns copy of the handed over action with the time since the handover added to the paused duration.
+func (a Action) Resume(now time.Time) Action {
+	if !a.IsHandedOver() {
+		return a
+	}
+
+	paused := a.GetPausedDuration()
+	if gap := now.Sub(a.HandoverTime.Time); gap > 0 {
+		paused += gap
+	}
+
+	a.HandoverTime = nil
+	a.PausedDuration = &meta.Duration{Duration: paused}
+	return a
+}
+
+// Deadline returns the time after which the action is timed out.
+func (a Action) Deadline(timeout time.Duration) time.Time {
+	return a.CreationTime.Add(a.GetPausedDuration() + timeout)
+}
+
 // AsPlan parse action list into plan
 func AsPlan(a []Action) Plan {
 	return a
@@ -428,3 +494,17 @@ func (p Plan) Filter(condition func(a Action) bool) Plan {
 
 	return r
 }
+
+// Handover returns copy of the plan with all started actions marked as handed over at the given time.
+func (p Plan) Handover(now meta.Time) Plan {
+	if len(p) == 0 {
+		return p
+	}
+
+	r := make(Plan, len(p))
+	for id, a := range p {
+		r[id] = a.Handover(now)
+	}
+
+	return r
+}
@@ -20,10 +20,45 @@
 
 package v1
 
-import "github.com/arangodb/kube-arangodb/pkg/util"
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// LocalStoragePolicy defines how failed members are handled, when their volumes can be used
+// only on nodes which are gone or unschedulable (e.g. local volumes).
+type LocalStoragePolicy string
+
+const (
+	// LocalStoragePolicyWait recreates the member with its existing PVC and waits for the node to come back
+	LocalStoragePolicyWait LocalStoragePolicy = "Wait"
+	// LocalStoragePolicyRecreate replaces the member with a new one, with a fresh PVC on another node
+	LocalStoragePolicyRecreate LocalStoragePolicy = "Recreate"
+)
+
+func (l LocalStoragePolicy) String() string {
+	return string(l)
+}
+
+// Validate the policy
+func (l *LocalStoragePolicy) Validate() error {
+	if l == nil {
+		return nil
+	}
+
+	switch *l {
+	case LocalStoragePolicyWait, LocalStoragePolicyRecreate:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown local storage policy: '%s'", l.String()))
+	}
+}
 
 type ArangoDeploymentRecoverySpec struct {
 	AutoRecover *bool `json:"autoRecover"`
+	// LocalStoragePolicy defines how failed members with volumes on gone nodes are handled: Wait or Recreate.
+	// When not set, failed members are handled as any other failed member of the group.
+	LocalStoragePolicy *LocalStoragePolicy `json:"localStoragePolicy,omitempty"`
 }
 
 func (a *ArangoDeploymentRecoverySpec) Get() ArangoDeploymentRecoverySpec {
@@ -37,3 +72,25 @@ func (a *ArangoDeploymentRecoverySpec) Get() ArangoDeploymentRecoverySpec {
 func (a ArangoDeploymentRecoverySpec) GetAutoRecover() bool {
 	return util.BoolOrDefault(a.AutoRecover, false)
 }
+
+// GetLocalStoragePolicy returns the local storage policy, empty when not set.
+func (a ArangoDeploymentRecoverySpec) GetLocalStoragePolicy() LocalStoragePolicy {
+	if a.LocalStoragePolicy == nil {
+		return ""
+	}
+
+	return *a.LocalStoragePolicy
+}
+
+// Validate the given spec
+func (a *ArangoDeploymentRecoverySpec) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	if err := a.LocalStoragePolicy.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "localStoragePolicy"))
+	}
+
+	return nil
+}
@@ -20,6 +20,10 @@
 
 package v1
 
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // SecretHashes keeps track of the value of secrets
 // so we can detect changes.
 // For each used secret, a sha256 hash is stored.
@@ -32,8 +36,25 @@ type SecretHashes struct {
 	TLSCA string `json:"tls-ca,omitempty"`
 	// SyncTLSCA contains the hash of the sync.tls.caSecretName secret
 	SyncTLSCA string `json:"sync-tls-ca,omitempty"`
+	// License contains the hash of the license.secretName secret
+	License string `json:"license,omitempty"`
 	// User's map contains hashes for each user
 	Users map[string]string `json:"users,omitempty"`
+	// AccessPackages contains hashes of the access packages created by the operator, indexed by the secret name
+	AccessPackages map[string]AccessPackageHash `json:"access-packages,omitempty"`
+}
+
+// AccessPackageHash keeps track of the access package and CA secrets it was created from
+type AccessPackageHash struct {
+	// Hash contains the hash of the access package secret and the client authentication and TLS CA secrets
+	Hash string `json:"hash"`
+	// ExpiresAt defines when the client authentication certificate of the access package expires
+	ExpiresAt meta.Time `json:"expiresAt"`
+}
+
+// Equal compares two AccessPackageHash
+func (a AccessPackageHash) Equal(other AccessPackageHash) bool {
+	return a.Hash == other.Hash && a.ExpiresAt.Equal(&other.ExpiresAt)
 }
 
 // Equal compares two SecretHashes
@@ -48,7 +69,9 @@ func (sh *SecretHashes) Equal(other *SecretHashes) bool {
 		sh.RocksDBEncryptionKey == other.RocksDBEncryptionKey &&
 		sh.TLSCA == other.TLSCA &&
 		sh.SyncTLSCA == other.SyncTLSCA &&
-		isStringMapEqual(sh.Users, other.Users)
+		sh.License == other.License &&
+		isStringMapEqual(sh.Users, other.Users) &&
+		isAccessPackageHashMapEqual(sh.AccessPackages, other.AccessPackages)
 }
 
 // NewEmptySecretHashes creates new empty structure
@@ -84,3 +107,18 @@ func isStringMapEqual(first map[string]string, second map[string]string) bool {
 
 	return true
 }
+
+func isAccessPackageHashMapEqual(first map[string]AccessPackageHash, second map[string]AccessPackageHash) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for key, valueF := range first {
+		valueS, ok := second[key]
+		if !ok || !valueF.Equal(valueS) {
+			return false
+		}
+	}
+
+	return true
+}
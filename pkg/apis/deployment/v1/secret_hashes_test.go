@@ -109,6 +109,34 @@ func TestSecretHashes_Equal(t *testing.T) {
 			},
 			Expected: false,
 		},
+		{
+			Name: "Access packages are different",
+			CompareFrom: &SecretHashes{
+				AccessPackages: map[string]AccessPackageHash{
+					"ap": {Hash: "123"},
+				},
+			},
+			CompareTo: &SecretHashes{
+				AccessPackages: map[string]AccessPackageHash{
+					"ap": {Hash: "1234"},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Access packages are the same",
+			CompareFrom: &SecretHashes{
+				AccessPackages: map[string]AccessPackageHash{
+					"ap": {Hash: "123"},
+				},
+			},
+			CompareTo: &SecretHashes{
+				AccessPackages: map[string]AccessPackageHash{
+					"ap": {Hash: "123"},
+				},
+			},
+			Expected: true,
+		},
 		{
 			Name: "Secret hashes are the same",
 			CompareFrom: &SecretHashes{
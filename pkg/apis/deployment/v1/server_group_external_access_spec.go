@@ -0,0 +1,102 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"net"
+
+	core "k8s.io/api/core/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// ServerGroupExternalAccessSpec holds configuration of the services exposing each member of the group individually
+type ServerGroupExternalAccessSpec struct {
+	// Type of the per-member external access service. Only None, NodePort and LoadBalancer are supported
+	Type *ExternalAccessType `json:"type,omitempty"`
+	// NodePortBase is the first node port assigned to members of the group. Each member gets the next free port,
+	// which is kept for the whole lifetime of the member. When not set, node ports are allocated by Kubernetes
+	NodePortBase *int `json:"nodePortBase,omitempty"`
+	// LoadBalancerSourceRanges define LoadBalancerSourceRanges used for LoadBalancer Type
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+}
+
+// GetType returns the value of type.
+func (s *ServerGroupExternalAccessSpec) GetType() ExternalAccessType {
+	if s == nil || s.Type == nil {
+		return ExternalAccessTypeNone
+	}
+
+	return *s.Type
+}
+
+// IsEnabled returns true when members of the group are exposed individually
+func (s *ServerGroupExternalAccessSpec) IsEnabled() bool {
+	return !s.GetType().IsNone()
+}
+
+// GetServiceType returns the k8s ServiceType of the per-member services
+func (s *ServerGroupExternalAccessSpec) GetServiceType() core.ServiceType {
+	return s.GetType().AsServiceType()
+}
+
+// GetNodePortBase returns the first node port assigned to members or 0 when ports are allocated by Kubernetes
+func (s *ServerGroupExternalAccessSpec) GetNodePortBase() int {
+	if s == nil || s.NodePortBase == nil {
+		return 0
+	}
+
+	return *s.NodePortBase
+}
+
+// GetLoadBalancerSourceRanges returns the source ranges of the LoadBalancer services
+func (s *ServerGroupExternalAccessSpec) GetLoadBalancerSourceRanges() []string {
+	if s == nil {
+		return nil
+	}
+
+	return s.LoadBalancerSourceRanges
+}
+
+// Validate the given spec
+func (s *ServerGroupExternalAccessSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	switch t := s.GetType(); t {
+	case ExternalAccessTypeNone, ExternalAccessTypeNodePort, ExternalAccessTypeLoadBalancer:
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unsupported per-member external access type: '%s'", t))
+	}
+
+	if err := validatePort(s.NodePortBase); err != nil {
+		return errors.Wrapf(err, "Validation of NodePortBase failed")
+	}
+
+	for _, x := range s.LoadBalancerSourceRanges {
+		if _, _, err := net.ParseCIDR(x); err != nil {
+			return errors.WithStack(errors.Newf("Failed to parse loadbalancer source range '%s': %s", x, err))
+		}
+	}
+
+	return nil
+}
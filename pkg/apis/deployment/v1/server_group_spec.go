@@ -117,6 +117,16 @@ type ServerGroupSpec struct {
 	VolumeClaimTemplate *core.PersistentVolumeClaim `json:"volumeClaimTemplate,omitempty"`
 	// VolumeResizeMode specified resize mode for pvc
 	VolumeResizeMode *PVCResizeMode `json:"pvcResizeMode,omitempty"`
+	// StorageClassChangeMode defines how members are moved to the new StorageClass: manual (default) or replace.
+	// Only for Agents and DBServers
+	StorageClassChangeMode *StorageClassChangeMode `json:"storageClassChangeMode,omitempty"`
+	// VolumeAutoExpansion defines automatic expansion of member volumes, which are almost full
+	VolumeAutoExpansion *ServerGroupVolumeAutoExpansionSpec `json:"volumeAutoExpansion,omitempty"`
+	// PVCDeletionPolicy defines what happens with the PVC of a removed member: Delete (default) or Retain.
+	PVCDeletionPolicy *PVCDeletionPolicy `json:"pvcDeletionPolicy,omitempty"`
+	// PVCRetentionPeriod defines how long a retained PVC is kept after its member was removed.
+	// Retained PVCs are kept until removed manually, when not set.
+	PVCRetentionPeriod *Duration `json:"pvcRetentionPeriod,omitempty"`
 	// Deprecated: VolumeAllowShrink allows shrink the volume
 	VolumeAllowShrink *bool `json:"volumeAllowShrink,omitempty"`
 	// AntiAffinity specified additional antiAffinity settings in ArangoDB Pod definitions
@@ -146,6 +156,8 @@ type ServerGroupSpec struct {
 	ShutdownMethod *ServerGroupShutdownMethod `json:"shutdownMethod,omitempty"`
 	// ShutdownDelay define how long operator should delay finalizer removal after shutdown
 	ShutdownDelay *int `json:"shutdownDelay,omitempty"`
+	// Port define port on which members of the group are listening, overrides spec.port. Only for ArangoD members
+	Port *int `json:"port,omitempty"`
 	// InternalPort define port used in internal communication, can be accessed over localhost via sidecar. Only for ArangoD members
 	InternalPort *int `json:"internalPort,omitempty"`
 	// InternalPortProtocol define protocol of port used in internal communication, can be accessed over localhost via sidecar. Only for ArangoD members
@@ -156,6 +168,14 @@ type ServerGroupSpec struct {
 	AllowMemberRecreation *bool `json:"allowMemberRecreation,omitempty"`
 	// TerminationGracePeriodSeconds override default TerminationGracePeriodSeconds for pods - via silent rotation
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// ExternalAccess exposes every member of the group with a dedicated NodePort or LoadBalancer service.
+	// Supported for Coordinators and DBServers only
+	ExternalAccess *ServerGroupExternalAccessSpec `json:"externalAccess,omitempty"`
+	// LogVolume defines a dedicated volume for the log file of ArangoD members. Logs are written into the file
+	// in addition to the standard output.
+	LogVolume *ServerGroupDirectoryVolume `json:"logVolume,omitempty"`
+	// AppsVolume defines a dedicated volume for the Foxx apps directory of ArangoD members.
+	AppsVolume *ServerGroupDirectoryVolume `json:"appsVolume,omitempty"`
 }
 
 // ServerGroupSpecSecurityContext contains specification for pod security context
@@ -521,15 +541,49 @@ func (s ServerGroupSpec) Validate(group ServerGroup, used bool, mode DeploymentM
 	} else if s.GetCount() != 0 {
 		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid count value %d for un-used group. Expected 0", s.GetCount()))
 	}
+	if err := validatePort(s.Port); err != nil {
+		return errors.Wrapf(err, "Validation of Port failed")
+	}
 	if port := s.InternalPort; port != nil {
 		if err := s.InternalPortProtocol.Validate(); err != nil {
 			return errors.Wrapf(err, "Validation of InternalPortProtocol failed")
 		}
-		switch p := *port; p {
-		case 8529:
-			return errors.WithStack(errors.Wrapf(ValidationError, "Port %d already in use", p))
+		if err := validatePort(port); err != nil {
+			return errors.Wrapf(err, "Validation of InternalPort failed")
 		}
 	}
+	if err := s.StorageClassChangeMode.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation of StorageClassChangeMode failed")
+	}
+	if err := s.VolumeAutoExpansion.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation of VolumeAutoExpansion failed")
+	}
+	if err := s.PVCDeletionPolicy.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation of PVCDeletionPolicy failed")
+	}
+	if s.PVCRetentionPeriod != nil {
+		if err := s.PVCRetentionPeriod.Validate(); err != nil {
+			return errors.Wrapf(err, "Validation of PVCRetentionPeriod failed")
+		}
+		if s.GetPVCRetentionPeriod() < 0 {
+			return errors.WithStack(errors.Wrapf(ValidationError, "PVCRetentionPeriod needs to be positive"))
+		}
+	}
+	if s.ExternalAccess.IsEnabled() && group != ServerGroupCoordinators && group != ServerGroupDBServers {
+		return errors.WithStack(errors.Wrapf(ValidationError, "ExternalAccess is not supported for group %s", group.AsRole()))
+	}
+	if err := s.ExternalAccess.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation of ExternalAccess failed")
+	}
+	if (s.LogVolume.IsEnabled() || s.AppsVolume.IsEnabled()) && !group.IsArangod() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "LogVolume and AppsVolume are not supported for group %s", group.AsRole()))
+	}
+	if err := s.LogVolume.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation of LogVolume failed")
+	}
+	if err := s.AppsVolume.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation of AppsVolume failed")
+	}
 	return nil
 }
 
@@ -664,6 +718,15 @@ func (s *ServerGroupSpec) SetDefaultsFrom(source ServerGroupSpec) {
 	if s.VolumeClaimTemplate == nil {
 		s.VolumeClaimTemplate = source.VolumeClaimTemplate.DeepCopy()
 	}
+	if s.ExternalAccess == nil {
+		s.ExternalAccess = source.ExternalAccess.DeepCopy()
+	}
+	if s.LogVolume == nil {
+		s.LogVolume = source.LogVolume.DeepCopy()
+	}
+	if s.AppsVolume == nil {
+		s.AppsVolume = source.AppsVolume.DeepCopy()
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
@@ -680,6 +743,10 @@ func (s ServerGroupSpec) ResetImmutableFields(group ServerGroup, fieldPrefix str
 		target.VolumeClaimTemplate = s.GetVolumeClaimTemplate()
 		resetFields = append(resetFields, fieldPrefix+".volumeClaimTemplate")
 	}
+	if util.IntOrDefault(s.Port) != util.IntOrDefault(target.Port) {
+		target.Port = util.NewIntOrNil(s.Port)
+		resetFields = append(resetFields, fieldPrefix+".port")
+	}
 	return resetFields
 }
 
@@ -692,6 +759,11 @@ func (s ServerGroupSpec) GetVolumeAllowShrink() bool {
 	return *s.VolumeAllowShrink
 }
 
+// GetPVCRetentionPeriod returns how long a retained PVC is kept, 0 when it is kept until removed manually.
+func (s ServerGroupSpec) GetPVCRetentionPeriod() time.Duration {
+	return DurationOrDefault(s.PVCRetentionPeriod).AsDuration()
+}
+
 func (s *ServerGroupSpec) GetEntrypoint(defaultEntrypoint string) string {
 	if s == nil || s.Entrypoint == nil {
 		return defaultEntrypoint
@@ -0,0 +1,92 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// DefaultVolumeAutoExpansionIncreasePercent define default increase of the volume size (in percents) on automatic expansion.
+const DefaultVolumeAutoExpansionIncreasePercent = 20
+
+// ServerGroupVolumeAutoExpansionSpec holds configuration of the automatic expansion of almost full member volumes
+type ServerGroupVolumeAutoExpansionSpec struct {
+	// Enabled turns on expansion of the PVC, when the usage of the member data directory crosses the disk usage warning threshold
+	Enabled *bool `json:"enabled,omitempty"`
+	// IncreasePercent defines by how much (in percents of the current size) the volume is expanded, defaults to 20
+	IncreasePercent *int `json:"increasePercent,omitempty"`
+	// MaxSize defines the size over which volumes are not expanded. Volumes are not limited when not set
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+// IsEnabled returns true when almost full volumes of the group are expanded automatically
+func (s *ServerGroupVolumeAutoExpansionSpec) IsEnabled() bool {
+	if s == nil {
+		return false
+	}
+
+	return util.BoolOrDefault(s.Enabled, false)
+}
+
+// GetIncreasePercent returns the increase of the volume size (in percents) on each expansion
+func (s *ServerGroupVolumeAutoExpansionSpec) GetIncreasePercent() int {
+	if s == nil || s.IncreasePercent == nil {
+		return DefaultVolumeAutoExpansionIncreasePercent
+	}
+
+	return *s.IncreasePercent
+}
+
+// GetExpandedSize returns the size to which the volume of the given size is expanded.
+// Returns false when the volume cannot be expanded any more.
+func (s *ServerGroupVolumeAutoExpansionSpec) GetExpandedSize(size resource.Quantity) (resource.Quantity, bool) {
+	expanded := *resource.NewQuantity(size.Value()+size.Value()*int64(s.GetIncreasePercent())/100, resource.BinarySI)
+
+	if s != nil && s.MaxSize != nil && expanded.Cmp(*s.MaxSize) > 0 {
+		expanded = s.MaxSize.DeepCopy()
+	}
+
+	if expanded.Cmp(size) <= 0 {
+		return size, false
+	}
+
+	return expanded, true
+}
+
+// Validate the given spec
+func (s *ServerGroupVolumeAutoExpansionSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if p := s.GetIncreasePercent(); p < 1 || p > 100 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "increasePercent: Value %d is out of range 1-100", p))
+	}
+
+	if s.MaxSize != nil && s.MaxSize.Sign() <= 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "maxSize: needs to be positive"))
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncExternalAccessSpecAccessPackageRenewalMargin(t *testing.T) {
+	assert.Equal(t, 30*24*time.Hour, SyncExternalAccessSpec{}.GetAccessPackageRenewalMargin())
+	assert.Equal(t, 48*time.Hour, SyncExternalAccessSpec{AccessPackageRenewalMargin: NewDuration("48h")}.GetAccessPackageRenewalMargin())
+
+	assert.NoError(t, SyncExternalAccessSpec{AccessPackageRenewalMargin: NewDuration("48h")}.Validate())
+	assert.Error(t, SyncExternalAccessSpec{AccessPackageRenewalMargin: NewDuration("invalid")}.Validate())
+	assert.Error(t, SyncExternalAccessSpec{AccessPackageRenewalMargin: NewDuration("0s")}.Validate())
+
+	var s SyncExternalAccessSpec
+	s.SetDefaultsFrom(SyncExternalAccessSpec{AccessPackageRenewalMargin: NewDuration("1h")})
+	assert.Equal(t, time.Hour, s.GetAccessPackageRenewalMargin())
+}
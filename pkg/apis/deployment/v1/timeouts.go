@@ -35,6 +35,14 @@ type Timeouts struct {
 	// MaintenanceGracePeriod action timeout
 	MaintenanceGracePeriod *Timeout `json:"maintenanceGracePeriod,omitempty"`
 
+	// MemberCheck defines the timeout of requests used to check the state of members (reachability, version, health).
+	// Operator default is used when it is not set.
+	MemberCheck *Timeout `json:"memberCheck,omitempty"`
+
+	// ReplicationLag defines the maximum replication lag of active failover followers which allows rotation of members.
+	// Rotations are not blocked by the replication lag when it is not set.
+	ReplicationLag *Timeout `json:"replicationLag,omitempty"`
+
 	// Actions
 	Actions ActionTimeouts `json:"actions,omitempty"`
 
@@ -53,6 +61,28 @@ func (t *Timeouts) GetMaintenanceGracePeriod() time.Duration {
 	return t.MaintenanceGracePeriod.Get(DefaultMaintenanceGracePeriod)
 }
 
+// GetMemberCheck returns the timeout of member state checks or the given default when it is not set
+func (t *Timeouts) GetMemberCheck(def time.Duration) time.Duration {
+	if t == nil {
+		return def
+	}
+
+	if v := t.MemberCheck.Get(def); v > 0 {
+		return v
+	}
+
+	return def
+}
+
+// GetReplicationLag returns the maximum replication lag allowing rotation of members, 0 if not limited
+func (t *Timeouts) GetReplicationLag() time.Duration {
+	if t == nil {
+		return 0
+	}
+
+	return t.ReplicationLag.Get(0)
+}
+
 func (t *Timeouts) Get() Timeouts {
 	if t == nil {
 		return Timeouts{}
@@ -35,12 +35,21 @@ func TestTLSSpecValidate(t *testing.T) {
 	assert.Nil(t, TLSSpec{CASecretName: util.NewString("None"), AltNames: []string{}}.Validate())
 	assert.Nil(t, TLSSpec{CASecretName: util.NewString("None"), AltNames: []string{"foo"}}.Validate())
 	assert.Nil(t, TLSSpec{CASecretName: util.NewString("None"), AltNames: []string{"email@example.com", "127.0.0.1"}}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), TTL: NewDuration("48h"), RenewalMargin: NewDuration("24h")}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), AltNames: []string{"${MEMBER_ROLE}-${MEMBER_ID}.${DEPLOYMENT_NAME}.example.com"}}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), MinVersion: TLSVersion13.New(), Ciphers: []string{"ECDHE-ECDSA-AES256-GCM-SHA384", "!aNULL"}}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("None"), MinVersion: TLSVersion("1.0").New()}.Validate())
 
 	// Not valid
 	assert.Error(t, TLSSpec{CASecretName: nil}.Validate())
 	assert.Error(t, TLSSpec{CASecretName: util.NewString("")}.Validate())
 	assert.Error(t, TLSSpec{CASecretName: util.NewString("Foo")}.Validate())
 	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), AltNames: []string{"@@"}}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), RenewalMargin: NewDuration("1x")}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), TTL: NewDuration("24h"), RenewalMargin: NewDuration("48h")}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), MinVersion: TLSVersion("1.0").New()}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), Ciphers: []string{""}}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), Ciphers: []string{"AES128-SHA:AES256-SHA"}}.Validate())
 }
 
 func TestTLSSpecIsSecure(t *testing.T) {
@@ -61,4 +70,44 @@ func TestTLSSpecSetDefaults(t *testing.T) {
 	assert.Len(t, def(TLSSpec{AltNames: []string{"foo.local"}}).GetAltNames(), 1)
 	assert.Equal(t, defaultTLSTTL, def(TLSSpec{}).GetTTL())
 	assert.Equal(t, time.Hour, def(TLSSpec{TTL: NewDuration("1h")}).GetTTL().AsDuration())
+	assert.Equal(t, 7*24*time.Hour, def(TLSSpec{}).GetRenewalMargin())
+	assert.Equal(t, time.Hour, def(TLSSpec{RenewalMargin: NewDuration("1h")}).GetRenewalMargin())
+}
+
+func TestTLSSpecGetParsedMemberAltNames(t *testing.T) {
+	spec := TLSSpec{AltNames: []string{
+		"${MEMBER_ID}.example.com",
+		"${DEPLOYMENT_NAME}.${DEPLOYMENT_NAMESPACE}.example.com",
+		"${MEMBER_ROLE}@example.com",
+		"127.0.0.1",
+	}}
+
+	dnsNames, ipAddresses, emailAddresses, err := spec.GetParsedMemberAltNames("test", "ns", "coordinator", "crdn-abc")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"crdn-abc.example.com", "test.ns.example.com"}, dnsNames)
+	assert.Equal(t, []string{"127.0.0.1"}, ipAddresses)
+	assert.Equal(t, []string{"coordinator@example.com"}, emailAddresses)
+}
+
+func TestTLSSpecClientCertificatesValidate(t *testing.T) {
+	spec := func(certs ...TLSClientCertificateSpec) TLSSpec {
+		return TLSSpec{CASecretName: util.NewString("ca"), TTL: NewDuration("720h"), ClientCertificates: certs}
+	}
+
+	// Valid
+	assert.Nil(t, spec(TLSClientCertificateSpec{SecretName: "app"}).Validate())
+	assert.Nil(t, spec(TLSClientCertificateSpec{SecretName: "app", CommonName: util.NewString("app-user"), AltNames: []string{"app.example.com", "10.0.0.1", "app@example.com"}}).Validate())
+	assert.Nil(t, spec(TLSClientCertificateSpec{SecretName: "app", TTL: NewDuration("8760h")}, TLSClientCertificateSpec{SecretName: "other"}).Validate())
+
+	// Not valid
+	assert.Error(t, spec(TLSClientCertificateSpec{SecretName: "App"}).Validate())
+	assert.Error(t, spec(TLSClientCertificateSpec{SecretName: "ca"}).Validate())
+	assert.Error(t, spec(TLSClientCertificateSpec{SecretName: "app", CommonName: util.NewString("")}).Validate())
+	assert.Error(t, spec(TLSClientCertificateSpec{SecretName: "app", AltNames: []string{"not valid"}}).Validate())
+	assert.Error(t, spec(TLSClientCertificateSpec{SecretName: "app", TTL: NewDuration("invalid")}).Validate())
+	assert.Error(t, spec(TLSClientCertificateSpec{SecretName: "app", TTL: NewDuration("24h")}).Validate())
+	assert.Error(t, spec(TLSClientCertificateSpec{SecretName: "app"}, TLSClientCertificateSpec{SecretName: "app"}).Validate())
+
+	assert.Equal(t, "app", TLSClientCertificateSpec{SecretName: "app"}.GetCommonName())
+	assert.Equal(t, 720*time.Hour, TLSClientCertificateSpec{SecretName: "app"}.GetTTL(spec().GetTTL()).AsDuration())
 }
@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVaultSpecValidate(t *testing.T) {
+	agentSecret := &VaultSecretSpec{Path: util.NewString("secret/data/jwt"), Key: util.NewString("token")}
+	csiSecret := &VaultSecretSpec{SecretProviderClass: util.NewString("arangodb-jwt")}
+	role, address := util.NewString("arangodb"), util.NewString("https://vault:8200")
+
+	// Valid
+	assert.NoError(t, (*VaultSpec)(nil).Validate())
+	assert.NoError(t, (&VaultSpec{}).Validate())
+	assert.NoError(t, (&VaultSpec{Role: role, Address: address, JWT: agentSecret}).Validate())
+	assert.NoError(t, (&VaultSpec{Role: role, Address: address, TLS: &VaultSecretSpec{Path: util.NewString("secret/data/ca"), Key: util.NewString("bundle")}}).Validate())
+	assert.NoError(t, (&VaultSpec{Mode: VaultModeCSI.New(), Encryption: csiSecret}).Validate())
+	assert.NoError(t, (&VaultSpec{Mode: VaultModeCSI.New(), Role: role, Address: address, JWT: &VaultSecretSpec{Path: util.NewString("secret/data/jwt"), Key: util.NewString("token"), SecretProviderClass: util.NewString("arangodb-jwt")}}).Validate())
+
+	// Not valid
+	assert.Error(t, (&VaultSpec{Mode: VaultMode("unknown").New()}).Validate())
+	assert.Error(t, (&VaultSpec{Address: address, JWT: agentSecret}).Validate())
+	assert.Error(t, (&VaultSpec{Role: role, JWT: agentSecret}).Validate())
+	assert.Error(t, (&VaultSpec{Role: role, Address: address, JWT: &VaultSecretSpec{Key: util.NewString("token")}}).Validate())
+	assert.Error(t, (&VaultSpec{Role: role, Address: address, JWT: &VaultSecretSpec{Path: util.NewString("secret/data/jwt")}}).Validate())
+	assert.Error(t, (&VaultSpec{Role: role, Address: address, TLS: &VaultSecretSpec{Path: util.NewString("secret/data/ca"), Template: util.NewString("{{ .Data.ca }}")}}).Validate())
+	assert.Error(t, (&VaultSpec{Mode: VaultModeCSI.New(), Role: role, Address: address, JWT: agentSecret}).Validate())
+	assert.Error(t, (&VaultSpec{Mode: VaultModeCSI.New(), Role: role, Address: address, JWT: csiSecret}).Validate())
+}
+
+func TestVaultSpecSourced(t *testing.T) {
+	var nilSpec *VaultSpec
+	assert.False(t, nilSpec.IsJWTSourced())
+	assert.False(t, nilSpec.IsTLSSourced())
+	assert.False(t, nilSpec.IsEncryptionSourced())
+	assert.Equal(t, VaultModeAgent, nilSpec.GetMode())
+
+	spec := &VaultSpec{Mode: VaultModeCSI.New(), TLS: &VaultSecretSpec{}}
+	assert.False(t, spec.IsJWTSourced())
+	assert.True(t, spec.IsTLSSourced())
+	assert.False(t, spec.IsEncryptionSourced())
+	assert.Equal(t, VaultModeCSI, spec.GetMode())
+}
+
+func TestDeploymentSpecVaultValidate(t *testing.T) {
+	spec := DeploymentSpec{
+		Vault: &VaultSpec{
+			Mode:       VaultModeCSI.New(),
+			Encryption: &VaultSecretSpec{SecretProviderClass: util.NewString("arangodb-encryption")},
+		},
+	}
+	spec.SetDefaults("test")
+	spec.Image = util.NewString("arangodb/arangodb")
+	assert.Error(t, spec.Validate())
+
+	spec.RocksDB.Encryption.KeySecretName = util.NewString("encryption")
+	assert.NoError(t, spec.Validate())
+}
@@ -0,0 +1,156 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type ArangoDatabaseSpec struct {
+	// DeploymentName holds the name of the ArangoDeployment in which the database is managed
+	DeploymentName string `json:"deploymentName"`
+	// Name of the database, defaults to the name of the ArangoDatabase
+	Name *string `json:"name,omitempty"`
+	// Options defines the defaults of the collections in the database. Options are used only when database is created.
+	Options *ArangoDatabaseOptions `json:"options,omitempty"`
+	// DeletionPolicy defines what happens with the database when ArangoDatabase is removed, defaults to Retain
+	DeletionPolicy *ArangoDatabaseDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// GetDeletionPolicy returns the deletion policy of the database
+func (a ArangoDatabaseSpec) GetDeletionPolicy() ArangoDatabaseDeletionPolicy {
+	return a.DeletionPolicy.Get()
+}
+
+func (a ArangoDatabaseSpec) Validate() error {
+	if a.DeploymentName == "" {
+		return errors.Newf("deploymentName can not be empty")
+	}
+
+	if a.Name != nil && *a.Name == "" {
+		return errors.Newf("name can not be empty")
+	}
+
+	if err := a.Options.Validate(); err != nil {
+		return errors.Wrapf(err, "options")
+	}
+
+	if err := a.DeletionPolicy.Validate(); err != nil {
+		return errors.Wrapf(err, "deletionPolicy")
+	}
+
+	return nil
+}
+
+// ArangoDatabaseOptions defines the defaults of the collections in the database
+type ArangoDatabaseOptions struct {
+	// ReplicationFactor defines the default replication factor of the collections
+	ReplicationFactor *int `json:"replicationFactor,omitempty"`
+	// WriteConcern defines the default write concern of the collections
+	WriteConcern *int `json:"writeConcern,omitempty"`
+	// Sharding defines the default sharding of the collections, one of "", flexible or single
+	Sharding *driver.DatabaseSharding `json:"sharding,omitempty"`
+}
+
+// AsCreateOptions returns the driver options used to create the database
+func (a *ArangoDatabaseOptions) AsCreateOptions() *driver.CreateDatabaseOptions {
+	if a == nil {
+		return nil
+	}
+
+	var options driver.CreateDatabaseOptions
+
+	if v := a.ReplicationFactor; v != nil {
+		options.Options.ReplicationFactor = *v
+	}
+
+	if v := a.WriteConcern; v != nil {
+		options.Options.WriteConcern = *v
+	}
+
+	if v := a.Sharding; v != nil {
+		options.Options.Sharding = *v
+	}
+
+	return &options
+}
+
+func (a *ArangoDatabaseOptions) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	if v := a.ReplicationFactor; v != nil && *v < 1 {
+		return errors.Newf("replicationFactor has to be positive")
+	}
+
+	if v := a.WriteConcern; v != nil && *v < 1 {
+		return errors.Newf("writeConcern has to be positive")
+	}
+
+	if r, w := a.ReplicationFactor, a.WriteConcern; r != nil && w != nil && *w > *r {
+		return errors.Newf("writeConcern can not be greater than replicationFactor")
+	}
+
+	if v := a.Sharding; v != nil {
+		switch *v {
+		case driver.DatabaseShardingNone, driver.DatabaseShardingSingle, "flexible":
+		default:
+			return errors.Newf("sharding %s is not supported", *v)
+		}
+	}
+
+	return nil
+}
+
+type ArangoDatabaseDeletionPolicy string
+
+const (
+	// ArangoDatabaseDeletionPolicyRetain keeps the database when ArangoDatabase is removed
+	ArangoDatabaseDeletionPolicyRetain ArangoDatabaseDeletionPolicy = "Retain"
+	// ArangoDatabaseDeletionPolicyDelete removes the database when ArangoDatabase is removed
+	ArangoDatabaseDeletionPolicyDelete ArangoDatabaseDeletionPolicy = "Delete"
+
+	// ArangoDatabaseDeletionPolicyDefault defines default deletion policy
+	ArangoDatabaseDeletionPolicyDefault = ArangoDatabaseDeletionPolicyRetain
+)
+
+func (a *ArangoDatabaseDeletionPolicy) Get() ArangoDatabaseDeletionPolicy {
+	if a == nil {
+		return ArangoDatabaseDeletionPolicyDefault
+	}
+
+	return *a
+}
+
+func (a ArangoDatabaseDeletionPolicy) New() *ArangoDatabaseDeletionPolicy {
+	return &a
+}
+
+func (a *ArangoDatabaseDeletionPolicy) Validate() error {
+	switch v := a.Get(); v {
+	case ArangoDatabaseDeletionPolicyRetain, ArangoDatabaseDeletionPolicyDelete:
+		return nil
+	default:
+		return errors.Newf("policy %s is not supported", v)
+	}
+}
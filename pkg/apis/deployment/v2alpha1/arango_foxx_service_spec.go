@@ -0,0 +1,130 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"strings"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// ArangoFoxxServiceConfigMapKeyDefault defines default key of the service archive in the ConfigMap
+	ArangoFoxxServiceConfigMapKeyDefault = "service.zip"
+)
+
+type ArangoFoxxServiceSpec struct {
+	// DeploymentName holds the name of the ArangoDeployment in which the service is installed
+	DeploymentName string `json:"deploymentName"`
+	// Database holds the name of the database in which the service is installed
+	Database string `json:"database"`
+	// Mount defines the mount path of the service, e.g. /app
+	Mount string `json:"mount"`
+	// Source of the service archive
+	Source ArangoFoxxServiceSource `json:"source"`
+	// Setup defines if the setup script is executed on install, defaults to true
+	Setup *bool `json:"setup,omitempty"`
+	// Teardown defines if the teardown script is executed on uninstall, defaults to true
+	Teardown *bool `json:"teardown,omitempty"`
+}
+
+// GetSetup returns true when the setup script should be executed
+func (a ArangoFoxxServiceSpec) GetSetup() bool {
+	return util.BoolOrDefault(a.Setup, true)
+}
+
+// GetTeardown returns true when the teardown script should be executed
+func (a ArangoFoxxServiceSpec) GetTeardown() bool {
+	return util.BoolOrDefault(a.Teardown, true)
+}
+
+func (a ArangoFoxxServiceSpec) Validate() error {
+	if a.DeploymentName == "" {
+		return errors.Newf("deploymentName can not be empty")
+	}
+
+	if a.Database == "" {
+		return errors.Newf("database can not be empty")
+	}
+
+	if !strings.HasPrefix(a.Mount, "/") || len(a.Mount) < 2 {
+		return errors.Newf("mount %s has to be an absolute path", a.Mount)
+	}
+
+	if err := a.Source.Validate(); err != nil {
+		return errors.Wrapf(err, "source")
+	}
+
+	return nil
+}
+
+// ArangoFoxxServiceSource defines the source of the service archive. Exactly one source has to be set.
+type ArangoFoxxServiceSource struct {
+	// URL of the service archive, downloaded by the ArangoDB server
+	URL *string `json:"url,omitempty"`
+	// ConfigMap holding the service archive as binary data
+	ConfigMap *ArangoFoxxServiceConfigMapSource `json:"configMap,omitempty"`
+}
+
+func (a ArangoFoxxServiceSource) Validate() error {
+	if (a.URL == nil) == (a.ConfigMap == nil) {
+		return errors.Newf("exactly one of url or configMap has to be set")
+	}
+
+	if v := a.URL; v != nil {
+		if !strings.HasPrefix(*v, "http://") && !strings.HasPrefix(*v, "https://") {
+			return errors.Newf("url %s has to use http or https scheme", *v)
+		}
+	}
+
+	if v := a.ConfigMap; v != nil {
+		if err := v.Validate(); err != nil {
+			return errors.Wrapf(err, "configMap")
+		}
+	}
+
+	return nil
+}
+
+type ArangoFoxxServiceConfigMapSource struct {
+	// Name of the ConfigMap
+	Name string `json:"name"`
+	// Key of the service archive in the ConfigMap, defaults to service.zip
+	Key *string `json:"key,omitempty"`
+}
+
+// GetKey returns the key of the service archive in the ConfigMap
+func (a ArangoFoxxServiceConfigMapSource) GetKey() string {
+	return util.StringOrDefault(a.Key, ArangoFoxxServiceConfigMapKeyDefault)
+}
+
+func (a ArangoFoxxServiceConfigMapSource) Validate() error {
+	if a.Name == "" {
+		return errors.Newf("name can not be empty")
+	}
+
+	if a.Key != nil && *a.Key == "" {
+		return errors.Newf("key can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"reflect"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	// ArangoTaskDumpType runs arangodump against the deployment
+	ArangoTaskDumpType ArangoTaskType = "Dump"
+	// ArangoTaskRestoreType runs arangorestore against the deployment
+	ArangoTaskRestoreType ArangoTaskType = "Restore"
+)
+
+// IsDumpOrRestore returns true when the task type is Dump or Restore
+func (a ArangoTaskType) IsDumpOrRestore() bool {
+	return a == ArangoTaskDumpType || a == ArangoTaskRestoreType
+}
+
+// ArangoTaskDumpDetails defines the details of the Dump and Restore tasks
+type ArangoTaskDumpDetails struct {
+	// DeploymentName holds the name of the ArangoDeployment which is dumped or restored
+	DeploymentName string `json:"deploymentName"`
+	// Database to dump or restore, all databases are processed when empty
+	Database string `json:"database,omitempty"`
+	// Collections to dump or restore, all collections are processed when empty
+	Collections []string `json:"collections,omitempty"`
+	// Volume holding the dump, e.g. PersistentVolumeClaim or CSI volume backed by object storage
+	Volume core.VolumeSource `json:"volume"`
+	// SubPath of the dump in the volume
+	SubPath string `json:"subPath,omitempty"`
+	// Threads used by the tool
+	Threads *int `json:"threads,omitempty"`
+	// Image used to run the tool, defaults to the image of the deployment
+	Image *string `json:"image,omitempty"`
+}
+
+func (a ArangoTaskDumpDetails) Validate() error {
+	if a.DeploymentName == "" {
+		return errors.Newf("deploymentName can not be empty")
+	}
+
+	if a.Database == "" && len(a.Collections) > 0 {
+		return errors.Newf("collections require database to be set")
+	}
+
+	if reflect.DeepEqual(a.Volume, core.VolumeSource{}) {
+		return errors.Newf("volume has to be set")
+	}
+
+	if v := a.Threads; v != nil && *v < 1 {
+		return errors.Newf("threads has to be positive")
+	}
+
+	if v := a.Image; v != nil && *v == "" {
+		return errors.Newf("image can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type ArangoUserSpec struct {
+	// DeploymentName holds the name of the ArangoDeployment in which the user is managed
+	DeploymentName string `json:"deploymentName"`
+	// Name of the user in the database, defaults to the name of the ArangoUser
+	Name *string `json:"name,omitempty"`
+	// PasswordSecretName holds the name of the Secret with the password key. User without password is created when not set.
+	PasswordSecretName *string `json:"passwordSecretName,omitempty"`
+	// Active defines if the user is able to log in, defaults to true
+	Active *bool `json:"active,omitempty"`
+	// Grants defines the access of the user to the databases and collections
+	Grants []ArangoUserGrant `json:"grants,omitempty"`
+}
+
+// IsActive returns true if the user is able to log in
+func (a ArangoUserSpec) IsActive() bool {
+	if a.Active == nil {
+		return true
+	}
+
+	return *a.Active
+}
+
+// GetPasswordSecretName returns the name of the password Secret, empty when not set
+func (a ArangoUserSpec) GetPasswordSecretName() string {
+	if a.PasswordSecretName == nil {
+		return ""
+	}
+
+	return *a.PasswordSecretName
+}
+
+func (a ArangoUserSpec) Validate() error {
+	if a.DeploymentName == "" {
+		return errors.Newf("deploymentName can not be empty")
+	}
+
+	if a.Name != nil && *a.Name == "" {
+		return errors.Newf("name can not be empty")
+	}
+
+	if a.Name != nil && *a.Name == UserNameRoot {
+		return errors.Newf("user %s can not be managed", UserNameRoot)
+	}
+
+	for id, g := range a.Grants {
+		if err := g.Validate(); err != nil {
+			return errors.Wrapf(err, "grants[%d]", id)
+		}
+	}
+
+	return nil
+}
+
+// ArangoUserGrant defines the access of the user to the database or to the collection
+type ArangoUserGrant struct {
+	// Database holds the name of the database, `*` defines the default access to all databases
+	Database string `json:"database"`
+	// Collection holds the name of the collection, `*` defines the default access to all collections.
+	// Access is granted to the database when not set.
+	Collection string `json:"collection,omitempty"`
+	// Access defines the access level, one of rw, ro, none
+	Access driver.Grant `json:"access"`
+}
+
+func (a ArangoUserGrant) Validate() error {
+	if a.Database == "" {
+		return errors.Newf("database can not be empty")
+	}
+
+	switch a.Access {
+	case driver.GrantReadWrite, driver.GrantReadOnly, driver.GrantNone:
+		return nil
+	default:
+		return errors.Newf("access %s is not supported", a.Access)
+	}
+}
@@ -0,0 +1,32 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+type ArangoUserStatus struct {
+	// Created is set to true when the user was created by the operator. Only created users are removed with the ArangoUser.
+	Created bool `json:"created,omitempty"`
+	// PasswordSecretVersion holds the resource version of the password Secret which was set on the user
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+	// Grants holds the grants which were applied on the user, grants removed from the spec are revoked
+	Grants []ArangoUserGrant `json:"grants,omitempty"`
+	// Conditions specific to the user
+	Conditions ConditionList `json:"conditions,omitempty"`
+}
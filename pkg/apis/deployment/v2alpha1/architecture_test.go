@@ -0,0 +1,55 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ArangoDeploymentArchitecture_Validate(t *testing.T) {
+	require.NoError(t, ArangoDeploymentArchitecture{}.Validate())
+	require.NoError(t, ArangoDeploymentArchitecture{ArangoDeploymentArchitectureAMD64}.Validate())
+	require.NoError(t, ArangoDeploymentArchitecture{ArangoDeploymentArchitectureAMD64, ArangoDeploymentArchitectureARM64}.Validate())
+	require.Error(t, ArangoDeploymentArchitecture{ArangoDeploymentArchitectureAMD64, ArangoDeploymentArchitectureAMD64}.Validate())
+	require.Error(t, ArangoDeploymentArchitecture{"ppc64le"}.Validate())
+}
+
+func Test_ArangoDeploymentArchitecture_NodeSelector(t *testing.T) {
+	term := ArangoDeploymentArchitecture{}.AsNodeSelectorRequirement()
+	require.Len(t, term.MatchExpressions, 1)
+	require.Equal(t, []string{"amd64"}, term.MatchExpressions[0].Values)
+
+	term = ArangoDeploymentArchitecture{ArangoDeploymentArchitectureARM64, ArangoDeploymentArchitectureAMD64}.AsNodeSelectorRequirement()
+	require.Len(t, term.MatchExpressions, 1)
+	require.Equal(t, []string{"arm64", "amd64"}, term.MatchExpressions[0].Values)
+}
+
+func Test_ImageInfo_HasArchitecture(t *testing.T) {
+	legacy := ImageInfo{Image: "foo"}
+	require.True(t, legacy.HasArchitecture(ArangoDeploymentArchitectureAMD64))
+	require.False(t, legacy.HasArchitecture(ArangoDeploymentArchitectureARM64))
+
+	arm := ImageInfo{Image: "foo", Architectures: ArangoDeploymentArchitecture{ArangoDeploymentArchitectureARM64}}
+	require.False(t, arm.HasArchitecture(ArangoDeploymentArchitectureAMD64))
+	require.True(t, arm.HasArchitecture(ArangoDeploymentArchitectureARM64))
+}
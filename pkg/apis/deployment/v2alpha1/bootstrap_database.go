@@ -0,0 +1,158 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"regexp"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+var (
+	bootstrapDatabaseNameRE   = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9_-]{0,63}|_system)$`)
+	bootstrapCollectionNameRE = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{0,255}$`)
+)
+
+// BootstrapGrant is the access level of a user to a database
+type BootstrapGrant string
+
+const (
+	// BootstrapGrantReadWrite grants read and write access
+	BootstrapGrantReadWrite BootstrapGrant = "rw"
+	// BootstrapGrantReadOnly grants read only access
+	BootstrapGrantReadOnly BootstrapGrant = "ro"
+	// BootstrapGrantNone revokes access
+	BootstrapGrantNone BootstrapGrant = "none"
+)
+
+// Validate the grant.
+func (g BootstrapGrant) Validate() error {
+	switch g {
+	case BootstrapGrantReadWrite, BootstrapGrantReadOnly, BootstrapGrantNone:
+		return nil
+	default:
+		return errors.Newf("unknown grant %s", g)
+	}
+}
+
+// BootstrapCollectionType is the type of a bootstrapped collection
+type BootstrapCollectionType string
+
+const (
+	// BootstrapCollectionTypeDocument defines document collection
+	BootstrapCollectionTypeDocument BootstrapCollectionType = "document"
+	// BootstrapCollectionTypeEdge defines edge collection
+	BootstrapCollectionTypeEdge BootstrapCollectionType = "edge"
+)
+
+// Get returns the collection type or the default (document).
+func (t *BootstrapCollectionType) Get() BootstrapCollectionType {
+	if t == nil || *t == "" {
+		return BootstrapCollectionTypeDocument
+	}
+
+	return *t
+}
+
+// Validate the collection type.
+func (t *BootstrapCollectionType) Validate() error {
+	switch v := t.Get(); v {
+	case BootstrapCollectionTypeDocument, BootstrapCollectionTypeEdge:
+		return nil
+	default:
+		return errors.Newf("unknown collection type %s", v)
+	}
+}
+
+// BootstrapDatabase defines a database created on the first start of the deployment
+type BootstrapDatabase struct {
+	// Name of the database. Databases which already exist, e.g. _system, are not recreated
+	Name string `json:"name"`
+	// Users contains a map of username to the access level to the database (rw, ro or none).
+	// Users need to be defined in passwordSecretNames
+	Users map[string]BootstrapGrant `json:"users,omitempty"`
+	// Collections contains collections created in the database
+	Collections []BootstrapCollection `json:"collections,omitempty"`
+}
+
+// Validate the database specification.
+func (d *BootstrapDatabase) Validate(users PasswordSecretNameList) error {
+	if !bootstrapDatabaseNameRE.MatchString(d.Name) {
+		return errors.Newf("database name %s is not valid", d.Name)
+	}
+
+	for user, grant := range d.Users {
+		if _, ok := users[user]; !ok {
+			return errors.Newf("user %s is not defined in passwordSecretNames", user)
+		}
+
+		if err := grant.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid grant of user %s", user)
+		}
+	}
+
+	collections := map[string]bool{}
+	for _, collection := range d.Collections {
+		if collections[collection.Name] {
+			return errors.Newf("collection %s is defined multiple times", collection.Name)
+		}
+		collections[collection.Name] = true
+
+		if err := collection.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid collection %s", collection.Name)
+		}
+	}
+
+	return nil
+}
+
+// BootstrapCollection defines a collection created in a bootstrapped database
+type BootstrapCollection struct {
+	// Name of the collection
+	Name string `json:"name"`
+	// Type of the collection, document (default) or edge
+	Type *BootstrapCollectionType `json:"type,omitempty"`
+	// NumberOfShards of the collection, used in the Cluster mode
+	NumberOfShards *int `json:"numberOfShards,omitempty"`
+	// ReplicationFactor of the collection, used in the Cluster mode
+	ReplicationFactor *int `json:"replicationFactor,omitempty"`
+}
+
+// Validate the collection specification.
+func (c *BootstrapCollection) Validate() error {
+	if !bootstrapCollectionNameRE.MatchString(c.Name) {
+		return errors.Newf("collection name %s is not valid", c.Name)
+	}
+
+	if err := c.Type.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if c.NumberOfShards != nil && *c.NumberOfShards < 1 {
+		return errors.Newf("numberOfShards needs to be greater than 0")
+	}
+
+	if c.ReplicationFactor != nil && *c.ReplicationFactor < 1 {
+		return errors.Newf("replicationFactor needs to be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_BootstrapSpec_Validate(t *testing.T) {
+	edge := BootstrapCollectionTypeEdge
+	unknown := BootstrapCollectionType("graph")
+
+	valid := func() BootstrapSpec {
+		return BootstrapSpec{
+			PasswordSecretNames: PasswordSecretNameList{
+				UserNameRoot: PasswordSecretNameNone,
+				"app":        "app-password",
+			},
+			Databases: []BootstrapDatabase{
+				{
+					Name:  "app",
+					Users: map[string]BootstrapGrant{"app": BootstrapGrantReadWrite},
+					Collections: []BootstrapCollection{
+						{Name: "users"},
+						{Name: "follows", Type: &edge, NumberOfShards: util.NewInt(3), ReplicationFactor: util.NewInt(2)},
+					},
+				},
+				{
+					Name:  "_system",
+					Users: map[string]BootstrapGrant{"app": BootstrapGrantReadOnly},
+				},
+			},
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		s := valid()
+		require.NoError(t, s.Validate())
+	})
+
+	cases := map[string]struct {
+		mod func(s *BootstrapSpec)
+		err string
+	}{
+		"None password of other user": {
+			mod: func(s *BootstrapSpec) { s.PasswordSecretNames["app"] = PasswordSecretNameNone },
+			err: "magic value None not allowed for app",
+		},
+		"Duplicated database": {
+			mod: func(s *BootstrapSpec) { s.Databases = append(s.Databases, BootstrapDatabase{Name: "app"}) },
+			err: "database app is defined multiple times",
+		},
+		"Invalid database name": {
+			mod: func(s *BootstrapSpec) { s.Databases[0].Name = "1app" },
+			err: "invalid database 1app: database name 1app is not valid",
+		},
+		"Unknown user": {
+			mod: func(s *BootstrapSpec) { s.Databases[0].Users["other"] = BootstrapGrantReadOnly },
+			err: "invalid database app: user other is not defined in passwordSecretNames",
+		},
+		"Unknown grant": {
+			mod: func(s *BootstrapSpec) { s.Databases[0].Users["app"] = "admin" },
+			err: "invalid database app: invalid grant of user app: unknown grant admin",
+		},
+		"Duplicated collection": {
+			mod: func(s *BootstrapSpec) {
+				s.Databases[0].Collections = append(s.Databases[0].Collections, BootstrapCollection{Name: "users"})
+			},
+			err: "invalid database app: collection users is defined multiple times",
+		},
+		"Unknown collection type": {
+			mod: func(s *BootstrapSpec) { s.Databases[0].Collections[0].Type = &unknown },
+			err: "invalid database app: invalid collection users: unknown collection type graph",
+		},
+		"Invalid number of shards": {
+			mod: func(s *BootstrapSpec) { s.Databases[0].Collections[0].NumberOfShards = util.NewInt(0) },
+			err: "invalid database app: invalid collection users: numberOfShards needs to be greater than 0",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := valid()
+			c.mod(&s)
+			require.EqualError(t, s.Validate(), c.err)
+		})
+	}
+}
@@ -96,6 +96,36 @@ const (
 
 	// ConditionTypeLicenseSet indicates that license V2 is set on cluster.
 	ConditionTypeLicenseSet ConditionType = "LicenseSet"
+
+	// ConditionTypeMemberStuck indicates that the member responds to the version request but is not able to serve requests.
+	ConditionTypeMemberStuck ConditionType = "MemberStuck"
+	// ConditionTypeDiskUsageHigh indicates that the usage of the member data directory filesystem crossed the warning threshold.
+	ConditionTypeDiskUsageHigh ConditionType = "DiskUsageHigh"
+	// ConditionTypeVolumeAlmostFull indicates that data directory filesystem of one or more members crossed the warning threshold.
+	ConditionTypeVolumeAlmostFull ConditionType = "VolumeAlmostFull"
+
+	// ConditionTypeProgressing indicates that the deployment is applying changes.
+	ConditionTypeProgressing ConditionType = "Progressing"
+	// ConditionTypeDegraded indicates that members of the deployment failed or are not ready outside of planned operations.
+	ConditionTypeDegraded ConditionType = "Degraded"
+	// ConditionTypeBackupInProgress indicates that a backup of the deployment is being created, uploaded or downloaded.
+	ConditionTypeBackupInProgress ConditionType = "BackupInProgress"
+	// ConditionTypeUpgradeCompleted indicates that the last version upgrade of the deployment completed successfully.
+	ConditionTypeUpgradeCompleted ConditionType = "UpgradeCompleted"
+	// ConditionTypeLicenseExpiring indicates that the license installed in the deployment expires soon or is expired.
+	ConditionTypeLicenseExpiring ConditionType = "LicenseExpiring"
+	// ConditionTypeUpgradeBlocked indicates that the version upgrade is blocked because it would produce an unsupported version skew.
+	ConditionTypeUpgradeBlocked ConditionType = "UpgradeBlocked"
+	// ConditionTypePasswordRotated indicates that the password of a bootstrapped user was rotated.
+	// Last update time of the condition is the time of the last rotation.
+	ConditionTypePasswordRotated ConditionType = "PasswordRotated"
+	// ConditionTypeInitializedFromBackup indicates that the deployment was restored from the backup of spec.initFrom.
+	ConditionTypeInitializedFromBackup ConditionType = "InitializedFromBackup"
+	// ConditionTypeImageDiscoveryFailed indicates that the image of the deployment can not be discovered.
+	ConditionTypeImageDiscoveryFailed ConditionType = "ImageDiscoveryFailed"
+	// ConditionTypeExternalAccessTLSIgnored indicates that the certificate of spec.externalAccess.tls can not be served,
+	// because TLS SNI is not supported by the deployment.
+	ConditionTypeExternalAccessTLSIgnored ConditionType = "ExternalAccessTLSIgnored"
 )
 
 // Condition represents one current condition of a deployment or deployment member.
@@ -0,0 +1,42 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_DeploymentInitFromSpec_Validate(t *testing.T) {
+	require.NoError(t, (*DeploymentInitFromSpec)(nil).Validate())
+	require.NoError(t, (&DeploymentInitFromSpec{Backup: util.NewString("backup")}).Validate())
+	require.NoError(t, (&DeploymentInitFromSpec{
+		Backup:   util.NewString("2022-03-01T10.00.00Z_a1b2c3"),
+		Download: &DeploymentInitFromDownloadSpec{RepositoryURL: "s3://bucket/backups", CredentialsSecretName: "s3-credentials"},
+	}).Validate())
+
+	require.Error(t, (&DeploymentInitFromSpec{}).Validate())
+	require.Error(t, (&DeploymentInitFromSpec{Backup: util.NewString("Invalid_Name")}).Validate())
+	require.Error(t, (&DeploymentInitFromSpec{Backup: util.NewString("id"), Download: &DeploymentInitFromDownloadSpec{}}).Validate())
+}
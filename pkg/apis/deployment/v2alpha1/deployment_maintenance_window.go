@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// DeploymentMaintenanceWindowSpec defines recurring period of time in which disruptive operations are allowed.
+type DeploymentMaintenanceWindowSpec struct {
+	// Schedule defines start of the window, in cron format
+	Schedule string `json:"schedule"`
+	// Duration defines length of the window
+	Duration Duration `json:"duration"`
+}
+
+// IsActive returns true when the given time is inside the window. Nil window is always active.
+func (m *DeploymentMaintenanceWindowSpec) IsActive(now time.Time) bool {
+	if m == nil {
+		return true
+	}
+
+	expr, err := cron.ParseStandard(m.Schedule)
+	if err != nil {
+		return false
+	}
+
+	duration := m.Duration.AsDuration()
+	if duration <= 0 {
+		return false
+	}
+
+	start := expr.Next(now.Add(-duration))
+
+	return !start.IsZero() && !start.After(now)
+}
+
+// Validate the given spec
+func (m *DeploymentMaintenanceWindowSpec) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if expr, err := cron.ParseStandard(m.Schedule); err != nil {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid schedule: '%s': %s", m.Schedule, err.Error()))
+	} else if expr.Next(time.Now()).IsZero() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid schedule: '%s'", m.Schedule))
+	}
+
+	if m.Duration == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Duration needs to be set"))
+	}
+
+	if err := m.Duration.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "duration"))
+	}
+
+	if m.Duration.AsDuration() <= 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Duration needs to be positive"))
+	}
+
+	return nil
+}
+
+// DeploymentMaintenanceWindowList is a list of maintenance windows
+type DeploymentMaintenanceWindowList []DeploymentMaintenanceWindowSpec
+
+// IsActive returns true when the given time is inside any of the windows. Empty list is always active.
+func (m DeploymentMaintenanceWindowList) IsActive(now time.Time) bool {
+	if len(m) == 0 {
+		return true
+	}
+
+	for id := range m {
+		if m[id].IsActive(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate the given list
+func (m DeploymentMaintenanceWindowList) Validate() error {
+	for id := range m {
+		if err := m[id].Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, fmt.Sprintf("%d", id)))
+		}
+	}
+
+	return nil
+}
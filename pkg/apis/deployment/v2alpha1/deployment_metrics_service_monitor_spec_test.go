@@ -0,0 +1,43 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_MetricsServiceMonitorSpec(t *testing.T) {
+	var empty *MetricsServiceMonitorSpec
+	require.Equal(t, MetricsServiceMonitorKindServiceMonitor, empty.GetKind())
+	require.Equal(t, DefaultMetricsServiceMonitorInterval, empty.GetInterval())
+	require.NoError(t, empty.Validate())
+
+	podMonitor := MetricsServiceMonitorKindPodMonitor
+	require.NoError(t, (&MetricsServiceMonitorSpec{Kind: &podMonitor, Interval: util.NewString("1m30s")}).Validate())
+
+	unknown := MetricsServiceMonitorKind("Probe")
+	require.Error(t, (&MetricsServiceMonitorSpec{Kind: &unknown}).Validate())
+	require.Error(t, (&MetricsServiceMonitorSpec{Interval: util.NewString("10")}).Validate())
+}
@@ -23,6 +23,7 @@ package v2alpha1
 
 import (
 	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	v1 "k8s.io/api/core/v1"
 )
@@ -58,8 +59,15 @@ const (
 	MetricsModeSidecar MetricsMode = "sidecar"
 	// deprecated
 	MetricsModeInternal MetricsMode = "internal"
+	// MetricsModeDirect scrapes metrics endpoint of the arangod directly, without exporter sidecar
+	MetricsModeDirect MetricsMode = "direct"
 )
 
+// HasExporterSidecar returns true when the exporter sidecar is injected into the member pods
+func (m MetricsMode) HasExporterSidecar() bool {
+	return m != MetricsModeDirect
+}
+
 func (m *MetricsMode) Get() MetricsMode {
 	if m == nil {
 		return MetricsModeExporter
@@ -82,6 +90,9 @@ type MetricsSpec struct {
 	ServiceMonitor *MetricsServiceMonitorSpec `json:"serviceMonitor,omitempty"`
 
 	Port *uint16 `json:"port,omitempty"`
+
+	// PrometheusRules enables creation of a PrometheusRule with curated alerts for the deployment
+	PrometheusRules *bool `json:"prometheusRules,omitempty"`
 }
 
 func (s *MetricsSpec) IsTLS() bool {
@@ -105,6 +116,11 @@ func (s *MetricsSpec) IsEnabled() bool {
 	return util.BoolOrDefault(s.Enabled, false)
 }
 
+// IsPrometheusRulesEnabled returns whether a PrometheusRule with alerts should be created
+func (s *MetricsSpec) IsPrometheusRulesEnabled() bool {
+	return util.BoolOrDefault(s.PrometheusRules, false)
+}
+
 // deprecated
 // HasImage returns whether a image was specified or not
 func (s *MetricsSpec) HasImage() bool {
@@ -145,6 +161,9 @@ func (s *MetricsSpec) SetDefaultsFrom(source MetricsSpec) {
 	if s.Image == nil {
 		s.Image = util.NewStringOrNil(source.Image)
 	}
+	if s.PrometheusRules == nil {
+		s.PrometheusRules = util.NewBoolOrNil(source.PrometheusRules)
+	}
 	if s.Authentication.JWTTokenSecretName == nil {
 		s.Authentication.JWTTokenSecretName = util.NewStringOrNil(source.Authentication.JWTTokenSecretName)
 	}
@@ -161,6 +180,10 @@ func (s *MetricsSpec) Validate() error {
 		}
 	}
 
+	if err := s.ServiceMonitor.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "serviceMonitor"))
+	}
+
 	return nil
 }
 
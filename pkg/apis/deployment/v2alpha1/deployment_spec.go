@@ -89,6 +89,9 @@ const (
 	DeploymentCommunicationMethodIP DeploymentCommunicationMethod = "ip"
 )
 
+// DefaultDiskUsageWarningThreshold define default disk usage (in percents) at which members are marked with the DiskUsageHigh condition.
+const DefaultDiskUsageWarningThreshold = 90
+
 // DeploymentSpec contains the spec part of a ArangoDeployment resource.
 type DeploymentSpec struct {
 	Mode               *DeploymentMode                   `json:"mode,omitempty"`
@@ -98,11 +101,17 @@ type DeploymentSpec struct {
 	ImagePullPolicy    *core.PullPolicy                  `json:"imagePullPolicy,omitempty"`
 	ImagePullSecrets   []string                          `json:"imagePullSecrets,omitempty"`
 	ImageDiscoveryMode *DeploymentImageDiscoveryModeSpec `json:"imageDiscoveryMode,omitempty"`
+	ImageHint          *DeploymentImageHintSpec          `json:"imageHint,omitempty"`
 	DowntimeAllowed    *bool                             `json:"downtimeAllowed,omitempty"`
 	DisableIPv6        *bool                             `json:"disableIPv6,omitempty"`
 
 	Upgrade *DeploymentUpgradeSpec `json:"upgrade,omitempty"`
 
+	// MaintenanceWindows defines when disruptive operations (rotations, upgrades, member replacements, volume expansions,
+	// shard rebalancing and image changes of the upgrade channel) are allowed.
+	// When empty, operations are allowed at any time. Recovery of failed members is not limited.
+	MaintenanceWindows DeploymentMaintenanceWindowList `json:"maintenanceWindows,omitempty"`
+
 	Features *DeploymentFeatures `json:"features,omitempty"`
 
 	NetworkAttachedVolumes *bool `json:"networkAttachedVolumes,omitempty"`
@@ -124,6 +133,10 @@ type DeploymentSpec struct {
 
 	RestoreEncryptionSecret *string `json:"restoreEncryptionSecret,omitempty"`
 
+	// InitFrom defines the backup from which a new deployment is restored before it is marked as ready.
+	// It can be set only when the deployment is created.
+	InitFrom *DeploymentInitFromSpec `json:"initFrom,omitempty"`
+
 	// AllowUnsafeUpgrade determines if upgrade on missing member or with not in sync shards is allowed
 	AllowUnsafeUpgrade *bool `json:"allowUnsafeUpgrade,omitempty"`
 
@@ -150,6 +163,13 @@ type DeploymentSpec struct {
 
 	MemberPropagationMode *DeploymentMemberPropagationMode `json:"memberPropagationMode,omitempty"`
 
+	// MemberHealthCheckMode define how the operator checks the state of members, defaults to basic
+	MemberHealthCheckMode *DeploymentMemberHealthCheckMode `json:"memberHealthCheckMode,omitempty"`
+
+	// DiskUsageWarningThreshold define the usage of the data directory filesystem (in percents) at which members are marked
+	// with the DiskUsageHigh condition, defaults to 90
+	DiskUsageWarningThreshold *int `json:"diskUsageWarningThreshold,omitempty"`
+
 	Chaos ChaosSpec `json:"chaos"`
 
 	Recovery *ArangoDeploymentRecoverySpec `json:"recovery,omitempty"`
@@ -171,6 +191,70 @@ type DeploymentSpec struct {
 
 	// Architecture definition of supported architectures
 	Architecture ArangoDeploymentArchitecture `json:"architecture,omitempty"`
+
+	// Port define port on which ArangoDB servers are listening, defaults to 8529. It can be overridden per group.
+	Port *int `json:"port,omitempty"`
+
+	// Vault define secret material sourced from HashiCorp Vault instead of Kubernetes Secrets
+	Vault *VaultSpec `json:"vault,omitempty"`
+
+	// AuditLog define audit log configuration of the ArangoDB servers, Enterprise only
+	AuditLog *AuditLogSpec `json:"auditLog,omitempty"`
+}
+
+// GetPort returns the port on which ArangoDB servers are listening.
+func (s DeploymentSpec) GetPort() int {
+	if s.Port == nil {
+		return k8sutil.ArangoPort
+	}
+
+	return *s.Port
+}
+
+// GetServerGroupPort returns the port on which members of the given group are listening.
+func (s DeploymentSpec) GetServerGroupPort(group ServerGroup) int {
+	if p := s.GetServerGroupSpec(group).Port; p != nil {
+		return *p
+	}
+
+	return s.GetPort()
+}
+
+// GetDatabasePort returns the port of servers which are handling database clients (coordinators or single servers).
+func (s DeploymentSpec) GetDatabasePort() int {
+	if s.GetMode().HasSingleServers() {
+		return s.GetServerGroupPort(ServerGroupSingle)
+	}
+
+	return s.GetServerGroupPort(ServerGroupCoordinators)
+}
+
+// GetTLSSNI returns SNI mapping of server certificates, including certificate of the external access.
+func (s DeploymentSpec) GetTLSSNI() TLSSNISpec {
+	sni := s.TLS.GetSNI()
+
+	secret := s.ExternalAccess.TLS.GetSecretName()
+	if secret == "" {
+		return sni
+	}
+
+	mapping := make(map[string][]string, len(sni.Mapping)+1)
+	for k, v := range sni.Mapping {
+		mapping[k] = v
+	}
+
+	mapping[secret] = append(append([]string{}, mapping[secret]...), s.ExternalAccess.GetServerNames()...)
+
+	return TLSSNISpec{Mapping: mapping}
+}
+
+// GetDiskUsageWarningThreshold returns the disk usage (in percents) at which members are marked with the DiskUsageHigh condition.
+func (s DeploymentSpec) GetDiskUsageWarningThreshold() int {
+	if s.DiskUsageWarningThreshold == nil {
+		return DefaultDiskUsageWarningThreshold
+	}
+
+	return *s.DiskUsageWarningThreshold
 }
 
 // GetAllowMemberRecreation returns member recreation policy based on group and settings
@@ -382,6 +466,12 @@ func (s *DeploymentSpec) SetDefaultsFrom(source DeploymentSpec) {
 	if s.Database == nil {
 		s.Database = source.Database.DeepCopy()
 	}
+	if s.Vault == nil {
+		s.Vault = source.Vault.DeepCopy()
+	}
+	if s.AuditLog == nil {
+		s.AuditLog = source.AuditLog.DeepCopy()
+	}
 
 	s.License.SetDefaultsFrom(source.License)
 	s.ExternalAccess.SetDefaultsFrom(source.ExternalAccess)
@@ -419,6 +509,12 @@ func (s *DeploymentSpec) Validate() error {
 	if s.GetImage() == "" {
 		return errors.WithStack(errors.Wrapf(ValidationError, "spec.image must be set"))
 	}
+	if err := ValidateImage(s.GetImage()); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.image"))
+	}
+	if err := s.ImageHint.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.imageHint"))
+	}
 	if err := s.ExternalAccess.Validate(); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.externalAccess"))
 	}
@@ -428,9 +524,35 @@ func (s *DeploymentSpec) Validate() error {
 	if err := s.Authentication.Validate(false); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.auth"))
 	}
+	if s.ExternalAccess.TLS.GetSecretName() != "" {
+		if !s.TLS.IsSecure() {
+			return errors.WithStack(errors.Wrapf(ValidationError, "spec.externalAccess.tls: requires TLS to be enabled"))
+		}
+		if err := s.GetTLSSNI().Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, "spec.externalAccess.tls"))
+		}
+	}
 	if err := s.TLS.Validate(); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.tls"))
 	}
+	if err := s.Vault.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.vault"))
+	}
+	if err := s.AuditLog.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.auditLog"))
+	}
+	if s.Vault.IsJWTSourced() && !s.IsAuthenticated() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "spec.vault.jwt: requires authentication to be enabled"))
+	}
+	if s.Vault.IsTLSSourced() && !s.TLS.IsSecure() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "spec.vault.tls: requires TLS to be enabled"))
+	}
+	if s.Vault.IsTLSSourced() && len(s.TLS.ClientCertificates) > 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "spec.tls.clientCertificates: requires CA managed by the operator"))
+	}
+	if s.Vault.IsEncryptionSourced() && !s.RocksDB.IsEncrypted() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "spec.vault.encryption: requires encryption to be enabled"))
+	}
 	if err := s.Sync.Validate(s.GetMode()); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.sync"))
 	}
@@ -458,15 +580,55 @@ func (s *DeploymentSpec) Validate() error {
 	if err := s.Chaos.Validate(); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.chaos"))
 	}
+	if err := s.Upgrade.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.upgrade"))
+	}
+	if err := s.MaintenanceWindows.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.maintenanceWindows"))
+	}
+	if err := s.Recovery.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.recovery"))
+	}
 	if err := s.License.Validate(); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.licenseKey"))
 	}
 	if err := s.Bootstrap.Validate(); err != nil {
 		return errors.WithStack(err)
 	}
+	if err := s.InitFrom.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.initFrom"))
+	}
 	if err := s.Architecture.Validate(); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.architecture"))
 	}
+	if err := validatePort(s.Port); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.port"))
+	}
+	if t := s.DiskUsageWarningThreshold; t != nil && (*t < 1 || *t > 100) {
+		return errors.WithStack(errors.Wrapf(ValidationError, "spec.diskUsageWarningThreshold: Value %d is out of range 1-100", *t))
+	}
+	for _, group := range AllServerGroups {
+		if !group.IsArangod() {
+			continue
+		}
+
+		if p := s.GetServerGroupSpec(group).InternalPort; p != nil && *p == s.GetServerGroupPort(group) {
+			return errors.WithStack(errors.Wrapf(ValidationError, "spec.%s.internalPort: Port %d already in use", group.AsRole(), *p))
+		}
+	}
+	return nil
+}
+
+// validatePort validates if port is in the allowed range
+func validatePort(port *int) error {
+	if port == nil {
+		return nil
+	}
+
+	if p := *port; p < 1 || p > 65535 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Port %d is out of range", p))
+	}
+
 	return nil
 }
 
@@ -493,10 +655,18 @@ func (s DeploymentSpec) ResetImmutableFields(target *DeploymentSpec) []string {
 		target.StorageEngine = NewStorageEngineOrNil(s.StorageEngine)
 		resetFields = append(resetFields, "storageEngine")
 	}
+	if s.GetPort() != target.GetPort() {
+		target.Port = util.NewIntOrNil(s.Port)
+		resetFields = append(resetFields, "port")
+	}
 	if s.IsDisableIPv6() != target.IsDisableIPv6() {
 		target.DisableIPv6 = util.NewBoolOrNil(s.DisableIPv6)
 		resetFields = append(resetFields, "disableIPv6")
 	}
+	if !s.InitFrom.Equal(target.InitFrom) {
+		target.InitFrom = s.InitFrom.DeepCopy()
+		resetFields = append(resetFields, "initFrom")
+	}
 	if l := s.ExternalAccess.ResetImmutableFields("externalAccess", &target.ExternalAccess); l != nil {
 		resetFields = append(resetFields, l...)
 	}
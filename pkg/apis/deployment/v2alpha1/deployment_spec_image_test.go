@@ -0,0 +1,67 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func TestValidateImage(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	require.NoError(t, ValidateImage("arangodb/enterprise:3.9.1"))
+	require.NoError(t, ValidateImage("arangodb/enterprise@"+digest))
+	require.NoError(t, ValidateImage("registry.local:5000/arangodb/enterprise:3.9.1@"+digest))
+
+	require.Error(t, ValidateImage(""))
+	require.Error(t, ValidateImage("@"+digest))
+	require.Error(t, ValidateImage("arangodb/enterprise@sha256:abc"))
+	require.Error(t, ValidateImage("arangodb/enterprise@md5:"+strings.Repeat("a", 32)))
+
+	require.True(t, IsImageDigest("arangodb/enterprise@"+digest))
+	require.False(t, IsImageDigest("arangodb/enterprise:3.9.1"))
+}
+
+func TestDeploymentImageHintSpec(t *testing.T) {
+	var nilHint *DeploymentImageHintSpec
+	require.NoError(t, nilHint.Validate())
+	require.False(t, nilHint.IsEnterprise())
+
+	require.Error(t, (&DeploymentImageHintSpec{}).Validate())
+	require.Error(t, (&DeploymentImageHintSpec{Version: "latest"}).Validate())
+
+	hint := &DeploymentImageHintSpec{Version: "3.9.1", Enterprise: util.NewBool(true)}
+	require.NoError(t, hint.Validate())
+
+	info := hint.ImageInfo("arangodb/enterprise:3.9.1", ArangoDeploymentArchitecture{ArangoDeploymentArchitectureAMD64})
+	require.Equal(t, ImageInfo{
+		Image:           "arangodb/enterprise:3.9.1",
+		ImageID:         "arangodb/enterprise:3.9.1",
+		ArangoDBVersion: "3.9.1",
+		Enterprise:      true,
+		Architectures:   ArangoDeploymentArchitecture{ArangoDeploymentArchitectureAMD64},
+	}, info)
+}
@@ -23,6 +23,8 @@ package v2alpha1
 import (
 	"sort"
 	"strings"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type DeploymentStatusAgencySize int
@@ -64,6 +66,9 @@ func (d DeploymentStatusAgencyIDs) Equal(b DeploymentStatusAgencyIDs) bool {
 type DeploymentStatusAgencyInfo struct {
 	Size *DeploymentStatusAgencySize `json:"size,omitempty"`
 	IDs  DeploymentStatusAgencyIDs   `json:"ids,omitempty"`
+
+	// State keeps the summary of the agency state
+	State *DeploymentStatusAgencyState `json:"state,omitempty"`
 }
 
 func (d *DeploymentStatusAgencyInfo) Equal(b *DeploymentStatusAgencyInfo) bool {
@@ -75,5 +80,50 @@ func (d *DeploymentStatusAgencyInfo) Equal(b *DeploymentStatusAgencyInfo) bool {
 		return true
 	}
 
-	return d.IDs.Equal(b.IDs) && d.Size.Equal(b.Size)
+	return d.IDs.Equal(b.IDs) && d.Size.Equal(b.Size) && d.State.Equal(b.State)
+}
+
+// DeploymentStatusAgencyState keeps the summary of the agency state
+type DeploymentStatusAgencyState struct {
+	// Leader holds the ID of the agency leader
+	Leader string `json:"leader,omitempty"`
+	// CommitIndex holds the agency commit index
+	CommitIndex uint64 `json:"commitIndex,omitempty"`
+	// SupervisionMode holds the mode of the agency supervision
+	SupervisionMode string `json:"supervisionMode,omitempty"`
+	// Maintenance is set when the supervision maintenance mode is enabled
+	Maintenance bool `json:"maintenance,omitempty"`
+	// UpdatedAt holds the time of the last update of the summary
+	UpdatedAt meta.Time `json:"updatedAt,omitempty"`
+}
+
+func (d *DeploymentStatusAgencyState) Equal(b *DeploymentStatusAgencyState) bool {
+	if d == nil && b == nil {
+		return true
+	}
+
+	if d == nil || b == nil {
+		return false
+	}
+
+	return d.Leader == b.Leader &&
+		d.CommitIndex == b.CommitIndex &&
+		d.SupervisionMode == b.SupervisionMode &&
+		d.Maintenance == b.Maintenance &&
+		d.UpdatedAt.Equal(&b.UpdatedAt)
+}
+
+// EqualSummary checks for equality of the summary ignoring the commit index and the update time
+func (d *DeploymentStatusAgencyState) EqualSummary(b *DeploymentStatusAgencyState) bool {
+	if d == nil && b == nil {
+		return true
+	}
+
+	if d == nil || b == nil {
+		return false
+	}
+
+	return d.Leader == b.Leader &&
+		d.SupervisionMode == b.SupervisionMode &&
+		d.Maintenance == b.Maintenance
 }
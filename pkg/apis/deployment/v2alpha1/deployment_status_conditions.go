@@ -0,0 +1,93 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UpdateStatusConditions updates Progressing, Degraded and BackupInProgress conditions of the deployment
+// following Kubernetes conventions, so the state can be assessed by generic tools.
+// Returns true when changes were made, false otherwise.
+func (ds *DeploymentStatus) UpdateStatusConditions(backupsInProgress []string) bool {
+	changed := ds.updateProgressingCondition()
+
+	if ds.updateDegradedCondition() {
+		changed = true
+	}
+
+	if len(backupsInProgress) > 0 {
+		if ds.Conditions.Update(ConditionTypeBackupInProgress, true, "Backup in progress",
+			fmt.Sprintf("Backups: %s", strings.Join(backupsInProgress, ", "))) {
+			changed = true
+		}
+	} else if ds.Conditions.Update(ConditionTypeBackupInProgress, false, "No backup in progress", "") {
+		changed = true
+	}
+
+	return changed
+}
+
+func (ds *DeploymentStatus) updateProgressingCondition() bool {
+	for _, plan := range []Plan{ds.HighPriorityPlan, ds.Plan} {
+		if !plan.IsEmpty() {
+			return ds.Conditions.Update(ConditionTypeProgressing, true, "Plan in progress",
+				fmt.Sprintf("Executing %s action", plan[0].Type))
+		}
+	}
+
+	if c, ok := ds.Conditions.Get(ConditionTypeUpToDate); ok && !c.IsTrue() {
+		return ds.Conditions.Update(ConditionTypeProgressing, true, c.Reason, c.Message)
+	}
+
+	return ds.Conditions.Update(ConditionTypeProgressing, false, "Deployment is up to date", "")
+}
+
+func (ds *DeploymentStatus) updateDegradedCondition() bool {
+	var failed, notReady []string
+
+	ds.Members.ForeachServerGroup(func(group ServerGroup, list MemberStatusList) error {
+		for _, m := range list {
+			if m.Phase.IsFailed() || m.Conditions.IsTrue(ConditionTypeMemberStuck) ||
+				m.Conditions.IsTrue(ConditionTypeAgentRecoveryNeeded) || m.Conditions.IsTrue(ConditionTypeUpgradeFailed) {
+				failed = append(failed, m.ID)
+			} else if m.Phase.IsReady() && m.Conditions.IsTrue(ConditionTypeStarted) && !m.Conditions.IsTrue(ConditionTypeReady) {
+				// Member was ready before
+				notReady = append(notReady, m.ID)
+			}
+		}
+		return nil
+	})
+
+	if len(failed) > 0 {
+		return ds.Conditions.Update(ConditionTypeDegraded, true, "Members failed",
+			fmt.Sprintf("Members: %s", strings.Join(failed, ", ")))
+	}
+
+	// Members are expected to be not ready during planned operations, e.g. rotation
+	if len(notReady) > 0 && ds.IsPlanEmpty() {
+		return ds.Conditions.Update(ConditionTypeDegraded, true, "Members not ready",
+			fmt.Sprintf("Members: %s", strings.Join(notReady, ", ")))
+	}
+
+	return ds.Conditions.Update(ConditionTypeDegraded, false, "All members are healthy", "")
+}
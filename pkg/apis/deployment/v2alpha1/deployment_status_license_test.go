@@ -0,0 +1,76 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeploymentStatusUpdateLicenseStatus(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	period := 7 * 24 * time.Hour
+
+	member := func(id string, expires time.Time) MemberStatus {
+		e := meta.NewTime(expires)
+		return MemberStatus{ID: id, Server: &MemberServerStatus{License: "enterprise", LicenseExpires: &e}}
+	}
+
+	s := DeploymentStatus{}
+	assert.False(t, s.UpdateLicenseStatus(now, period))
+	assert.Nil(t, s.License)
+
+	s.Members.Coordinators = MemberStatusList{member("a", now.Add(30*24*time.Hour))}
+	s.Members.DBServers = MemberStatusList{member("b", now.Add(20*24*time.Hour))}
+
+	require.True(t, s.UpdateLicenseStatus(now, period))
+	require.NotNil(t, s.License)
+	assert.Equal(t, "enterprise", s.License.Type)
+	assert.Equal(t, now.Add(20*24*time.Hour).Unix(), s.License.Expires.Unix())
+	assert.False(t, s.Conditions.IsTrue(ConditionTypeLicenseExpiring))
+	assert.False(t, s.UpdateLicenseStatus(now, period))
+
+	// Members without reported license keep the last known license
+	s.Members.DBServers[0].Server = nil
+	s.Members.Coordinators[0].Server = nil
+	assert.False(t, s.UpdateLicenseStatus(now, period))
+	assert.NotNil(t, s.License)
+
+	s.Members.DBServers = MemberStatusList{member("b", now.Add(3*24*time.Hour))}
+	require.True(t, s.UpdateLicenseStatus(now, period))
+	c, ok := s.Conditions.Get(ConditionTypeLicenseExpiring)
+	require.True(t, ok)
+	assert.True(t, c.IsTrue())
+	assert.Equal(t, "License expires soon", c.Reason)
+
+	require.True(t, s.UpdateLicenseStatus(now.Add(4*24*time.Hour), period))
+	c, _ = s.Conditions.Get(ConditionTypeLicenseExpiring)
+	assert.Equal(t, "License expired", c.Reason)
+
+	// Renewed license
+	s.Members.DBServers = MemberStatusList{member("b", now.Add(365*24*time.Hour))}
+	require.True(t, s.UpdateLicenseStatus(now, period))
+	assert.False(t, s.Conditions.IsTrue(ConditionTypeLicenseExpiring))
+}
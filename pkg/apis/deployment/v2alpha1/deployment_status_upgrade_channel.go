@@ -0,0 +1,51 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentStatusUpgradeChannel keeps the result of the last upgrade channel check
+type DeploymentStatusUpgradeChannel struct {
+	// Channel which was checked
+	Channel UpgradeChannel `json:"channel,omitempty"`
+	// LastCheck keeps time of the last registry check
+	LastCheck meta.Time `json:"lastCheck,omitempty"`
+	// Image keeps the newest image found in the channel
+	Image string `json:"image,omitempty"`
+	// Error keeps the error of the last registry check
+	Error string `json:"error,omitempty"`
+}
+
+// Equal checks for equality
+func (d *DeploymentStatusUpgradeChannel) Equal(other *DeploymentStatusUpgradeChannel) bool {
+	if d == nil && other == nil {
+		return true
+	} else if d == nil || other == nil {
+		return false
+	}
+
+	return d.Channel == other.Channel &&
+		d.LastCheck.Equal(&other.LastCheck) &&
+		d.Image == other.Image &&
+		d.Error == other.Error
+}
@@ -0,0 +1,122 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentStatusUpdateUpgradeStatus(t *testing.T) {
+	oldImage := ImageInfo{Image: "arangodb/arangodb:3.9.1", ArangoDBVersion: "3.9.1"}
+	newImage := ImageInfo{Image: "arangodb/arangodb:3.9.2", ArangoDBVersion: "3.9.2"}
+	now := time.Now()
+
+	member := func(id string, image ImageInfo) MemberStatus {
+		return MemberStatus{ID: id, Image: image.DeepCopy()}
+	}
+
+	t.Run("Without upgrade", func(t *testing.T) {
+		s := DeploymentStatus{}
+		assert.False(t, s.UpdateUpgradeStatus(now))
+
+		s.CurrentImage = newImage.DeepCopy()
+		s.Members.DBServers = MemberStatusList{member("a", newImage)}
+		assert.False(t, s.UpdateUpgradeStatus(now))
+		assert.Nil(t, s.Upgrade)
+	})
+
+	t.Run("Upgrade", func(t *testing.T) {
+		s := DeploymentStatus{CurrentImage: newImage.DeepCopy()}
+		s.Members.Agents = MemberStatusList{member("c", oldImage)}
+		s.Members.DBServers = MemberStatusList{member("a", oldImage), member("b", oldImage)}
+		s.Plan = Plan{{Type: ActionTypeSetMemberCurrentImage, MemberID: "a"}, {Type: ActionTypeRotateMember, MemberID: "a"}}
+
+		require.True(t, s.UpdateUpgradeStatus(now))
+		require.NotNil(t, s.Upgrade)
+		assert.Equal(t, DeploymentUpgradePhaseInProgress, s.Upgrade.Phase)
+		assert.EqualValues(t, "3.9.1", s.Upgrade.FromVersion)
+		assert.EqualValues(t, "3.9.2", s.Upgrade.ToVersion)
+		assert.Equal(t, 0, s.Upgrade.MembersUpgraded)
+		assert.Equal(t, 3, s.Upgrade.MembersRemaining)
+		assert.Equal(t, "a", s.Upgrade.CurrentMember)
+		assert.False(t, s.Conditions.IsTrue(ConditionTypeUpgradeCompleted))
+		assert.False(t, s.UpdateUpgradeStatus(now.Add(time.Minute)))
+
+		s.Members.DBServers[0] = member("a", newImage)
+		s.Plan = Plan{{Type: ActionTypeRotateMember, MemberID: "b"}}
+		require.True(t, s.UpdateUpgradeStatus(now.Add(time.Minute)))
+		assert.Equal(t, 1, s.Upgrade.MembersUpgraded)
+		assert.Equal(t, 2, s.Upgrade.MembersRemaining)
+		assert.Equal(t, "b", s.Upgrade.CurrentMember)
+		assert.Equal(t, now.Unix(), s.Upgrade.StartTime.Unix())
+
+		s.Members.DBServers[1].Conditions.Update(ConditionTypeUpgradeFailed, true, "", "")
+		require.True(t, s.UpdateUpgradeStatus(now.Add(2*time.Minute)))
+		assert.Equal(t, DeploymentUpgradePhaseFailed, s.Upgrade.Phase)
+		assert.True(t, s.Conditions.IsTrue(ConditionTypeUpgradeFailed))
+
+		s.Members.Agents[0] = member("c", newImage)
+		s.Members.DBServers[1] = member("b", newImage)
+		s.Plan = nil
+		require.True(t, s.UpdateUpgradeStatus(now.Add(3*time.Minute)))
+		assert.Equal(t, DeploymentUpgradePhaseCompleted, s.Upgrade.Phase)
+		assert.Equal(t, 3, s.Upgrade.MembersUpgraded)
+		assert.Equal(t, 0, s.Upgrade.MembersRemaining)
+		assert.Empty(t, s.Upgrade.CurrentMember)
+		require.NotNil(t, s.Upgrade.FinishTime)
+		assert.True(t, s.Conditions.IsTrue(ConditionTypeUpgradeCompleted))
+		assert.False(t, s.Conditions.IsTrue(ConditionTypeUpgradeFailed))
+
+		// Completed upgrade is not changed by later rotations
+		s.Plan = Plan{{Type: ActionTypeRotateMember, MemberID: "a"}}
+		assert.False(t, s.UpdateUpgradeStatus(now.Add(4*time.Minute)))
+	})
+
+	t.Run("Aborted", func(t *testing.T) {
+		s := DeploymentStatus{CurrentImage: newImage.DeepCopy()}
+		s.Members.DBServers = MemberStatusList{member("a", oldImage), member("b", oldImage)}
+		assert.False(t, s.MarkUpgradeFailed("aborted"))
+
+		require.True(t, s.UpdateUpgradeStatus(now))
+		require.True(t, s.MarkUpgradeFailed("aborted"))
+		assert.Equal(t, DeploymentUpgradePhaseFailed, s.Upgrade.Phase)
+		c, ok := s.Conditions.Get(ConditionTypeUpgradeFailed)
+		require.True(t, ok)
+		assert.Equal(t, "aborted", c.Message)
+
+		// Failure is kept while members are not upgraded
+		s.Members.DBServers[0] = member("a", newImage)
+		s.UpdateUpgradeStatus(now)
+		assert.Equal(t, DeploymentUpgradePhaseFailed, s.Upgrade.Phase)
+		assert.Equal(t, 1, s.Upgrade.MembersUpgraded)
+		c, _ = s.Conditions.Get(ConditionTypeUpgradeFailed)
+		assert.Equal(t, "aborted", c.Message)
+
+		s.Members.DBServers[1] = member("b", newImage)
+		s.UpdateUpgradeStatus(now)
+		assert.Equal(t, DeploymentUpgradePhaseCompleted, s.Upgrade.Phase)
+		assert.False(t, s.Conditions.IsTrue(ConditionTypeUpgradeFailed))
+	})
+}
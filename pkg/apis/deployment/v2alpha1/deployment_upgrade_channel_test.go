@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeChannel(t *testing.T) {
+	require.NoError(t, UpgradeChannel("3.9-patch").Validate())
+	require.Error(t, UpgradeChannel("3.9").Validate())
+	require.Error(t, UpgradeChannel("3.9.1-patch").Validate())
+	require.Error(t, UpgradeChannel("latest").Validate())
+
+	c := UpgradeChannel("3.9-patch")
+	require.True(t, c.Contains(driver.Version("3.9.2")))
+	require.False(t, c.Contains(driver.Version("3.10.0")))
+	require.False(t, c.Contains(driver.Version("3.8.7")))
+
+	tag, ok := c.SelectLatest([]string{"latest", "3.8.7", "3.9.2", "3.9.10", "3.9.11-rc.1", "3.9.3", "3.10.0"})
+	require.True(t, ok)
+	require.Equal(t, "3.9.10", tag)
+
+	_, ok = c.SelectLatest([]string{"latest", "3.8.7", "3.10.0"})
+	require.False(t, ok)
+}
+
+func TestDeploymentUpgradeSpec(t *testing.T) {
+	var nilSpec *DeploymentUpgradeSpec
+	require.NoError(t, nilSpec.Validate())
+	require.Equal(t, UpgradeChannel(""), nilSpec.Get().GetChannel())
+	require.Equal(t, DefaultUpgradeChannelCheckInterval, nilSpec.Get().GetChannelCheckInterval())
+
+	channel := UpgradeChannel("3.9-patch")
+	spec := DeploymentUpgradeSpec{Channel: &channel, ChannelCheckInterval: NewDuration("10m")}
+	require.NoError(t, spec.Validate())
+	require.Equal(t, channel, spec.GetChannel())
+	require.Equal(t, 10*time.Minute, spec.GetChannelCheckInterval())
+
+	invalid := UpgradeChannel("3.9")
+	require.Error(t, (&DeploymentUpgradeSpec{Channel: &invalid}).Validate())
+	require.Error(t, (&DeploymentUpgradeSpec{ChannelCheckInterval: NewDuration("x")}).Validate())
+}
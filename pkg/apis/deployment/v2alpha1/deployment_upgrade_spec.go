@@ -20,9 +20,29 @@
 
 package v2alpha1
 
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// DefaultUpgradeChannelCheckInterval is the default interval between registry checks of the upgrade channel
+	DefaultUpgradeChannelCheckInterval = time.Hour
+)
+
 type DeploymentUpgradeSpec struct {
 	// Flag specify if upgrade should be auto-injected, even if is not required (in case of stuck)
 	AutoUpgrade bool `json:"autoUpgrade"`
+	// AutoRollback defines if deployment should be rolled back to the previous image when upgrade fails,
+	// as long as version rules allow downgrade of already upgraded members
+	AutoRollback *bool `json:"autoRollback,omitempty"`
+	// Channel defines image channel (e.g. `3.9-patch`). When set, the operator resolves the newest
+	// patch release of the channel from the registry and upgrades the deployment to it.
+	Channel *UpgradeChannel `json:"channel,omitempty"`
+	// ChannelCheckInterval defines how often the registry is checked for new images of the channel
+	ChannelCheckInterval *Duration `json:"channelCheckInterval,omitempty"`
 }
 
 func (d *DeploymentUpgradeSpec) Get() DeploymentUpgradeSpec {
@@ -32,3 +52,49 @@ func (d *DeploymentUpgradeSpec) Get() DeploymentUpgradeSpec {
 
 	return *d
 }
+
+// GetAutoRollback returns true when failed upgrades should be rolled back
+func (d DeploymentUpgradeSpec) GetAutoRollback() bool {
+	return util.BoolOrDefault(d.AutoRollback, false)
+}
+
+// GetChannel returns the upgrade channel, empty when not set
+func (d DeploymentUpgradeSpec) GetChannel() UpgradeChannel {
+	if d.Channel == nil {
+		return ""
+	}
+
+	return *d.Channel
+}
+
+// GetChannelCheckInterval returns the interval between registry checks of the upgrade channel
+func (d DeploymentUpgradeSpec) GetChannelCheckInterval() time.Duration {
+	if d.ChannelCheckInterval != nil {
+		if v := d.ChannelCheckInterval.AsDuration(); v > 0 {
+			return v
+		}
+	}
+
+	return DefaultUpgradeChannelCheckInterval
+}
+
+// Validate the given spec
+func (d *DeploymentUpgradeSpec) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if d.Channel != nil {
+		if err := d.Channel.Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, "channel"))
+		}
+	}
+
+	if d.ChannelCheckInterval != nil {
+		if err := d.ChannelCheckInterval.Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, "channelCheckInterval"))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/validation"
+)
+
+// ExternalAccessIngressSpec holds configuration of the Ingress created for the external access of type Ingress
+type ExternalAccessIngressSpec struct {
+	// Host under which the deployment is exposed by the ingress controller
+	Host *string `json:"host,omitempty"`
+	// IngressClassName defines the ingress controller which serves the Ingress
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// TLSSecretName define name of the secret with certificate (tls.crt, tls.key) presented by the ingress controller
+	TLSSecretName *string `json:"tlsSecretName,omitempty"`
+	// Annotations added to the Ingress, e.g. to configure the backend protocol of the ingress controller
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// GetHost returns the host of the Ingress
+func (s *ExternalAccessIngressSpec) GetHost() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.Host)
+}
+
+// GetIngressClassName returns the ingress class name or nil when not set
+func (s *ExternalAccessIngressSpec) GetIngressClassName() *string {
+	if s == nil {
+		return nil
+	}
+
+	return s.IngressClassName
+}
+
+// GetTLSSecretName returns the name of the secret with the certificate of the Ingress
+func (s *ExternalAccessIngressSpec) GetTLSSecretName() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.TLSSecretName)
+}
+
+// GetAnnotations returns annotations of the Ingress
+func (s *ExternalAccessIngressSpec) GetAnnotations() map[string]string {
+	if s == nil {
+		return nil
+	}
+
+	return s.Annotations
+}
+
+// Validate the given spec
+func (s *ExternalAccessIngressSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if s.Host != nil && !validation.IsValidDNSName(s.GetHost()) {
+		return errors.WithStack(errors.Newf("host '%s' is not a valid DNS name", s.GetHost()))
+	}
+
+	if s.TLSSecretName != nil {
+		if err := k8sutil.ValidateResourceName(s.GetTLSSecretName()); err != nil {
+			return errors.WithStack(errors.Wrap(err, "tlsSecretName"))
+		}
+
+		if s.GetHost() == "" {
+			return errors.WithStack(errors.Newf("tlsSecretName requires host"))
+		}
+	}
+
+	return nil
+}
@@ -21,14 +21,23 @@
 package v2alpha1
 
 import (
+	"fmt"
 	"net"
 	"net/url"
+	"strconv"
+	"strings"
+
+	core "k8s.io/api/core/v1"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 
 	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/validation"
 )
 
+// maxSessionAffinityTimeoutSeconds is the maximum session affinity timeout accepted by Kubernetes (1 day)
+const maxSessionAffinityTimeoutSeconds = 86400
+
 // ExternalAccessSpec holds configuration for the external access provided for the deployment.
 type ExternalAccessSpec struct {
 	// Type of external access
@@ -42,8 +51,33 @@ type ExternalAccessSpec struct {
 	// cloud-provider does not support the feature.
 	// More info: https://kubernetes.io/docs/tasks/access-application-cluster/configure-cloud-provider-firewall/
 	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
-	// Advertised Endpoint is passed to the coordinators/single servers for advertising a specific endpoint
+	// LoadBalancerClass selects the load-balancer implementation serving the service, in case of Auto or LoadBalancer type.
+	// Changing it recreates the service.
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+	// SessionAffinity of the external access service, one of None (default) or ClientIP.
+	SessionAffinity *core.ServiceAffinity `json:"sessionAffinity,omitempty"`
+	// SessionAffinityTimeoutSeconds define the maximum session sticky time in case of ClientIP session affinity.
+	SessionAffinityTimeoutSeconds *int32 `json:"sessionAffinityTimeoutSeconds,omitempty"`
+	// ExternalTrafficPolicy of the external access service, one of Cluster (default) or Local.
+	// Used only in case of Auto, NodePort or LoadBalancer type.
+	ExternalTrafficPolicy *core.ServiceExternalTrafficPolicyType `json:"externalTrafficPolicy,omitempty"`
+	// Advertised Endpoint is passed to the coordinators/single servers for advertising a specific endpoint.
+	// It can be an endpoint (e.g. ssl://db.example.com:8529) or a DNS name with optional port (e.g. db.example.com),
+	// which is then advertised with the protocol of the deployment and the database port by default.
 	AdvertisedEndpoint *string `json:"advertisedEndpoint,omitempty"`
+	// AutoAdvertisedEndpoint advertises the endpoint of the load-balancer assigned to the external access service,
+	// when AdvertisedEndpoint is not set. Members are rotated when the endpoint changes.
+	AutoAdvertisedEndpoint *bool `json:"autoAdvertisedEndpoint,omitempty"`
+	// ExternalDNS enables the external-dns hostname annotation with the host of the advertised endpoint
+	// on the external access service.
+	ExternalDNS *bool `json:"externalDNS,omitempty"`
+	// AltNames define additional DNS names or IP addresses under which the external access is reachable.
+	// They are added to the certificates of members exposed via external access.
+	AltNames []string `json:"altNames,omitempty"`
+	// TLS define certificate presented on the external access hostnames. Only for database external access.
+	TLS *ExternalAccessTLSSpec `json:"tls,omitempty"`
+	// Ingress defines the Ingress created in case of Ingress type.
+	Ingress *ExternalAccessIngressSpec `json:"ingress,omitempty"`
 }
 
 // GetType returns the value of type.
@@ -61,11 +95,129 @@ func (s ExternalAccessSpec) GetLoadBalancerIP() string {
 	return util.StringOrDefault(s.LoadBalancerIP)
 }
 
+// GetLoadBalancerClass returns the load-balancer class or nil when not set.
+func (s ExternalAccessSpec) GetLoadBalancerClass() *string {
+	return s.LoadBalancerClass
+}
+
+// GetSessionAffinity returns the session affinity of the external access service.
+func (s ExternalAccessSpec) GetSessionAffinity() core.ServiceAffinity {
+	if s.SessionAffinity == nil {
+		return core.ServiceAffinityNone
+	}
+
+	return *s.SessionAffinity
+}
+
+// GetSessionAffinityConfig returns the session affinity config of the external access service.
+func (s ExternalAccessSpec) GetSessionAffinityConfig() *core.SessionAffinityConfig {
+	if s.GetSessionAffinity() != core.ServiceAffinityClientIP {
+		return nil
+	}
+
+	timeout := core.DefaultClientIPServiceAffinitySeconds
+	if s.SessionAffinityTimeoutSeconds != nil {
+		timeout = *s.SessionAffinityTimeoutSeconds
+	}
+
+	return &core.SessionAffinityConfig{
+		ClientIP: &core.ClientIPConfig{
+			TimeoutSeconds: util.NewInt32(timeout),
+		},
+	}
+}
+
+// GetExternalTrafficPolicy returns the external traffic policy of the external access service.
+func (s ExternalAccessSpec) GetExternalTrafficPolicy() core.ServiceExternalTrafficPolicyType {
+	if s.ExternalTrafficPolicy == nil {
+		return core.ServiceExternalTrafficPolicyTypeCluster
+	}
+
+	return *s.ExternalTrafficPolicy
+}
+
 // GetAdvertisedEndpoint returns the advertised endpoint or empty string if none was specified
 func (s ExternalAccessSpec) GetAdvertisedEndpoint() string {
 	return util.StringOrDefault(s.AdvertisedEndpoint)
 }
 
+// GetAdvertisedEndpointURL returns the endpoint advertised by the coordinators/single servers.
+// When only a DNS name (with optional port) is specified, the endpoint is built with the given protocol and default port.
+func (s ExternalAccessSpec) GetAdvertisedEndpointURL(secure bool, defaultPort int) string {
+	ep := s.GetAdvertisedEndpoint()
+	if ep == "" || strings.Contains(ep, "://") {
+		return ep
+	}
+
+	scheme := "tcp"
+	if secure {
+		scheme = "ssl"
+	}
+
+	host, port := ep, strconv.Itoa(defaultPort)
+	if h, p, err := net.SplitHostPort(ep); err == nil {
+		host, port = h, p
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, port))
+}
+
+// IsAutoAdvertisedEndpoint returns true when the endpoint of the load-balancer should be advertised.
+func (s ExternalAccessSpec) IsAutoAdvertisedEndpoint() bool {
+	return !s.HasAdvertisedEndpoint() && util.BoolOrDefault(s.AutoAdvertisedEndpoint, false)
+}
+
+// GetAdvertisedEndpointHost returns the host of the advertised endpoint or empty string if none was specified.
+func (s ExternalAccessSpec) GetAdvertisedEndpointHost() string {
+	if s.AdvertisedEndpoint == nil {
+		return ""
+	}
+
+	u, err := url.Parse(s.GetAdvertisedEndpointURL(false, 0))
+	if err != nil {
+		return ""
+	}
+
+	return u.Hostname()
+}
+
+// GetExternalDNSHostname returns the hostname announced to external-dns or empty string when it is not enabled.
+func (s ExternalAccessSpec) GetExternalDNSHostname() string {
+	if !util.BoolOrDefault(s.ExternalDNS, false) {
+		return ""
+	}
+
+	if host := s.GetAdvertisedEndpointHost(); net.ParseIP(host) == nil {
+		return host
+	}
+
+	return ""
+}
+
+// GetAltNames returns the alt names of the external access together with the host of the advertised endpoint.
+func (s ExternalAccessSpec) GetAltNames() []string {
+	names := append([]string{}, s.AltNames...)
+
+	if host := s.GetAdvertisedEndpointHost(); host != "" {
+		names = append(names, host)
+	}
+
+	return names
+}
+
+// GetServerNames returns DNS names of the external access, used to serve the external access certificate.
+func (s ExternalAccessSpec) GetServerNames() []string {
+	var names []string
+
+	for _, name := range s.GetAltNames() {
+		if net.ParseIP(name) == nil {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
 // HasAdvertisedEndpoint return whether an advertised endpoint was specified or not
 func (s ExternalAccessSpec) HasAdvertisedEndpoint() bool {
 	return s.AdvertisedEndpoint != nil
@@ -78,15 +230,55 @@ func (s ExternalAccessSpec) Validate() error {
 	}
 	if s.AdvertisedEndpoint != nil {
 		ep := s.GetAdvertisedEndpoint()
-		if _, err := url.Parse(ep); err != nil {
+		if _, err := url.Parse(s.GetAdvertisedEndpointURL(false, 0)); err != nil {
 			return errors.WithStack(errors.Newf("Failed to parse advertised endpoint '%s': %s", ep, err))
 		}
 	}
+	if util.BoolOrDefault(s.ExternalDNS, false) {
+		if host := s.GetAdvertisedEndpointHost(); host == "" || net.ParseIP(host) != nil || !validation.IsValidDNSName(host) {
+			return errors.WithStack(errors.Newf("externalDNS requires advertisedEndpoint with DNS name"))
+		}
+	}
+	for _, name := range s.AltNames {
+		if net.ParseIP(name) == nil && !validation.IsValidDNSName(name) {
+			return errors.WithStack(errors.Newf("'%s' is not a valid alternate name", name))
+		}
+	}
+	if err := s.TLS.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "tls"))
+	}
+	if err := s.Ingress.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "ingress"))
+	}
+	if s.TLS.GetSecretName() != "" && len(s.GetServerNames()) == 0 {
+		return errors.WithStack(errors.Newf("tls.secretName requires altNames or advertisedEndpoint with DNS name"))
+	}
 	for _, x := range s.LoadBalancerSourceRanges {
 		if _, _, err := net.ParseCIDR(x); err != nil {
 			return errors.WithStack(errors.Newf("Failed to parse loadbalancer source range '%s': %s", x, err))
 		}
 	}
+	if s.LoadBalancerClass != nil && *s.LoadBalancerClass == "" {
+		return errors.WithStack(errors.Newf("loadBalancerClass cannot be empty"))
+	}
+	switch a := s.GetSessionAffinity(); a {
+	case core.ServiceAffinityNone, core.ServiceAffinityClientIP:
+	default:
+		return errors.WithStack(errors.Newf("Unsupported session affinity '%s'", a))
+	}
+	if t := s.SessionAffinityTimeoutSeconds; t != nil {
+		if s.GetSessionAffinity() != core.ServiceAffinityClientIP {
+			return errors.WithStack(errors.Newf("sessionAffinityTimeoutSeconds requires ClientIP session affinity"))
+		}
+		if *t <= 0 || *t > maxSessionAffinityTimeoutSeconds {
+			return errors.WithStack(errors.Newf("sessionAffinityTimeoutSeconds %d is out of range", *t))
+		}
+	}
+	switch p := s.GetExternalTrafficPolicy(); p {
+	case core.ServiceExternalTrafficPolicyTypeCluster, core.ServiceExternalTrafficPolicyTypeLocal:
+	default:
+		return errors.WithStack(errors.Newf("Unsupported external traffic policy '%s'", p))
+	}
 	return nil
 }
 
@@ -108,9 +300,38 @@ func (s *ExternalAccessSpec) SetDefaultsFrom(source ExternalAccessSpec) {
 	if s.LoadBalancerSourceRanges == nil && len(source.LoadBalancerSourceRanges) > 0 {
 		s.LoadBalancerSourceRanges = append([]string{}, source.LoadBalancerSourceRanges...)
 	}
+	if s.LoadBalancerClass == nil {
+		s.LoadBalancerClass = util.NewStringOrNil(source.LoadBalancerClass)
+	}
+	if s.SessionAffinity == nil && source.SessionAffinity != nil {
+		v := *source.SessionAffinity
+		s.SessionAffinity = &v
+	}
+	if s.SessionAffinityTimeoutSeconds == nil {
+		s.SessionAffinityTimeoutSeconds = util.NewInt32OrNil(source.SessionAffinityTimeoutSeconds)
+	}
+	if s.ExternalTrafficPolicy == nil && source.ExternalTrafficPolicy != nil {
+		v := *source.ExternalTrafficPolicy
+		s.ExternalTrafficPolicy = &v
+	}
 	if s.AdvertisedEndpoint == nil {
 		s.AdvertisedEndpoint = source.AdvertisedEndpoint
 	}
+	if s.AutoAdvertisedEndpoint == nil {
+		s.AutoAdvertisedEndpoint = util.NewBoolOrNil(source.AutoAdvertisedEndpoint)
+	}
+	if s.ExternalDNS == nil {
+		s.ExternalDNS = util.NewBoolOrNil(source.ExternalDNS)
+	}
+	if s.AltNames == nil && len(source.AltNames) > 0 {
+		s.AltNames = append([]string{}, source.AltNames...)
+	}
+	if s.TLS == nil {
+		s.TLS = source.TLS.DeepCopy()
+	}
+	if s.Ingress == nil {
+		s.Ingress = source.Ingress.DeepCopy()
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
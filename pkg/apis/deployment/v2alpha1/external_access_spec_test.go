@@ -0,0 +1,100 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+)
+
+func TestExternalAccessSpecValidate(t *testing.T) {
+	// Valid
+	assert.Nil(t, ExternalAccessSpec{}.Validate())
+	assert.Nil(t, ExternalAccessSpec{AltNames: []string{"db.example.com", "10.0.0.1"}}.Validate())
+	assert.Nil(t, ExternalAccessSpec{AltNames: []string{"db.example.com"}, TLS: &ExternalAccessTLSSpec{SecretName: util.NewString("public")}}.Validate())
+	assert.Nil(t, ExternalAccessSpec{AdvertisedEndpoint: util.NewString("https://db.example.com"), TLS: &ExternalAccessTLSSpec{SecretName: util.NewString("public")}}.Validate())
+
+	// Not valid
+	assert.Error(t, ExternalAccessSpec{AltNames: []string{"@@"}}.Validate())
+	assert.Error(t, ExternalAccessSpec{TLS: &ExternalAccessTLSSpec{SecretName: util.NewString("public")}}.Validate())
+	assert.Error(t, ExternalAccessSpec{AltNames: []string{"10.0.0.1"}, TLS: &ExternalAccessTLSSpec{SecretName: util.NewString("public")}}.Validate())
+	assert.Error(t, ExternalAccessSpec{AltNames: []string{"db.example.com"}, TLS: &ExternalAccessTLSSpec{SecretName: util.NewString("Public")}}.Validate())
+}
+
+func TestExternalAccessSpecGetAltNames(t *testing.T) {
+	s := ExternalAccessSpec{
+		AltNames:           []string{"lb.example.com", "10.0.0.1"},
+		AdvertisedEndpoint: util.NewString("https://db.example.com:8529"),
+	}
+
+	assert.Equal(t, []string{"lb.example.com", "10.0.0.1", "db.example.com"}, s.GetAltNames())
+	assert.Equal(t, []string{"lb.example.com", "db.example.com"}, s.GetServerNames())
+}
+
+func TestExternalAccessSpecValidateServiceSettings(t *testing.T) {
+	clientIP := core.ServiceAffinityClientIP
+	none := core.ServiceAffinityNone
+	local := core.ServiceExternalTrafficPolicyTypeLocal
+	invalidAffinity := core.ServiceAffinity("Invalid")
+	invalidPolicy := core.ServiceExternalTrafficPolicyType("Invalid")
+
+	// Valid
+	assert.Nil(t, ExternalAccessSpec{LoadBalancerClass: util.NewString("service.k8s.aws/nlb")}.Validate())
+	assert.Nil(t, ExternalAccessSpec{SessionAffinity: &clientIP, SessionAffinityTimeoutSeconds: util.NewInt32(600)}.Validate())
+	assert.Nil(t, ExternalAccessSpec{ExternalTrafficPolicy: &local}.Validate())
+
+	// Not valid
+	assert.Error(t, ExternalAccessSpec{LoadBalancerClass: util.NewString("")}.Validate())
+	assert.Error(t, ExternalAccessSpec{SessionAffinity: &invalidAffinity}.Validate())
+	assert.Error(t, ExternalAccessSpec{SessionAffinity: &none, SessionAffinityTimeoutSeconds: util.NewInt32(600)}.Validate())
+	assert.Error(t, ExternalAccessSpec{SessionAffinity: &clientIP, SessionAffinityTimeoutSeconds: util.NewInt32(0)}.Validate())
+	assert.Error(t, ExternalAccessSpec{ExternalTrafficPolicy: &invalidPolicy}.Validate())
+}
+
+func TestExternalAccessSpecGetSessionAffinityConfig(t *testing.T) {
+	clientIP := core.ServiceAffinityClientIP
+
+	assert.Nil(t, ExternalAccessSpec{}.GetSessionAffinityConfig())
+	assert.Equal(t, core.DefaultClientIPServiceAffinitySeconds, *ExternalAccessSpec{SessionAffinity: &clientIP}.GetSessionAffinityConfig().ClientIP.TimeoutSeconds)
+	assert.Equal(t, int32(600), *ExternalAccessSpec{SessionAffinity: &clientIP, SessionAffinityTimeoutSeconds: util.NewInt32(600)}.GetSessionAffinityConfig().ClientIP.TimeoutSeconds)
+}
+
+func TestExternalAccessSpecAdvertisedEndpoint(t *testing.T) {
+	assert.Equal(t, "", ExternalAccessSpec{}.GetAdvertisedEndpointURL(true, 8529))
+	assert.Equal(t, "tcp://db.example.com:8529", ExternalAccessSpec{AdvertisedEndpoint: util.NewString("db.example.com")}.GetAdvertisedEndpointURL(false, 8529))
+	assert.Equal(t, "ssl://db.example.com:443", ExternalAccessSpec{AdvertisedEndpoint: util.NewString("db.example.com:443")}.GetAdvertisedEndpointURL(true, 8529))
+	assert.Equal(t, "https://db.example.com:8530", ExternalAccessSpec{AdvertisedEndpoint: util.NewString("https://db.example.com:8530")}.GetAdvertisedEndpointURL(true, 8529))
+
+	assert.Equal(t, "db.example.com", ExternalAccessSpec{AdvertisedEndpoint: util.NewString("db.example.com")}.GetAdvertisedEndpointHost())
+	assert.Equal(t, "db.example.com", ExternalAccessSpec{AdvertisedEndpoint: util.NewString("ssl://db.example.com:8529")}.GetAdvertisedEndpointHost())
+}
+
+func TestExternalAccessSpecExternalDNS(t *testing.T) {
+	assert.Equal(t, "", ExternalAccessSpec{AdvertisedEndpoint: util.NewString("db.example.com")}.GetExternalDNSHostname())
+	assert.Equal(t, "db.example.com", ExternalAccessSpec{AdvertisedEndpoint: util.NewString("db.example.com"), ExternalDNS: util.NewBool(true)}.GetExternalDNSHostname())
+
+	assert.Nil(t, ExternalAccessSpec{AdvertisedEndpoint: util.NewString("db.example.com"), ExternalDNS: util.NewBool(true)}.Validate())
+	assert.Error(t, ExternalAccessSpec{ExternalDNS: util.NewBool(true)}.Validate())
+	assert.Error(t, ExternalAccessSpec{AdvertisedEndpoint: util.NewString("tcp://10.0.0.1:8529"), ExternalDNS: util.NewBool(true)}.Validate())
+}
@@ -38,12 +38,15 @@ const (
 	ExternalAccessTypeLoadBalancer ExternalAccessType = "LoadBalancer"
 	// ExternalAccessTypeNodePort yields a cluster with a service of type `NodePort` to provide external access
 	ExternalAccessTypeNodePort ExternalAccessType = "NodePort"
+	// ExternalAccessTypeIngress yields a cluster with a service of type `ClusterIP` exposed by an Ingress
+	ExternalAccessTypeIngress ExternalAccessType = "Ingress"
 )
 
 func (t ExternalAccessType) IsNone() bool         { return t == ExternalAccessTypeNone }
 func (t ExternalAccessType) IsAuto() bool         { return t == ExternalAccessTypeAuto }
 func (t ExternalAccessType) IsLoadBalancer() bool { return t == ExternalAccessTypeLoadBalancer }
 func (t ExternalAccessType) IsNodePort() bool     { return t == ExternalAccessTypeNodePort }
+func (t ExternalAccessType) IsIngress() bool      { return t == ExternalAccessTypeIngress }
 
 // AsServiceType returns the k8s ServiceType for this ExternalAccessType.
 // If type is "Auto", ServiceTypeLoadBalancer is returned.
@@ -53,6 +56,8 @@ func (t ExternalAccessType) AsServiceType() v1.ServiceType {
 		return v1.ServiceTypeLoadBalancer
 	case ExternalAccessTypeNodePort:
 		return v1.ServiceTypeNodePort
+	case ExternalAccessTypeIngress:
+		return v1.ServiceTypeClusterIP
 	default:
 		return ""
 	}
@@ -62,7 +67,7 @@ func (t ExternalAccessType) AsServiceType() v1.ServiceType {
 // Return errors when validation fails, nil on success.
 func (t ExternalAccessType) Validate() error {
 	switch t {
-	case ExternalAccessTypeNone, ExternalAccessTypeAuto, ExternalAccessTypeLoadBalancer, ExternalAccessTypeNodePort:
+	case ExternalAccessTypeNone, ExternalAccessTypeAuto, ExternalAccessTypeLoadBalancer, ExternalAccessTypeNodePort, ExternalAccessTypeIngress:
 		return nil
 	default:
 		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown external access type: '%s'", string(t)))
@@ -32,6 +32,28 @@ type ImageInfo struct {
 	ImageID         string         `json:"image-id,omitempty"`         // Unique ID (with SHA256) of the image
 	ArangoDBVersion driver.Version `json:"arangodb-version,omitempty"` // ArangoDB version within the image
 	Enterprise      bool           `json:"enterprise,omitempty"`       // If set, this is an enterprise image
+	// Architectures keeps list of architectures for which image was verified by the image discovery
+	Architectures ArangoDeploymentArchitecture `json:"architectures,omitempty"`
+}
+
+// HasArchitecture returns true if image was verified for the given architecture.
+// Images discovered before architecture verification was introduced are assumed to provide the default architecture.
+func (i *ImageInfo) HasArchitecture(arch ArangoDeploymentArchitectureType) bool {
+	if i == nil {
+		return false
+	}
+
+	if len(i.Architectures) == 0 {
+		return arch == ArangoDeploymentArchitectureDefault
+	}
+
+	for _, a := range i.Architectures {
+		if a == arch {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (i *ImageInfo) String() string {
@@ -105,7 +127,8 @@ func (i *ImageInfo) Equal(other *ImageInfo) bool {
 	return i.ArangoDBVersion == other.ArangoDBVersion &&
 		i.Enterprise == other.Enterprise &&
 		i.Image == other.Image &&
-		i.ImageID == other.ImageID
+		i.ImageID == other.ImageID &&
+		i.Architectures.Equal(other.Architectures)
 }
 
 // Equal compares to ImageInfoList
@@ -22,6 +22,7 @@ package v2alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/stretchr/testify/assert"
@@ -33,3 +34,11 @@ func TestLicenseSpecValidation(t *testing.T) {
 
 	assert.Error(t, LicenseSpec{SecretName: util.NewString("@@")}.Validate())
 }
+
+func TestLicenseSpecExpirationWarningPeriod(t *testing.T) {
+	assert.Equal(t, DefaultLicenseExpirationWarningPeriod, LicenseSpec{}.GetExpirationWarningPeriod())
+	assert.Equal(t, 48*time.Hour, LicenseSpec{ExpirationWarningPeriod: NewDuration("48h")}.GetExpirationWarningPeriod())
+
+	assert.Nil(t, LicenseSpec{ExpirationWarningPeriod: NewDuration("48h")}.Validate())
+	assert.Error(t, LicenseSpec{ExpirationWarningPeriod: NewDuration("2 days")}.Validate())
+}
@@ -0,0 +1,97 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MemberServerStatus holds the state reported by the ArangoDB server of the member
+type MemberServerStatus struct {
+	// Version holds the ArangoDB version reported by the server
+	Version driver.Version `json:"version,omitempty"`
+	// License holds the type of license reported by the server (community or enterprise)
+	License string `json:"license,omitempty"`
+	// LicenseExpires holds the expiration time of the installed license
+	LicenseExpires *metav1.Time `json:"licenseExpires,omitempty"`
+	// StartedAt holds the time when the server was started. The uptime of the server is calculated from it.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// ReplicationLag holds the time by which the follower is behind the leader (active failover only)
+	ReplicationLag *metav1.Duration `json:"replicationLag,omitempty"`
+	// ShardsNotInSync holds the number of shards of the DBServer which are not in sync (cluster only)
+	ShardsNotInSync int `json:"shardsNotInSync,omitempty"`
+	// Disk holds the usage of the filesystem of the data directory
+	Disk *MemberServerDiskStatus `json:"disk,omitempty"`
+}
+
+// MemberServerDiskStatus holds the usage of the filesystem of the member data directory
+type MemberServerDiskStatus struct {
+	// Total holds the size of the filesystem
+	Total resource.Quantity `json:"total"`
+	// UsedPercent holds the used part of the filesystem in percents
+	UsedPercent int `json:"usedPercent"`
+}
+
+// Equal checks for equality
+func (m *MemberServerDiskStatus) Equal(other *MemberServerDiskStatus) bool {
+	if m == nil && other == nil {
+		return true
+	} else if m == nil || other == nil {
+		return false
+	}
+
+	return m.Total.Cmp(other.Total) == 0 &&
+		m.UsedPercent == other.UsedPercent
+}
+
+// Equal checks for equality
+func (m *MemberServerStatus) Equal(other *MemberServerStatus) bool {
+	if m == nil && other == nil {
+		return true
+	} else if m == nil || other == nil {
+		return false
+	} else if m == other {
+		return true
+	}
+
+	return m.Version == other.Version &&
+		m.License == other.License &&
+		((m.LicenseExpires == nil && other.LicenseExpires == nil) || util.TimeCompareEqualPointer(m.LicenseExpires, other.LicenseExpires)) &&
+		((m.StartedAt == nil && other.StartedAt == nil) || util.TimeCompareEqualPointer(m.StartedAt, other.StartedAt)) &&
+		m.GetReplicationLag() == other.GetReplicationLag() &&
+		(m.ReplicationLag == nil) == (other.ReplicationLag == nil) &&
+		m.ShardsNotInSync == other.ShardsNotInSync &&
+		m.Disk.Equal(other.Disk)
+}
+
+// GetReplicationLag returns the replication lag of the follower, 0 if not known
+func (m *MemberServerStatus) GetReplicationLag() time.Duration {
+	if m == nil || m.ReplicationLag == nil {
+		return 0
+	}
+
+	return m.ReplicationLag.Duration
+}
@@ -82,6 +82,10 @@ type MemberStatus struct {
 	Endpoint *string `json:"endpoint,omitempty"`
 	// Topology define topology member status assignment
 	Topology *TopologyMemberStatus `json:"topology,omitempty"`
+	// TLSCertificateExpiry holds the expiration time of the member TLS keyfile certificate
+	TLSCertificateExpiry *metav1.Time `json:"tlsCertificateExpiry,omitempty"`
+	// Server holds the state reported by the ArangoDB server of the member
+	Server *MemberServerStatus `json:"server,omitempty"`
 
 	// deprecated
 	// SideCarSpecs contains list of specifications specified for side cars
@@ -106,7 +110,9 @@ func (s MemberStatus) Equal(other MemberStatus) bool {
 		s.Image.Equal(other.Image) &&
 		s.OldImage.Equal(other.OldImage) &&
 		s.Upgrade == other.Upgrade &&
-		util.CompareStringPointers(s.Endpoint, other.Endpoint)
+		util.CompareStringPointers(s.Endpoint, other.Endpoint) &&
+		((s.TLSCertificateExpiry == nil && other.TLSCertificateExpiry == nil) || util.TimeCompareEqualPointer(s.TLSCertificateExpiry, other.TLSCertificateExpiry)) &&
+		s.Server.Equal(other.Server)
 }
 
 // Age returns the duration since the creation timestamp of this member.
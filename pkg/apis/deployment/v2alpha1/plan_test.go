@@ -23,8 +23,10 @@ package v2alpha1
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Test_Action_Marshal(t *testing.T) {
@@ -34,3 +36,49 @@ func Test_Action_Marshal(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, `{"id":"","type":"","creationTime":null}`, string(data))
 }
+
+func Test_Action_Handover(t *testing.T) {
+	created := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	started := meta.NewTime(created.Add(time.Minute))
+	handover := meta.NewTime(created.Add(10 * time.Minute))
+
+	t.Run("Not started", func(t *testing.T) {
+		a := Action{CreationTime: meta.NewTime(created)}
+
+		require.False(t, a.Handover(handover).IsHandedOver())
+	})
+
+	t.Run("Handover and resume", func(t *testing.T) {
+		a := Action{CreationTime: meta.NewTime(created), StartTime: &started}
+
+		h := a.Handover(handover)
+		require.True(t, h.IsHandedOver())
+		require.False(t, h.Equal(a))
+		require.Equal(t, created.Add(time.Hour), h.Deadline(time.Hour))
+
+		// Handover time is kept when handed over again
+		require.True(t, h.Handover(meta.NewTime(handover.Add(time.Minute))).Equal(h))
+
+		r := h.Resume(handover.Add(5 * time.Minute))
+		require.False(t, r.IsHandedOver())
+		require.Equal(t, 5*time.Minute, r.GetPausedDuration())
+		require.Equal(t, created.Add(time.Hour+5*time.Minute), r.Deadline(time.Hour))
+
+		// Paused time is accumulated
+		r = r.Handover(meta.NewTime(handover.Add(20 * time.Minute))).Resume(handover.Add(22 * time.Minute))
+		require.Equal(t, 7*time.Minute, r.GetPausedDuration())
+	})
+
+	t.Run("Plan", func(t *testing.T) {
+		p := Plan{
+			{CreationTime: meta.NewTime(created), StartTime: &started},
+			{CreationTime: meta.NewTime(created)},
+		}
+
+		h := p.Handover(handover)
+		require.True(t, h[0].IsHandedOver())
+		require.False(t, h[1].IsHandedOver())
+		require.False(t, p[0].IsHandedOver())
+		require.False(t, h.Equal(p))
+	})
+}
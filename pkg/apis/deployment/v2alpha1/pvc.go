@@ -20,6 +20,8 @@
 
 package v2alpha1
 
+import "github.com/arangodb/kube-arangodb/pkg/util/errors"
+
 type PVCResizeMode string
 
 const (
@@ -38,3 +40,67 @@ func (p *PVCResizeMode) Get() PVCResizeMode {
 func (p PVCResizeMode) String() string {
 	return string(p)
 }
+
+// StorageClassChangeMode defines how members are moved to the new StorageClass, when it is changed in the spec.
+type StorageClassChangeMode string
+
+const (
+	// StorageClassChangeModeManual requires members to be replaced manually, by annotating their pods for replacement
+	StorageClassChangeModeManual StorageClassChangeMode = "manual"
+	// StorageClassChangeModeReplace replaces members one by one, by the operator
+	StorageClassChangeModeReplace StorageClassChangeMode = "replace"
+)
+
+func (p *StorageClassChangeMode) Get() StorageClassChangeMode {
+	if p == nil {
+		return StorageClassChangeModeManual
+	}
+
+	return *p
+}
+
+func (p StorageClassChangeMode) String() string {
+	return string(p)
+}
+
+// Validate the mode
+func (p *StorageClassChangeMode) Validate() error {
+	switch v := p.Get(); v {
+	case StorageClassChangeModeManual, StorageClassChangeModeReplace:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown storage class change mode: '%s'", v))
+	}
+}
+
+// PVCDeletionPolicy defines what happens with the PVC of a member, when the member is removed.
+type PVCDeletionPolicy string
+
+const (
+	// PVCDeletionPolicyDelete removes the PVC together with the member
+	PVCDeletionPolicyDelete PVCDeletionPolicy = "Delete"
+	// PVCDeletionPolicyRetain keeps the PVC after the member is removed, for the retention period (if set)
+	PVCDeletionPolicyRetain PVCDeletionPolicy = "Retain"
+)
+
+func (p *PVCDeletionPolicy) Get() PVCDeletionPolicy {
+	if p == nil {
+		return PVCDeletionPolicyDelete
+	}
+
+	return *p
+}
+
+func (p PVCDeletionPolicy) String() string {
+	return string(p)
+}
+
+// Validate the policy
+func (p *PVCDeletionPolicy) Validate() error {
+	switch v := p.Get(); v {
+	case PVCDeletionPolicyDelete, PVCDeletionPolicyRetain:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown pvc deletion policy: '%s'", v))
+	}
+}
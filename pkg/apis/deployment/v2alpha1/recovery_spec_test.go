@@ -0,0 +1,43 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArangoDeploymentRecoverySpecValidate(t *testing.T) {
+	policy := func(p LocalStoragePolicy) *ArangoDeploymentRecoverySpec {
+		return &ArangoDeploymentRecoverySpec{LocalStoragePolicy: &p}
+	}
+
+	var nilSpec *ArangoDeploymentRecoverySpec
+	require.NoError(t, nilSpec.Validate())
+	require.NoError(t, (&ArangoDeploymentRecoverySpec{}).Validate())
+	require.NoError(t, policy(LocalStoragePolicyWait).Validate())
+	require.NoError(t, policy(LocalStoragePolicyRecreate).Validate())
+	require.Error(t, policy("Delete").Validate())
+
+	require.Equal(t, LocalStoragePolicy(""), nilSpec.Get().GetLocalStoragePolicy())
+	require.Equal(t, LocalStoragePolicyRecreate, policy(LocalStoragePolicyRecreate).Get().GetLocalStoragePolicy())
+}
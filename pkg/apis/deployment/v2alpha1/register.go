@@ -52,8 +52,16 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&ArangoMemberList{},
 		&ArangoClusterSynchronization{},
 		&ArangoClusterSynchronizationList{},
+		&ArangoCollection{},
+		&ArangoCollectionList{},
+		&ArangoDatabase{},
+		&ArangoDatabaseList{},
+		&ArangoFoxxService{},
+		&ArangoFoxxServiceList{},
 		&ArangoTask{},
 		&ArangoTaskList{},
+		&ArangoUser{},
+		&ArangoUserList{},
 	)
 	metav1.AddToGroupVersion(s, SchemeGroupVersion)
 	return nil
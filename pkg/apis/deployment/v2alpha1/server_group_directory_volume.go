@@ -0,0 +1,76 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	core "k8s.io/api/core/v1"
+)
+
+// ServerGroupDirectoryVolume defines a dedicated volume for a directory of the ArangoD server,
+// so the content of the directory cannot fill the data volume.
+// When no source is defined, an emptyDir volume is used.
+type ServerGroupDirectoryVolume struct {
+	// EmptyDir defines the emptyDir volume, e.g. with size limit
+	EmptyDir *core.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+	// VolumeClaimTemplate defines the PVC which is created together with the member pod and removed with it
+	VolumeClaimTemplate *core.PersistentVolumeClaimTemplate `json:"volumeClaimTemplate,omitempty"`
+}
+
+// IsEnabled returns true when the dedicated volume is defined
+func (s *ServerGroupDirectoryVolume) IsEnabled() bool {
+	return s != nil
+}
+
+// VolumeSource returns the source of the volume
+func (s *ServerGroupDirectoryVolume) VolumeSource() core.VolumeSource {
+	if s != nil && s.VolumeClaimTemplate != nil {
+		return core.VolumeSource{
+			Ephemeral: &core.EphemeralVolumeSource{
+				VolumeClaimTemplate: s.VolumeClaimTemplate.DeepCopy(),
+			},
+		}
+	}
+
+	if s != nil && s.EmptyDir != nil {
+		return core.VolumeSource{
+			EmptyDir: s.EmptyDir.DeepCopy(),
+		}
+	}
+
+	return core.VolumeSource{
+		EmptyDir: &core.EmptyDirVolumeSource{},
+	}
+}
+
+// Validate the given spec
+func (s *ServerGroupDirectoryVolume) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if s.EmptyDir != nil && s.VolumeClaimTemplate != nil {
+		return errors.WithStack(errors.Wrapf(ValidationError, "only one of emptyDir and volumeClaimTemplate can be defined"))
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func TestServerGroupDirectoryVolume(t *testing.T) {
+	var nilVolume *ServerGroupDirectoryVolume
+	assert.False(t, nilVolume.IsEnabled())
+	assert.NoError(t, nilVolume.Validate())
+
+	v := &ServerGroupDirectoryVolume{}
+	assert.True(t, v.IsEnabled())
+	assert.NotNil(t, v.VolumeSource().EmptyDir)
+
+	v = &ServerGroupDirectoryVolume{VolumeClaimTemplate: &core.PersistentVolumeClaimTemplate{}}
+	assert.NotNil(t, v.VolumeSource().Ephemeral)
+	assert.Nil(t, v.VolumeSource().EmptyDir)
+
+	v.EmptyDir = &core.EmptyDirVolumeSource{}
+	assert.Error(t, v.Validate())
+}
+
+func TestServerGroupSpecDirectoryVolumesValidation(t *testing.T) {
+	spec := ServerGroupSpec{Count: util.NewInt(1), LogVolume: &ServerGroupDirectoryVolume{}}
+	require.NoError(t, spec.Validate(ServerGroupSingle, true, DeploymentModeSingle, EnvironmentDevelopment))
+
+	spec = ServerGroupSpec{Count: util.NewInt(1), AppsVolume: &ServerGroupDirectoryVolume{}}
+	require.Error(t, spec.Validate(ServerGroupSyncMasters, true, DeploymentModeCluster, EnvironmentDevelopment))
+}
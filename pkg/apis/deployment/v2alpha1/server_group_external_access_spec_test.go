@@ -0,0 +1,57 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func TestServerGroupExternalAccessSpecValidate(t *testing.T) {
+	var empty *ServerGroupExternalAccessSpec
+	assert.NoError(t, empty.Validate())
+	assert.False(t, empty.IsEnabled())
+
+	assert.NoError(t, (&ServerGroupExternalAccessSpec{
+		Type:         NewExternalAccessType(ExternalAccessTypeNodePort),
+		NodePortBase: util.NewInt(30100),
+	}).Validate())
+	assert.NoError(t, (&ServerGroupExternalAccessSpec{
+		Type:                     NewExternalAccessType(ExternalAccessTypeLoadBalancer),
+		LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+	}).Validate())
+
+	assert.Error(t, (&ServerGroupExternalAccessSpec{Type: NewExternalAccessType(ExternalAccessTypeAuto)}).Validate())
+	assert.Error(t, (&ServerGroupExternalAccessSpec{Type: NewExternalAccessType(ExternalAccessTypeIngress)}).Validate())
+	assert.Error(t, (&ServerGroupExternalAccessSpec{NodePortBase: util.NewInt(70000)}).Validate())
+	assert.Error(t, (&ServerGroupExternalAccessSpec{LoadBalancerSourceRanges: []string{"10.0.0.0"}}).Validate())
+}
+
+func TestServerGroupSpecValidateExternalAccess(t *testing.T) {
+	ea := &ServerGroupExternalAccessSpec{Type: NewExternalAccessType(ExternalAccessTypeNodePort)}
+
+	assert.NoError(t, ServerGroupSpec{Count: util.NewInt(2), ExternalAccess: ea}.Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.NoError(t, ServerGroupSpec{Count: util.NewInt(2), ExternalAccess: ea}.Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, ServerGroupSpec{Count: util.NewInt(3), ExternalAccess: ea}.Validate(ServerGroupAgents, true, DeploymentModeCluster, EnvironmentDevelopment))
+}
@@ -129,3 +129,18 @@ func TestServerGroupSpecValidateArgs(t *testing.T) {
 	assert.Error(t, ServerGroupSpec{Count: util.NewInt(1), Args: []string{"--master.endpoint=http://something"}}.Validate(ServerGroupSyncMasters, true, DeploymentModeCluster, EnvironmentDevelopment))
 	assert.Error(t, ServerGroupSpec{Count: util.NewInt(1), Args: []string{"--mq.type=strange"}}.Validate(ServerGroupSyncMasters, true, DeploymentModeCluster, EnvironmentDevelopment))
 }
+
+func TestServerGroupSpecValidatePVCDeletionPolicy(t *testing.T) {
+	retain := PVCDeletionPolicyRetain
+	unknown := PVCDeletionPolicy("Keep")
+
+	// Valid
+	assert.Nil(t, ServerGroupSpec{Count: util.NewInt(2), PVCDeletionPolicy: &retain}.Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Nil(t, ServerGroupSpec{Count: util.NewInt(2), PVCDeletionPolicy: &retain, PVCRetentionPeriod: NewDuration("24h")}.Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	// Invalid
+	assert.Error(t, ServerGroupSpec{Count: util.NewInt(2), PVCDeletionPolicy: &unknown}.Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, ServerGroupSpec{Count: util.NewInt(2), PVCDeletionPolicy: &retain, PVCRetentionPeriod: NewDuration("1 day")}.Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, ServerGroupSpec{Count: util.NewInt(2), PVCDeletionPolicy: &retain, PVCRetentionPeriod: NewDuration("-1h")}.Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+
+	assert.Equal(t, PVCDeletionPolicyDelete, ServerGroupSpec{}.PVCDeletionPolicy.Get())
+}
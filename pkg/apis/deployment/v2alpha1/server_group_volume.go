@@ -43,6 +43,8 @@ var (
 		k8sutil.LifecycleVolumeName,
 		k8sutil.FoxxAppEphemeralVolumeName,
 		k8sutil.TMPEphemeralVolumeName,
+		k8sutil.ArangodLogsVolumeName,
+		k8sutil.ArangodAppsVolumeName,
 	}
 )
 
@@ -0,0 +1,62 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func TestServerGroupVolumeAutoExpansionSpec_GetExpandedSize(t *testing.T) {
+	var disabled *ServerGroupVolumeAutoExpansionSpec
+	require.False(t, disabled.IsEnabled())
+
+	s := &ServerGroupVolumeAutoExpansionSpec{Enabled: util.NewBool(true)}
+	require.True(t, s.IsEnabled())
+
+	size, ok := s.GetExpandedSize(resource.MustParse("10Gi"))
+	require.True(t, ok)
+	require.Equal(t, int64(12*1024*1024*1024), size.Value())
+
+	maxSize := resource.MustParse("11Gi")
+	s.MaxSize = &maxSize
+
+	size, ok = s.GetExpandedSize(resource.MustParse("10Gi"))
+	require.True(t, ok)
+	require.Equal(t, 0, size.Cmp(maxSize))
+
+	_, ok = s.GetExpandedSize(resource.MustParse("11Gi"))
+	require.False(t, ok)
+}
+
+func TestServerGroupVolumeAutoExpansionSpec_Validate(t *testing.T) {
+	require.NoError(t, (*ServerGroupVolumeAutoExpansionSpec)(nil).Validate())
+	require.NoError(t, (&ServerGroupVolumeAutoExpansionSpec{Enabled: util.NewBool(true), IncreasePercent: util.NewInt(50)}).Validate())
+	require.Error(t, (&ServerGroupVolumeAutoExpansionSpec{IncreasePercent: util.NewInt(0)}).Validate())
+	require.Error(t, (&ServerGroupVolumeAutoExpansionSpec{IncreasePercent: util.NewInt(101)}).Validate())
+
+	zero := resource.MustParse("0")
+	require.Error(t, (&ServerGroupVolumeAutoExpansionSpec{MaxSize: &zero}).Validate())
+}
@@ -24,6 +24,7 @@ import (
 	"net"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 
@@ -35,8 +36,14 @@ type SyncExternalAccessSpec struct {
 	ExternalAccessSpec
 	MasterEndpoint           []string `json:"masterEndpoint,omitempty"`
 	AccessPackageSecretNames []string `json:"accessPackageSecretNames,omitempty"`
+	// AccessPackageRenewalMargin define how long before expiration access packages are renewed
+	AccessPackageRenewalMargin *Duration `json:"accessPackageRenewalMargin,omitempty"`
 }
 
+const (
+	defaultAccessPackageRenewalMargin = Duration("720h") // 30 days
+)
+
 // GetMasterEndpoint returns the value of masterEndpoint.
 func (s SyncExternalAccessSpec) GetMasterEndpoint() []string {
 	return s.MasterEndpoint
@@ -47,6 +54,11 @@ func (s SyncExternalAccessSpec) GetAccessPackageSecretNames() []string {
 	return s.AccessPackageSecretNames
 }
 
+// GetAccessPackageRenewalMargin returns the period before expiration in which access packages are renewed.
+func (s SyncExternalAccessSpec) GetAccessPackageRenewalMargin() time.Duration {
+	return DurationOrDefault(s.AccessPackageRenewalMargin, defaultAccessPackageRenewalMargin).AsDuration()
+}
+
 // ResolveMasterEndpoint returns the value of `--master.endpoint` option passed to arangosync.
 func (s SyncExternalAccessSpec) ResolveMasterEndpoint(syncServiceHostName string, syncServicePort int) []string {
 	if len(s.MasterEndpoint) > 0 {
@@ -63,6 +75,9 @@ func (s SyncExternalAccessSpec) Validate() error {
 	if err := s.ExternalAccessSpec.Validate(); err != nil {
 		return errors.WithStack(err)
 	}
+	if s.GetType().IsIngress() {
+		return errors.WithStack(errors.Newf("Ingress external access type is not supported for sync"))
+	}
 	for _, ep := range s.MasterEndpoint {
 		if _, err := url.Parse(ep); err != nil {
 			return errors.WithStack(errors.Newf("Failed to parse master endpoint '%s': %s", ep, err))
@@ -73,6 +88,14 @@ func (s SyncExternalAccessSpec) Validate() error {
 			return errors.WithStack(errors.Newf("Invalid name '%s' in accessPackageSecretNames: %s", name, err))
 		}
 	}
+	if m := s.AccessPackageRenewalMargin; m != nil {
+		if err := m.Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, "accessPackageRenewalMargin"))
+		}
+		if s.GetAccessPackageRenewalMargin() <= 0 {
+			return errors.WithStack(errors.Wrapf(ValidationError, "accessPackageRenewalMargin %s needs to be positive", *m))
+		}
+	}
 	return nil
 }
 
@@ -90,6 +113,9 @@ func (s *SyncExternalAccessSpec) SetDefaultsFrom(source SyncExternalAccessSpec)
 	if s.AccessPackageSecretNames == nil && source.AccessPackageSecretNames != nil {
 		s.AccessPackageSecretNames = append([]string{}, source.AccessPackageSecretNames...)
 	}
+	if s.AccessPackageRenewalMargin == nil {
+		s.AccessPackageRenewalMargin = NewDurationOrNil(source.AccessPackageRenewalMargin)
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
@@ -0,0 +1,41 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_Timeouts_GetMemberCheck(t *testing.T) {
+	var nilTimeouts *Timeouts
+	require.Equal(t, time.Second, nilTimeouts.GetMemberCheck(time.Second))
+	require.Equal(t, time.Second, (&Timeouts{}).GetMemberCheck(time.Second))
+
+	zero := Timeout(meta.Duration{})
+	require.Equal(t, time.Second, (&Timeouts{MemberCheck: &zero}).GetMemberCheck(time.Second))
+
+	custom := Timeout(meta.Duration{Duration: 10 * time.Second})
+	require.Equal(t, 10*time.Second, (&Timeouts{MemberCheck: &custom}).GetMemberCheck(time.Second))
+}
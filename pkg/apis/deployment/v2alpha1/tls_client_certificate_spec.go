@@ -0,0 +1,124 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"net"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/validation"
+)
+
+// TLSClientCertificateSpec define client certificate issued by the operator for applications.
+// Certificate is signed by the deployment CA, stored in the secret (tls.crt, tls.key, ca.crt) and renewed before expiration.
+type TLSClientCertificateSpec struct {
+	// SecretName define name of the secret in which certificate is stored
+	SecretName string `json:"secretName"`
+	// CommonName of the certificate, defaults to the secret name
+	CommonName *string `json:"commonName,omitempty"`
+	// AltNames define DNS names, IP addresses or email addresses added to the certificate
+	AltNames []string `json:"altNames,omitempty"`
+	// TTL of the certificate, defaults to spec.tls.ttl
+	TTL *Duration `json:"ttl,omitempty"`
+}
+
+// GetCommonName returns the common name of the certificate
+func (c TLSClientCertificateSpec) GetCommonName() string {
+	if c.CommonName == nil {
+		return c.SecretName
+	}
+
+	return *c.CommonName
+}
+
+// GetTTL returns the TTL of the certificate
+func (c TLSClientCertificateSpec) GetTTL(defaultTTL Duration) Duration {
+	return DurationOrDefault(c.TTL, defaultTTL)
+}
+
+// GetParsedAltNames splits the list of AltNames into DNS names, IP addresses & email addresses.
+func (c TLSClientCertificateSpec) GetParsedAltNames() (hosts, emailAddresses []string, err error) {
+	for _, name := range c.AltNames {
+		if net.ParseIP(name) != nil || validation.IsValidDNSName(name) {
+			hosts = append(hosts, name)
+		} else if validation.IsValidEmailAddress(name) {
+			emailAddresses = append(emailAddresses, name)
+		} else {
+			return nil, nil, errors.WithStack(errors.Newf("'%s' is not a valid alternate name", name))
+		}
+	}
+
+	return hosts, emailAddresses, nil
+}
+
+// Validate the given spec
+func (c TLSClientCertificateSpec) Validate(tls TLSSpec) error {
+	if err := k8sutil.ValidateResourceName(c.SecretName); err != nil {
+		return errors.WithStack(errors.Wrap(err, "secretName"))
+	}
+
+	if c.SecretName == tls.GetCASecretName() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "secretName: %s is used by the CA", c.SecretName))
+	}
+
+	if c.GetCommonName() == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "commonName: needs to be set"))
+	}
+
+	if _, _, err := c.GetParsedAltNames(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "altNames"))
+	}
+
+	if c.TTL != nil {
+		if err := c.TTL.Validate(); err != nil {
+			return errors.WithStack(errors.Wrap(err, "ttl"))
+		}
+	}
+
+	if ttl := c.GetTTL(tls.GetTTL()).AsDuration(); ttl <= tls.GetRenewalMargin() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "ttl %s needs to be greater than renewalMargin %s", c.GetTTL(tls.GetTTL()), tls.GetRenewalMargin()))
+	}
+
+	return nil
+}
+
+// TLSClientCertificatesSpec define list of client certificates issued by the operator
+type TLSClientCertificatesSpec []TLSClientCertificateSpec
+
+// Validate the given spec
+func (c TLSClientCertificatesSpec) Validate(tls TLSSpec) error {
+	names := map[string]bool{}
+
+	for id, cert := range c {
+		if err := cert.Validate(tls); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "%d", id))
+		}
+
+		if names[cert.SecretName] {
+			return errors.WithStack(errors.Wrapf(ValidationError, "%d.secretName: %s is not unique", id, cert.SecretName))
+		}
+
+		names[cert.SecretName] = true
+	}
+
+	return nil
+}
@@ -22,6 +22,8 @@ package v2alpha1
 
 import (
 	"net"
+	"strings"
+	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 
@@ -49,8 +51,41 @@ const (
 	TLSRotateModeRecreate TLSRotateMode = "recreate"
 )
 
+// TLSVersion defines the minimal TLS protocol version accepted by the servers
+type TLSVersion string
+
+const (
+	// TLSVersion12 accepts TLS 1.2 connections
+	TLSVersion12 TLSVersion = "1.2"
+	// TLSVersion13 accepts TLS 1.3 connections only
+	TLSVersion13 TLSVersion = "1.3"
+)
+
+func (t *TLSVersion) Get() TLSVersion {
+	if t == nil {
+		return ""
+	}
+
+	return *t
+}
+
+func (t TLSVersion) New() *TLSVersion {
+	return &t
+}
+
+// Validate the TLS version
+func (t TLSVersion) Validate() error {
+	switch t {
+	case TLSVersion12, TLSVersion13:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown TLS version: '%s'", string(t)))
+	}
+}
+
 const (
-	defaultTLSTTL = Duration("2610h") // About 3 month
+	defaultTLSTTL           = Duration("2610h") // About 3 month
+	defaultTLSRenewalMargin = Duration("168h")  // One week
 )
 
 // TLSSpec holds TLS specific configuration settings
@@ -60,8 +95,27 @@ type TLSSpec struct {
 	TTL          *Duration      `json:"ttl,omitempty"`
 	SNI          *TLSSNISpec    `json:"sni,omitempty"`
 	Mode         *TLSRotateMode `json:"mode,omitempty"`
+	// RenewalMargin define how long before expiration server and CA certificates are renewed
+	RenewalMargin *Duration `json:"renewalMargin,omitempty"`
+	// ClientCertificates define client certificates issued by the operator for applications
+	ClientCertificates TLSClientCertificatesSpec `json:"clientCertificates,omitempty"`
+	// MinVersion define the minimal TLS protocol version accepted by arangod
+	MinVersion *TLSVersion `json:"minVersion,omitempty"`
+	// Ciphers define the list of OpenSSL cipher names accepted by arangod
+	Ciphers []string `json:"ciphers,omitempty"`
 }
 
+const (
+	// TLSAltNameTemplateDeploymentName is replaced in AltNames with the name of the deployment
+	TLSAltNameTemplateDeploymentName = "${DEPLOYMENT_NAME}"
+	// TLSAltNameTemplateDeploymentNamespace is replaced in AltNames with the namespace of the deployment
+	TLSAltNameTemplateDeploymentNamespace = "${DEPLOYMENT_NAMESPACE}"
+	// TLSAltNameTemplateMemberRole is replaced in AltNames with the role of the member
+	TLSAltNameTemplateMemberRole = "${MEMBER_ROLE}"
+	// TLSAltNameTemplateMemberID is replaced in AltNames with the ID of the member
+	TLSAltNameTemplateMemberID = "${MEMBER_ID}"
+)
+
 const (
 	// CASecretNameDisabled is the value of CASecretName to use for disabling authentication.
 	CASecretNameDisabled = "None"
@@ -82,6 +136,16 @@ func (s TLSSpec) GetTTL() Duration {
 	return DurationOrDefault(s.TTL)
 }
 
+// GetRenewalMargin returns the period before expiration in which server and CA certificates are renewed.
+func (s TLSSpec) GetRenewalMargin() time.Duration {
+	return DurationOrDefault(s.RenewalMargin, defaultTLSRenewalMargin).AsDuration()
+}
+
+// GetCiphers returns the OpenSSL cipher list accepted by arangod, empty when not set.
+func (s TLSSpec) GetCiphers() string {
+	return strings.Join(s.Ciphers, ":")
+}
+
 func (a TLSSpec) GetSNI() TLSSNISpec {
 	if a.SNI == nil {
 		return TLSSNISpec{}
@@ -96,9 +160,25 @@ func (s TLSSpec) IsSecure() bool {
 }
 
 // GetParsedAltNames splits the list of AltNames into DNS names, IP addresses & email addresses.
+// Templated names are rendered with placeholder values.
 // When an entry is not valid for any of those categories, an error is returned.
 func (s TLSSpec) GetParsedAltNames() (dnsNames, ipAddresses, emailAddresses []string, err error) {
+	return s.GetParsedMemberAltNames("deployment", "namespace", "role", "id")
+}
+
+// GetParsedMemberAltNames renders templated AltNames for the given member and splits them into
+// DNS names, IP addresses & email addresses.
+// When an entry is not valid for any of those categories, an error is returned.
+func (s TLSSpec) GetParsedMemberAltNames(deploymentName, namespace, role, id string) (dnsNames, ipAddresses, emailAddresses []string, err error) {
+	r := strings.NewReplacer(
+		TLSAltNameTemplateDeploymentName, deploymentName,
+		TLSAltNameTemplateDeploymentNamespace, namespace,
+		TLSAltNameTemplateMemberRole, role,
+		TLSAltNameTemplateMemberID, id,
+	)
+
 	for _, name := range s.GetAltNames() {
+		name = r.Replace(name)
 		if net.ParseIP(name) != nil {
 			ipAddresses = append(ipAddresses, name)
 		} else if validation.IsValidDNSName(name) {
@@ -124,6 +204,27 @@ func (s TLSSpec) Validate() error {
 		if err := s.GetTTL().Validate(); err != nil {
 			return errors.WithStack(err)
 		}
+		if m := s.RenewalMargin; m != nil {
+			if err := m.Validate(); err != nil {
+				return errors.WithStack(errors.Wrap(err, "renewalMargin"))
+			}
+			if ttl := s.GetTTL().AsDuration(); ttl > 0 && s.GetRenewalMargin() >= ttl {
+				return errors.WithStack(errors.Wrapf(ValidationError, "renewalMargin %s needs to be lower than ttl %s", *m, s.GetTTL()))
+			}
+		}
+		if err := s.ClientCertificates.Validate(s); err != nil {
+			return errors.WithStack(errors.Wrap(err, "clientCertificates"))
+		}
+		if v := s.MinVersion; v != nil {
+			if err := v.Validate(); err != nil {
+				return errors.WithStack(errors.Wrap(err, "minVersion"))
+			}
+		}
+		for _, c := range s.Ciphers {
+			if c == "" || strings.ContainsAny(c, ": \t") {
+				return errors.WithStack(errors.Wrapf(ValidationError, "ciphers: '%s' is not a valid cipher name", c))
+			}
+		}
 	}
 	return nil
 }
@@ -156,4 +257,16 @@ func (s *TLSSpec) SetDefaultsFrom(source TLSSpec) {
 	if s.SNI == nil {
 		s.SNI = source.SNI.DeepCopy()
 	}
+	if s.RenewalMargin == nil {
+		s.RenewalMargin = NewDurationOrNil(source.RenewalMargin)
+	}
+	if s.ClientCertificates == nil {
+		s.ClientCertificates = source.ClientCertificates
+	}
+	if s.MinVersion == nil && source.MinVersion != nil {
+		s.MinVersion = source.MinVersion.Get().New()
+	}
+	if s.Ciphers == nil {
+		s.Ciphers = source.Ciphers
+	}
 }
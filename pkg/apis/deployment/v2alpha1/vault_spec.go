@@ -0,0 +1,269 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+type VaultMode string
+
+func (v *VaultMode) Get() VaultMode {
+	if v == nil {
+		return VaultModeAgent
+	}
+
+	return *v
+}
+
+func (v VaultMode) New() *VaultMode {
+	return &v
+}
+
+// Validate the given mode
+func (v *VaultMode) Validate() error {
+	switch m := v.Get(); m {
+	case VaultModeAgent, VaultModeCSI:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown vault mode: '%s'", string(m)))
+	}
+}
+
+const (
+	// VaultModeAgent renders secret material into pods with the Vault Agent injector
+	VaultModeAgent VaultMode = "agent"
+	// VaultModeCSI mounts secret material into pods with the Secrets Store CSI driver and Vault provider
+	VaultModeCSI VaultMode = "csi"
+
+	// VaultDefaultAuthPath is the default mount path of the Kubernetes auth method
+	VaultDefaultAuthPath = "kubernetes"
+)
+
+// VaultSpec holds the configuration of secret material sourced from HashiCorp Vault.
+// Material sourced from Vault is delivered directly to the pods, operator holds only references and
+// does not create nor rotate the corresponding Kubernetes Secrets.
+type VaultSpec struct {
+	// Mode define how secret material is delivered to the pods, "agent" (default) or "csi"
+	Mode *VaultMode `json:"mode,omitempty"`
+	// Role define the Vault role used by the Vault Agent injector and by the operator
+	Role *string `json:"role,omitempty"`
+	// Address define the address of the Vault server used by the operator, required when JWT or TLS is sourced from Vault
+	Address *string `json:"address,omitempty"`
+	// AuthPath define the mount path of the Kubernetes auth method used by the operator, defaults to "kubernetes"
+	AuthPath *string `json:"authPath,omitempty"`
+	// JWT define source of the JWT secret (token).
+	// Operator reads the token from Vault (path and key are required in all modes) to authenticate to the deployment,
+	// the secret referenced by spec.auth.jwtSecretName is not used.
+	JWT *VaultSecretSpec `json:"jwt,omitempty"`
+	// TLS define source of the CA bundle (PEM encoded certificate and private key) read by the operator (path and key are required).
+	// CA is stored in the secret referenced by spec.tls.caSecretName and used to sign server keyfiles instead of the generated CA.
+	TLS *VaultSecretSpec `json:"tls,omitempty"`
+	// Encryption define source of the RocksDB encryption key (key), encryption is enabled with spec.rocksdb.encryption.keySecretName.
+	// In agent mode the key is expected to be stored base64 encoded.
+	Encryption *VaultSecretSpec `json:"encryption,omitempty"`
+}
+
+// VaultSecretSpec holds reference to a single secret in Vault
+type VaultSecretSpec struct {
+	// Path define path of the secret in Vault, used in agent mode
+	Path *string `json:"path,omitempty"`
+	// Key define field of the secret rendered into the file, used in agent mode
+	Key *string `json:"key,omitempty"`
+	// Template overrides the Vault Agent template used to render the secret, used in agent mode
+	Template *string `json:"template,omitempty"`
+	// SecretProviderClass define name of the SecretProviderClass mounted into the pods, used in csi mode.
+	// Object needs to be exposed in the file with the same name as the key of the corresponding Kubernetes Secret.
+	SecretProviderClass *string `json:"secretProviderClass,omitempty"`
+}
+
+// GetMode returns the mode of the Vault integration
+func (v *VaultSpec) GetMode() VaultMode {
+	if v == nil {
+		return VaultModeAgent
+	}
+
+	return v.Mode.Get()
+}
+
+// GetRole returns the Vault role
+func (v *VaultSpec) GetRole() string {
+	if v == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(v.Role)
+}
+
+// GetAddress returns the address of the Vault server
+func (v *VaultSpec) GetAddress() string {
+	if v == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(v.Address)
+}
+
+// GetAuthPath returns the mount path of the Kubernetes auth method
+func (v *VaultSpec) GetAuthPath() string {
+	if v == nil {
+		return VaultDefaultAuthPath
+	}
+
+	return util.StringOrDefault(v.AuthPath, VaultDefaultAuthPath)
+}
+
+// IsJWTSourced returns true when JWT secret is sourced from Vault
+func (v *VaultSpec) IsJWTSourced() bool {
+	return v != nil && v.JWT != nil
+}
+
+// IsTLSSourced returns true when TLS CA is sourced from Vault
+func (v *VaultSpec) IsTLSSourced() bool {
+	return v != nil && v.TLS != nil
+}
+
+// IsEncryptionSourced returns true when encryption key is sourced from Vault
+func (v *VaultSpec) IsEncryptionSourced() bool {
+	return v != nil && v.Encryption != nil
+}
+
+// Validate the given spec
+func (v *VaultSpec) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	if err := v.Mode.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "mode"))
+	}
+
+	if v.GetMode() == VaultModeAgent && (v.IsJWTSourced() || v.IsTLSSourced() || v.IsEncryptionSourced()) {
+		if v.GetRole() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "role: needs to be set in %s mode", VaultModeAgent))
+		}
+	}
+
+	if v.IsJWTSourced() || v.IsTLSSourced() {
+		// Operator reads the secrets from Vault
+		if v.GetRole() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "role: needs to be set when jwt or tls is sourced from Vault"))
+		}
+		if v.GetAddress() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "address: needs to be set when jwt or tls is sourced from Vault"))
+		}
+	}
+
+	if err := v.JWT.Validate(v.GetMode()); err != nil {
+		return errors.WithStack(errors.Wrap(err, "jwt"))
+	}
+
+	if err := v.JWT.validateOperatorSource(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "jwt"))
+	}
+
+	if err := v.TLS.validateOperatorSource(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "tls"))
+	}
+
+	if err := v.Encryption.Validate(v.GetMode()); err != nil {
+		return errors.WithStack(errors.Wrap(err, "encryption"))
+	}
+
+	return nil
+}
+
+// GetPath returns the path of the secret in Vault
+func (v *VaultSecretSpec) GetPath() string {
+	if v == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(v.Path)
+}
+
+// GetKey returns the field of the secret rendered into the file
+func (v *VaultSecretSpec) GetKey() string {
+	if v == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(v.Key)
+}
+
+// GetTemplate returns the custom Vault Agent template
+func (v *VaultSecretSpec) GetTemplate() string {
+	if v == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(v.Template)
+}
+
+// GetSecretProviderClass returns the name of the SecretProviderClass
+func (v *VaultSecretSpec) GetSecretProviderClass() string {
+	if v == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(v.SecretProviderClass)
+}
+
+// Validate the given spec
+func (v *VaultSecretSpec) Validate(mode VaultMode) error {
+	if v == nil {
+		return nil
+	}
+
+	switch mode {
+	case VaultModeAgent:
+		if v.GetPath() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "path: needs to be set in %s mode", mode))
+		}
+		if v.GetKey() == "" && v.GetTemplate() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "key: needs to be set in %s mode when template is not provided", mode))
+		}
+	case VaultModeCSI:
+		if err := k8sutil.ValidateResourceName(v.GetSecretProviderClass()); err != nil {
+			return errors.WithStack(errors.Wrap(err, "secretProviderClass"))
+		}
+	}
+
+	return nil
+}
+
+// validateOperatorSource validates the spec of the secret read by the operator
+func (v *VaultSecretSpec) validateOperatorSource() error {
+	if v == nil {
+		return nil
+	}
+
+	if v.GetPath() == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "path: needs to be set"))
+	}
+	if v.GetKey() == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "key: needs to be set"))
+	}
+
+	return nil
+}
@@ -28,12 +28,30 @@ package v2alpha1
 import (
 	time "time"
 
+	godriver "github.com/arangodb/go-driver"
 	sharedv1 "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPackageHash) DeepCopyInto(out *AccessPackageHash) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPackageHash.
+func (in *AccessPackageHash) DeepCopy() *AccessPackageHash {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPackageHash)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Action) DeepCopyInto(out *Action) {
 	*out = *in
@@ -49,6 +67,15 @@ func (in *Action) DeepCopyInto(out *Action) {
 			(*out)[key] = val
 		}
 	}
+	if in.HandoverTime != nil {
+		in, out := &in.HandoverTime, &out.HandoverTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PausedDuration != nil {
+		in, out := &in.PausedDuration, &out.PausedDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -227,7 +254,7 @@ func (in *ArangoClusterSynchronizationStatus) DeepCopy() *ArangoClusterSynchroni
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoDeployment) DeepCopyInto(out *ArangoDeployment) {
+func (in *ArangoCollection) DeepCopyInto(out *ArangoCollection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -236,18 +263,18 @@ func (in *ArangoDeployment) DeepCopyInto(out *ArangoDeployment) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeployment.
-func (in *ArangoDeployment) DeepCopy() *ArangoDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoCollection.
+func (in *ArangoCollection) DeepCopy() *ArangoCollection {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoDeployment)
+	out := new(ArangoCollection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ArangoDeployment) DeepCopyObject() runtime.Object {
+func (in *ArangoCollection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -255,33 +282,54 @@ func (in *ArangoDeployment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ArangoDeploymentArchitecture) DeepCopyInto(out *ArangoDeploymentArchitecture) {
-	{
-		in := &in
-		*out = make(ArangoDeploymentArchitecture, len(*in))
+func (in *ArangoCollectionIndex) DeepCopyInto(out *ArangoCollectionIndex) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
 		copy(*out, *in)
-		return
 	}
+	if in.Unique != nil {
+		in, out := &in.Unique, &out.Unique
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sparse != nil {
+		in, out := &in.Sparse, &out.Sparse
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExpireAfter != nil {
+		in, out := &in.ExpireAfter, &out.ExpireAfter
+		*out = new(int)
+		**out = **in
+	}
+	if in.GeoJSON != nil {
+		in, out := &in.GeoJSON, &out.GeoJSON
+		*out = new(bool)
+		**out = **in
+	}
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentArchitecture.
-func (in ArangoDeploymentArchitecture) DeepCopy() ArangoDeploymentArchitecture {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoCollectionIndex.
+func (in *ArangoCollectionIndex) DeepCopy() *ArangoCollectionIndex {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoDeploymentArchitecture)
+	out := new(ArangoCollectionIndex)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoDeploymentList) DeepCopyInto(out *ArangoDeploymentList) {
+func (in *ArangoCollectionList) DeepCopyInto(out *ArangoCollectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ArangoDeployment, len(*in))
+		*out = make([]ArangoCollection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -289,18 +337,18 @@ func (in *ArangoDeploymentList) DeepCopyInto(out *ArangoDeploymentList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentList.
-func (in *ArangoDeploymentList) DeepCopy() *ArangoDeploymentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoCollectionList.
+func (in *ArangoCollectionList) DeepCopy() *ArangoCollectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoDeploymentList)
+	out := new(ArangoCollectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ArangoDeploymentList) DeepCopyObject() runtime.Object {
+func (in *ArangoCollectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -308,131 +356,78 @@ func (in *ArangoDeploymentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoDeploymentRebalancerOptimizersSpec) DeepCopyInto(out *ArangoDeploymentRebalancerOptimizersSpec) {
+func (in *ArangoCollectionSpec) DeepCopyInto(out *ArangoCollectionSpec) {
 	*out = *in
-	if in.Leader != nil {
-		in, out := &in.Leader, &out.Leader
-		*out = new(bool)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
 		**out = **in
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerOptimizersSpec.
-func (in *ArangoDeploymentRebalancerOptimizersSpec) DeepCopy() *ArangoDeploymentRebalancerOptimizersSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ArangoDeploymentRebalancerOptimizersSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoDeploymentRebalancerReadersSpec) DeepCopyInto(out *ArangoDeploymentRebalancerReadersSpec) {
-	*out = *in
-	if in.Count != nil {
-		in, out := &in.Count, &out.Count
-		*out = new(bool)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ArangoCollectionType)
 		**out = **in
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerReadersSpec.
-func (in *ArangoDeploymentRebalancerReadersSpec) DeepCopy() *ArangoDeploymentRebalancerReadersSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ArangoDeploymentRebalancerReadersSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoDeploymentRebalancerSpec) DeepCopyInto(out *ArangoDeploymentRebalancerSpec) {
-	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.NumberOfShards != nil {
+		in, out := &in.NumberOfShards, &out.NumberOfShards
+		*out = new(int)
 		**out = **in
 	}
-	if in.ParallelMoves != nil {
-		in, out := &in.ParallelMoves, &out.ParallelMoves
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
 		*out = new(int)
 		**out = **in
 	}
-	if in.Readers != nil {
-		in, out := &in.Readers, &out.Readers
-		*out = new(ArangoDeploymentRebalancerReadersSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Optimizers != nil {
-		in, out := &in.Optimizers, &out.Optimizers
-		*out = new(ArangoDeploymentRebalancerOptimizersSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Indexes != nil {
+		in, out := &in.Indexes, &out.Indexes
+		*out = make([]ArangoCollectionIndex, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerSpec.
-func (in *ArangoDeploymentRebalancerSpec) DeepCopy() *ArangoDeploymentRebalancerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoCollectionSpec.
+func (in *ArangoCollectionSpec) DeepCopy() *ArangoCollectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoDeploymentRebalancerSpec)
+	out := new(ArangoCollectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoDeploymentRebalancerStatus) DeepCopyInto(out *ArangoDeploymentRebalancerStatus) {
+func (in *ArangoCollectionStatus) DeepCopyInto(out *ArangoCollectionStatus) {
 	*out = *in
-	if in.LastCheckTime != nil {
-		in, out := &in.LastCheckTime, &out.LastCheckTime
-		*out = (*in).DeepCopy()
-	}
-	if in.MoveJobs != nil {
-		in, out := &in.MoveJobs, &out.MoveJobs
+	if in.Indexes != nil {
+		in, out := &in.Indexes, &out.Indexes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerStatus.
-func (in *ArangoDeploymentRebalancerStatus) DeepCopy() *ArangoDeploymentRebalancerStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(ArangoDeploymentRebalancerStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoDeploymentRecoverySpec) DeepCopyInto(out *ArangoDeploymentRecoverySpec) {
-	*out = *in
-	if in.AutoRecover != nil {
-		in, out := &in.AutoRecover, &out.AutoRecover
-		*out = new(bool)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(ConditionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRecoverySpec.
-func (in *ArangoDeploymentRecoverySpec) DeepCopy() *ArangoDeploymentRecoverySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoCollectionStatus.
+func (in *ArangoCollectionStatus) DeepCopy() *ArangoCollectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoDeploymentRecoverySpec)
+	out := new(ArangoCollectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoMember) DeepCopyInto(out *ArangoMember) {
+func (in *ArangoDatabase) DeepCopyInto(out *ArangoDatabase) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -441,18 +436,18 @@ func (in *ArangoMember) DeepCopyInto(out *ArangoMember) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMember.
-func (in *ArangoMember) DeepCopy() *ArangoMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDatabase.
+func (in *ArangoDatabase) DeepCopy() *ArangoDatabase {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoMember)
+	out := new(ArangoDatabase)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ArangoMember) DeepCopyObject() runtime.Object {
+func (in *ArangoDatabase) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -460,13 +455,13 @@ func (in *ArangoMember) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoMemberList) DeepCopyInto(out *ArangoMemberList) {
+func (in *ArangoDatabaseList) DeepCopyInto(out *ArangoDatabaseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ArangoMember, len(*in))
+		*out = make([]ArangoDatabase, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -474,18 +469,18 @@ func (in *ArangoMemberList) DeepCopyInto(out *ArangoMemberList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberList.
-func (in *ArangoMemberList) DeepCopy() *ArangoMemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDatabaseList.
+func (in *ArangoDatabaseList) DeepCopy() *ArangoDatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoMemberList)
+	out := new(ArangoDatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ArangoMemberList) DeepCopyObject() runtime.Object {
+func (in *ArangoDatabaseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -493,54 +488,69 @@ func (in *ArangoMemberList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoMemberPodTemplate) DeepCopyInto(out *ArangoMemberPodTemplate) {
+func (in *ArangoDatabaseOptions) DeepCopyInto(out *ArangoDatabaseOptions) {
 	*out = *in
-	if in.PodSpec != nil {
-		in, out := &in.PodSpec, &out.PodSpec
-		*out = new(v1.PodTemplateSpec)
-		(*in).DeepCopyInto(*out)
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int)
+		**out = **in
 	}
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
-		*out = new(string)
+	if in.WriteConcern != nil {
+		in, out := &in.WriteConcern, &out.WriteConcern
+		*out = new(int)
+		**out = **in
+	}
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		*out = new(godriver.DatabaseSharding)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberPodTemplate.
-func (in *ArangoMemberPodTemplate) DeepCopy() *ArangoMemberPodTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDatabaseOptions.
+func (in *ArangoDatabaseOptions) DeepCopy() *ArangoDatabaseOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoMemberPodTemplate)
+	out := new(ArangoDatabaseOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoMemberSpec) DeepCopyInto(out *ArangoMemberSpec) {
+func (in *ArangoDatabaseSpec) DeepCopyInto(out *ArangoDatabaseSpec) {
 	*out = *in
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(ArangoMemberPodTemplate)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(ArangoDatabaseOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(ArangoDatabaseDeletionPolicy)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberSpec.
-func (in *ArangoMemberSpec) DeepCopy() *ArangoMemberSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDatabaseSpec.
+func (in *ArangoDatabaseSpec) DeepCopy() *ArangoDatabaseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoMemberSpec)
+	out := new(ArangoDatabaseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoMemberStatus) DeepCopyInto(out *ArangoMemberStatus) {
+func (in *ArangoDatabaseStatus) DeepCopyInto(out *ArangoDatabaseStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -549,26 +559,21 @@ func (in *ArangoMemberStatus) DeepCopyInto(out *ArangoMemberStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(ArangoMemberPodTemplate)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberStatus.
-func (in *ArangoMemberStatus) DeepCopy() *ArangoMemberStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDatabaseStatus.
+func (in *ArangoDatabaseStatus) DeepCopy() *ArangoDatabaseStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoMemberStatus)
+	out := new(ArangoDatabaseStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoTask) DeepCopyInto(out *ArangoTask) {
+func (in *ArangoDeployment) DeepCopyInto(out *ArangoDeployment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -577,18 +582,18 @@ func (in *ArangoTask) DeepCopyInto(out *ArangoTask) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTask.
-func (in *ArangoTask) DeepCopy() *ArangoTask {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeployment.
+func (in *ArangoDeployment) DeepCopy() *ArangoDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoTask)
+	out := new(ArangoDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ArangoTask) DeepCopyObject() runtime.Object {
+func (in *ArangoDeployment) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -596,33 +601,33 @@ func (in *ArangoTask) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ArangoTaskDetails) DeepCopyInto(out *ArangoTaskDetails) {
+func (in ArangoDeploymentArchitecture) DeepCopyInto(out *ArangoDeploymentArchitecture) {
 	{
 		in := &in
-		*out = make(ArangoTaskDetails, len(*in))
+		*out = make(ArangoDeploymentArchitecture, len(*in))
 		copy(*out, *in)
 		return
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskDetails.
-func (in ArangoTaskDetails) DeepCopy() ArangoTaskDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentArchitecture.
+func (in ArangoDeploymentArchitecture) DeepCopy() ArangoDeploymentArchitecture {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoTaskDetails)
+	out := new(ArangoDeploymentArchitecture)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoTaskList) DeepCopyInto(out *ArangoTaskList) {
+func (in *ArangoDeploymentList) DeepCopyInto(out *ArangoDeploymentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ArangoTask, len(*in))
+		*out = make([]ArangoDeployment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -630,18 +635,18 @@ func (in *ArangoTaskList) DeepCopyInto(out *ArangoTaskList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskList.
-func (in *ArangoTaskList) DeepCopy() *ArangoTaskList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentList.
+func (in *ArangoDeploymentList) DeepCopy() *ArangoDeploymentList {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoTaskList)
+	out := new(ArangoDeploymentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ArangoTaskList) DeepCopyObject() runtime.Object {
+func (in *ArangoDeploymentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -649,34 +654,595 @@ func (in *ArangoTaskList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoTaskSpec) DeepCopyInto(out *ArangoTaskSpec) {
+func (in *ArangoDeploymentRebalancerOptimizersSpec) DeepCopyInto(out *ArangoDeploymentRebalancerOptimizersSpec) {
 	*out = *in
-	if in.Details != nil {
-		in, out := &in.Details, &out.Details
-		*out = make(ArangoTaskDetails, len(*in))
-		copy(*out, *in)
+	if in.Leader != nil {
+		in, out := &in.Leader, &out.Leader
+		*out = new(bool)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskSpec.
-func (in *ArangoTaskSpec) DeepCopy() *ArangoTaskSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerOptimizersSpec.
+func (in *ArangoDeploymentRebalancerOptimizersSpec) DeepCopy() *ArangoDeploymentRebalancerOptimizersSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoTaskSpec)
+	out := new(ArangoDeploymentRebalancerOptimizersSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ArangoTaskStatus) DeepCopyInto(out *ArangoTaskStatus) {
+func (in *ArangoDeploymentRebalancerReadersSpec) DeepCopyInto(out *ArangoDeploymentRebalancerReadersSpec) {
 	*out = *in
-	if in.AcceptedSpec != nil {
-		in, out := &in.AcceptedSpec, &out.AcceptedSpec
-		*out = new(ArangoTaskSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(bool)
+		**out = **in
 	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerReadersSpec.
+func (in *ArangoDeploymentRebalancerReadersSpec) DeepCopy() *ArangoDeploymentRebalancerReadersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRebalancerReadersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRebalancerSpec) DeepCopyInto(out *ArangoDeploymentRebalancerSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ParallelMoves != nil {
+		in, out := &in.ParallelMoves, &out.ParallelMoves
+		*out = new(int)
+		**out = **in
+	}
+	if in.Readers != nil {
+		in, out := &in.Readers, &out.Readers
+		*out = new(ArangoDeploymentRebalancerReadersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Optimizers != nil {
+		in, out := &in.Optimizers, &out.Optimizers
+		*out = new(ArangoDeploymentRebalancerOptimizersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerSpec.
+func (in *ArangoDeploymentRebalancerSpec) DeepCopy() *ArangoDeploymentRebalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRebalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRebalancerStatus) DeepCopyInto(out *ArangoDeploymentRebalancerStatus) {
+	*out = *in
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MoveJobs != nil {
+		in, out := &in.MoveJobs, &out.MoveJobs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRebalancerStatus.
+func (in *ArangoDeploymentRebalancerStatus) DeepCopy() *ArangoDeploymentRebalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRebalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoDeploymentRecoverySpec) DeepCopyInto(out *ArangoDeploymentRecoverySpec) {
+	*out = *in
+	if in.AutoRecover != nil {
+		in, out := &in.AutoRecover, &out.AutoRecover
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LocalStoragePolicy != nil {
+		in, out := &in.LocalStoragePolicy, &out.LocalStoragePolicy
+		*out = new(LocalStoragePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoDeploymentRecoverySpec.
+func (in *ArangoDeploymentRecoverySpec) DeepCopy() *ArangoDeploymentRecoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoDeploymentRecoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoFoxxService) DeepCopyInto(out *ArangoFoxxService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoFoxxService.
+func (in *ArangoFoxxService) DeepCopy() *ArangoFoxxService {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoFoxxService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoFoxxService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoFoxxServiceConfigMapSource) DeepCopyInto(out *ArangoFoxxServiceConfigMapSource) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoFoxxServiceConfigMapSource.
+func (in *ArangoFoxxServiceConfigMapSource) DeepCopy() *ArangoFoxxServiceConfigMapSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoFoxxServiceConfigMapSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoFoxxServiceList) DeepCopyInto(out *ArangoFoxxServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArangoFoxxService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoFoxxServiceList.
+func (in *ArangoFoxxServiceList) DeepCopy() *ArangoFoxxServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoFoxxServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoFoxxServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoFoxxServiceSource) DeepCopyInto(out *ArangoFoxxServiceSource) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ArangoFoxxServiceConfigMapSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoFoxxServiceSource.
+func (in *ArangoFoxxServiceSource) DeepCopy() *ArangoFoxxServiceSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoFoxxServiceSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoFoxxServiceSpec) DeepCopyInto(out *ArangoFoxxServiceSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Setup != nil {
+		in, out := &in.Setup, &out.Setup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Teardown != nil {
+		in, out := &in.Teardown, &out.Teardown
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoFoxxServiceSpec.
+func (in *ArangoFoxxServiceSpec) DeepCopy() *ArangoFoxxServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoFoxxServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoFoxxServiceStatus) DeepCopyInto(out *ArangoFoxxServiceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(ConditionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoFoxxServiceStatus.
+func (in *ArangoFoxxServiceStatus) DeepCopy() *ArangoFoxxServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoFoxxServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMember) DeepCopyInto(out *ArangoMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMember.
+func (in *ArangoMember) DeepCopy() *ArangoMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberList) DeepCopyInto(out *ArangoMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArangoMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberList.
+func (in *ArangoMemberList) DeepCopy() *ArangoMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberPodTemplate) DeepCopyInto(out *ArangoMemberPodTemplate) {
+	*out = *in
+	if in.PodSpec != nil {
+		in, out := &in.PodSpec, &out.PodSpec
+		*out = new(corev1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberPodTemplate.
+func (in *ArangoMemberPodTemplate) DeepCopy() *ArangoMemberPodTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberPodTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoMemberPodTemplatePatch) DeepCopyInto(out *ArangoMemberPodTemplatePatch) {
+	{
+		in := &in
+		*out = make(ArangoMemberPodTemplatePatch, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberPodTemplatePatch.
+func (in ArangoMemberPodTemplatePatch) DeepCopy() ArangoMemberPodTemplatePatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberPodTemplatePatch)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberSpec) DeepCopyInto(out *ArangoMemberSpec) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(ArangoMemberPodTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ArangoMemberSpecOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberSpec.
+func (in *ArangoMemberSpec) DeepCopy() *ArangoMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberSpecOverrides) DeepCopyInto(out *ArangoMemberSpecOverrides) {
+	*out = *in
+	if in.EntrypointDebug != nil {
+		in, out := &in.EntrypointDebug, &out.EntrypointDebug
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PodTemplatePatch != nil {
+		in, out := &in.PodTemplatePatch, &out.PodTemplatePatch
+		*out = make(ArangoMemberPodTemplatePatch, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberSpecOverrides.
+func (in *ArangoMemberSpecOverrides) DeepCopy() *ArangoMemberSpecOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberSpecOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberStatus) DeepCopyInto(out *ArangoMemberStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(ConditionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(ArangoMemberPodTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberStatus.
+func (in *ArangoMemberStatus) DeepCopy() *ArangoMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoTask) DeepCopyInto(out *ArangoTask) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTask.
+func (in *ArangoTask) DeepCopy() *ArangoTask {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoTask) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoTaskDetails) DeepCopyInto(out *ArangoTaskDetails) {
+	{
+		in := &in
+		*out = make(ArangoTaskDetails, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskDetails.
+func (in ArangoTaskDetails) DeepCopy() ArangoTaskDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTaskDetails)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoTaskDumpDetails) DeepCopyInto(out *ArangoTaskDumpDetails) {
+	*out = *in
+	if in.Collections != nil {
+		in, out := &in.Collections, &out.Collections
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Volume.DeepCopyInto(&out.Volume)
+	if in.Threads != nil {
+		in, out := &in.Threads, &out.Threads
+		*out = new(int)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskDumpDetails.
+func (in *ArangoTaskDumpDetails) DeepCopy() *ArangoTaskDumpDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTaskDumpDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoTaskList) DeepCopyInto(out *ArangoTaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArangoTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskList.
+func (in *ArangoTaskList) DeepCopy() *ArangoTaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoTaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoTaskSpec) DeepCopyInto(out *ArangoTaskSpec) {
+	*out = *in
 	if in.Details != nil {
 		in, out := &in.Details, &out.Details
 		*out = make(ArangoTaskDetails, len(*in))
@@ -685,12 +1251,243 @@ func (in *ArangoTaskStatus) DeepCopyInto(out *ArangoTaskStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskStatus.
-func (in *ArangoTaskStatus) DeepCopy() *ArangoTaskStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskSpec.
+func (in *ArangoTaskSpec) DeepCopy() *ArangoTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoTaskStatus) DeepCopyInto(out *ArangoTaskStatus) {
+	*out = *in
+	if in.AcceptedSpec != nil {
+		in, out := &in.AcceptedSpec, &out.AcceptedSpec
+		*out = new(ArangoTaskSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make(ArangoTaskDetails, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskStatus.
+func (in *ArangoTaskStatus) DeepCopy() *ArangoTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoUser) DeepCopyInto(out *ArangoUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoUser.
+func (in *ArangoUser) DeepCopy() *ArangoUser {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoUserGrant) DeepCopyInto(out *ArangoUserGrant) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoUserGrant.
+func (in *ArangoUserGrant) DeepCopy() *ArangoUserGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoUserGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoUserList) DeepCopyInto(out *ArangoUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArangoUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoUserList.
+func (in *ArangoUserList) DeepCopy() *ArangoUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoUserSpec) DeepCopyInto(out *ArangoUserSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordSecretName != nil {
+		in, out := &in.PasswordSecretName, &out.PasswordSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]ArangoUserGrant, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoUserSpec.
+func (in *ArangoUserSpec) DeepCopy() *ArangoUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ArangoTaskStatus)
+	out := new(ArangoUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoUserStatus) DeepCopyInto(out *ArangoUserStatus) {
+	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]ArangoUserGrant, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(ConditionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoUserStatus.
+func (in *ArangoUserStatus) DeepCopy() *ArangoUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogSpec) DeepCopyInto(out *AuditLogSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(AuditLogOutput)
+		**out = **in
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sidecar != nil {
+		in, out := &in.Sidecar, &out.Sidecar
+		*out = new(corev1.Container)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogSpec.
+func (in *AuditLogSpec) DeepCopy() *AuditLogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationRotationSpec) DeepCopyInto(out *AuthenticationRotationSpec) {
+	*out = *in
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationRotationSpec.
+func (in *AuthenticationRotationSpec) DeepCopy() *AuthenticationRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationRotationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -703,6 +1500,11 @@ func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(AuthenticationRotationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -738,6 +1540,67 @@ func (in BackOff) DeepCopy() BackOff {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapCollection) DeepCopyInto(out *BootstrapCollection) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(BootstrapCollectionType)
+		**out = **in
+	}
+	if in.NumberOfShards != nil {
+		in, out := &in.NumberOfShards, &out.NumberOfShards
+		*out = new(int)
+		**out = **in
+	}
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapCollection.
+func (in *BootstrapCollection) DeepCopy() *BootstrapCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapDatabase) DeepCopyInto(out *BootstrapDatabase) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make(map[string]BootstrapGrant, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Collections != nil {
+		in, out := &in.Collections, &out.Collections
+		*out = make([]BootstrapCollection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapDatabase.
+func (in *BootstrapDatabase) DeepCopy() *BootstrapDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BootstrapSpec) DeepCopyInto(out *BootstrapSpec) {
 	*out = *in
@@ -748,6 +1611,13 @@ func (in *BootstrapSpec) DeepCopyInto(out *BootstrapSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]BootstrapDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -822,54 +1692,153 @@ func (in ConditionList) DeepCopyInto(out *ConditionList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionList.
-func (in ConditionList) DeepCopy() ConditionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionList.
+func (in ConditionList) DeepCopy() ConditionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+	*out = *in
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
+func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentFeatures) DeepCopyInto(out *DeploymentFeatures) {
+	*out = *in
+	if in.FoxxQueues != nil {
+		in, out := &in.FoxxQueues, &out.FoxxQueues
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentFeatures.
+func (in *DeploymentFeatures) DeepCopy() *DeploymentFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentImageHintSpec) DeepCopyInto(out *DeploymentImageHintSpec) {
+	*out = *in
+	if in.Enterprise != nil {
+		in, out := &in.Enterprise, &out.Enterprise
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentImageHintSpec.
+func (in *DeploymentImageHintSpec) DeepCopy() *DeploymentImageHintSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentImageHintSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentInitFromDownloadSpec) DeepCopyInto(out *DeploymentInitFromDownloadSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentInitFromDownloadSpec.
+func (in *DeploymentInitFromDownloadSpec) DeepCopy() *DeploymentInitFromDownloadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentInitFromDownloadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentInitFromSpec) DeepCopyInto(out *DeploymentInitFromSpec) {
+	*out = *in
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(string)
+		**out = **in
+	}
+	if in.Download != nil {
+		in, out := &in.Download, &out.Download
+		*out = new(DeploymentInitFromDownloadSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentInitFromSpec.
+func (in *DeploymentInitFromSpec) DeepCopy() *DeploymentInitFromSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConditionList)
+	out := new(DeploymentInitFromSpec)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
-	*out = *in
-	if in.Maintenance != nil {
-		in, out := &in.Maintenance, &out.Maintenance
-		*out = new(bool)
-		**out = **in
+func (in DeploymentMaintenanceWindowList) DeepCopyInto(out *DeploymentMaintenanceWindowList) {
+	{
+		in := &in
+		*out = make(DeploymentMaintenanceWindowList, len(*in))
+		copy(*out, *in)
+		return
 	}
-	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
-func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentMaintenanceWindowList.
+func (in DeploymentMaintenanceWindowList) DeepCopy() DeploymentMaintenanceWindowList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseSpec)
+	out := new(DeploymentMaintenanceWindowList)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentFeatures) DeepCopyInto(out *DeploymentFeatures) {
+func (in *DeploymentMaintenanceWindowSpec) DeepCopyInto(out *DeploymentMaintenanceWindowSpec) {
 	*out = *in
-	if in.FoxxQueues != nil {
-		in, out := &in.FoxxQueues, &out.FoxxQueues
-		*out = new(bool)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentFeatures.
-func (in *DeploymentFeatures) DeepCopy() *DeploymentFeatures {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentMaintenanceWindowSpec.
+func (in *DeploymentMaintenanceWindowSpec) DeepCopy() *DeploymentMaintenanceWindowSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentFeatures)
+	out := new(DeploymentMaintenanceWindowSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -915,7 +1884,7 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 	}
 	if in.ImagePullPolicy != nil {
 		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
-		*out = new(v1.PullPolicy)
+		*out = new(corev1.PullPolicy)
 		**out = **in
 	}
 	if in.ImagePullSecrets != nil {
@@ -928,6 +1897,11 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(DeploymentImageDiscoveryModeSpec)
 		**out = **in
 	}
+	if in.ImageHint != nil {
+		in, out := &in.ImageHint, &out.ImageHint
+		*out = new(DeploymentImageHintSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DowntimeAllowed != nil {
 		in, out := &in.DowntimeAllowed, &out.DowntimeAllowed
 		*out = new(bool)
@@ -941,7 +1915,12 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 	if in.Upgrade != nil {
 		in, out := &in.Upgrade, &out.Upgrade
 		*out = new(DeploymentUpgradeSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make(DeploymentMaintenanceWindowList, len(*in))
+		copy(*out, *in)
 	}
 	if in.Features != nil {
 		in, out := &in.Features, &out.Features
@@ -997,6 +1976,11 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.InitFrom != nil {
+		in, out := &in.InitFrom, &out.InitFrom
+		*out = new(DeploymentInitFromSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AllowUnsafeUpgrade != nil {
 		in, out := &in.AllowUnsafeUpgrade, &out.AllowUnsafeUpgrade
 		*out = new(bool)
@@ -1031,6 +2015,16 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(DeploymentMemberPropagationMode)
 		**out = **in
 	}
+	if in.MemberHealthCheckMode != nil {
+		in, out := &in.MemberHealthCheckMode, &out.MemberHealthCheckMode
+		*out = new(DeploymentMemberHealthCheckMode)
+		**out = **in
+	}
+	if in.DiskUsageWarningThreshold != nil {
+		in, out := &in.DiskUsageWarningThreshold, &out.DiskUsageWarningThreshold
+		*out = new(int)
+		**out = **in
+	}
 	in.Chaos.DeepCopyInto(&out.Chaos)
 	if in.Recovery != nil {
 		in, out := &in.Recovery, &out.Recovery
@@ -1068,6 +2062,21 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = make(ArangoDeploymentArchitecture, len(*in))
 		copy(*out, *in)
 	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuditLog != nil {
+		in, out := &in.AuditLog, &out.AuditLog
+		*out = new(AuditLogSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1084,6 +2093,11 @@ func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 	*out = *in
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(DeploymentStatusOperator)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Restore != nil {
 		in, out := &in.Restore, &out.Restore
 		*out = new(DeploymentRestoreResult)
@@ -1092,12 +2106,14 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make(ImageInfoList, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.CurrentImage != nil {
 		in, out := &in.CurrentImage, &out.CurrentImage
 		*out = new(ImageInfo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	in.Members.DeepCopyInto(&out.Members)
 	if in.Conditions != nil {
@@ -1159,6 +2175,21 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.UpgradeChannel != nil {
+		in, out := &in.UpgradeChannel, &out.UpgradeChannel
+		*out = new(DeploymentStatusUpgradeChannel)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(DeploymentStatusUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(DeploymentStatusLicense)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1205,6 +2236,11 @@ func (in *DeploymentStatusAgencyInfo) DeepCopyInto(out *DeploymentStatusAgencyIn
 		*out = make(DeploymentStatusAgencyIDs, len(*in))
 		copy(*out, *in)
 	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(DeploymentStatusAgencyState)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1218,6 +2254,23 @@ func (in *DeploymentStatusAgencyInfo) DeepCopy() *DeploymentStatusAgencyInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStatusAgencyState) DeepCopyInto(out *DeploymentStatusAgencyState) {
+	*out = *in
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatusAgencyState.
+func (in *DeploymentStatusAgencyState) DeepCopy() *DeploymentStatusAgencyState {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatusAgencyState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentStatusHashes) DeepCopyInto(out *DeploymentStatusHashes) {
 	*out = *in
@@ -1305,6 +2358,26 @@ func (in *DeploymentStatusHashesTLS) DeepCopy() *DeploymentStatusHashesTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStatusLicense) DeepCopyInto(out *DeploymentStatusLicense) {
+	*out = *in
+	if in.Expires != nil {
+		in, out := &in.Expires, &out.Expires
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatusLicense.
+func (in *DeploymentStatusLicense) DeepCopy() *DeploymentStatusLicense {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatusLicense)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentStatusMemberElement) DeepCopyInto(out *DeploymentStatusMemberElement) {
 	*out = *in
@@ -1402,9 +2475,79 @@ func (in *DeploymentStatusMembers) DeepCopy() *DeploymentStatusMembers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStatusOperator) DeepCopyInto(out *DeploymentStatusOperator) {
+	*out = *in
+	in.LastReconcile.DeepCopyInto(&out.LastReconcile)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatusOperator.
+func (in *DeploymentStatusOperator) DeepCopy() *DeploymentStatusOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatusOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStatusUpgrade) DeepCopyInto(out *DeploymentStatusUpgrade) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.FinishTime != nil {
+		in, out := &in.FinishTime, &out.FinishTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatusUpgrade.
+func (in *DeploymentStatusUpgrade) DeepCopy() *DeploymentStatusUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatusUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStatusUpgradeChannel) DeepCopyInto(out *DeploymentStatusUpgradeChannel) {
+	*out = *in
+	in.LastCheck.DeepCopyInto(&out.LastCheck)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatusUpgradeChannel.
+func (in *DeploymentStatusUpgradeChannel) DeepCopy() *DeploymentStatusUpgradeChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStatusUpgradeChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentUpgradeSpec) DeepCopyInto(out *DeploymentUpgradeSpec) {
 	*out = *in
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(UpgradeChannel)
+		**out = **in
+	}
+	if in.ChannelCheckInterval != nil {
+		in, out := &in.ChannelCheckInterval, &out.ChannelCheckInterval
+		*out = new(Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1465,6 +2608,44 @@ func (in *EphemeralVolumes) DeepCopy() *EphemeralVolumes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessIngressSpec) DeepCopyInto(out *ExternalAccessIngressSpec) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLSSecretName != nil {
+		in, out := &in.TLSSecretName, &out.TLSSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessIngressSpec.
+func (in *ExternalAccessIngressSpec) DeepCopy() *ExternalAccessIngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessIngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAccessSpec) DeepCopyInto(out *ExternalAccessSpec) {
 	*out = *in
@@ -1488,11 +2669,56 @@ func (in *ExternalAccessSpec) DeepCopyInto(out *ExternalAccessSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(corev1.ServiceAffinity)
+		**out = **in
+	}
+	if in.SessionAffinityTimeoutSeconds != nil {
+		in, out := &in.SessionAffinityTimeoutSeconds, &out.SessionAffinityTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExternalTrafficPolicy != nil {
+		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
+		*out = new(corev1.ServiceExternalTrafficPolicyType)
+		**out = **in
+	}
 	if in.AdvertisedEndpoint != nil {
 		in, out := &in.AdvertisedEndpoint, &out.AdvertisedEndpoint
 		*out = new(string)
 		**out = **in
 	}
+	if in.AutoAdvertisedEndpoint != nil {
+		in, out := &in.AutoAdvertisedEndpoint, &out.AutoAdvertisedEndpoint
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AltNames != nil {
+		in, out := &in.AltNames, &out.AltNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ExternalAccessTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(ExternalAccessIngressSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1506,9 +2732,35 @@ func (in *ExternalAccessSpec) DeepCopy() *ExternalAccessSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessTLSSpec) DeepCopyInto(out *ExternalAccessTLSSpec) {
+	*out = *in
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessTLSSpec.
+func (in *ExternalAccessTLSSpec) DeepCopy() *ExternalAccessTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageInfo) DeepCopyInto(out *ImageInfo) {
 	*out = *in
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make(ArangoDeploymentArchitecture, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1527,7 +2779,9 @@ func (in ImageInfoList) DeepCopyInto(out *ImageInfoList) {
 	{
 		in := &in
 		*out = make(ImageInfoList, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 		return
 	}
 }
@@ -1550,6 +2804,11 @@ func (in *LicenseSpec) DeepCopyInto(out *LicenseSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExpirationWarningPeriod != nil {
+		in, out := &in.ExpirationWarningPeriod, &out.ExpirationWarningPeriod
+		*out = new(Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1600,6 +2859,57 @@ func (in List) DeepCopy() List {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberServerDiskStatus) DeepCopyInto(out *MemberServerDiskStatus) {
+	*out = *in
+	out.Total = in.Total.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberServerDiskStatus.
+func (in *MemberServerDiskStatus) DeepCopy() *MemberServerDiskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberServerDiskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberServerStatus) DeepCopyInto(out *MemberServerStatus) {
+	*out = *in
+	if in.LicenseExpires != nil {
+		in, out := &in.LicenseExpires, &out.LicenseExpires
+		*out = (*in).DeepCopy()
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ReplicationLag != nil {
+		in, out := &in.ReplicationLag, &out.ReplicationLag
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(MemberServerDiskStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberServerStatus.
+func (in *MemberServerStatus) DeepCopy() *MemberServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
 	*out = *in
@@ -1613,7 +2923,7 @@ func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
 	}
 	if in.RecentTerminations != nil {
 		in, out := &in.RecentTerminations, &out.RecentTerminations
-		*out = make([]metav1.Time, len(*in))
+		*out = make([]v1.Time, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1621,12 +2931,12 @@ func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(ImageInfo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.OldImage != nil {
 		in, out := &in.OldImage, &out.OldImage
 		*out = new(ImageInfo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Endpoint != nil {
 		in, out := &in.Endpoint, &out.Endpoint
@@ -1638,9 +2948,18 @@ func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
 		*out = new(TopologyMemberStatus)
 		**out = **in
 	}
+	if in.TLSCertificateExpiry != nil {
+		in, out := &in.TLSCertificateExpiry, &out.TLSCertificateExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(MemberServerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SideCarSpecs != nil {
 		in, out := &in.SideCarSpecs, &out.SideCarSpecs
-		*out = make(map[string]v1.Container, len(*in))
+		*out = make(map[string]corev1.Container, len(*in))
 		for key, val := range *in {
 			(*out)[key] = *val.DeepCopy()
 		}
@@ -1701,6 +3020,27 @@ func (in *MetricsAuthenticationSpec) DeepCopy() *MetricsAuthenticationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsRelabelConfig) DeepCopyInto(out *MetricsRelabelConfig) {
+	*out = *in
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsRelabelConfig.
+func (in *MetricsRelabelConfig) DeepCopy() *MetricsRelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsRelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsServiceMonitorSpec) DeepCopyInto(out *MetricsServiceMonitorSpec) {
 	*out = *in
@@ -1716,6 +3056,30 @@ func (in *MetricsServiceMonitorSpec) DeepCopyInto(out *MetricsServiceMonitorSpec
 			(*out)[key] = val
 		}
 	}
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(MetricsServiceMonitorKind)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(string)
+		**out = **in
+	}
+	if in.Relabelings != nil {
+		in, out := &in.Relabelings, &out.Relabelings
+		*out = make([]MetricsRelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetricRelabelings != nil {
+		in, out := &in.MetricRelabelings, &out.MetricRelabelings
+		*out = make([]MetricsRelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1764,6 +3128,11 @@ func (in *MetricsSpec) DeepCopyInto(out *MetricsSpec) {
 		*out = new(uint16)
 		**out = **in
 	}
+	if in.PrometheusRules != nil {
+		in, out := &in.PrometheusRules, &out.PrometheusRules
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1890,6 +3259,13 @@ func (in *SecretHashes) DeepCopyInto(out *SecretHashes) {
 			(*out)[key] = val
 		}
 	}
+	if in.AccessPackages != nil {
+		in, out := &in.AccessPackages, &out.AccessPackages
+		*out = make(map[string]AccessPackageHash, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -1903,6 +3279,32 @@ func (in *SecretHashes) DeepCopy() *SecretHashes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupDirectoryVolume) DeepCopyInto(out *ServerGroupDirectoryVolume) {
+	*out = *in
+	if in.EmptyDir != nil {
+		in, out := &in.EmptyDir, &out.EmptyDir
+		*out = new(corev1.EmptyDirVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeClaimTemplate != nil {
+		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
+		*out = new(corev1.PersistentVolumeClaimTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupDirectoryVolume.
+func (in *ServerGroupDirectoryVolume) DeepCopy() *ServerGroupDirectoryVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupDirectoryVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerGroupEnvVar) DeepCopyInto(out *ServerGroupEnvVar) {
 	*out = *in
@@ -1939,12 +3341,43 @@ func (in ServerGroupEnvVars) DeepCopy() ServerGroupEnvVars {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupExternalAccessSpec) DeepCopyInto(out *ServerGroupExternalAccessSpec) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ExternalAccessType)
+		**out = **in
+	}
+	if in.NodePortBase != nil {
+		in, out := &in.NodePortBase, &out.NodePortBase
+		*out = new(int)
+		**out = **in
+	}
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupExternalAccessSpec.
+func (in *ServerGroupExternalAccessSpec) DeepCopy() *ServerGroupExternalAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupExternalAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerGroupInitContainers) DeepCopyInto(out *ServerGroupInitContainers) {
 	*out = *in
 	if in.Containers != nil {
 		in, out := &in.Containers, &out.Containers
-		*out = make([]v1.Container, len(*in))
+		*out = make([]corev1.Container, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2110,7 +3543,7 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2173,7 +3606,7 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 	}
 	if in.VolumeClaimTemplate != nil {
 		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
-		*out = new(v1.PersistentVolumeClaim)
+		*out = new(corev1.PersistentVolumeClaim)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.VolumeResizeMode != nil {
@@ -2181,6 +3614,26 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 		*out = new(PVCResizeMode)
 		**out = **in
 	}
+	if in.StorageClassChangeMode != nil {
+		in, out := &in.StorageClassChangeMode, &out.StorageClassChangeMode
+		*out = new(StorageClassChangeMode)
+		**out = **in
+	}
+	if in.VolumeAutoExpansion != nil {
+		in, out := &in.VolumeAutoExpansion, &out.VolumeAutoExpansion
+		*out = new(ServerGroupVolumeAutoExpansionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PVCDeletionPolicy != nil {
+		in, out := &in.PVCDeletionPolicy, &out.PVCDeletionPolicy
+		*out = new(PVCDeletionPolicy)
+		**out = **in
+	}
+	if in.PVCRetentionPeriod != nil {
+		in, out := &in.PVCRetentionPeriod, &out.PVCRetentionPeriod
+		*out = new(Duration)
+		**out = **in
+	}
 	if in.VolumeAllowShrink != nil {
 		in, out := &in.VolumeAllowShrink, &out.VolumeAllowShrink
 		*out = new(bool)
@@ -2188,17 +3641,17 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 	}
 	if in.AntiAffinity != nil {
 		in, out := &in.AntiAffinity, &out.AntiAffinity
-		*out = new(v1.PodAntiAffinity)
+		*out = new(corev1.PodAntiAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.PodAffinity)
+		*out = new(corev1.PodAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.NodeAffinity != nil {
 		in, out := &in.NodeAffinity, &out.NodeAffinity
-		*out = new(v1.NodeAffinity)
+		*out = new(corev1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SidecarCoreNames != nil {
@@ -2208,7 +3661,7 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 	}
 	if in.Sidecars != nil {
 		in, out := &in.Sidecars, &out.Sidecars
-		*out = make([]v1.Container, len(*in))
+		*out = make([]corev1.Container, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2257,6 +3710,11 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
 	if in.InternalPort != nil {
 		in, out := &in.InternalPort, &out.InternalPort
 		*out = new(int)
@@ -2282,6 +3740,21 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ExternalAccess != nil {
+		in, out := &in.ExternalAccess, &out.ExternalAccess
+		*out = new(ServerGroupExternalAccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogVolume != nil {
+		in, out := &in.LogVolume, &out.LogVolume
+		*out = new(ServerGroupDirectoryVolume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppsVolume != nil {
+		in, out := &in.AppsVolume, &out.AppsVolume
+		*out = new(ServerGroupDirectoryVolume)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2305,7 +3778,7 @@ func (in *ServerGroupSpecSecurityContext) DeepCopyInto(out *ServerGroupSpecSecur
 	}
 	if in.AddCapabilities != nil {
 		in, out := &in.AddCapabilities, &out.AddCapabilities
-		*out = make([]v1.Capability, len(*in))
+		*out = make([]corev1.Capability, len(*in))
 		copy(*out, *in)
 	}
 	if in.AllowPrivilegeEscalation != nil {
@@ -2398,7 +3871,7 @@ func (in *ServerGroupSpecVolumeConfigMap) DeepCopyInto(out *ServerGroupSpecVolum
 	out.LocalObjectReference = in.LocalObjectReference
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]v1.KeyToPath, len(*in))
+		*out = make([]corev1.KeyToPath, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2452,7 +3925,7 @@ func (in *ServerGroupSpecVolumeMount) DeepCopyInto(out *ServerGroupSpecVolumeMou
 	*out = *in
 	if in.MountPropagation != nil {
 		in, out := &in.MountPropagation, &out.MountPropagation
-		*out = new(v1.MountPropagationMode)
+		*out = new(corev1.MountPropagationMode)
 		**out = **in
 	}
 	return
@@ -2495,7 +3968,7 @@ func (in *ServerGroupSpecVolumeSecret) DeepCopyInto(out *ServerGroupSpecVolumeSe
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]v1.KeyToPath, len(*in))
+		*out = make([]corev1.KeyToPath, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2545,6 +4018,37 @@ func (in ServerGroupSpecVolumes) DeepCopy() ServerGroupSpecVolumes {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupVolumeAutoExpansionSpec) DeepCopyInto(out *ServerGroupVolumeAutoExpansionSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncreasePercent != nil {
+		in, out := &in.IncreasePercent, &out.IncreasePercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupVolumeAutoExpansionSpec.
+func (in *ServerGroupVolumeAutoExpansionSpec) DeepCopy() *ServerGroupVolumeAutoExpansionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupVolumeAutoExpansionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ServerGroups) DeepCopyInto(out *ServerGroups) {
 	{
@@ -2575,7 +4079,7 @@ func (in *ServerIDGroupSpec) DeepCopyInto(out *ServerIDGroupSpec) {
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2589,17 +4093,17 @@ func (in *ServerIDGroupSpec) DeepCopyInto(out *ServerIDGroupSpec) {
 	}
 	if in.AntiAffinity != nil {
 		in, out := &in.AntiAffinity, &out.AntiAffinity
-		*out = new(v1.PodAntiAffinity)
+		*out = new(corev1.PodAntiAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.PodAffinity)
+		*out = new(corev1.PodAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.NodeAffinity != nil {
 		in, out := &in.NodeAffinity, &out.NodeAffinity
-		*out = new(v1.NodeAffinity)
+		*out = new(corev1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ServiceAccountName != nil {
@@ -2614,7 +4118,7 @@ func (in *ServerIDGroupSpec) DeepCopyInto(out *ServerIDGroupSpec) {
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -2670,6 +4174,11 @@ func (in *SyncExternalAccessSpec) DeepCopyInto(out *SyncExternalAccessSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AccessPackageRenewalMargin != nil {
+		in, out := &in.AccessPackageRenewalMargin, &out.AccessPackageRenewalMargin
+		*out = new(Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2713,6 +4222,59 @@ func (in *SyncSpec) DeepCopy() *SyncSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSClientCertificateSpec) DeepCopyInto(out *TLSClientCertificateSpec) {
+	*out = *in
+	if in.CommonName != nil {
+		in, out := &in.CommonName, &out.CommonName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AltNames != nil {
+		in, out := &in.AltNames, &out.AltNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSClientCertificateSpec.
+func (in *TLSClientCertificateSpec) DeepCopy() *TLSClientCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSClientCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in TLSClientCertificatesSpec) DeepCopyInto(out *TLSClientCertificatesSpec) {
+	{
+		in := &in
+		*out = make(TLSClientCertificatesSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSClientCertificatesSpec.
+func (in TLSClientCertificatesSpec) DeepCopy() TLSClientCertificatesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSClientCertificatesSpec)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSNISpec) DeepCopyInto(out *TLSSNISpec) {
 	*out = *in
@@ -2772,6 +4334,28 @@ func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 		*out = new(TLSRotateMode)
 		**out = **in
 	}
+	if in.RenewalMargin != nil {
+		in, out := &in.RenewalMargin, &out.RenewalMargin
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ClientCertificates != nil {
+		in, out := &in.ClientCertificates, &out.ClientCertificates
+		*out = make(TLSClientCertificatesSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MinVersion != nil {
+		in, out := &in.MinVersion, &out.MinVersion
+		*out = new(TLSVersion)
+		**out = **in
+	}
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2809,6 +4393,16 @@ func (in *Timeouts) DeepCopyInto(out *Timeouts) {
 		*out = new(Timeout)
 		**out = **in
 	}
+	if in.MemberCheck != nil {
+		in, out := &in.MemberCheck, &out.MemberCheck
+		*out = new(Timeout)
+		**out = **in
+	}
+	if in.ReplicationLag != nil {
+		in, out := &in.ReplicationLag, &out.ReplicationLag
+		*out = new(Timeout)
+		**out = **in
+	}
 	if in.Actions != nil {
 		in, out := &in.Actions, &out.Actions
 		*out = make(ActionTimeouts, len(*in))
@@ -2986,3 +4580,90 @@ func (in TopologyStatusZones) DeepCopy() TopologyStatusZones {
 	in.DeepCopyInto(out)
 	return *out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretSpec) DeepCopyInto(out *VaultSecretSpec) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretProviderClass != nil {
+		in, out := &in.SecretProviderClass, &out.SecretProviderClass
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretSpec.
+func (in *VaultSecretSpec) DeepCopy() *VaultSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSpec) DeepCopyInto(out *VaultSpec) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(VaultMode)
+		**out = **in
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthPath != nil {
+		in, out := &in.AuthPath, &out.AuthPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.JWT != nil {
+		in, out := &in.JWT, &out.JWT
+		*out = new(VaultSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(VaultSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(VaultSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSpec.
+func (in *VaultSpec) DeepCopy() *VaultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -31,6 +31,8 @@ type ConditionType string
 const (
 	// ConditionTypeConfigured indicates that the replication has been configured.
 	ConditionTypeConfigured ConditionType = "Configured"
+	// ConditionTypeInSync indicates that all shards of the destination are synchronized incrementally.
+	ConditionTypeInSync ConditionType = "InSync"
 )
 
 // Condition represents one current condition of a deployment or deployment member.
@@ -20,9 +20,39 @@
 
 package v1
 
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // EndpointStatus contains the status of either the source or destination endpoint.
 type EndpointStatus struct {
 	// Databases holds the replication status of all databases from the point of view of this endpoint.
 	// List is ordered by name of the database.
 	Databases []DatabaseStatus `json:"databases,omitempty"`
+
+	// Phase holds the overall synchronization status reported by the syncmaster
+	// (inactive, initializing, initial-sync, running, cancelling, failed).
+	Phase string `json:"phase,omitempty"`
+	// ShardsTotal holds the number of replicated shards.
+	ShardsTotal int `json:"shardsTotal,omitempty"`
+	// ShardsInSync holds the number of shards which are synchronized incrementally.
+	ShardsInSync int `json:"shardsInSync,omitempty"`
+	// Lag holds the highest delay of all shards.
+	Lag *meta.Duration `json:"lag,omitempty"`
+}
+
+// IsInSync returns true when all shards finished the initial synchronization
+// and the incremental synchronization is running.
+func (e EndpointStatus) IsInSync() bool {
+	return e.Phase == ShardStatusRunning && e.ShardsTotal > 0 && e.ShardsInSync == e.ShardsTotal
+}
+
+// GetLag returns the lag of the endpoint, or 0 when unknown.
+func (e EndpointStatus) GetLag() time.Duration {
+	if e.Lag == nil {
+		return 0
+	}
+	return e.Lag.Duration
 }
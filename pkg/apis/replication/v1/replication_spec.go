@@ -27,6 +27,10 @@ import "github.com/arangodb/kube-arangodb/pkg/util/errors"
 type DeploymentReplicationSpec struct {
 	Source      EndpointSpec `json:"source"`
 	Destination EndpointSpec `json:"destination"`
+
+	// Switchover stops the replication cleanly and makes the destination writable,
+	// optionally reversing the direction of the replication.
+	Switchover *DeploymentReplicationSwitchoverSpec `json:"switchover,omitempty"`
 }
 
 // Validate the given spec, returning an error on validation
@@ -38,6 +42,9 @@ func (s DeploymentReplicationSpec) Validate() error {
 	if err := s.Destination.Validate(false); err != nil {
 		return errors.WithStack(err)
 	}
+	if err := s.Switchover.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "switchover"))
+	}
 	return nil
 }
 
@@ -36,6 +36,13 @@ type DeploymentReplicationStatus struct {
 	// Destination contains the detailed status of the destination endpoint
 	Destination EndpointStatus `json:"destination"`
 
+	// Switchover holds the progress of the switchover of the replication
+	Switchover *DeploymentReplicationSwitchoverStatus `json:"switchover,omitempty"`
+
+	// SourceAuthenticationHash holds the checksum of the client authentication keyfile & TLS CA of the source
+	// used to configure the synchronization.
+	SourceAuthenticationHash string `json:"sourceAuthenticationHash,omitempty"`
+
 	// CancelFailures records the number of times that the configuration was canceled
 	// which resulted in an error.
 	CancelFailures int `json:"cancel-failures,omitempty"`
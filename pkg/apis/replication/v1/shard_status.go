@@ -20,7 +20,36 @@
 
 package v1
 
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ShardStatusRunning is the status of a shard which is synchronized incrementally
+	ShardStatusRunning = "running"
+)
+
 // ShardStatus contains the status of a single shard.
 type ShardStatus struct {
 	Status string `json:"status"`
+	// StatusMessage holds a human readable message about the status of the shard
+	StatusMessage string `json:"statusMessage,omitempty"`
+	// Delay holds the delay between the source and the destination of the shard
+	Delay *meta.Duration `json:"delay,omitempty"`
+}
+
+// IsInSync returns true when the initial synchronization of the shard is done
+// and its changes are replicated incrementally.
+func (s ShardStatus) IsInSync() bool {
+	return s.Status == ShardStatusRunning
+}
+
+// GetDelay returns the delay of the shard, or 0 when unknown.
+func (s ShardStatus) GetDelay() time.Duration {
+	if s.Delay == nil {
+		return 0
+	}
+	return s.Delay.Duration
 }
@@ -26,6 +26,7 @@
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -96,7 +97,9 @@ func (in *CollectionStatus) DeepCopyInto(out *CollectionStatus) {
 	if in.Shards != nil {
 		in, out := &in.Shards, &out.Shards
 		*out = make([]ShardStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -161,6 +164,11 @@ func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -179,6 +187,11 @@ func (in *DeploymentReplicationSpec) DeepCopyInto(out *DeploymentReplicationSpec
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
 	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(DeploymentReplicationSwitchoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -204,6 +217,11 @@ func (in *DeploymentReplicationStatus) DeepCopyInto(out *DeploymentReplicationSt
 	}
 	in.Source.DeepCopyInto(&out.Source)
 	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(DeploymentReplicationSwitchoverStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -217,6 +235,59 @@ func (in *DeploymentReplicationStatus) DeepCopy() *DeploymentReplicationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentReplicationSwitchoverSpec) DeepCopyInto(out *DeploymentReplicationSwitchoverSpec) {
+	*out = *in
+	if in.Reverse != nil {
+		in, out := &in.Reverse, &out.Reverse
+		*out = new(bool)
+		**out = **in
+	}
+	in.ReverseSourceAuthentication.DeepCopyInto(&out.ReverseSourceAuthentication)
+	if in.Force != nil {
+		in, out := &in.Force, &out.Force
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForceAfter != nil {
+		in, out := &in.ForceAfter, &out.ForceAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentReplicationSwitchoverSpec.
+func (in *DeploymentReplicationSwitchoverSpec) DeepCopy() *DeploymentReplicationSwitchoverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentReplicationSwitchoverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentReplicationSwitchoverStatus) DeepCopyInto(out *DeploymentReplicationSwitchoverStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.FinishTime != nil {
+		in, out := &in.FinishTime, &out.FinishTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentReplicationSwitchoverStatus.
+func (in *DeploymentReplicationSwitchoverStatus) DeepCopy() *DeploymentReplicationSwitchoverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentReplicationSwitchoverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointAuthenticationSpec) DeepCopyInto(out *EndpointAuthenticationSpec) {
 	*out = *in
@@ -281,6 +352,11 @@ func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -318,6 +394,11 @@ func (in *EndpointTLSSpec) DeepCopy() *EndpointTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShardStatus) DeepCopyInto(out *ShardStatus) {
 	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -20,6 +20,12 @@
 
 package v2alpha1
 
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // DatabaseStatus contains the status of a single database.
 type DatabaseStatus struct {
 	// Name of the database
@@ -27,4 +33,19 @@ type DatabaseStatus struct {
 	// Collections holds the replication status of each collection in the database.
 	// List is ordered by name of the collection.
 	Collections []CollectionStatus `json:"collections,omitempty"`
+
+	// ShardsTotal holds the number of shards in the database.
+	ShardsTotal int `json:"shardsTotal,omitempty"`
+	// ShardsInSync holds the number of shards in the database which are synchronized incrementally.
+	ShardsInSync int `json:"shardsInSync,omitempty"`
+	// Lag holds the highest delay of all shards in the database.
+	Lag *meta.Duration `json:"lag,omitempty"`
+}
+
+// GetLag returns the lag of the database, or 0 when unknown.
+func (d DatabaseStatus) GetLag() time.Duration {
+	if d.Lag == nil {
+		return 0
+	}
+	return d.Lag.Duration
 }
@@ -0,0 +1,125 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// DeploymentReplicationSwitchoverSpec contains the specification of the switchover of the replication.
+// When set, the replication is stopped and the destination is made writable.
+type DeploymentReplicationSwitchoverSpec struct {
+	// Reverse the direction of the replication once the destination is writable.
+	Reverse *bool `json:"reverse,omitempty"`
+	// ReverseSourceAuthentication holds the authentication used to reach the syncmasters of the current destination,
+	// once it becomes the source of the reversed replication.
+	ReverseSourceAuthentication EndpointAuthenticationSpec `json:"reverseSourceAuth,omitempty"`
+	// Force stops the synchronization even when the source datacenter can not be reached.
+	// Data which is not yet transferred to the destination is lost.
+	Force *bool `json:"force,omitempty"`
+	// ForceAfter is the time after the start of the switchover after which the synchronization is stopped with force
+	// when it did not stop cleanly. When not set, the synchronization is stopped with force only when Force is set.
+	ForceAfter *meta.Duration `json:"forceAfter,omitempty"`
+}
+
+// IsForced returns true when the synchronization needs to be stopped with force
+// for a switchover started at the given time.
+func (s *DeploymentReplicationSwitchoverSpec) IsForced(start meta.Time, now time.Time) bool {
+	if s == nil {
+		return false
+	}
+	if util.BoolOrDefault(s.Force) {
+		return true
+	}
+	if s.ForceAfter == nil {
+		return false
+	}
+	return !start.IsZero() && now.Sub(start.Time) >= s.ForceAfter.Duration
+}
+
+// IsReverse returns true when the direction of the replication needs to be reversed.
+func (s *DeploymentReplicationSwitchoverSpec) IsReverse() bool {
+	if s == nil {
+		return false
+	}
+	return util.BoolOrDefault(s.Reverse)
+}
+
+// Validate the given spec, returning an error on validation
+// problems or nil if all ok.
+func (s *DeploymentReplicationSwitchoverSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if err := s.ReverseSourceAuthentication.Validate(s.IsReverse()); err != nil {
+		return errors.WithStack(errors.Wrap(err, "reverseSourceAuth"))
+	}
+	if s.ForceAfter != nil && s.ForceAfter.Duration < 0 {
+		return errors.WithStack(errors.Newf("forceAfter must not be negative"))
+	}
+	return nil
+}
+
+// DeploymentReplicationSwitchoverPhase is a strongly typed phase of the switchover
+type DeploymentReplicationSwitchoverPhase string
+
+const (
+	// DeploymentReplicationSwitchoverPhaseStopping indicates that the replication is being stopped
+	DeploymentReplicationSwitchoverPhaseStopping DeploymentReplicationSwitchoverPhase = "Stopping"
+	// DeploymentReplicationSwitchoverPhaseStopped indicates that the replication is stopped and the destination is writable
+	DeploymentReplicationSwitchoverPhaseStopped DeploymentReplicationSwitchoverPhase = "Stopped"
+	// DeploymentReplicationSwitchoverPhaseReversed indicates that the direction of the replication has been reversed
+	DeploymentReplicationSwitchoverPhaseReversed DeploymentReplicationSwitchoverPhase = "Reversed"
+)
+
+// DeploymentReplicationSwitchoverStatus contains the progress of the switchover.
+type DeploymentReplicationSwitchoverStatus struct {
+	// Phase holds the current phase of the switchover
+	Phase DeploymentReplicationSwitchoverPhase `json:"phase,omitempty"`
+	// Message holds a human readable message about the progress of the switchover (e.g. last error)
+	Message string `json:"message,omitempty"`
+	// StartTime holds the time when the switchover has been started
+	StartTime meta.Time `json:"startTime,omitempty"`
+	// FinishTime holds the time when the destination became writable
+	FinishTime *meta.Time `json:"finishTime,omitempty"`
+	// Forced is set when the synchronization has been stopped with force
+	Forced bool `json:"forced,omitempty"`
+}
+
+// Reverse returns the spec of the replication in the reversed direction.
+// The destination becomes the source (using the reverse source authentication of the switchover)
+// and the switchover request is removed.
+func (s DeploymentReplicationSpec) Reverse() DeploymentReplicationSpec {
+	r := *s.DeepCopy()
+
+	r.Source, r.Destination = r.Destination, r.Source
+	if s.Switchover != nil {
+		r.Source.Authentication = *s.Switchover.ReverseSourceAuthentication.DeepCopy()
+	}
+	r.Switchover = nil
+
+	return r
+}
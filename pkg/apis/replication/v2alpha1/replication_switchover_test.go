@@ -0,0 +1,85 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func TestDeploymentReplicationSwitchoverSpecValidate(t *testing.T) {
+	var nilSpec *DeploymentReplicationSwitchoverSpec
+	require.NoError(t, nilSpec.Validate())
+	require.False(t, nilSpec.IsReverse())
+
+	require.NoError(t, (&DeploymentReplicationSwitchoverSpec{}).Validate())
+	require.Error(t, (&DeploymentReplicationSwitchoverSpec{Reverse: util.NewBool(true)}).Validate())
+	require.NoError(t, (&DeploymentReplicationSwitchoverSpec{
+		Reverse:                     util.NewBool(true),
+		ReverseSourceAuthentication: EndpointAuthenticationSpec{KeyfileSecretName: util.NewString("dc2-auth")},
+	}).Validate())
+	require.Error(t, (&DeploymentReplicationSwitchoverSpec{ForceAfter: &meta.Duration{Duration: -time.Minute}}).Validate())
+}
+
+func TestDeploymentReplicationSwitchoverSpecIsForced(t *testing.T) {
+	now := time.Now()
+	start := meta.NewTime(now.Add(-10 * time.Minute))
+
+	var nilSpec *DeploymentReplicationSwitchoverSpec
+	require.False(t, nilSpec.IsForced(start, now))
+	require.False(t, (&DeploymentReplicationSwitchoverSpec{}).IsForced(start, now))
+	require.True(t, (&DeploymentReplicationSwitchoverSpec{Force: util.NewBool(true)}).IsForced(start, now))
+	require.True(t, (&DeploymentReplicationSwitchoverSpec{ForceAfter: &meta.Duration{Duration: 5 * time.Minute}}).IsForced(start, now))
+	require.False(t, (&DeploymentReplicationSwitchoverSpec{ForceAfter: &meta.Duration{Duration: 15 * time.Minute}}).IsForced(start, now))
+}
+
+func TestDeploymentReplicationSpecReverse(t *testing.T) {
+	spec := DeploymentReplicationSpec{
+		Source: EndpointSpec{
+			DeploymentName: util.NewString("dc1"),
+			Authentication: EndpointAuthenticationSpec{KeyfileSecretName: util.NewString("dc1-auth")},
+		},
+		Destination: EndpointSpec{
+			DeploymentName: util.NewString("dc2"),
+		},
+		Switchover: &DeploymentReplicationSwitchoverSpec{
+			Reverse:                     util.NewBool(true),
+			ReverseSourceAuthentication: EndpointAuthenticationSpec{KeyfileSecretName: util.NewString("dc2-auth")},
+		},
+	}
+	require.NoError(t, spec.Validate())
+
+	r := spec.Reverse()
+	require.NoError(t, r.Validate())
+	require.Nil(t, r.Switchover)
+	require.Equal(t, "dc2", r.Source.GetDeploymentName())
+	require.Equal(t, "dc2-auth", r.Source.Authentication.GetKeyfileSecretName())
+	require.Equal(t, "dc1", r.Destination.GetDeploymentName())
+
+	// Original spec is not modified
+	require.NotNil(t, spec.Switchover)
+	require.Equal(t, "dc1", spec.Source.GetDeploymentName())
+}
@@ -29,8 +29,11 @@ import (
 // LocalStorageSpec contains the specification part of
 // an ArangoLocalStorage.
 type LocalStorageSpec struct {
-	StorageClass StorageClassSpec  `json:"storageClass"`
-	LocalPath    []string          `json:"localPath,omitempty"`
+	StorageClass StorageClassSpec `json:"storageClass"`
+	LocalPath    []string         `json:"localPath,omitempty"`
+	// Devices contains mount points of local devices (e.g. NVMe disks). Each device is provisioned
+	// as a single PersistentVolume with the capacity of the whole device.
+	Devices      []string          `json:"devices,omitempty"`
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 	Privileged   *bool             `json:"privileged,omitempty"`
 }
@@ -41,7 +44,7 @@ func (s LocalStorageSpec) Validate() error {
 	if err := s.StorageClass.Validate(); err != nil {
 		return errors.WithStack(err)
 	}
-	if len(s.LocalPath) == 0 {
+	if len(s.LocalPath) == 0 && len(s.Devices) == 0 {
 		return errors.WithStack(errors.Wrapf(ValidationError, "localPath cannot be empty"))
 	}
 	for _, p := range s.LocalPath {
@@ -49,6 +52,19 @@ func (s LocalStorageSpec) Validate() error {
 			return errors.WithStack(errors.Wrapf(ValidationError, "localPath cannot contain empty strings"))
 		}
 	}
+	paths := make(map[string]bool, len(s.LocalPath)+len(s.Devices))
+	for _, p := range s.LocalPath {
+		paths[p] = true
+	}
+	for _, p := range s.Devices {
+		if len(p) == 0 {
+			return errors.WithStack(errors.Wrapf(ValidationError, "devices cannot contain empty strings"))
+		}
+		if paths[p] {
+			return errors.WithStack(errors.Wrapf(ValidationError, "device %s is used more than once", p))
+		}
+		paths[p] = true
+	}
 	return nil
 }
 
@@ -69,6 +85,10 @@ func (s LocalStorageSpec) ResetImmutableFields(target *LocalStorageSpec) []strin
 		target.LocalPath = s.LocalPath
 		result = append(result, "localPath")
 	}
+	if strings.Join(s.Devices, ",") != strings.Join(target.Devices, ",") {
+		target.Devices = s.Devices
+		result = append(result, "devices")
+	}
 	// TODO NodeSelector
 	return result
 }
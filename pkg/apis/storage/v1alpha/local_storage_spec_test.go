@@ -40,6 +40,15 @@ func TestLocalStorageSpecCreation(t *testing.T) {
 	class = StorageClassSpec{"spec-name", true}
 	local = LocalStorageSpec{StorageClass: class, LocalPath: []string{}}
 	assert.True(t, IsValidation(local.Validate()))
+
+	local = LocalStorageSpec{StorageClass: class, Devices: []string{"/mnt/nvme0", "/mnt/nvme1"}}
+	assert.NoError(t, local.Validate(), "devices without local path are allowed")
+
+	local = LocalStorageSpec{StorageClass: class, Devices: []string{""}}
+	assert.True(t, IsValidation(local.Validate()), "should fail as the empty sting is not a valid device")
+
+	local = LocalStorageSpec{StorageClass: class, LocalPath: []string{"/mnt/nvme0"}, Devices: []string{"/mnt/nvme0"}}
+	assert.True(t, IsValidation(local.Validate()), "should fail as the device is used as local path")
 }
 
 // Test reset of local storage spec
@@ -27,4 +27,21 @@ type LocalStorageStatus struct {
 	State LocalStorageState `json:"state,omitempty"`
 	// Reason for the state this object is in.
 	Reason string `json:"reason,omitempty"`
+	// Nodes holds the capacity and usage of the local storage per node, sorted by node name.
+	Nodes []LocalStorageNodeStatus `json:"nodes,omitempty"`
+}
+
+// LocalStorageNodeStatus contains the capacity and usage of the local storage on a single node.
+// All sizes are in bytes.
+type LocalStorageNodeStatus struct {
+	// NodeName is the name of the node
+	NodeName string `json:"nodeName"`
+	// Capacity is the total size of the filesystems of local paths and devices
+	Capacity int64 `json:"capacity"`
+	// Available is the size which can still be provisioned
+	Available int64 `json:"available"`
+	// Provisioned is the total capacity of PersistentVolumes created on the node
+	Provisioned int64 `json:"provisioned"`
+	// Volumes is the number of PersistentVolumes created on the node
+	Volumes int `json:"volumes"`
 }
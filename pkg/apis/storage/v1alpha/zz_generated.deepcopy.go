@@ -35,7 +35,7 @@ func (in *ArangoLocalStorage) DeepCopyInto(out *ArangoLocalStorage) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -90,6 +90,22 @@ func (in *ArangoLocalStorageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageNodeStatus) DeepCopyInto(out *LocalStorageNodeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageNodeStatus.
+func (in *LocalStorageNodeStatus) DeepCopy() *LocalStorageNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalStorageSpec) DeepCopyInto(out *LocalStorageSpec) {
 	*out = *in
@@ -99,6 +115,11 @@ func (in *LocalStorageSpec) DeepCopyInto(out *LocalStorageSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -127,6 +148,11 @@ func (in *LocalStorageSpec) DeepCopy() *LocalStorageSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalStorageStatus) DeepCopyInto(out *LocalStorageStatus) {
 	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]LocalStorageNodeStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
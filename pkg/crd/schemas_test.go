@@ -0,0 +1,218 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package crd
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/robfig/cron"
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+const schemasDir = "../../chart/kube-arangodb-crd/schemas"
+
+var schemaCRDs = map[string]string{
+	"arangodeployments.database.arangodb.com":                        "deployment",
+	"arangodeploymentreplications.replication.database.arangodb.com": "deployment-replication",
+	"arangobackups.backup.arangodb.com":                              "backup",
+	"arangobackuppolicies.backup.arangodb.com":                       "backup-policy",
+}
+
+func loadSchema(t *testing.T, name string) apiextensions.JSONSchemaProps {
+	data, err := os.ReadFile(filepath.Join(schemasDir, name+".yaml"))
+	require.NoError(t, err)
+
+	var schema apiextensions.JSONSchemaProps
+	require.NoError(t, yaml.Unmarshal(data, &schema))
+	return schema
+}
+
+func schemaProperty(t *testing.T, schema apiextensions.JSONSchemaProps, path ...string) apiextensions.JSONSchemaProps {
+	for _, p := range path {
+		if p == "[]" {
+			require.NotNil(t, schema.Items, "%v", path)
+			schema = *schema.Items.Schema
+			continue
+		}
+		prop, ok := schema.Properties[p]
+		require.True(t, ok, "missing property %s in %v", p, path)
+		schema = prop
+	}
+	return schema
+}
+
+func requireStructural(t *testing.T, path string, schema apiextensions.JSONSchemaProps) {
+	require.NotEmpty(t, schema.Type, "missing type at %s", path)
+
+	for name, prop := range schema.Properties {
+		require.Equal(t, "object", schema.Type, "properties on non object at %s", path)
+		requireStructural(t, path+"."+name, prop)
+	}
+
+	for _, name := range schema.Required {
+		_, ok := schema.Properties[name]
+		require.True(t, ok, "required property %s not defined at %s", name, path)
+	}
+
+	if schema.Items != nil {
+		require.Equal(t, "array", schema.Type, "items on non array at %s", path)
+		require.NotNil(t, schema.Items.Schema, "items without schema at %s", path)
+		requireStructural(t, path+"[]", *schema.Items.Schema)
+	}
+
+	if schema.Pattern != "" {
+		_, err := regexp.Compile(schema.Pattern)
+		require.NoError(t, err, "invalid pattern at %s", path)
+	}
+}
+
+func requireEnum(t *testing.T, schema apiextensions.JSONSchemaProps, values ...string) {
+	require.Len(t, schema.Enum, len(values))
+	for id, v := range values {
+		require.Equal(t, `"`+v+`"`, string(schema.Enum[id].Raw))
+	}
+}
+
+func Test_Schemas_Structural(t *testing.T) {
+	for _, name := range schemaCRDs {
+		t.Run(name, func(t *testing.T) {
+			requireStructural(t, name, loadSchema(t, name))
+		})
+	}
+}
+
+func Test_Schemas_Manifests(t *testing.T) {
+	for _, manifest := range []string{"../../manifests/arango-crd.yaml", "../../manifests/kustomize/crd/arango-crd.yaml"} {
+		t.Run(manifest, func(t *testing.T) {
+			data, err := os.ReadFile(manifest)
+			require.NoError(t, err)
+
+			found := map[string]bool{}
+
+			for _, doc := range strings.Split(string(data), "\n---") {
+				var crd apiextensions.CustomResourceDefinition
+				require.NoError(t, yaml.Unmarshal([]byte(doc), &crd))
+
+				name, ok := schemaCRDs[crd.GetName()]
+				if !ok {
+					continue
+				}
+
+				expected := loadSchema(t, name)
+				for _, v := range crd.Spec.Versions {
+					require.NotNil(t, v.Schema, "%s/%s", crd.GetName(), v.Name)
+					require.Equal(t, expected, *v.Schema.OpenAPIV3Schema, "%s/%s", crd.GetName(), v.Name)
+				}
+				found[name] = true
+			}
+
+			require.Len(t, found, len(schemaCRDs))
+		})
+	}
+}
+
+func Test_Schemas_DeploymentEnums(t *testing.T) {
+	spec := schemaProperty(t, loadSchema(t, "deployment"), "spec")
+
+	requireEnum(t, schemaProperty(t, spec, "mode"),
+		string(api.DeploymentModeSingle), string(api.DeploymentModeActiveFailover), string(api.DeploymentModeCluster))
+	requireEnum(t, schemaProperty(t, spec, "environment"),
+		string(api.EnvironmentDevelopment), string(api.EnvironmentProduction))
+	requireEnum(t, schemaProperty(t, spec, "storageEngine"),
+		string(api.StorageEngineMMFiles), string(api.StorageEngineRocksDB))
+	requireEnum(t, schemaProperty(t, spec, "externalAccess", "type"),
+		string(api.ExternalAccessTypeNone), string(api.ExternalAccessTypeAuto), string(api.ExternalAccessTypeLoadBalancer),
+		string(api.ExternalAccessTypeNodePort), string(api.ExternalAccessTypeIngress))
+	requireEnum(t, schemaProperty(t, spec, "tls", "mode"),
+		string(api.TLSRotateModeInPlace), string(api.TLSRotateModeRecreate))
+	requireEnum(t, schemaProperty(t, spec, "tls", "minVersion"),
+		string(api.TLSVersion12), string(api.TLSVersion13))
+	requireEnum(t, schemaProperty(t, spec, "metrics", "mode"),
+		string(api.MetricsModeExporter), string(api.MetricsModeSidecar), string(api.MetricsModeInternal), string(api.MetricsModeDirect))
+	requireEnum(t, schemaProperty(t, spec, "metrics", "serviceMonitor", "kind"),
+		string(api.MetricsServiceMonitorKindServiceMonitor), string(api.MetricsServiceMonitorKindPodMonitor))
+	requireEnum(t, *schemaProperty(t, spec, "bootstrap", "databases", "[]", "users").AdditionalProperties.Schema,
+		string(api.BootstrapGrantReadWrite), string(api.BootstrapGrantReadOnly), string(api.BootstrapGrantNone))
+	requireEnum(t, schemaProperty(t, spec, "bootstrap", "databases", "[]", "collections", "[]", "type"),
+		string(api.BootstrapCollectionTypeDocument), string(api.BootstrapCollectionTypeEdge))
+}
+
+func Test_Schemas_Patterns(t *testing.T) {
+	spec := schemaProperty(t, loadSchema(t, "deployment"), "spec")
+
+	t.Run("Duration", func(t *testing.T) {
+		pattern := regexp.MustCompile(schemaProperty(t, spec, "tls", "ttl").Pattern)
+		require.Equal(t, pattern.String(), schemaProperty(t, spec, "maintenanceWindows", "[]", "duration").Pattern)
+
+		for _, d := range []string{"0", "1s", "2160h", "1h30m", "1.5h", "-10m", "100ms", "10us", "10µs"} {
+			_, err := time.ParseDuration(d)
+			require.NoError(t, err, d)
+			require.True(t, pattern.MatchString(d), d)
+		}
+
+		require.True(t, pattern.MatchString(""))
+
+		for _, d := range []string{"1", "1d", "h", "10 s", "1h 30m", "abc"} {
+			_, err := time.ParseDuration(d)
+			require.Error(t, err, d)
+			require.False(t, pattern.MatchString(d), d)
+		}
+	})
+
+	t.Run("Bootstrap names", func(t *testing.T) {
+		database := regexp.MustCompile(schemaProperty(t, spec, "bootstrap", "databases", "[]", "name").Pattern)
+		collection := regexp.MustCompile(schemaProperty(t, spec, "bootstrap", "databases", "[]", "collections", "[]", "name").Pattern)
+
+		for _, n := range []string{"db", "my-db_1", "_system", "_other", "1db", "", "my db", strings.Repeat("a", 64), strings.Repeat("a", 65)} {
+			d := api.BootstrapDatabase{Name: n}
+			require.Equal(t, d.Validate(nil) == nil, database.MatchString(n), n)
+
+			c := api.BootstrapCollection{Name: n}
+			require.Equal(t, c.Validate() == nil, collection.MatchString(n), n)
+		}
+	})
+
+	t.Run("Schedule", func(t *testing.T) {
+		pattern := regexp.MustCompile(schemaProperty(t, loadSchema(t, "backup-policy"), "spec", "schedule").Pattern)
+		require.Equal(t, pattern.String(), schemaProperty(t, spec, "maintenanceWindows", "[]", "schedule").Pattern)
+
+		for _, s := range []string{"*/5 * * * *", "0 2 * * 1-5", "@daily", "@hourly", "@every 1h30m"} {
+			_, err := cron.ParseStandard(s)
+			require.NoError(t, err, s)
+			require.True(t, pattern.MatchString(s), s)
+		}
+
+		for _, s := range []string{"", "* * * *", "@tomorrow", "daily"} {
+			_, err := cron.ParseStandard(s)
+			require.Error(t, err, s)
+			require.False(t, pattern.MatchString(s), s)
+		}
+	})
+}
@@ -22,6 +22,8 @@ package deployment
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
 	"strings"
 	"time"
 
@@ -34,6 +36,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
@@ -53,7 +57,7 @@ func (d *Deployment) createAccessPackages(ctx context.Context) error {
 
 	if !spec.Sync.IsEnabled() {
 		// We're only relevant when sync is enabled
-		return nil
+		return d.cleanupAccessPackageHashes(ctx, nil)
 	}
 
 	// Create all access packages that we're asked to build
@@ -66,86 +70,180 @@ func (d *Deployment) createAccessPackages(ctx context.Context) error {
 	}
 
 	// Remove all access packages that we did build, but are no longer needed
-	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
-	defer cancel()
-	secretList, err := secrets.List(ctxChild, metav1.ListOptions{})
-	if err != nil {
-		log.Debug().Err(err).Msg("Failed to list secrets")
+	var obsolete []*v1.Secret
+	if err := d.GetCachedStatus().IterateSecrets(func(secret *v1.Secret) error {
+		obsolete = append(obsolete, secret)
+		return nil
+	}, func(secret *v1.Secret) bool {
+		if !d.isOwnerOf(secret) {
+			return false
+		}
+		if _, found := secret.Data[constants.SecretAccessPackageYaml]; !found {
+			// Secret is not an access package
+			return false
+		}
+		_, wanted := apNameMap[secret.GetName()]
+		return !wanted
+	}); err != nil {
 		return errors.WithStack(err)
 	}
-	for _, secret := range secretList.Items {
-		if d.isOwnerOf(&secret) {
-			if _, found := secret.Data[constants.SecretAccessPackageYaml]; found {
-				// Secret is an access package
-				if _, wanted := apNameMap[secret.GetName()]; !wanted {
-					// We found an obsolete access package secret. Remove it.
-					err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
-						return secrets.Delete(ctxChild, secret.GetName(), metav1.DeleteOptions{
-							Preconditions: &metav1.Preconditions{UID: &secret.UID},
-						})
-					})
-					if err != nil && !k8sutil.IsNotFound(err) {
-						// Not serious enough to stop everything now, just log and create an event
-						log.Warn().Err(err).Msg("Failed to remove obsolete access package secret")
-						d.CreateEvent(k8sutil.NewErrorEvent("Access Package cleanup failed", err, d.apiObject))
-					} else {
-						// Access package removed, notify user
-						log.Info().Str("secret-name", secret.GetName()).Msg("Removed access package Secret")
-						d.CreateEvent(k8sutil.NewAccessPackageDeletedEvent(d.apiObject, secret.GetName()))
+	for _, secret := range obsolete {
+		// We found an obsolete access package secret. Remove it.
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return secrets.Delete(ctxChild, secret.GetName(), metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{UID: &secret.UID},
+			})
+		})
+		if err != nil && !k8sutil.IsNotFound(err) {
+			// Not serious enough to stop everything now, just log and create an event
+			log.Warn().Err(err).Msg("Failed to remove obsolete access package secret")
+			d.CreateEvent(k8sutil.NewErrorEvent("Access Package cleanup failed", err, d.apiObject))
+		} else {
+			// Access package removed, notify user
+			log.Info().Str("secret-name", secret.GetName()).Msg("Removed access package Secret")
+			d.CreateEvent(k8sutil.NewAccessPackageDeletedEvent(d.apiObject, secret.GetName()))
+		}
+	}
+
+	return d.cleanupAccessPackageHashes(ctx, apNameMap)
+}
+
+// cleanupAccessPackageHashes removes hashes of access packages which are no longer needed from the status
+func (d *Deployment) cleanupAccessPackageHashes(ctx context.Context, wanted map[string]struct{}) error {
+	status, _ := d.GetStatus()
+	if status.SecretHashes == nil {
+		return nil
+	}
+
+	for name := range status.SecretHashes.AccessPackages {
+		if _, ok := wanted[name]; !ok {
+			return d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+				if s.SecretHashes == nil {
+					return false
+				}
+
+				changed := false
+				for name := range s.SecretHashes.AccessPackages {
+					if _, ok := wanted[name]; !ok {
+						delete(s.SecretHashes.AccessPackages, name)
+						changed = true
 					}
 				}
-			}
+				return changed
+			})
 		}
 	}
 
 	return nil
 }
 
+// setAccessPackageHash saves the hash of the access package in the status
+func (d *Deployment) setAccessPackageHash(ctx context.Context, apSecretName string, hash api.AccessPackageHash) error {
+	return d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		if s.SecretHashes == nil {
+			s.SecretHashes = api.NewEmptySecretHashes()
+		}
+		if current, ok := s.SecretHashes.AccessPackages[apSecretName]; ok && current.Equal(hash) {
+			return false
+		}
+		if s.SecretHashes.AccessPackages == nil {
+			s.SecretHashes.AccessPackages = map[string]api.AccessPackageHash{}
+		}
+		s.SecretHashes.AccessPackages[apSecretName] = hash
+		return true
+	})
+}
+
+// getAccessPackageHash returns the hash of the access package secret and the CA secrets it is created from
+func getAccessPackageHash(accessPackage, clientAuthCA, tlsCA *v1.Secret) string {
+	return util.SHA256FromString(strings.Join([]string{
+		util.SHA256(accessPackage.Data[constants.SecretTLSKeyfile]),
+		util.SHA256(accessPackage.Data[constants.SecretCACertificate]),
+		util.SHA256(clientAuthCA.Data[constants.SecretCACertificate]),
+		util.SHA256(clientAuthCA.Data[constants.SecretCAKey]),
+		util.SHA256(tlsCA.Data[constants.SecretCACertificate]),
+	}, "|"))
+}
+
 // ensureAccessPackage creates an arangosync access package with given name
-// it is does not already exist.
+// it is does not already exist, or renews it when it is about to expire
+// or the CA certificates have changed.
 func (d *Deployment) ensureAccessPackage(ctx context.Context, apSecretName string) error {
 	log := d.deps.Log
 	ns := d.GetNamespace()
 	secrets := d.deps.Client.Kubernetes().CoreV1().Secrets(ns)
 	spec := d.apiObject.Spec
 
-	err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
-		_, err := secrets.Get(ctxChild, apSecretName, metav1.GetOptions{})
-		return err
-	})
-	if err == nil {
-		// Secret already exists
+	cache := d.GetCachedStatus()
+
+	existing, exists := cache.Secret(apSecretName)
+	if exists && !d.isOwnerOf(existing) {
+		// Secret is not managed by the operator
 		return nil
-	} else if !k8sutil.IsNotFound(err) {
-		log.Debug().Err(err).Str("name", apSecretName).Msg("Failed to get arangosync access package secret")
-		return errors.WithStack(err)
 	}
 
 	// Fetch client authentication CA
 	clientAuthSecretName := spec.Sync.Authentication.GetClientCASecretName()
-	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
-	defer cancel()
-	clientAuthCert, clientAuthKey, _, err := k8sutil.GetCASecret(ctxChild, secrets, clientAuthSecretName, nil)
-	if err != nil {
-		log.Debug().Err(err).Msg("Failed to get client-auth CA secret")
-		return errors.WithStack(err)
+	clientAuthSecret, ok := cache.Secret(clientAuthSecretName)
+	if !ok {
+		log.Debug().Str("secret-name", clientAuthSecretName).Msg("Client-auth CA secret not found")
+		return errors.Newf("Client-auth CA secret %s not found", clientAuthSecretName)
 	}
 
 	// Fetch TLS CA public key
 	tlsCASecretName := spec.Sync.TLS.GetCASecretName()
-	tlsCACert, err := k8sutil.GetCACertficateSecret(ctx, secrets, tlsCASecretName)
+	tlsCASourceSecret, ok := cache.Secret(tlsCASecretName)
+	if !ok {
+		log.Debug().Str("secret-name", tlsCASecretName).Msg("TLS CA secret not found")
+		return errors.Newf("TLS CA secret %s not found", tlsCASecretName)
+	}
+
+	renewalMargin := spec.Sync.ExternalAccess.GetAccessPackageRenewalMargin()
+
+	if exists {
+		// Skip parsing of the secrets when nothing has changed since the last check
+		if status, _ := d.GetStatus(); status.SecretHashes != nil {
+			if h, ok := status.SecretHashes.AccessPackages[apSecretName]; ok &&
+				h.Hash == getAccessPackageHash(existing, clientAuthSecret, tlsCASourceSecret) &&
+				time.Now().Add(renewalMargin).Before(h.ExpiresAt.Time) {
+				return nil
+			}
+		}
+	} else {
+		existing = nil
+	}
+
+	clientAuthCert, clientAuthKey, _, err := k8sutil.GetCAFromSecret(clientAuthSecret, nil)
 	if err != nil {
-		log.Debug().Err(err).Msg("Failed to get TLS CA secret")
+		log.Debug().Err(err).Msg("Failed to get client-auth CA secret")
 		return errors.WithStack(err)
 	}
 
-	// Create keyfile
+	tlsCACertData, found := tlsCASourceSecret.Data[constants.SecretCACertificate]
+	if !found {
+		log.Debug().Msg("Failed to get TLS CA secret")
+		return errors.Newf("No '%s' found in secret '%s'", constants.SecretCACertificate, tlsCASecretName)
+	}
+	tlsCACert := string(tlsCACertData)
+
 	ca, err := certificates.LoadCAFromPEM(clientAuthCert, clientAuthKey)
 	if err != nil {
 		log.Debug().Err(err).Msg("Failed to parse client-auth CA")
 		return errors.WithStack(err)
 	}
 
+	var renewalReason string
+	if existing != nil {
+		renewalReason = accessPackageRenewalReason(existing, ca, tlsCACert, time.Now(), renewalMargin)
+		if renewalReason == "" {
+			// Secret is up to date
+			return d.setAccessPackageHash(ctx, apSecretName, api.AccessPackageHash{
+				Hash:      getAccessPackageHash(existing, clientAuthSecret, tlsCASourceSecret),
+				ExpiresAt: metav1.NewTime(getAccessPackageExpiration(existing)),
+			})
+		}
+	}
+
 	// Create certificate
 	options := certificates.CreateCertificateOptions{
 		ValidFor:     clientAuthValidFor,
@@ -206,16 +304,46 @@ func (d *Deployment) ensureAccessPackage(ctx context.Context, apSecretName strin
 	}
 	allYaml := strings.TrimSpace(string(keyfileYaml)) + "\n---\n" + strings.TrimSpace(string(tlsCAYaml))
 
+	data := map[string][]byte{
+		constants.SecretAccessPackageYaml: []byte(allYaml),
+		constants.SecretCACertificate:     []byte(tlsCACert),
+		constants.SecretTLSKeyfile:        []byte(keyfile),
+	}
+
+	if existing != nil {
+		// Renew secret containing access package
+		secret := existing.DeepCopy()
+		secret.Data = data
+		err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			_, err := secrets.Update(ctxChild, secret, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			log.Debug().Err(err).Str("secret-name", apSecretName).Msg("Failed to renew access package Secret")
+			return errors.WithStack(err)
+		}
+
+		// Update secrets of the access package which are applied in the same namespace
+		d.updateAppliedAccessPackageSecret(ctx, keyfileSecret)
+		d.updateAppliedAccessPackageSecret(ctx, tlsCASecret)
+
+		// Write log entry & create event
+		log.Info().Str("secret-name", apSecretName).Str("reason", renewalReason).Msg("Renewed access package Secret")
+		d.CreateEvent(k8sutil.NewAccessPackageRenewedEvent(d.apiObject, apSecretName, renewalReason))
+
+		return d.setAccessPackageHash(ctx, apSecretName, api.AccessPackageHash{
+			Hash:      getAccessPackageHash(secret, clientAuthSecret, tlsCASourceSecret),
+			ExpiresAt: metav1.NewTime(getAccessPackageExpiration(secret)),
+		})
+	}
+
 	// Create secret containing access package
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: apSecretName,
-		},
-		Data: map[string][]byte{
-			constants.SecretAccessPackageYaml: []byte(allYaml),
-			constants.SecretCACertificate:     []byte(tlsCACert),
-			constants.SecretTLSKeyfile:        []byte(keyfile),
+			Name:   apSecretName,
+			Labels: k8sutil.LabelsForDeployment(d.apiObject.GetName(), ""),
 		},
+		Data: data,
 	}
 	// Attach secret to owner
 	secret.SetOwnerReferences(append(secret.GetOwnerReferences(), d.apiObject.AsOwner()))
@@ -233,5 +361,81 @@ func (d *Deployment) ensureAccessPackage(ctx context.Context, apSecretName strin
 	log.Info().Str("secret-name", apSecretName).Msg("Created access package Secret")
 	d.CreateEvent(k8sutil.NewAccessPackageCreatedEvent(d.apiObject, apSecretName))
 
-	return nil
+	return d.setAccessPackageHash(ctx, apSecretName, api.AccessPackageHash{
+		Hash:      getAccessPackageHash(secret, clientAuthSecret, tlsCASourceSecret),
+		ExpiresAt: metav1.NewTime(getAccessPackageExpiration(secret)),
+	})
+}
+
+// updateAppliedAccessPackageSecret updates the data of a secret from the access package,
+// when the access package has been applied in the namespace of the deployment
+// (e.g. when both datacenters are managed in the same Kubernetes cluster).
+// Secrets applied in other namespaces or clusters are not reachable by the operator,
+// renewed access package has to be applied there again.
+func (d *Deployment) updateAppliedAccessPackageSecret(ctx context.Context, expected v1.Secret) {
+	log := d.deps.Log
+	secrets := d.deps.Client.Kubernetes().CoreV1().Secrets(d.GetNamespace())
+
+	err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		s, err := secrets.Get(ctxChild, expected.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if s.GetLabels()[labelKeyOriginalDeployment] != d.apiObject.GetName() {
+			// Not created from the access package of this deployment
+			return nil
+		}
+
+		s.Data = expected.Data
+		_, err = secrets.Update(ctxChild, s, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil && !k8sutil.IsNotFound(err) {
+		log.Warn().Err(err).Str("secret-name", expected.GetName()).Msg("Failed to update applied access package Secret")
+	}
+}
+
+// getAccessPackageExpiration returns the expiration time of the client authentication certificate of the access package.
+// Returns zero time when the certificate cannot be parsed, so the access package is checked again.
+func getAccessPackageExpiration(secret *v1.Secret) time.Time {
+	kf, err := certificates.NewKeyfile(string(secret.Data[constants.SecretTLSKeyfile]))
+	if err != nil || len(kf.Certificate) == 0 {
+		return time.Time{}
+	}
+	cert, err := x509.ParseCertificate(kf.Certificate[0])
+	if err != nil {
+		return time.Time{}
+	}
+
+	return cert.NotAfter
+}
+
+// accessPackageRenewalReason returns the reason why the given access package secret needs to be renewed.
+// Returns an empty string when the access package is up to date.
+func accessPackageRenewalReason(secret *v1.Secret, clientAuthCA certificates.CA, tlsCACert string, now time.Time, renewalMargin time.Duration) string {
+	if string(secret.Data[constants.SecretCACertificate]) != tlsCACert {
+		return "TLS CA certificate changed"
+	}
+
+	kf, err := certificates.NewKeyfile(string(secret.Data[constants.SecretTLSKeyfile]))
+	if err != nil || len(kf.Certificate) == 0 {
+		return "Invalid client authentication keyfile"
+	}
+	cert, err := x509.ParseCertificate(kf.Certificate[0])
+	if err != nil {
+		return "Invalid client authentication certificate"
+	}
+
+	if !now.Add(renewalMargin).Before(cert.NotAfter) {
+		return fmt.Sprintf("Client authentication certificate expires at %s", cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if len(clientAuthCA.Certificate) == 0 {
+		return ""
+	}
+	if err := cert.CheckSignatureFrom(clientAuthCA.Certificate[0]); err != nil {
+		return "Client authentication CA certificate changed"
+	}
+
+	return ""
 }
@@ -20,10 +20,33 @@
 
 package deployment
 
-import "github.com/arangodb/kube-arangodb/pkg/metrics"
+import (
+	"context"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+)
 
 var (
 	inspectDeploymentAgencyIndex   = metrics.MustRegisterGaugeVec(metricsComponent, "inspect_deployment_agency_index", "Index of the agency cache", metrics.DeploymentName)
 	inspectDeploymentAgencyFetches = metrics.MustRegisterCounterVec(metricsComponent, "inspect_deployment_agency_fetches", "Number of agency fetches", metrics.DeploymentName)
 	inspectDeploymentAgencyErrors  = metrics.MustRegisterCounterVec(metricsComponent, "inspect_deployment_agency_errors", "Number of agency errors", metrics.DeploymentName)
 )
+
+// refreshAgencyStatus publishes the summary of the agency cache in the status
+func (d *Deployment) refreshAgencyStatus(ctx context.Context) error {
+	if !d.GetSpec().GetMode().HasAgents() {
+		return nil
+	}
+
+	state, ok := d.GetAgencyCache()
+	if !ok {
+		return nil
+	}
+
+	return d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		return agency.UpdateStatus(s, state, time.Now())
+	})
+}
@@ -98,6 +98,7 @@ func (c *cache) Reload(ctx context.Context, client agency.Agency) (uint64, error
 
 	if cfg.CommitIndex == c.commitIndex && c.valid {
 		// We are on same index, nothing to do
+		c.data.Leader = cfg.LeaderId
 		return cfg.CommitIndex, err
 	}
 
@@ -105,6 +106,8 @@ func (c *cache) Reload(ctx context.Context, client agency.Agency) (uint64, error
 		c.valid = false
 		return cfg.CommitIndex, err
 	} else {
+		data.Leader = cfg.LeaderId
+		data.CommitIndex = cfg.CommitIndex
 		c.data = data
 		c.valid = true
 		c.commitIndex = cfg.CommitIndex
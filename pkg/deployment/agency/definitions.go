@@ -35,6 +35,7 @@ const (
 
 	SupervisionKey            = "Supervision"
 	SupervisionMaintenanceKey = "Maintenance"
+	SupervisionStateKey       = "State"
 )
 
 func GetAgencyKey(parts ...string) string {
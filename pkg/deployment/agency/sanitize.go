@@ -0,0 +1,70 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package agency
+
+import "strings"
+
+// SanitizedValue replaces values of sensitive keys in the sanitized agency data
+const SanitizedValue = "<redacted>"
+
+var sensitiveKeys = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"credential",
+	"jwt",
+	"privatekey",
+}
+
+// Sanitize replaces values of keys which may hold credentials in the decoded agency JSON data.
+// Data is modified in place and returned.
+func Sanitize(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveKey(key) {
+				v[key] = SanitizedValue
+				continue
+			}
+
+			v[key] = Sanitize(value)
+		}
+	case []interface{}:
+		for id, value := range v {
+			v[id] = Sanitize(value)
+		}
+	}
+
+	return data
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(key))
+
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package agency
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Sanitize(t *testing.T) {
+	var data interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "agency": {
+    "arango": {
+      "Plan": {"Version": 12, "Databases": {"_system": {"name": "_system"}}},
+      "Target": {
+        "HotBackup": {"Transfers": [{"remote": "s3:bucket", "config": {"access_key_id": "id", "secret_access_key": "key"}}]},
+        "JWT-Secret": "abc",
+        "userPassword": "pass"
+      }
+    }
+  }
+}`), &data))
+
+	sanitized := Sanitize(data).(map[string]interface{})
+
+	arango := sanitized["agency"].(map[string]interface{})["arango"].(map[string]interface{})
+	require.Equal(t, float64(12), arango["Plan"].(map[string]interface{})["Version"])
+
+	target := arango["Target"].(map[string]interface{})
+	require.Equal(t, SanitizedValue, target["JWT-Secret"])
+	require.Equal(t, SanitizedValue, target["userPassword"])
+
+	transfer := target["HotBackup"].(map[string]interface{})["Transfers"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "s3:bucket", transfer["remote"])
+	config := transfer["config"].(map[string]interface{})
+	require.Equal(t, "id", config["access_key_id"])
+	require.Equal(t, SanitizedValue, config["secret_access_key"])
+}
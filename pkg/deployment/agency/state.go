@@ -40,7 +40,7 @@ func loadState(ctx context.Context, client agency.Agency) (State, error) {
 
 	var data []byte
 
-	req, err = req.SetBody(GetAgencyReadRequest(GetAgencyReadKey(GetAgencyKey(ArangoKey, SupervisionKey, SupervisionMaintenanceKey), GetAgencyKey(ArangoKey, PlanKey, PlanCollectionsKey), GetAgencyKey(ArangoKey, CurrentKey, PlanCollectionsKey))))
+	req, err = req.SetBody(GetAgencyReadRequest(GetAgencyReadKey(GetAgencyKey(ArangoKey, SupervisionKey, SupervisionMaintenanceKey), GetAgencyKey(ArangoKey, SupervisionKey, SupervisionStateKey), GetAgencyKey(ArangoKey, PlanKey, PlanCollectionsKey), GetAgencyKey(ArangoKey, CurrentKey, PlanCollectionsKey))))
 	if err != nil {
 		return State{}, err
 	}
@@ -91,6 +91,11 @@ type State struct {
 	Supervision StateSupervision `json:"Supervision"`
 	Plan        StatePlan        `json:"Plan"`
 	Current     StateCurrent     `json:"Current"`
+
+	// Leader holds the ID of the agency leader which returned the state
+	Leader string `json:"-"`
+	// CommitIndex holds the agency commit index of the state
+	CommitIndex uint64 `json:"-"`
 }
 
 type StateCurrent struct {
@@ -103,6 +108,13 @@ type StatePlan struct {
 
 type StateSupervision struct {
 	Maintenance StateExists `json:"Maintenance,omitempty"`
+
+	State StateSupervisionState `json:"State,omitempty"`
+}
+
+type StateSupervisionState struct {
+	// Mode holds the mode of the supervision (Normal, Maintenance)
+	Mode string `json:"Mode,omitempty"`
 }
 
 type StateExists bool
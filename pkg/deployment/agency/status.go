@@ -0,0 +1,61 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package agency
+
+import (
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StatusRefreshInterval defines how often the commit index is published when the rest of the summary did not change
+const StatusRefreshInterval = time.Minute
+
+// Summary returns the summary of the agency state
+func (s State) Summary(now time.Time) *api.DeploymentStatusAgencyState {
+	return &api.DeploymentStatusAgencyState{
+		Leader:          s.Leader,
+		CommitIndex:     s.CommitIndex,
+		SupervisionMode: s.Supervision.State.Mode,
+		Maintenance:     s.Supervision.Maintenance.Exists(),
+		UpdatedAt:       meta.NewTime(now),
+	}
+}
+
+// UpdateStatus publishes the summary of the agency state in the deployment status.
+// Returns true when the status was changed.
+func UpdateStatus(status *api.DeploymentStatus, state State, now time.Time) bool {
+	summary := state.Summary(now)
+
+	if status.Agency == nil {
+		status.Agency = &api.DeploymentStatusAgencyInfo{}
+	}
+
+	if current := status.Agency.State; current != nil && current.EqualSummary(summary) {
+		if current.CommitIndex == summary.CommitIndex || now.Sub(current.UpdatedAt.Time) < StatusRefreshInterval {
+			return false
+		}
+	}
+
+	status.Agency.State = summary
+	return true
+}
@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package agency
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_State_Supervision(t *testing.T) {
+	var s StateRoots
+
+	require.NoError(t, json.Unmarshal([]byte(`[{"arango":{"Supervision":{"State":{"Mode":"Normal","Timestamp":"2022-01-01T00:00:00Z"},"Maintenance":true}}}]`), &s))
+	require.Len(t, s, 1)
+
+	require.Equal(t, "Normal", s[0].Arango.Supervision.State.Mode)
+	require.True(t, s[0].Arango.Supervision.Maintenance.Exists())
+}
+
+func Test_UpdateStatus(t *testing.T) {
+	now := time.Now()
+
+	state := State{Leader: "AGNT-1", CommitIndex: 10}
+	state.Supervision.State.Mode = "Normal"
+
+	var status api.DeploymentStatus
+
+	require.True(t, UpdateStatus(&status, state, now))
+	require.NotNil(t, status.Agency)
+	require.NotNil(t, status.Agency.State)
+	require.Equal(t, "AGNT-1", status.Agency.State.Leader)
+	require.EqualValues(t, 10, status.Agency.State.CommitIndex)
+	require.Equal(t, "Normal", status.Agency.State.SupervisionMode)
+	require.False(t, status.Agency.State.Maintenance)
+
+	t.Run("Same state", func(t *testing.T) {
+		require.False(t, UpdateStatus(&status, state, now.Add(time.Hour)))
+	})
+
+	t.Run("Commit index is not published immediately", func(t *testing.T) {
+		state.CommitIndex = 11
+		require.False(t, UpdateStatus(&status, state, now.Add(time.Second)))
+		require.EqualValues(t, 10, status.Agency.State.CommitIndex)
+	})
+
+	t.Run("Commit index is published after refresh interval", func(t *testing.T) {
+		require.True(t, UpdateStatus(&status, state, now.Add(StatusRefreshInterval)))
+		require.EqualValues(t, 11, status.Agency.State.CommitIndex)
+	})
+
+	t.Run("Leader change is published immediately", func(t *testing.T) {
+		state.Leader = "AGNT-2"
+		require.True(t, UpdateStatus(&status, state, now.Add(StatusRefreshInterval+time.Second)))
+		require.Equal(t, "AGNT-2", status.Agency.State.Leader)
+	})
+}
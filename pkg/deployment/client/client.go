@@ -44,6 +44,12 @@ type Client interface {
 
 	GetJWT(ctx context.Context) (JWTDetails, error)
 	RefreshJWT(ctx context.Context) (JWTDetails, error)
+
+	GetStatistics(ctx context.Context) (Statistics, error)
+
+	GetEngineStatistics(ctx context.Context) (EngineStatistics, error)
+
+	GetReplicationApplierState(ctx context.Context) (ReplicationApplierState, error)
 }
 
 type client struct {
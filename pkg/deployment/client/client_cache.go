@@ -70,13 +70,13 @@ func (cc *cache) Connection(ctx context.Context, host string) (driver.Connection
 	return cc.factory.Connection(host)
 }
 
-func (cc *cache) extendHost(host string) string {
+func (cc *cache) extendHost(host string, port int) string {
 	scheme := "http"
 	if cc.in.GetSpec().TLS.IsSecure() {
 		scheme = "https"
 	}
 
-	return scheme + "://" + net.JoinHostPort(host, strconv.Itoa(k8sutil.ArangoPort))
+	return scheme + "://" + net.JoinHostPort(host, strconv.Itoa(port))
 }
 
 func (cc *cache) getClient(group api.ServerGroup, id string) (driver.Client, error) {
@@ -87,7 +87,7 @@ func (cc *cache) getClient(group api.ServerGroup, id string) (driver.Client, err
 		return nil, err
 	}
 
-	c, err := cc.factory.Client(cc.extendHost(m.GetEndpoint(endpoint)))
+	c, err := cc.factory.Client(cc.extendHost(m.GetEndpoint(endpoint), cc.in.GetSpec().GetServerGroupPort(group)))
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -123,7 +123,7 @@ func (cc *cache) GetAuth() conn.Auth {
 }
 
 func (cc *cache) getDatabaseClient() (driver.Client, error) {
-	c, err := cc.factory.Client(cc.extendHost(k8sutil.CreateDatabaseClientServiceDNSName(cc.in.GetAPIObject())))
+	c, err := cc.factory.Client(cc.extendHost(k8sutil.CreateDatabaseClientServiceDNSName(cc.in.GetAPIObject()), cc.in.GetSpec().GetDatabasePort()))
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -163,7 +163,7 @@ func (cc *cache) getAgencyClient() (agency.Agency, error) {
 			return nil, err
 		}
 
-		dnsNames = append(dnsNames, cc.extendHost(m.GetEndpoint(endpoint)))
+		dnsNames = append(dnsNames, cc.extendHost(m.GetEndpoint(endpoint), cc.in.GetSpec().GetServerGroupPort(api.ServerGroupAgents)))
 	}
 
 	if len(dnsNames) == 0 {
@@ -0,0 +1,68 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+const EngineStatisticsUrl = "/_api/engine/stats"
+
+type EngineStatistics struct {
+	// FreeDiskSpace holds the number of free bytes on the filesystem of the data directory
+	FreeDiskSpace uint64 `json:"rocksdb.free-disk-space,omitempty"`
+	// TotalDiskSpace holds the size in bytes of the filesystem of the data directory
+	TotalDiskSpace uint64 `json:"rocksdb.total-disk-space,omitempty"`
+}
+
+// GetUsedDiskSpace returns the number of used bytes on the filesystem of the data directory
+func (e EngineStatistics) GetUsedDiskSpace() uint64 {
+	if e.FreeDiskSpace > e.TotalDiskSpace {
+		return 0
+	}
+
+	return e.TotalDiskSpace - e.FreeDiskSpace
+}
+
+func (c *client) GetEngineStatistics(ctx context.Context) (EngineStatistics, error) {
+	req, err := c.c.NewRequest(http.MethodGet, EngineStatisticsUrl)
+	if err != nil {
+		return EngineStatistics{}, err
+	}
+
+	resp, err := c.c.Do(ctx, req)
+	if err != nil {
+		return EngineStatistics{}, err
+	}
+
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return EngineStatistics{}, err
+	}
+
+	var s EngineStatistics
+
+	if err := resp.ParseBody("", &s); err != nil {
+		return EngineStatistics{}, err
+	}
+
+	return s, nil
+}
@@ -34,6 +34,14 @@ type LicenseClient interface {
 
 type License struct {
 	Hash string `json:"hash,omitempty"`
+
+	Status   string          `json:"status,omitempty"`
+	Features LicenseFeatures `json:"features,omitempty"`
+}
+
+type LicenseFeatures struct {
+	// Expires holds the expiration time of the license as unix timestamp
+	Expires int64 `json:"expires,omitempty"`
 }
 
 func (c *client) GetLicense(ctx context.Context) (License, error) {
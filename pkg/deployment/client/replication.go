@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const ReplicationApplierStateUrl = "/_api/replication/applier-state"
+
+// hlcTimeShift defines the number of bits of hybrid logical clock ticks used by the logical counter
+const hlcTimeShift = 20
+
+type ReplicationApplierState struct {
+	State ReplicationApplierStateDetails `json:"state"`
+}
+
+type ReplicationApplierStateDetails struct {
+	Running bool `json:"running"`
+
+	LastAppliedContinuousTick   string `json:"lastAppliedContinuousTick,omitempty"`
+	LastAvailableContinuousTick string `json:"lastAvailableContinuousTick,omitempty"`
+}
+
+// IsFollower returns true when the global replication applier is running, what is the case on followers
+// in the active failover mode.
+func (r ReplicationApplierState) IsFollower() bool {
+	return r.State.Running
+}
+
+// GetLag returns the time by which the applied data is behind the data available on the leader.
+// Ticks are hybrid logical clock values, which hold the physical time in milliseconds in the upper bits.
+func (r ReplicationApplierState) GetLag() time.Duration {
+	applied, err := strconv.ParseUint(r.State.LastAppliedContinuousTick, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	available, err := strconv.ParseUint(r.State.LastAvailableContinuousTick, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	if available <= applied {
+		return 0
+	}
+
+	return time.Duration((available>>hlcTimeShift)-(applied>>hlcTimeShift)) * time.Millisecond
+}
+
+func (c *client) GetReplicationApplierState(ctx context.Context) (ReplicationApplierState, error) {
+	req, err := c.c.NewRequest(http.MethodGet, ReplicationApplierStateUrl)
+	if err != nil {
+		return ReplicationApplierState{}, err
+	}
+
+	req = req.SetQuery("global", "true")
+
+	resp, err := c.c.Do(ctx, req)
+	if err != nil {
+		return ReplicationApplierState{}, err
+	}
+
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return ReplicationApplierState{}, err
+	}
+
+	var s ReplicationApplierState
+
+	if err := resp.ParseBody("", &s); err != nil {
+		return ReplicationApplierState{}, err
+	}
+
+	return s, nil
+}
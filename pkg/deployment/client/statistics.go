@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const AdminStatisticsUrl = "/_admin/statistics"
+
+type Statistics struct {
+	Server StatisticsServer `json:"server,omitempty"`
+}
+
+type StatisticsServer struct {
+	// Uptime holds the number of seconds elapsed since the server was started
+	Uptime float64 `json:"uptime,omitempty"`
+
+	Threads StatisticsServerThreads `json:"threads,omitempty"`
+}
+
+type StatisticsServerThreads struct {
+	// SchedulerThreads holds the number of scheduler threads
+	SchedulerThreads int `json:"scheduler-threads,omitempty"`
+	// InProgress holds the number of requests which are processed
+	InProgress int `json:"in-progress,omitempty"`
+	// Queued holds the number of requests which are waiting in the scheduler queue
+	Queued int `json:"queued,omitempty"`
+}
+
+// GetUptime returns the time elapsed since the server was started
+func (s Statistics) GetUptime() time.Duration {
+	return time.Duration(s.Server.Uptime * float64(time.Second))
+}
+
+func (c *client) GetStatistics(ctx context.Context) (Statistics, error) {
+	req, err := c.c.NewRequest(http.MethodGet, AdminStatisticsUrl)
+	if err != nil {
+		return Statistics{}, err
+	}
+
+	resp, err := c.c.Do(ctx, req)
+	if err != nil {
+		return Statistics{}, err
+	}
+
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return Statistics{}, err
+	}
+
+	var s Statistics
+
+	if err := resp.ParseBody("", &s); err != nil {
+		return Statistics{}, err
+	}
+
+	return s, nil
+}
@@ -28,7 +28,10 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/arangodb/kube-arangodb/pkg/util/dryrun"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
+	"github.com/arangodb/kube-arangodb/pkg/util/vault"
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
 	"k8s.io/apimachinery/pkg/types"
@@ -88,6 +91,15 @@ func (d *Deployment) GetBackup(ctx context.Context, backup string) (*backupApi.A
 	return d.deps.Client.Arango().BackupV1().ArangoBackups(d.Namespace()).Get(ctxChild, backup, meta.GetOptions{})
 }
 
+// CreateBackup creates a backup resource in the namespace of the deployment
+func (d *Deployment) CreateBackup(ctx context.Context, backup *backupApi.ArangoBackup) error {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	_, err := d.deps.Client.Arango().BackupV1().ArangoBackups(d.Namespace()).Create(ctxChild, backup, meta.CreateOptions{})
+	return err
+}
+
 // GetAPIObject returns the deployment as k8s object.
 func (d *Deployment) GetAPIObject() k8sutil.APIObject {
 	return d.apiObject
@@ -102,6 +114,29 @@ func (d *Deployment) GetScope() scope.Scope {
 	return d.config.Scope
 }
 
+// GetVaultClient returns the client used to read secrets from Vault, nil when operator does not read secrets from Vault
+func (d *Deployment) GetVaultClient() vault.Client {
+	spec := d.apiObject.Spec.Vault
+	if !spec.IsJWTSourced() && !spec.IsTLSSourced() {
+		return nil
+	}
+
+	config := vault.Config{
+		Address:  spec.GetAddress(),
+		AuthPath: spec.GetAuthPath(),
+		Role:     spec.GetRole(),
+	}
+
+	d.vault.lock.Lock()
+	defer d.vault.lock.Unlock()
+
+	if c := d.vault.client; c == nil || c.Config().Address != config.Address || c.Config().AuthPath != config.AuthPath || c.Config().Role != config.Role {
+		d.vault.client = vault.NewClient(config)
+	}
+
+	return d.vault.client
+}
+
 func (d *Deployment) GetOperatorImage() string {
 	return d.config.OperatorImage
 }
@@ -255,7 +290,7 @@ func (d *Deployment) getConnConfig() (http.ConnectionConfig, error) {
 	}
 
 	connConfig := http.ConnectionConfig{
-		Transport:          transport,
+		Transport:          tracing.WrapTransport(dryrun.WrapArangoDTransport(transport)),
 		DontFollowRedirect: true,
 	}
 
@@ -270,14 +305,19 @@ func (d *Deployment) getAuth() (driver.Authentication, error) {
 	var secret string
 	var found bool
 
-	// Check if we can find token in folder
-	if i := d.apiObject.Status.CurrentImage; i == nil || features.JWTRotation().Supported(i.ArangoDBVersion, i.Enterprise) {
-		secret, found = d.getJWTFolderToken()
-	}
+	if d.apiObject.Spec.Vault.IsJWTSourced() {
+		// Token is kept in Vault, secret referenced by spec.auth.jwtSecretName is not used
+		secret, found = d.getVaultJWTToken()
+	} else {
+		// Check if we can find token in folder
+		if i := d.apiObject.Status.CurrentImage; i == nil || features.JWTRotation().Supported(i.ArangoDBVersion, i.Enterprise) {
+			secret, found = d.getJWTFolderToken()
+		}
 
-	// Fallback to token
-	if !found {
-		secret, found = d.getJWTToken()
+		// Fallback to token
+		if !found {
+			secret, found = d.getJWTToken()
+		}
 	}
 
 	if !found {
@@ -316,6 +356,26 @@ func (d *Deployment) getJWTFolderToken() (string, bool) {
 	return "", false
 }
 
+func (d *Deployment) getVaultJWTToken() (string, bool) {
+	c := d.GetVaultClient()
+	if c == nil {
+		return "", false
+	}
+
+	source := d.apiObject.Spec.Vault.JWT
+
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	token, err := c.Read(ctx, source.GetPath(), source.GetKey())
+	if err != nil {
+		d.deps.Log.Error().Err(err).Str("path", source.GetPath()).Msgf("Unable to read JWT from Vault")
+		return "", false
+	}
+
+	return token, true
+}
+
 func (d *Deployment) getJWTToken() (string, bool) {
 	s, err := d.GetCachedStatus().SecretReadInterface().Get(context.Background(), d.apiObject.Spec.Authentication.GetJWTSecretName(), meta.GetOptions{})
 	if err != nil {
@@ -612,8 +672,11 @@ func (d *Deployment) WithStatusUpdate(ctx context.Context, action reconciler.Dep
 	}, force...)
 }
 
+// SecretsModInterface returns the interface to modify secrets. Secrets created by the operator are labeled
+// with the deployment, so they are loaded by the inspector regardless of the inspector selector.
 func (d *Deployment) SecretsModInterface() secret.ModInterface {
-	return kclient.NewModInterface(d.deps.Client, d.namespace).Secrets()
+	return k8sutil.NewLabeledSecretsModInterface(kclient.NewModInterface(d.deps.Client, d.namespace).Secrets(),
+		k8sutil.LabelsForDeployment(d.GetName(), ""))
 }
 
 func (d *Deployment) PodsModInterface() podMod.ModInterface {
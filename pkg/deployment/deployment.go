@@ -58,28 +58,43 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/resilience"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/resources"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+	"github.com/arangodb/kube-arangodb/pkg/util/registry"
 	"github.com/arangodb/kube-arangodb/pkg/util/trigger"
+	"github.com/arangodb/kube-arangodb/pkg/util/vault"
 )
 
 // Config holds configuration settings for a Deployment
 type Config struct {
+	// OperatorPodName is the name of the operator pod, recorded in the status of the deployment
+	OperatorPodName           string
 	ServiceAccount            string
 	AllowChaos                bool
 	ScalingIntegrationEnabled bool
 	OperatorImage             string
 	ArangoImage               string
 	Scope                     scope.Scope
+	// MemberStateRefreshInterval defines how often the state of members is refreshed in the background.
+	// State is refreshed in each inspection when it is not set.
+	MemberStateRefreshInterval time.Duration
 }
 
 // Dependencies holds dependent services for a Deployment
 type Dependencies struct {
-	Log           zerolog.Logger
+	Log zerolog.Logger
+	// LogService is an optional service providing loggers of deployment components.
+	// Deployment logger is used for all components when not provided.
+	LogService    logging.Service
 	EventRecorder record.EventRecorder
 
 	Client kclient.Client
+
+	// Watcher is an optional inspector watcher shared between deployments of the same namespace.
+	// It is owned and started by the caller when provided.
+	Watcher *inspector.Watcher
 }
 
 // deploymentEventType strongly typed type of event
@@ -119,15 +134,21 @@ type Deployment struct {
 	config Config
 	deps   Dependencies
 
+	agencyLog zerolog.Logger
+
 	eventCh chan *deploymentEvent
 	stopCh  chan struct{}
 	stopped int32
+	// handover is set when the deployment is stopped to be handed over to the next operator.
+	handover int32
+	runDone  chan struct{}
 
 	inspectTrigger            trigger.Trigger
 	inspectCRDTrigger         trigger.Trigger
 	updateDeploymentTrigger   trigger.Trigger
 	clientCache               deploymentClient.Cache
 	currentState              inspectorInterface.Inspector
+	watcher                   *inspector.Watcher
 	agencyCache               agency.Cache
 	recentInspectionErrors    int
 	clusterScalingIntegration *clusterScalingIntegration
@@ -137,6 +158,16 @@ type Deployment struct {
 	chaosMonkey               *chaos.Monkey
 	syncClientCache           client.ClientCache
 	haveServiceMonitorCRD     bool
+	lastBackupTimestamp       int64
+	registryClient            registry.Client
+	vault                     struct {
+		lock   sync.Mutex
+		client vault.Client
+	}
+	backups struct {
+		state     backupsState
+		refreshed time.Time
+	}
 
 	memberState memberState.StateInspector
 }
@@ -217,17 +248,28 @@ func New(config Config, deps Dependencies, apiObject *api.ArangoDeployment) (*De
 		deps:        deps,
 		eventCh:     make(chan *deploymentEvent, deploymentEventQueueSize),
 		stopCh:      make(chan struct{}),
+		runDone:     make(chan struct{}),
 		agencyCache: agency.NewCache(apiObject.Spec.Mode),
+
+		registryClient: registry.NewClient(nil),
 	}
 
 	d.memberState = memberState.NewStateInspector(d)
 
+	if deps.Watcher != nil {
+		d.watcher = deps.Watcher
+	} else {
+		d.watcher = inspector.NewWatcher(deps.Log, deps.Client, apiObject.GetNamespace())
+		d.watcher.Run(d.stopCh)
+	}
+
 	d.clientCache = deploymentClient.NewClientCache(d, conn.NewFactory(d.getAuth, d.getConnConfig))
 
 	d.status.last = *(apiObject.Status.DeepCopy())
-	d.reconciler = reconcile.NewReconciler(deps.Log, d)
+	d.reconciler = reconcile.NewReconciler(d.componentLogger(logging.LoggerNameReconciliation), d)
 	d.resilience = resilience.NewResilience(deps.Log, d)
-	d.resources = resources.NewResources(deps.Log, d)
+	d.resources = resources.NewResources(d.componentLogger(logging.LoggerNameResources), d)
+	d.agencyLog = d.componentLogger(logging.LoggerNameAgency)
 	if d.status.last.AcceptedSpec == nil {
 		// We've validated the spec, so let's use it from now.
 		d.status.last.AcceptedSpec = apiObject.Spec.DeepCopy()
@@ -235,6 +277,13 @@ func New(config Config, deps Dependencies, apiObject *api.ArangoDeployment) (*De
 
 	localInventory.Add(d)
 
+	if interval := config.MemberStateRefreshInterval; interval > 0 {
+		go d.memberState.Run(d.stopCh, interval, func() api.DeploymentStatusMemberElements {
+			status, _ := d.GetStatus()
+			return status.Members.AsList()
+		})
+	}
+
 	go d.run()
 	go d.listenForPodEvents(d.stopCh)
 	go d.listenForPVCEvents(d.stopCh)
@@ -272,6 +321,15 @@ func (d *Deployment) Delete() {
 	}
 }
 
+// componentLogger returns the logger of the deployment component
+func (d *Deployment) componentLogger(name string) zerolog.Logger {
+	if d.deps.LogService == nil {
+		return d.deps.Log
+	}
+
+	return d.deps.LogService.MustGetLogger(name).With().Str("deployment", d.name).Logger()
+}
+
 // send given event into the deployment event queue.
 func (d *Deployment) send(ev *deploymentEvent) {
 	select {
@@ -291,6 +349,8 @@ func (d *Deployment) send(ev *deploymentEvent) {
 // It processes the event queue and polls the state of generated
 // resource on a regular basis.
 func (d *Deployment) run() {
+	defer close(d.runDone)
+
 	log := d.deps.Log
 
 	// Create agency mapping
@@ -304,6 +364,9 @@ func (d *Deployment) run() {
 			if err := d.resources.EnsureServiceMonitor(context.TODO()); err != nil {
 				d.CreateEvent(k8sutil.NewErrorEvent("Failed to create service monitor", err, d.GetAPIObject()))
 			}
+			if err := d.resources.EnsurePodMonitor(context.TODO()); err != nil {
+				d.CreateEvent(k8sutil.NewErrorEvent("Failed to create pod monitor", err, d.GetAPIObject()))
+			}
 		}
 
 		// Create initial topology
@@ -329,6 +392,13 @@ func (d *Deployment) run() {
 	for {
 		select {
 		case <-d.stopCh:
+			d.cleanupMetrics()
+
+			if atomic.LoadInt32(&d.handover) == 1 {
+				// Resources stay in place for the next operator.
+				log.Info().Msg("Deployment is handed over to the next operator")
+				return
+			}
 			cachedStatus, err := inspector.NewInspector(context.Background(), d.deps.Client, d.GetNamespace())
 			if err != nil {
 				log.Error().Err(err).Msg("Unable to get resources")
@@ -468,45 +538,53 @@ func (d *Deployment) updateCRStatus(ctx context.Context, force ...bool) error {
 
 	// Send update to API server
 	depls := d.deps.Client.Arango().DatabaseV1().ArangoDeployments(d.GetNamespace())
-	attempt := 0
-	for {
-		attempt++
-		q := patch.NewPatch(patch.ItemReplace(patch.NewPath("status"), d.status.last))
-
-		if d.apiObject.GetDeletionTimestamp() == nil {
-			if ensureFinalizers(d.apiObject) {
-				q.ItemAdd(patch.NewPath("metadata", "finalizers"), d.apiObject.Finalizers)
+	update := d.apiObject.DeepCopy()
+	err := k8sutil.RetryOnConflict(func() error {
+		return globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			// Finalizers can not be changed with the status subresource
+			if update.GetDeletionTimestamp() == nil && ensureFinalizers(update) {
+				current, err := depls.Update(ctxChild, update, meta.UpdateOptions{})
+				if err != nil {
+					return err
+				}
+				update = current.DeepCopy()
 			}
-		}
 
-		var newAPIObject *api.ArangoDeployment
-		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
-			p, err := q.Marshal()
+			update.Status = d.status.last
+			newAPIObject, err := depls.UpdateStatus(ctxChild, update, meta.UpdateOptions{})
+			if k8sutil.IsNotFound(err) {
+				// CRD is installed without the status subresource
+				newAPIObject, err = depls.Update(ctxChild, update, meta.UpdateOptions{})
+			}
 			if err != nil {
 				return err
 			}
 
-			newAPIObject, err = depls.Patch(ctxChild, d.GetName(), types.JSONPatchType, p, meta.PatchOptions{})
-
-			return err
-		})
-		if err == nil {
 			// Update internal object
 			d.apiObject = newAPIObject
 			return nil
-		}
-		if attempt < 10 {
-			continue
-		}
-		if err != nil {
-			d.deps.Log.Debug().Err(err).Msg("failed to patch ArangoDeployment status")
-			return errors.WithStack(errors.Newf("failed to patch ArangoDeployment status: %v", err))
-		}
+		})
+	}, func() error {
+		// API object may have been changed already, reload api object and try again
+		return globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			current, err := depls.Get(ctxChild, update.GetName(), meta.GetOptions{})
+			if err != nil {
+				return err
+			}
+			update = current.DeepCopy()
+			return nil
+		})
+	})
+	if err != nil {
+		d.deps.Log.Debug().Err(err).Msg("failed to update ArangoDeployment status")
+		return errors.WithStack(errors.Newf("failed to update ArangoDeployment status: %v", err))
 	}
+
+	return nil
 }
 
 // Update the spec part of the API object (d.apiObject)
-// to the given object, while preserving the status.
+// to the given object. The status is written by updateCRStatus.
 // On success, d.apiObject is updated.
 func (d *Deployment) updateCRSpec(ctx context.Context, newSpec api.DeploymentSpec, force ...bool) error {
 
@@ -519,46 +597,35 @@ func (d *Deployment) updateCRSpec(ctx context.Context, newSpec api.DeploymentSpe
 	}
 
 	// Send update to API server
+	depls := d.deps.Client.Arango().DatabaseV1().ArangoDeployments(d.GetNamespace())
 	update := d.apiObject.DeepCopy()
-	attempt := 0
-	for {
-		attempt++
+	err := k8sutil.RetryOnConflict(func() error {
 		update.Spec = newSpec
-		update.Status = d.status.last
-		ns := d.apiObject.GetNamespace()
-		var newAPIObject *api.ArangoDeployment
-		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
-			var err error
-			newAPIObject, err = d.deps.Client.Arango().DatabaseV1().ArangoDeployments(ns).Update(ctxChild, update, meta.UpdateOptions{})
-
-			return err
-		})
-		if err == nil {
+		return globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			newAPIObject, err := depls.Update(ctxChild, update, meta.UpdateOptions{})
+			if err != nil {
+				return err
+			}
 			// Update internal object
 			d.apiObject = newAPIObject
 			return nil
-		}
-		if attempt < 10 && k8sutil.IsConflict(err) {
-			// API object may have been changed already,
-			// Reload api object and try again
-			var current *api.ArangoDeployment
-
-			err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
-				var err error
-				current, err = d.deps.Client.Arango().DatabaseV1().ArangoDeployments(ns).Get(ctxChild, update.GetName(), meta.GetOptions{})
-
+		})
+	}, func() error {
+		// API object may have been changed already, reload api object and try again
+		return globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			current, err := depls.Get(ctxChild, update.GetName(), meta.GetOptions{})
+			if err != nil {
 				return err
-			})
-			if err == nil {
-				update = current.DeepCopy()
-				continue
 			}
-		}
-		if err != nil {
-			d.deps.Log.Debug().Err(err).Msg("failed to patch ArangoDeployment spec")
-			return errors.WithStack(errors.Newf("failed to patch ArangoDeployment spec: %v", err))
-		}
+			update = current.DeepCopy()
+			return nil
+		})
+	})
+	if err != nil {
+		d.deps.Log.Debug().Err(err).Msg("failed to patch ArangoDeployment spec")
+		return errors.WithStack(errors.Newf("failed to patch ArangoDeployment spec: %v", err))
 	}
+	return nil
 }
 
 // isOwnerOf returns true if the given object belong to this deployment.
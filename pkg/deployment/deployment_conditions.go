@@ -0,0 +1,163 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/version"
+)
+
+const (
+	// operatorStatusRefreshInterval defines how often the time of the last reconcile is refreshed in the status
+	operatorStatusRefreshInterval = time.Minute
+	// backupsStateRefreshInterval defines how often backups of the deployment are listed
+	backupsStateRefreshInterval = 30 * time.Second
+)
+
+// backupsState keeps the state of the backups of the deployment
+type backupsState struct {
+	// inProgress contains names of the backups which are being created, uploaded or downloaded
+	inProgress []string
+	// summary describes the backup operation in progress
+	summary string
+	// lastBackup is the creation time of the most recent ready backup
+	lastBackup meta.Time
+}
+
+// refreshStatusConditions updates conventional status conditions, the upgrade progress, the license, the summary and the operator identity of the deployment
+func (d *Deployment) refreshStatusConditions(ctx context.Context) error {
+	backups, err := d.getCachedBackupsState(ctx)
+	if err != nil {
+		return err
+	}
+
+	var lastBackupTimestamp int64
+	if !backups.lastBackup.IsZero() {
+		lastBackupTimestamp = backups.lastBackup.Unix()
+	}
+	atomic.StoreInt64(&d.lastBackupTimestamp, lastBackupTimestamp)
+
+	var licenseExpiring *api.Condition
+
+	if err := d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		changed := s.UpdateStatusConditions(backups.inProgress)
+
+		if s.UpdateUpgradeStatus(time.Now()) {
+			changed = true
+		}
+
+		wasExpiring, _ := s.Conditions.Get(api.ConditionTypeLicenseExpiring)
+		if s.UpdateLicenseStatus(time.Now(), d.GetSpec().License.GetExpirationWarningPeriod()) {
+			changed = true
+
+			if c, ok := s.Conditions.Get(api.ConditionTypeLicenseExpiring); ok && c.IsTrue() && (!wasExpiring.IsTrue() || c.Reason != wasExpiring.Reason) {
+				licenseExpiring = c.DeepCopy()
+			}
+		}
+
+		if s.UpdateSummary(backups.summary) {
+			changed = true
+		}
+
+		if s.UpdateOperator(d.config.OperatorPodName, string(version.GetVersionV1().Version), time.Now(), operatorStatusRefreshInterval) {
+			changed = true
+		}
+
+		return changed
+	}); err != nil {
+		return err
+	}
+
+	if licenseExpiring != nil {
+		d.CreateEvent(k8sutil.NewLicenseExpiringEvent(d.apiObject, licenseExpiring.Reason, licenseExpiring.Message))
+	}
+
+	return nil
+}
+
+// getCachedBackupsState returns the state of the backups of the deployment, backups are listed at most once per backupsStateRefreshInterval
+func (d *Deployment) getCachedBackupsState(ctx context.Context) (backupsState, error) {
+	if time.Since(d.backups.refreshed) < backupsStateRefreshInterval {
+		return d.backups.state, nil
+	}
+
+	state, err := d.getBackupsState(ctx)
+	if err != nil {
+		return backupsState{}, err
+	}
+
+	d.backups.state = state
+	d.backups.refreshed = time.Now()
+
+	return state, nil
+}
+
+// getBackupsState returns the state of the backups of the deployment
+func (d *Deployment) getBackupsState(ctx context.Context) (backupsState, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	backups, err := d.deps.Client.Arango().BackupV1().ArangoBackups(d.Namespace()).List(ctxChild, meta.ListOptions{})
+	if err != nil {
+		return backupsState{}, errors.Wrapf(err, "Unable to list backups")
+	}
+
+	var state backupsState
+	for _, b := range backups.Items {
+		if b.Spec.Deployment.Name != d.GetName() {
+			continue
+		}
+
+		var summary string
+		switch b.Status.State {
+		case backupApi.ArangoBackupStateCreate:
+			summary = "Creating backup"
+		case backupApi.ArangoBackupStateUpload, backupApi.ArangoBackupStateUploading:
+			summary = "Waiting for backup upload"
+		case backupApi.ArangoBackupStateDownload, backupApi.ArangoBackupStateDownloading:
+			summary = "Waiting for backup download"
+		case backupApi.ArangoBackupStateReady:
+			if b.Status.Backup != nil && state.lastBackup.Before(&b.Status.Backup.CreationTimestamp) {
+				state.lastBackup = b.Status.Backup.CreationTimestamp
+			}
+			continue
+		default:
+			continue
+		}
+
+		state.inProgress = append(state.inProgress, b.GetName())
+		if state.summary == "" {
+			state.summary = summary
+		}
+	}
+
+	return state, nil
+}
@@ -0,0 +1,70 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	"sync/atomic"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// Handover stops the deployment, so it can be taken over by the next operator.
+// In contrast to Delete, finalizers of deployment resources are not removed.
+// The inspection in progress is finished, but no new plans are created or executed.
+// Actions in progress are marked as handed over, so the next operator resumes them instead of timing them out.
+func (d *Deployment) Handover(ctx context.Context) error {
+	d.deps.Log.Info().Msg("deployment is handed over")
+	atomic.StoreInt32(&d.handover, 1)
+	if atomic.CompareAndSwapInt32(&d.stopped, 0, 1) {
+		close(d.stopCh)
+	}
+
+	// Wait for the inspection in progress
+	select {
+	case <-d.runDone:
+	case <-ctx.Done():
+		return errors.WithStack(ctx.Err())
+	}
+
+	return d.handoverPlans(ctx)
+}
+
+// handoverPlans marks started actions of all plans as handed over and persists them in the status.
+func (d *Deployment) handoverPlans(ctx context.Context) error {
+	status, lastVersion := d.GetStatus()
+
+	now := meta.Now()
+	plan, highPriorityPlan := status.Plan.Handover(now), status.HighPriorityPlan.Handover(now)
+	if plan.Equal(status.Plan) && highPriorityPlan.Equal(status.HighPriorityPlan) {
+		return nil
+	}
+
+	status.Plan = plan
+	status.HighPriorityPlan = highPriorityPlan
+	if err := d.UpdateStatus(ctx, status, lastVersion, true); err != nil {
+		return errors.Wrapf(err, "Unable to persist handed over actions")
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
 
@@ -50,8 +51,44 @@ import (
 
 var (
 	inspectDeploymentDurationGauges = metrics.MustRegisterGaugeVec(metricsComponent, "inspect_deployment_duration", "Amount of time taken by a single inspection of a deployment (in sec)", metrics.DeploymentName)
+	inspectDeploymentIterations     = metrics.MustRegisterCounterVec(metricsComponent, "inspect_deployment_iterations", "Number of inspections of a deployment", metrics.Namespace, metrics.DeploymentName)
+	inspectDeploymentErrors         = metrics.MustRegisterCounterVec(metricsComponent, "inspect_deployment_errors", "Number of failed inspections of a deployment", metrics.Namespace, metrics.DeploymentName)
+	inspectDeploymentDurations      = metrics.MustRegisterHistogramVec(metricsComponent, "inspect_deployment_duration_seconds", "Distribution of time taken by inspections of a deployment",
+		[]float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120}, metrics.Namespace, metrics.DeploymentName)
 )
 
+// cleanupMetrics removes per deployment metric series of the deployment
+func (d *Deployment) cleanupMetrics() {
+	namespace, name := d.GetNamespace(), d.GetName()
+
+	inspectDeploymentDurationGauges.DeleteLabelValues(name)
+	inspectDeploymentIterations.DeleteLabelValues(namespace, name)
+	inspectDeploymentErrors.DeleteLabelValues(namespace, name)
+	inspectDeploymentDurations.DeleteLabelValues(namespace, name)
+
+	inspectDeploymentAgencyIndex.DeleteLabelValues(name)
+	inspectDeploymentAgencyFetches.DeleteLabelValues(name)
+	inspectDeploymentAgencyErrors.DeleteLabelValues(name)
+
+	d.resources.CleanupMetrics()
+}
+
+// getInspector returns an inspector with resources taken from the watcher caches,
+// or listed from the API server when the watcher is not running.
+func (d *Deployment) getInspector(ctx context.Context) (i inspectorInterface.Inspector, err error) {
+	ctx, span := tracing.Start(ctx, "RefreshInspector")
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+
+	if d.watcher == nil {
+		return inspector.NewInspector(ctx, d.deps.Client, d.GetNamespace())
+	}
+
+	return inspector.NewInspectorFromWatcher(ctx, d.watcher)
+}
+
 // inspectDeployment inspects the entire deployment, creates
 // a plan to update if needed and inspects underlying resources.
 // This function should be called when:
@@ -63,7 +100,11 @@ func (d *Deployment) inspectDeployment(lastInterval util.Interval) util.Interval
 	log := d.deps.Log
 	start := time.Now()
 
-	ctxReconciliation, cancelReconciliation := globals.GetGlobalTimeouts().Reconciliation().WithTimeout(context.Background())
+	ctxTrace, span := tracing.Start(context.Background(), "InspectDeployment",
+		tracing.String("namespace", d.GetNamespace()), tracing.String("deployment", d.GetName()))
+	defer span.End()
+
+	ctxReconciliation, cancelReconciliation := globals.GetGlobalTimeouts().Reconciliation().WithTimeout(ctxTrace)
 	defer cancelReconciliation()
 	defer func() {
 		d.deps.Log.Info().Msgf("Inspect loop took %s", time.Since(start))
@@ -74,10 +115,19 @@ func (d *Deployment) inspectDeployment(lastInterval util.Interval) util.Interval
 
 	deploymentName := d.GetName()
 	defer metrics.SetDuration(inspectDeploymentDurationGauges.WithLabelValues(deploymentName), start)
+	defer func() {
+		inspectDeploymentIterations.WithLabelValues(d.GetNamespace(), deploymentName).Inc()
+		inspectDeploymentDurations.WithLabelValues(d.GetNamespace(), deploymentName).Observe(time.Since(start).Seconds())
+		if hasError {
+			inspectDeploymentErrors.WithLabelValues(d.GetNamespace(), deploymentName).Inc()
+			span.SetError(errors.Newf("Inspection failed"))
+		}
+	}()
 
-	cachedStatus, err := inspector.NewInspector(context.Background(), d.deps.Client, d.GetNamespace())
+	cachedStatus, err := d.getInspector(ctxTrace)
 	if err != nil {
 		log.Error().Err(err).Msg("Unable to get resources")
+		hasError = true
 		return minInspectionInterval // Retry ASAP
 	}
 
@@ -116,7 +166,9 @@ func (d *Deployment) inspectDeployment(lastInterval util.Interval) util.Interval
 
 		d.apiObject = updated
 
-		d.GetMembersState().RefreshState(ctxReconciliation, updated.Status.Members.AsList())
+		if !d.GetMembersState().IsRunning() {
+			d.GetMembersState().RefreshState(ctxReconciliation, updated.Status.Members.AsList())
+		}
 		d.GetMembersState().Log(d.deps.Log)
 
 		inspectNextInterval, err := d.inspectDeploymentWithError(ctxReconciliation, nextInterval, cachedStatus)
@@ -194,6 +246,10 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 		return minInspectionInterval, errors.Wrapf(err, "Service creation failed")
 	}
 
+	if err := d.resources.EnsureAdvertisedEndpoint(ctx, cachedStatus); err != nil {
+		return minInspectionInterval, errors.Wrapf(err, "Advertised endpoint update failed")
+	}
+
 	if err := d.resources.EnsureSecrets(ctx, d.deps.Log, cachedStatus); err != nil {
 		return minInspectionInterval, errors.Wrapf(err, "Secret creation failed")
 	}
@@ -252,9 +308,13 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 	inspectDeploymentAgencyFetches.WithLabelValues(d.GetName()).Inc()
 	if offset, err := d.RefreshAgencyCache(ctx); err != nil {
 		inspectDeploymentAgencyErrors.WithLabelValues(d.GetName()).Inc()
-		d.deps.Log.Err(err).Msgf("Unable to refresh agency")
+		d.agencyLog.Err(err).Msgf("Unable to refresh agency")
 	} else {
 		inspectDeploymentAgencyIndex.WithLabelValues(d.GetName()).Set(float64(offset))
+
+		if err := d.refreshAgencyStatus(ctx); err != nil {
+			d.agencyLog.Err(err).Msgf("Unable to update agency status")
+		}
 	}
 
 	// Refresh maintenance lock
@@ -295,6 +355,10 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 		}
 	}
 
+	if err := d.refreshStatusConditions(ctx); err != nil {
+		d.deps.Log.Warn().Err(err).Msgf("Unable to update status conditions")
+	}
+
 	if d.apiObject.Status.IsPlanEmpty() && status.AppliedVersion != checksum {
 		if err := d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
 			s.AppliedVersion = checksum
@@ -333,6 +397,16 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 		nextInterval = minInspectionInterval
 	}
 
+	// Roll back failed upgrade
+	if err := d.inspectUpgradeRollback(ctx); err != nil {
+		d.deps.Log.Warn().Err(err).Msgf("Unable to roll back upgrade")
+	}
+
+	// Follow the upgrade channel
+	if err := d.inspectUpgradeChannel(ctx); err != nil {
+		d.deps.Log.Warn().Err(err).Msgf("Unable to inspect upgrade channel")
+	}
+
 	// Create access packages
 	if err := d.createAccessPackages(ctx); err != nil {
 		return minInspectionInterval, errors.Wrapf(err, "AccessPackage creation failed")
@@ -422,6 +496,15 @@ func (d *Deployment) ensureResources(ctx context.Context, lastInterval util.Inte
 		if err := d.resources.EnsureServiceMonitor(ctx); err != nil {
 			return minInspectionInterval, errors.Wrapf(err, "Service monitor creation failed")
 		}
+
+		if err := d.resources.EnsurePodMonitor(ctx); err != nil {
+			return minInspectionInterval, errors.Wrapf(err, "Pod monitor creation failed")
+		}
+
+		// Alerts are optional, deployment is inspected further when the rule can not be created
+		if err := d.resources.EnsurePrometheusRule(ctx, cachedStatus); err != nil {
+			d.deps.Log.Warn().Err(err).Msgf("Prometheus rule creation failed")
+		}
 	}
 
 	if err := d.resources.EnsurePVCs(ctx, cachedStatus); err != nil {
@@ -101,7 +101,7 @@ func createTestLifecycle(group api.ServerGroup) *core.Lifecycle {
 		lifecycle, _ := k8sutil.NewLifecycleFinalizers()
 		return lifecycle
 	}
-	lifecycle, _ := k8sutil.NewLifecyclePort()
+	lifecycle, _ := k8sutil.NewLifecyclePort(k8sutil.ArangoPort)
 	return lifecycle
 }
 
@@ -0,0 +1,207 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/registry"
+)
+
+const (
+	upgradeChannelRegistryTimeout = 30 * time.Second
+)
+
+// inspectUpgradeChannel resolves the newest image of the upgrade channel (respecting check interval)
+// and updates the deployment image when the deployment is healthy and the maintenance window is active.
+// Upgrade itself is handled by the regular image discovery and upgrade procedure.
+func (d *Deployment) inspectUpgradeChannel(ctx context.Context) error {
+	spec := d.apiObject.Spec
+	upgrade := spec.Upgrade.Get()
+	channel := upgrade.GetChannel()
+	status, _ := d.GetStatus()
+
+	if channel == "" {
+		if status.UpgradeChannel == nil {
+			return nil
+		}
+
+		return d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+			s.UpgradeChannel = nil
+			return true
+		})
+	}
+
+	channelStatus := status.UpgradeChannel
+	if channelStatus == nil || channelStatus.Channel != channel || time.Since(channelStatus.LastCheck.Time) >= upgrade.GetChannelCheckInterval() {
+		checked := d.checkUpgradeChannel(ctx, channel, spec.GetImage())
+		if checked.Error != "" {
+			d.deps.Log.Warn().Str("channel", channel.String()).Str("error", checked.Error).Msgf("Unable to check upgrade channel")
+		}
+
+		if err := d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+			s.UpgradeChannel = &checked
+			return true
+		}); err != nil {
+			return errors.Wrapf(err, "Unable to update upgrade channel status")
+		}
+
+		channelStatus = &checked
+	}
+
+	if ok, reason := isUpgradeChannelImageAcceptable(channel, spec, status, channelStatus.Image); !ok {
+		if reason != "" {
+			d.deps.Log.Debug().Str("channel", channel.String()).Str("image", channelStatus.Image).Msgf("Upgrade channel image not applied: %s", reason)
+		}
+		return nil
+	}
+
+	if !spec.MaintenanceWindows.IsActive(time.Now()) {
+		return nil
+	}
+
+	newSpec := spec.DeepCopy()
+	newSpec.Image = util.NewString(channelStatus.Image)
+
+	if err := d.updateCRSpec(ctx, *newSpec); err != nil {
+		return errors.Wrapf(err, "Unable to update image")
+	}
+
+	d.deps.Log.Info().Str("channel", channel.String()).Str("from", spec.GetImage()).Str("to", channelStatus.Image).Msgf("Image updated according to upgrade channel")
+	d.CreateEvent(k8sutil.NewUpgradeChannelImageEvent(d.apiObject, channel.String(), spec.GetImage(), channelStatus.Image))
+
+	return nil
+}
+
+// checkUpgradeChannel fetches tags of the deployment image repository and returns the newest image of the channel
+func (d *Deployment) checkUpgradeChannel(ctx context.Context, channel api.UpgradeChannel, image string) api.DeploymentStatusUpgradeChannel {
+	r := api.DeploymentStatusUpgradeChannel{
+		Channel:   channel,
+		LastCheck: meta.Now(),
+	}
+
+	ref, err := registry.ParseImage(image)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+
+	keychain, err := d.getImagePullKeychain()
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+
+	ctxChild, cancel := context.WithTimeout(ctx, upgradeChannelRegistryTimeout)
+	defer cancel()
+
+	tags, err := d.registryClient.ListTags(ctxChild, ref, keychain)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+
+	tag, ok := channel.SelectLatest(tags)
+	if !ok {
+		r.Error = "No images found for the channel"
+		return r
+	}
+
+	r.Image = ref.WithTag(tag).String()
+	return r
+}
+
+// getImagePullKeychain returns registry credentials from the image pull secrets of the deployment
+func (d *Deployment) getImagePullKeychain() (registry.Keychain, error) {
+	var keychain registry.Keychain
+
+	for _, name := range d.apiObject.Spec.ImagePullSecrets {
+		secret, ok := d.GetCachedStatus().Secret(name)
+		if !ok {
+			return nil, errors.Newf("Image pull secret %s not found", name)
+		}
+
+		k, err := registry.KeychainFromSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		keychain = keychain.Merge(k)
+	}
+
+	return keychain, nil
+}
+
+// isUpgradeChannelImageAcceptable returns true when the deployment can be upgraded to the given channel image.
+// Reason is empty when there is nothing to do.
+func isUpgradeChannelImageAcceptable(channel api.UpgradeChannel, spec api.DeploymentSpec, status api.DeploymentStatus, image string) (bool, string) {
+	if image == "" || image == spec.GetImage() {
+		return false, ""
+	}
+
+	if u := status.Upgrade; u != nil && (u.FromImage == image || (u.Phase == api.DeploymentUpgradePhaseRolledBack && u.ToImage == image)) {
+		return false, "Image was rolled back or downgraded"
+	}
+
+	if status.Phase != api.DeploymentPhaseRunning {
+		return false, "Deployment is not running"
+	}
+
+	if !status.IsPlanEmpty() {
+		return false, "Plan is not empty"
+	}
+
+	if !status.Conditions.IsTrue(api.ConditionTypeUpToDate) {
+		return false, "Deployment is not up to date"
+	}
+
+	if status.CurrentImage == nil || status.CurrentImage.Image != spec.GetImage() {
+		return false, "Current image is not yet discovered"
+	}
+
+	current := status.CurrentImage.ArangoDBVersion
+	if !channel.Contains(current) {
+		return false, "Current version does not belong to the channel"
+	}
+
+	ref, err := registry.ParseImage(image)
+	if err != nil {
+		return false, err.Error()
+	}
+
+	if !channel.Contains(driver.Version(ref.Tag)) {
+		return false, "Image version does not belong to the channel"
+	}
+
+	if driver.Version(ref.Tag).CompareTo(current) <= 0 {
+		return false, ""
+	}
+
+	return true, ""
+}
@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	upgraderules "github.com/arangodb/go-upgrade-rules"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// inspectUpgradeRollback rolls the deployment back to the previous image when the upgrade failed
+// and spec.upgrade.autoRollback is enabled. Members are downgraded by the regular upgrade procedure.
+func (d *Deployment) inspectUpgradeRollback(ctx context.Context) error {
+	spec := d.apiObject.Spec
+	if !spec.Upgrade.Get().GetAutoRollback() {
+		return nil
+	}
+
+	status, _ := d.GetStatus()
+	upgrade := status.Upgrade
+	if upgrade == nil || upgrade.Phase != api.DeploymentUpgradePhaseFailed || upgrade.FromImage == "" || spec.GetImage() != upgrade.ToImage {
+		return nil
+	}
+
+	previous, ok := status.Images.GetByImage(upgrade.FromImage)
+	if !ok {
+		return nil
+	}
+
+	if err := getUpgradeRollbackError(status, previous); err != nil {
+		if err := d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+			return s.Conditions.Update(api.ConditionTypeUpgradeFailed, true, "Upgrade failed", fmt.Sprintf("Rollback not possible: %s", err.Error()))
+		}); err != nil {
+			return errors.Wrapf(err, "Unable to update status")
+		}
+		return nil
+	}
+
+	newSpec := spec.DeepCopy()
+	newSpec.Image = util.NewString(previous.Image)
+
+	if err := d.updateCRSpec(ctx, *newSpec); err != nil {
+		return errors.Wrapf(err, "Unable to update image")
+	}
+
+	if err := d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		s.CurrentImage = previous.DeepCopy()
+		if s.Upgrade != nil {
+			s.Upgrade = s.Upgrade.DeepCopy()
+			s.Upgrade.Phase = api.DeploymentUpgradePhaseRolledBack
+			s.Upgrade.CurrentMember = ""
+		}
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "Unable to update status")
+	}
+
+	d.deps.Log.Info().Str("from", upgrade.ToImage).Str("to", previous.Image).Msgf("Failed upgrade rolled back")
+	d.CreateEvent(k8sutil.NewUpgradeRollbackEvent(d.apiObject, upgrade.ToImage, previous.Image))
+
+	return nil
+}
+
+// getUpgradeRollbackError returns error when members already running the new version can not be downgraded to the previous image
+func getUpgradeRollbackError(status api.DeploymentStatus, previous api.ImageInfo) error {
+	return status.Members.ForeachServerGroup(func(group api.ServerGroup, list api.MemberStatusList) error {
+		if !group.IsArangod() {
+			return nil
+		}
+
+		for _, m := range list {
+			if m.Image == nil || m.Image.Image == previous.Image {
+				continue
+			}
+
+			if err := upgraderules.CheckUpgradeRulesWithLicense(m.Image.ArangoDBVersion, previous.ArangoDBVersion,
+				asUpgradeLicense(*m.Image), asUpgradeLicense(previous)); err != nil {
+				return errors.Newf("member %s can not be downgraded from %s to %s: %s", m.ID, m.Image.ArangoDBVersion, previous.ArangoDBVersion, err.Error())
+			}
+		}
+
+		return nil
+	})
+}
+
+func asUpgradeLicense(info api.ImageInfo) upgraderules.License {
+	if info.Enterprise {
+		return upgraderules.LicenseEnterprise
+	}
+	return upgraderules.LicenseCommunity
+}
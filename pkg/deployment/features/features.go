@@ -48,11 +48,17 @@ func (f feature) Supported(v driver.Version, enterprise bool) bool {
 	return Supported(&f, v, enterprise)
 }
 
+// Enabled returns true if the feature is enabled.
+// Value set at runtime takes precedence over the flag of the feature.
 func (f feature) Enabled() bool {
 	if f.constValue != nil {
 		return *f.constValue
 	}
 
+	if enabled, ok := getOverride(f.name); ok {
+		return enabled
+	}
+
 	return f.enabled
 }
 
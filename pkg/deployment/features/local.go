@@ -44,6 +44,9 @@ func registerFeature(f Feature) {
 		panic("Feature already registered")
 	}
 
+	// Default is used until flags are parsed
+	*f.EnabledPointer() = f.EnabledByDefault()
+
 	features[f.Name()] = f
 }
 
@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package features
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+var overrides = map[string]bool{}
+var overridesLock sync.RWMutex
+
+// getOverride returns the value of the feature set at runtime, if any.
+func getOverride(name string) (bool, bool) {
+	overridesLock.RLock()
+	defer overridesLock.RUnlock()
+
+	enabled, ok := overrides[name]
+	return enabled, ok
+}
+
+// ApplyOverrides enables or disables features at runtime. Keys are feature names, values are booleans.
+// Overrides take precedence over flags. Features missing in values fall back to their flag values.
+// When any of the values is invalid, previous overrides are kept.
+func ApplyOverrides(values map[string]string) error {
+	newOverrides := make(map[string]bool, len(values))
+
+	featuresLock.Lock()
+	for name, value := range values {
+		f, ok := features[name]
+		if !ok {
+			featuresLock.Unlock()
+			return errors.Newf("unknown feature %s", name)
+		}
+
+		enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			featuresLock.Unlock()
+			return errors.Wrapf(err, "invalid value of feature %s", name)
+		}
+
+		if c, ok := f.(*feature); ok && c.constValue != nil && *c.constValue != enabled {
+			featuresLock.Unlock()
+			return errors.Newf("feature %s can not be changed", name)
+		}
+
+		newOverrides[name] = enabled
+	}
+	featuresLock.Unlock()
+
+	overridesLock.Lock()
+	defer overridesLock.Unlock()
+
+	overrides = newOverrides
+
+	return nil
+}
+
+// EnabledFeatures returns names of all enabled features.
+func EnabledFeatures() []string {
+	featuresLock.Lock()
+	defer featuresLock.Unlock()
+
+	var r []string
+	for name, f := range features {
+		if f.Enabled() {
+			r = append(r, name)
+		}
+	}
+
+	sort.Strings(r)
+
+	return r
+}
@@ -0,0 +1,64 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package features
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ApplyOverrides(t *testing.T) {
+	defer func() {
+		require.NoError(t, ApplyOverrides(nil))
+	}()
+
+	require.False(t, EphemeralVolumes().Enabled())
+	require.True(t, WebhookValidation().Enabled())
+
+	t.Run("Override flags", func(t *testing.T) {
+		require.NoError(t, ApplyOverrides(map[string]string{
+			EphemeralVolumes().Name():  "true",
+			WebhookValidation().Name(): " false",
+		}))
+
+		require.True(t, EphemeralVolumes().Enabled())
+		require.False(t, WebhookValidation().Enabled())
+		require.Contains(t, EnabledFeatures(), EphemeralVolumes().Name())
+		require.NotContains(t, EnabledFeatures(), WebhookValidation().Name())
+	})
+
+	t.Run("Invalid values keep previous overrides", func(t *testing.T) {
+		require.EqualError(t, ApplyOverrides(map[string]string{"unknown": "true"}), "unknown feature unknown")
+		require.Error(t, ApplyOverrides(map[string]string{EphemeralVolumes().Name(): "maybe"}))
+		require.EqualError(t, ApplyOverrides(map[string]string{MetricsExporter().Name(): "false"}), "feature metrics-exporter can not be changed")
+
+		require.True(t, EphemeralVolumes().Enabled())
+		require.False(t, WebhookValidation().Enabled())
+	})
+
+	t.Run("Fall back to flags", func(t *testing.T) {
+		require.NoError(t, ApplyOverrides(map[string]string{}))
+
+		require.False(t, EphemeralVolumes().Enabled())
+		require.True(t, WebhookValidation().Enabled())
+	})
+}
@@ -31,11 +31,13 @@ import (
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/resources"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/utils"
+	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
@@ -45,6 +47,16 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/interfaces"
 )
 
+const (
+	// imageDiscoveryScheduleTimeout defines how long the image discovery pod can wait for scheduling before it is reported
+	imageDiscoveryScheduleTimeout = time.Minute
+	// imageDiscoveryArchitectureBackoff defines how long to wait before the image discovery pod is created again,
+	// after the image did not provide the requested architecture
+	imageDiscoveryArchitectureBackoff = 5 * time.Minute
+
+	imageDiscoveryFailedArchitectureReason = "Architecture Not Supported"
+)
+
 var _ interfaces.PodCreator = &ImageUpdatePod{}
 var _ interfaces.ContainerCreator = &ContainerIdentity{}
 
@@ -53,6 +65,7 @@ type ImageUpdatePod struct {
 	spec             api.DeploymentSpec
 	apiObject        k8sutil.APIObject
 	containerCreator interfaces.ContainerCreator
+	arch             api.ArangoDeploymentArchitectureType
 }
 
 // ContainerIdentity helps to resolve the container identity, e.g.: image ID, version of the entrypoint.
@@ -60,6 +73,7 @@ type ContainerIdentity struct {
 	ID              *api.ServerIDGroupSpec
 	image           string
 	imagePullPolicy core.PullPolicy
+	port            int
 }
 
 // ArangoDIdentity helps to resolve the ArangoD identity, e.g.: image ID, version of the entrypoint.
@@ -67,6 +81,7 @@ type ArangoDIdentity struct {
 	interfaces.ContainerCreator
 	License   api.LicenseSpec
 	ipAddress string
+	port      int
 }
 
 // ArangoSyncIdentity helps to resolve the ArangoSync identity, e.g.: image ID, version of the entrypoint.
@@ -111,10 +126,29 @@ func (d *Deployment) ensureImages(ctx context.Context, apiObject *api.ArangoDepl
 // image ID's into the status.Images list.
 // Returns: retrySoon, error
 func (ib *imagesBuilder) Run(ctx context.Context, cachedStatus inspectorInterface.Inspector) (bool, bool, error) {
+	if hint := ib.Spec.ImageHint; hint != nil {
+		// Image is described in the spec, discovery pod is not needed
+		return ib.applyImageHint(hint)
+	}
+
 	// Check ArangoDB image
-	if _, found := ib.Status.Images.GetByImage(ib.Spec.GetImage()); !found {
+	info, found := ib.Status.Images.GetByImage(ib.Spec.GetImage())
+	if !found {
 		// We need to find the image ID for the ArangoDB image
-		retrySoon, err := ib.fetchArangoDBImageIDAndVersion(ctx, cachedStatus, ib.Spec.GetImage())
+		retrySoon, err := ib.fetchArangoDBImageIDAndVersion(ctx, cachedStatus, ib.Spec.GetImage(), ib.Spec.Architecture.GetDefault())
+		if err != nil {
+			return retrySoon, false, errors.WithStack(err)
+		}
+		return retrySoon, false, nil
+	}
+
+	// Ensure that image provides all requested architectures
+	for _, arch := range ib.Spec.Architecture.GetAll() {
+		if info.HasArchitecture(arch) {
+			continue
+		}
+
+		retrySoon, err := ib.fetchArangoDBImageIDAndVersion(ctx, cachedStatus, ib.Spec.GetImage(), arch)
 		if err != nil {
 			return retrySoon, false, errors.WithStack(err)
 		}
@@ -124,16 +158,43 @@ func (ib *imagesBuilder) Run(ctx context.Context, cachedStatus inspectorInterfac
 	return false, true, nil
 }
 
+// applyImageHint stores the image info built from the image hint in the status.
+// Returns: retrySoon, exists, error
+func (ib *imagesBuilder) applyImageHint(hint *api.DeploymentImageHintSpec) (bool, bool, error) {
+	image := ib.Spec.GetImage()
+	info := hint.ImageInfo(image, ib.Spec.Architecture.GetAll())
+
+	if existing, ok := ib.Status.Images.GetByImage(image); ok && existing.Equal(&info) {
+		return false, true, nil
+	}
+
+	ib.Status.Images.AddOrUpdate(info)
+	ib.Status.Conditions.Remove(api.ConditionTypeImageDiscoveryFailed)
+	if err := ib.UpdateCRStatus(ib.Status); err != nil {
+		ib.Log.Warn().Err(err).Str("image", image).Msg("Failed to save Image Info in CR status")
+		return true, false, errors.WithStack(err)
+	}
+
+	ib.Log.Debug().
+		Str("image", image).
+		Str("arangodb-version", string(info.ArangoDBVersion)).
+		Msg("Image info set from image hint")
+
+	return false, true, nil
+}
+
 // fetchArangoDBImageIDAndVersion checks a running pod for fetching the ID of the given image.
-// When no pod exists, it is created, otherwise the ID is fetched & version detected.
+// When no pod exists, it is created on the node with given architecture, otherwise the ID is fetched & version detected.
 // Returns: retrySoon, error
-func (ib *imagesBuilder) fetchArangoDBImageIDAndVersion(ctx context.Context, cachedStatus inspectorInterface.Inspector, image string) (bool, error) {
+func (ib *imagesBuilder) fetchArangoDBImageIDAndVersion(ctx context.Context, cachedStatus inspectorInterface.Inspector, image string,
+	arch api.ArangoDeploymentArchitectureType) (bool, error) {
 	role := k8sutil.ImageIDAndVersionRole
-	id := fmt.Sprintf("%0x", sha1.Sum([]byte(image)))[:6]
+	id := imageIDPodID(image, arch, ib.Spec.Architecture.GetDefault())
 	podName := k8sutil.CreatePodName(ib.APIObject.GetName(), role, id, "")
 	log := ib.Log.With().
 		Str("pod", podName).
 		Str("image", image).
+		Str("arch", string(arch)).
 		Logger()
 
 	// Check if pod exists
@@ -142,6 +203,29 @@ func (ib *imagesBuilder) fetchArangoDBImageIDAndVersion(ctx context.Context, cac
 	pod, err := ib.Context.GetCachedStatus().PodReadInterface().Get(ctxChild, podName, metav1.GetOptions{})
 	if err == nil {
 		// Pod found
+		if k8sutil.IsPodImagePlatformNotAvailable(pod, utils.StringList{k8sutil.ServerContainerName}) {
+			// Image does not provide requested architecture, it will not start on this platform.
+			// Pod is created again after the backoff, in case the image was updated in the registry.
+			log.Warn().Msg("Image does not provide requested architecture")
+			if ib.Status.Conditions.UpdateWithHash(api.ConditionTypeImageDiscoveryFailed, true, imageDiscoveryFailedArchitectureReason,
+				fmt.Sprintf("Image %s does not provide architecture %s", image, arch), id) {
+				ib.Context.CreateEvent(k8sutil.NewImageArchitectureNotSupportedEvent(ib.APIObject, image, string(arch)))
+			} else {
+				// Restart the backoff
+				ib.Status.Conditions.Touch(api.ConditionTypeImageDiscoveryFailed)
+			}
+			if err := ib.UpdateCRStatus(ib.Status); err != nil {
+				return true, errors.WithStack(err)
+			}
+
+			err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+				return ib.Context.PodsModInterface().Delete(ctxChild, podName, metav1.DeleteOptions{})
+			})
+			if err != nil && !k8sutil.IsNotFound(err) {
+				log.Warn().Err(err).Msg("Failed to delete Image ID Pod")
+			}
+			return false, nil
+		}
 		if k8sutil.IsPodFailed(pod, utils.StringList{k8sutil.ServerContainerName}) {
 			// Wait some time before deleting the pod
 			if time.Now().After(pod.GetCreationTimestamp().Add(30 * time.Second)) {
@@ -155,6 +239,33 @@ func (ib *imagesBuilder) fetchArangoDBImageIDAndVersion(ctx context.Context, cac
 			}
 			return false, nil
 		}
+		if !k8sutil.IsPodScheduled(pod) {
+			if pod.GetAnnotations()[deployment.ArangoDeploymentImageDiscoveryChecksumAnnotation] != getImageDiscoveryChecksum(ib.Spec) {
+				// Placement of the pod changed, pod needs to be recreated
+				log.Info().Msg("Image discovery specification changed, recreating Image ID Pod")
+				err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+					return ib.Context.PodsModInterface().Delete(ctxChild, podName, metav1.DeleteOptions{})
+				})
+				if err != nil && !k8sutil.IsNotFound(err) {
+					log.Warn().Err(err).Msg("Failed to delete Image ID Pod")
+				}
+				return true, nil
+			}
+
+			if k8sutil.IsPodNotScheduledFor(pod, imageDiscoveryScheduleTimeout) {
+				// Image discovery has its own condition, PodSchedulingFailure is managed by the pod inspector for members only
+				log.Warn().Msg("Image ID Pod cannot be scheduled, check placement in spec.id")
+				if ib.Status.Conditions.UpdateWithHash(api.ConditionTypeImageDiscoveryFailed, true, "Pod Scheduling Timeout",
+					fmt.Sprintf("Image ID Pod %s cannot be scheduled, check placement in spec.id", podName), id) {
+					ib.Context.CreateEvent(k8sutil.NewPodsSchedulingFailureEvent([]string{podName}, ib.APIObject))
+					if err := ib.UpdateCRStatus(ib.Status); err != nil {
+						return true, errors.WithStack(err)
+					}
+				}
+			}
+
+			return true, nil
+		}
 		if !k8sutil.IsPodReady(pod) {
 			log.Debug().Msg("Image ID Pod is not yet ready")
 			return true, nil
@@ -165,13 +276,13 @@ func (ib *imagesBuilder) fetchArangoDBImageIDAndVersion(ctx context.Context, cac
 			log.Warn().Err(err).Msg("failed to get image ID from pod")
 			return true, nil
 		}
-		if imageID == "" {
-			// Fall back to specified image
+		if imageID == "" || api.IsImageDigest(image) {
+			// Fall back to specified image, images specified by digest are already pinned
 			imageID = image
 		}
 
 		// Try fetching the ArangoDB version
-		client, err := arangod.CreateArangodImageIDClient(ctx, ib.APIObject, pod.Status.PodIP)
+		client, err := arangod.CreateArangodImageIDClient(ctx, ib.APIObject, pod.Status.PodIP, ib.Spec.GetPort())
 		if err != nil {
 			log.Warn().Err(err).Msg("Failed to create Image ID Pod client")
 			return true, nil
@@ -200,8 +311,17 @@ func (ib *imagesBuilder) fetchArangoDBImageIDAndVersion(ctx context.Context, cac
 			ImageID:         imageID,
 			ArangoDBVersion: version,
 			Enterprise:      enterprise,
+			Architectures:   api.ArangoDeploymentArchitecture{arch},
+		}
+		if existing, ok := ib.Status.Images.GetByImage(image); ok && existing.ImageID == imageID {
+			// Keep architectures which were already verified for this image
+			info.Architectures = existing.Architectures.DeepCopy()
+			if !existing.HasArchitecture(arch) {
+				info.Architectures = append(info.Architectures, arch)
+			}
 		}
 		ib.Status.Images.AddOrUpdate(info)
+		ib.Status.Conditions.Remove(api.ConditionTypeImageDiscoveryFailed)
 		if err := ib.UpdateCRStatus(ib.Status); err != nil {
 			log.Warn().Err(err).Msg("Failed to save Image Info in CR status")
 			return true, errors.WithStack(err)
@@ -214,17 +334,27 @@ func (ib *imagesBuilder) fetchArangoDBImageIDAndVersion(ctx context.Context, cac
 		return false, nil
 	}
 
+	if c, ok := ib.Status.Conditions.Get(api.ConditionTypeImageDiscoveryFailed); ok && c.IsTrue() && c.Hash == id &&
+		c.Reason == imageDiscoveryFailedArchitectureReason && time.Since(c.LastUpdateTime.Time) < imageDiscoveryArchitectureBackoff {
+		// Image did not provide the architecture recently, wait before the next attempt
+		log.Debug().Msg("Image ID Pod creation is delayed after the architecture was not supported")
+		return false, nil
+	}
+
 	imagePod := ImageUpdatePod{
 		spec:      ib.Spec,
 		apiObject: ib.APIObject,
+		arch:      arch,
 		containerCreator: &ArangoDIdentity{
 			ContainerCreator: &ContainerIdentity{
 				ID:              ib.Spec.ID,
 				image:           image,
 				imagePullPolicy: ib.Spec.GetImagePullPolicy(),
+				port:            ib.Spec.GetPort(),
 			},
 			License:   ib.Spec.License,
 			ipAddress: ib.Spec.GetListenAddr(),
+			port:      ib.Spec.GetPort(),
 		},
 	}
 
@@ -246,8 +376,30 @@ func (ib *imagesBuilder) fetchArangoDBImageIDAndVersion(ctx context.Context, cac
 	return true, nil
 }
 
+// imageIDPodID returns the ID of the image discovery pod. Pod for the default architecture keeps
+// the ID based only on the image name.
+func imageIDPodID(image string, arch, defaultArch api.ArangoDeploymentArchitectureType) string {
+	if arch == defaultArch {
+		return fmt.Sprintf("%0x", sha1.Sum([]byte(image)))[:6]
+	}
+
+	return fmt.Sprintf("%0x", sha1.Sum([]byte(fmt.Sprintf("%s/%s", image, arch))))[:6]
+}
+
 func (i *ImageUpdatePod) Annotations() map[string]string {
-	return nil
+	return map[string]string{
+		deployment.ArangoDeploymentImageDiscoveryChecksumAnnotation: getImageDiscoveryChecksum(i.spec),
+	}
+}
+
+// getImageDiscoveryChecksum returns checksum of the image discovery pod specification
+func getImageDiscoveryChecksum(spec api.DeploymentSpec) string {
+	checksum, err := util.SHA256FromJSON(spec.ID)
+	if err != nil {
+		return ""
+	}
+
+	return checksum
 }
 
 func (i *ImageUpdatePod) Labels() map[string]string {
@@ -355,7 +507,7 @@ func (i *ImageUpdatePod) GetPodAffinity() *core.PodAffinity {
 func (i *ImageUpdatePod) GetNodeAffinity() *core.NodeAffinity {
 	a := core.NodeAffinity{}
 
-	pod.AppendArchSelector(&a, i.spec.Architecture)
+	pod.AppendArchSelector(&a, i.arch.AsArchitecture())
 
 	pod.MergeNodeAffinity(&a, i.spec.ID.Get().NodeAffinity)
 
@@ -402,7 +554,7 @@ func (a *ContainerIdentity) GetPorts() []core.ContainerPort {
 	return []core.ContainerPort{
 		{
 			Name:          k8sutil.ServerContainerName,
-			ContainerPort: int32(k8sutil.ArangoPort),
+			ContainerPort: int32(a.port),
 			Protocol:      core.ProtocolTCP,
 		},
 	}
@@ -429,7 +581,7 @@ func (a *ContainerIdentity) GetVolumeMounts() []core.VolumeMount {
 func (a *ArangoDIdentity) GetArgs() ([]string, error) {
 	return []string{
 		"--server.authentication=false",
-		fmt.Sprintf("--server.endpoint=tcp://%s:%d", a.ipAddress, k8sutil.ArangoPort),
+		fmt.Sprintf("--server.endpoint=tcp://%s:%d", a.ipAddress, a.port),
 		"--database.directory=" + k8sutil.ArangodVolumeMountDir,
 		"--log.output=+",
 	}, nil
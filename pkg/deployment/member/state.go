@@ -23,22 +23,48 @@ package member
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/arangodb/go-driver"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/client"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/reconciler"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	"github.com/rs/zerolog"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// stuckQueueLength defines the scheduler queue length at which member is considered stuck in the deep health check mode
+const stuckQueueLength = 4096
+
+// startedAtTolerance defines the maximum difference of the calculated server start time which is not reported as restart
+const startedAtTolerance = time.Minute
+
+// replicationLagTolerance defines the maximum change of the replication lag which is not reported in the status
+const replicationLagTolerance = 5 * time.Second
+
 type StateInspectorGetter interface {
 	GetMembersState() StateInspector
 }
 
+// StateMembersGetter returns members which state is refreshed in the background
+type StateMembersGetter func() api.DeploymentStatusMemberElements
+
 type StateInspector interface {
 	RefreshState(ctx context.Context, members api.DeploymentStatusMemberElements)
 	MemberState(id string) (State, bool)
 
+	// Run refreshes the state of members with the given interval until stopCh is closed
+	Run(stopCh <-chan struct{}, interval time.Duration, members StateMembersGetter)
+	// IsRunning returns true when the state is refreshed in the background
+	IsRunning() bool
+
+	// LastRefresh returns the time of the last finished refresh
+	LastRefresh() time.Time
+
 	Health() Health
 
 	State() State
@@ -46,7 +72,15 @@ type StateInspector interface {
 	Log(logger zerolog.Logger)
 }
 
-func NewStateInspector(client reconciler.DeploymentClient) StateInspector {
+type StateInspectorContext interface {
+	reconciler.DeploymentClient
+	reconciler.ArangoAgencyGet
+
+	// GetSpec returns the current specification of the deployment
+	GetSpec() api.DeploymentSpec
+}
+
+func NewStateInspector(client StateInspectorContext) StateInspector {
 	return &stateInspector{
 		client: client,
 	}
@@ -55,23 +89,80 @@ func NewStateInspector(client reconciler.DeploymentClient) StateInspector {
 type stateInspector struct {
 	lock sync.Mutex
 
+	// refreshLock serializes refreshes, so the state can be read while members are probed
+	refreshLock sync.Mutex
+
+	running bool
+
+	lastRefresh time.Time
+
 	members map[string]State
 
 	state State
 
 	health Health
 
-	client reconciler.DeploymentClient
+	client StateInspectorContext
 }
 
 func (s *stateInspector) Health() Health {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	return s.health
 }
 
 func (s *stateInspector) State() State {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	return s.state
 }
 
+func (s *stateInspector) IsRunning() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.running
+}
+
+func (s *stateInspector) LastRefresh() time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.lastRefresh
+}
+
+func (s *stateInspector) Run(stopCh <-chan struct{}, interval time.Duration, members StateMembersGetter) {
+	s.lock.Lock()
+	if s.running {
+		s.lock.Unlock()
+		return
+	}
+	s.running = true
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		s.running = false
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.RefreshState(context.Background(), members())
+
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (s *stateInspector) Log(logger zerolog.Logger) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -84,12 +175,17 @@ func (s *stateInspector) Log(logger zerolog.Logger) {
 }
 
 func (s *stateInspector) RefreshState(ctx context.Context, members api.DeploymentStatusMemberElements) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.refreshLock.Lock()
+	defer s.refreshLock.Unlock()
 
 	results := make([]State, len(members))
 
-	nctx, cancel := globals.GetGlobalTimeouts().ArangoDCheck().WithTimeout(ctx)
+	spec := s.client.GetSpec()
+
+	timeout := spec.Timeouts.GetMemberCheck(globals.GetGlobalTimeouts().ArangoDCheck().Get())
+	deep := spec.MemberHealthCheckMode.IsDeep()
+
+	nctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	members.ForEach(func(id int) {
@@ -107,9 +203,52 @@ func (s *stateInspector) RefreshState(ctx context.Context, members api.Deploymen
 		} else {
 			results[id].Version = v
 		}
+
+		// License and uptime are informational, member stays reachable when they can not be fetched
+		sc := client.NewClient(c.Connection())
+
+		if results[id].Version.IsEnterprise() {
+			if l, err := sc.GetLicense(nctx); err == nil {
+				results[id].License = &l
+			}
+		}
+
+		stats, err := sc.GetStatistics(nctx)
+		if err == nil {
+			results[id].Uptime = stats.GetUptime()
+		}
+
+		if deep {
+			known, stuck := checkMemberStuck(nctx, c, members[id].Group, stats, err)
+			results[id].Stuck = stuck
+			results[id].StuckUnknown = !known
+		}
+
+		switch members[id].Group {
+		case api.ServerGroupSingle, api.ServerGroupAgents, api.ServerGroupDBServers:
+			if engine, err := sc.GetEngineStatistics(nctx); err == nil && engine.TotalDiskSpace > 0 {
+				results[id].DiskTotal = engine.TotalDiskSpace
+				results[id].DiskUsed = engine.GetUsedDiskSpace()
+			}
+		}
+
+		if members[id].Group == api.ServerGroupSingle {
+			if applier, err := sc.GetReplicationApplierState(nctx); err == nil && applier.IsFollower() {
+				lag := applier.GetLag()
+				results[id].ReplicationLag = &lag
+			}
+		}
 	})
 
-	gctx, cancel := globals.GetGlobalTimeouts().ArangoDCheck().WithTimeout(ctx)
+	if agencyState, ok := s.client.GetAgencyCache(); ok {
+		for id := range members {
+			if members[id].Group == api.ServerGroupDBServers {
+				results[id].ShardsNotInSync = len(agency.GetDBServerShardsNotInSync(agencyState, members[id].Member.ID))
+			}
+		}
+	}
+
+	gctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	var cs State
@@ -127,9 +266,11 @@ func (s *stateInspector) RefreshState(ctx context.Context, members api.Deploymen
 		}
 	}
 
-	hctx, cancel := globals.GetGlobalTimeouts().ArangoDCheck().WithTimeout(ctx)
+	hctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	if cluster, err := c.Cluster(hctx); err != nil {
+	if err != nil {
+		h.Error = err
+	} else if cluster, err := c.Cluster(hctx); err != nil {
 		h.Error = err
 	} else {
 		if health, err := cluster.Health(hctx); err != nil {
@@ -145,9 +286,38 @@ func (s *stateInspector) RefreshState(ctx context.Context, members api.Deploymen
 		current[members[id].Member.ID] = results[id]
 	}
 
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	s.members = current
 	s.state = cs
 	s.health = h
+	s.lastRefresh = time.Now()
+}
+
+// checkMemberStuck returns error when member responds to the version request but is not able to serve requests.
+// State is unknown when statistics are not available (e.g. disabled on the server) and no other check is done for the group.
+func checkMemberStuck(ctx context.Context, c driver.Client, group api.ServerGroup, stats client.Statistics, statsErr error) (bool, error) {
+	known := false
+
+	if statsErr == nil {
+		known = true
+
+		if q := stats.Server.Threads.Queued; q >= stuckQueueLength {
+			return true, errors.Newf("Scheduler queue length exceeds %d", stuckQueueLength)
+		}
+	}
+
+	switch group {
+	case api.ServerGroupSingle, api.ServerGroupCoordinators:
+		known = true
+
+		if _, err := c.Database(ctx, "_system"); err != nil {
+			return true, errors.Wrapf(err, "Unable to read _system database")
+		}
+	}
+
+	return known, nil
 }
 
 func (s *stateInspector) MemberState(id string) (State, bool) {
@@ -173,6 +343,100 @@ type State struct {
 	Reachable error
 
 	Version driver.VersionInfo
+
+	// License is nil when license could not be fetched
+	License *client.License
+
+	// Uptime is 0 when statistics could not be fetched
+	Uptime time.Duration
+
+	// ReplicationLag is set only on followers in the active failover mode
+	ReplicationLag *time.Duration
+
+	ShardsNotInSync int
+
+	// DiskTotal and DiskUsed hold the size and usage of the filesystem of the data directory, 0 when not known
+	DiskTotal, DiskUsed uint64
+
+	// Stuck is set when member is reachable but is not able to serve requests, checked only in the deep health check mode
+	Stuck error
+
+	// StuckUnknown is set when it is not possible to check if member is stuck
+	StuckUnknown bool
+}
+
+// ServerStatus returns the status of the member server based on the state.
+// Start time calculated from the uptime is kept from the current status when it differs less than startedAtTolerance,
+// so the status is not updated on each refresh. The same applies to the replication lag and replicationLagTolerance.
+// Values which could not be fetched are kept from the current status.
+func (s State) ServerStatus(current *api.MemberServerStatus) *api.MemberServerStatus {
+	if !s.IsReachable() {
+		return current
+	}
+
+	status := &api.MemberServerStatus{
+		Version:         s.Version.Version,
+		License:         s.Version.License,
+		ShardsNotInSync: s.ShardsNotInSync,
+	}
+
+	if s.ReplicationLag != nil {
+		lag := s.ReplicationLag.Truncate(time.Second)
+
+		if current != nil && current.ReplicationLag != nil && absDuration(current.ReplicationLag.Duration-lag) < replicationLagTolerance {
+			lag = current.ReplicationLag.Duration
+		}
+
+		status.ReplicationLag = &meta.Duration{Duration: lag}
+	}
+
+	if s.License != nil {
+		if expires := s.License.Features.Expires; expires > 0 {
+			t := meta.Unix(expires, 0)
+			status.LicenseExpires = &t
+		}
+	} else if current != nil && current.Version == status.Version {
+		status.LicenseExpires = current.LicenseExpires.DeepCopy()
+	}
+
+	if s.DiskTotal > 0 {
+		status.Disk = &api.MemberServerDiskStatus{
+			Total:       *resource.NewQuantity(int64(s.DiskTotal), resource.BinarySI),
+			UsedPercent: s.DiskUsedPercent(),
+		}
+	}
+
+	if s.Uptime > 0 {
+		startedAt := time.Now().Add(-s.Uptime).Truncate(time.Second)
+
+		if current != nil && current.StartedAt != nil && absDuration(current.StartedAt.Time.Sub(startedAt)) < startedAtTolerance {
+			status.StartedAt = current.StartedAt.DeepCopy()
+		} else {
+			t := meta.NewTime(startedAt)
+			status.StartedAt = &t
+		}
+	} else if current != nil {
+		status.StartedAt = current.StartedAt.DeepCopy()
+	}
+
+	return status
+}
+
+// DiskUsedPercent returns the used part of the data directory filesystem in percents, 0 when not known
+func (s State) DiskUsedPercent() int {
+	if s.DiskTotal == 0 {
+		return 0
+	}
+
+	return int(s.DiskUsed * 100 / s.DiskTotal)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
 }
 
 func (s State) IsReachable() bool {
@@ -185,9 +449,18 @@ func (s State) Log(event *zerolog.Event) *zerolog.Event {
 	} else {
 		event = event.Bool("reachable", false)
 	}
+
+	if s.IsStuck() {
+		event = event.Bool("stuck", true).AnErr("stuckError", s.Stuck)
+	}
 	return event
 }
 
+// IsStuck returns true when member is reachable but is not able to serve requests
+func (s State) IsStuck() bool {
+	return s.IsReachable() && s.Stuck != nil
+}
+
 func (s State) IsInvalid() bool {
 	return !s.IsReachable()
 }
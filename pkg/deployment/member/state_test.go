@@ -0,0 +1,216 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package member
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/client"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_State_ServerStatus(t *testing.T) {
+	s := State{
+		Version: driver.VersionInfo{Version: "3.9.0", License: "enterprise"},
+		License: &client.License{Features: client.LicenseFeatures{Expires: 1700000000}},
+		Uptime:  time.Hour,
+	}
+
+	status := s.ServerStatus(nil)
+	require.NotNil(t, status)
+	require.EqualValues(t, "3.9.0", status.Version)
+	require.Equal(t, "enterprise", status.License)
+	require.NotNil(t, status.LicenseExpires)
+	require.Equal(t, int64(1700000000), status.LicenseExpires.Unix())
+	require.NotNil(t, status.StartedAt)
+	require.WithinDuration(t, time.Now().Add(-time.Hour), status.StartedAt.Time, 2*time.Second)
+
+	t.Run("Keep start time within tolerance", func(t *testing.T) {
+		s.Uptime = time.Hour + 10*time.Second
+
+		require.True(t, s.ServerStatus(status).Equal(status))
+	})
+
+	t.Run("Update start time after restart", func(t *testing.T) {
+		s.Uptime = time.Second
+
+		updated := s.ServerStatus(status)
+		require.False(t, updated.Equal(status))
+		require.True(t, updated.StartedAt.After(status.StartedAt.Time))
+	})
+
+	t.Run("Keep values which could not be fetched", func(t *testing.T) {
+		s := State{Version: driver.VersionInfo{Version: "3.9.0", License: "enterprise"}}
+
+		require.True(t, s.ServerStatus(status).Equal(status))
+	})
+
+	t.Run("Keep status of unreachable member", func(t *testing.T) {
+		current := &api.MemberServerStatus{StartedAt: &meta.Time{}}
+
+		require.Equal(t, current, State{Reachable: driver.ArangoError{}}.ServerStatus(current))
+	})
+
+	t.Run("Replication state", func(t *testing.T) {
+		applier := client.ReplicationApplierState{State: client.ReplicationApplierStateDetails{
+			Running:                     true,
+			LastAppliedContinuousTick:   "1048576000",
+			LastAvailableContinuousTick: "2096103424",
+		}}
+		lag := applier.GetLag()
+		require.Equal(t, 999*time.Millisecond, lag)
+
+		status := State{ReplicationLag: &lag, ShardsNotInSync: 2}.ServerStatus(nil)
+		require.Equal(t, time.Duration(0), status.GetReplicationLag())
+		require.NotNil(t, status.ReplicationLag)
+		require.Equal(t, 2, status.ShardsNotInSync)
+
+		t.Run("Keep lag within tolerance", func(t *testing.T) {
+			lag := 4 * time.Second
+			require.True(t, State{ReplicationLag: &lag, ShardsNotInSync: 2}.ServerStatus(status).Equal(status))
+		})
+
+		t.Run("Update lag above tolerance", func(t *testing.T) {
+			lag := time.Minute
+			require.Equal(t, time.Minute, State{ReplicationLag: &lag, ShardsNotInSync: 2}.ServerStatus(status).GetReplicationLag())
+		})
+	})
+}
+
+type testStateContext struct {
+	StateInspectorContext
+
+	spec api.DeploymentSpec
+}
+
+func (t testStateContext) GetServerClient(ctx context.Context, group api.ServerGroup, id string) (driver.Client, error) {
+	return nil, errors.Newf("server %s is not reachable", id)
+}
+
+func (t testStateContext) GetDatabaseClient(ctx context.Context) (driver.Client, error) {
+	return nil, errors.Newf("database is not reachable")
+}
+
+func (t testStateContext) GetAgencyCache() (agency.State, bool) {
+	return agency.State{}, false
+}
+
+func (t testStateContext) GetSpec() api.DeploymentSpec {
+	return t.spec
+}
+
+func Test_State_ServerStatus_Disk(t *testing.T) {
+	s := State{
+		Version:   driver.VersionInfo{Version: "3.9.0", License: "community"},
+		DiskTotal: 10 << 30,
+		DiskUsed:  9<<30 + 1,
+	}
+
+	require.Equal(t, 90, s.DiskUsedPercent())
+
+	status := s.ServerStatus(nil)
+	require.NotNil(t, status.Disk)
+	require.Equal(t, 90, status.Disk.UsedPercent)
+	require.Equal(t, "10Gi", status.Disk.Total.String())
+
+	t.Run("Unknown usage", func(t *testing.T) {
+		require.Equal(t, 0, State{}.DiskUsedPercent())
+		require.Nil(t, State{}.ServerStatus(nil).Disk)
+	})
+
+	t.Run("Small change does not update status", func(t *testing.T) {
+		s.DiskUsed += 1 << 20
+
+		require.True(t, s.ServerStatus(status).Equal(status))
+	})
+}
+
+func Test_StateInspector_Run(t *testing.T) {
+	s := NewStateInspector(testStateContext{})
+
+	members := func() api.DeploymentStatusMemberElements {
+		return api.DeploymentStatusMemberElements{
+			{Group: api.ServerGroupDBServers, Member: api.MemberStatus{ID: "dbserver"}},
+		}
+	}
+
+	stopCh := make(chan struct{})
+	go s.Run(stopCh, 10*time.Millisecond, members)
+
+	require.Eventually(t, func() bool {
+		return s.IsRunning() && !s.LastRefresh().IsZero()
+	}, time.Second, 5*time.Millisecond)
+
+	state, ok := s.MemberState("dbserver")
+	require.True(t, ok)
+	require.False(t, state.IsReachable())
+	require.Error(t, s.Health().Error)
+
+	close(stopCh)
+
+	require.Eventually(t, func() bool {
+		return !s.IsRunning()
+	}, time.Second, 5*time.Millisecond)
+}
+
+func Test_CheckMemberStuck(t *testing.T) {
+	t.Run("Statistics not available", func(t *testing.T) {
+		known, err := checkMemberStuck(context.Background(), nil, api.ServerGroupDBServers, client.Statistics{}, errors.New("disabled"))
+		require.False(t, known)
+		require.NoError(t, err)
+	})
+
+	t.Run("Queue below limit", func(t *testing.T) {
+		var stats client.Statistics
+		stats.Server.Threads.Queued = stuckQueueLength - 1
+
+		known, err := checkMemberStuck(context.Background(), nil, api.ServerGroupDBServers, stats, nil)
+		require.True(t, known)
+		require.NoError(t, err)
+	})
+
+	t.Run("Queue exceeded", func(t *testing.T) {
+		var stats client.Statistics
+		stats.Server.Threads.Queued = stuckQueueLength
+
+		known, err := checkMemberStuck(context.Background(), nil, api.ServerGroupDBServers, stats, nil)
+		require.True(t, known)
+		require.Error(t, err)
+
+		// Message does not change with the queue length
+		stats.Server.Threads.Queued = stuckQueueLength + 1
+		_, err2 := checkMemberStuck(context.Background(), nil, api.ServerGroupDBServers, stats, nil)
+		require.Equal(t, err.Error(), err2.Error())
+	})
+
+	t.Run("Stuck state", func(t *testing.T) {
+		require.False(t, State{}.IsStuck())
+		require.True(t, State{Stuck: errors.New("stuck")}.IsStuck())
+		require.False(t, State{Reachable: errors.New("unreachable"), Stuck: errors.New("stuck")}.IsStuck())
+	})
+}
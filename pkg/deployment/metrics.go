@@ -22,8 +22,11 @@ package deployment
 
 import (
 	"sync"
+	"sync/atomic"
 
+	driver "github.com/arangodb/go-driver"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	memberState "github.com/arangodb/kube-arangodb/pkg/deployment/member"
 	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -35,12 +38,20 @@ const (
 
 func init() {
 	localInventory = inventory{
-		deployments:                    map[string]map[string]*Deployment{},
-		deploymentsMetric:              metrics.NewDescription("arangodb_operator_deployments", "Number of active deployments", []string{"namespace", "deployment"}, nil),
-		deploymentMetricsMembersMetric: metrics.NewDescription("arango_operator_deployment_members", "List of members", []string{"namespace", "deployment", "role", "id"}, nil),
-		deploymentAgencyStateMetric:    metrics.NewDescription("arango_operator_deployment_agency_state", "Reachability of agency", []string{"namespace", "deployment"}, nil),
-		deploymentShardLeadersMetric:   metrics.NewDescription("arango_operator_deployment_shard_leaders", "Deployment leader shards distribution", []string{"namespace", "deployment", "database", "collection", "shard", "server"}, nil),
-		deploymentShardsMetric:         metrics.NewDescription("arango_operator_deployment_shards", "Deployment shards distribution", []string{"namespace", "deployment", "database", "collection", "shard", "server"}, nil),
+		deployments:                     map[string]map[string]*Deployment{},
+		deploymentsMetric:               metrics.NewDescription("arangodb_operator_deployments", "Number of active deployments", []string{"namespace", "deployment"}, nil),
+		deploymentMetricsMembersMetric:  metrics.NewDescription("arango_operator_deployment_members", "List of members", []string{"namespace", "deployment", "role", "id"}, nil),
+		deploymentAgencyStateMetric:     metrics.NewDescription("arango_operator_deployment_agency_state", "Reachability of agency", []string{"namespace", "deployment"}, nil),
+		deploymentShardLeadersMetric:    metrics.NewDescription("arango_operator_deployment_shard_leaders", "Deployment leader shards distribution", []string{"namespace", "deployment", "database", "collection", "shard", "server"}, nil),
+		deploymentShardsMetric:          metrics.NewDescription("arango_operator_deployment_shards", "Deployment shards distribution", []string{"namespace", "deployment", "database", "collection", "shard", "server"}, nil),
+		deploymentMemberReachableMetric: metrics.NewDescription("arango_operator_deployment_member_reachable", "Reachability of member", []string{"namespace", "deployment", "role", "id"}, nil),
+		deploymentMemberInfoMetric:      metrics.NewDescription("arango_operator_deployment_member_info", "Version and license of ArangoDB reported by member and its status reported by cluster (always 1)", []string{"namespace", "deployment", "role", "id", "version", "license", "status"}, nil),
+		deploymentMemberHealthMetric:    metrics.NewDescription("arango_operator_deployment_member_health", "Health of member reported by cluster (1 when status is GOOD)", []string{"namespace", "deployment", "role", "id"}, nil),
+		deploymentMemberDiskUsedMetric:  metrics.NewDescription("arango_operator_deployment_member_disk_used_bytes", "Used bytes of the filesystem of the member data directory", []string{"namespace", "deployment", "role", "id"}, nil),
+		deploymentMemberDiskTotalMetric: metrics.NewDescription("arango_operator_deployment_member_disk_total_bytes", "Size in bytes of the filesystem of the member data directory", []string{"namespace", "deployment", "role", "id"}, nil),
+		deploymentEventQueueMetric:      metrics.NewDescription("arango_operator_deployment_event_queue_depth", "Number of events waiting in the deployment event queue", []string{"namespace", "deployment"}, nil),
+		deploymentLastBackupMetric:      metrics.NewDescription("arango_operator_deployment_last_backup_timestamp", "Creation time of the most recent ready backup (unix timestamp in sec)", []string{"namespace", "deployment"}, nil),
+		deploymentLicenseExpiresMetric:  metrics.NewDescription("arango_operator_deployment_license_expires_timestamp", "Expiration time of the license installed in the deployment (unix timestamp in sec)", []string{"namespace", "deployment", "license"}, nil),
 	}
 
 	prometheus.MustRegister(&localInventory)
@@ -55,13 +66,22 @@ type inventory struct {
 	deployments map[string]map[string]*Deployment
 
 	deploymentsMetric, deploymentMetricsMembersMetric, deploymentAgencyStateMetric, deploymentShardsMetric, deploymentShardLeadersMetric metrics.Description
+
+	deploymentMemberReachableMetric, deploymentMemberInfoMetric, deploymentMemberHealthMetric metrics.Description
+
+	deploymentMemberDiskUsedMetric, deploymentMemberDiskTotalMetric metrics.Description
+
+	deploymentEventQueueMetric, deploymentLastBackupMetric, deploymentLicenseExpiresMetric metrics.Description
 }
 
 func (i *inventory) Describe(descs chan<- *prometheus.Desc) {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
-	metrics.NewPushDescription(descs).Push(i.deploymentsMetric, i.deploymentMetricsMembersMetric, i.deploymentAgencyStateMetric, i.deploymentShardLeadersMetric, i.deploymentShardsMetric)
+	metrics.NewPushDescription(descs).Push(i.deploymentsMetric, i.deploymentMetricsMembersMetric, i.deploymentAgencyStateMetric, i.deploymentShardLeadersMetric, i.deploymentShardsMetric,
+		i.deploymentMemberReachableMetric, i.deploymentMemberInfoMetric, i.deploymentMemberHealthMetric,
+		i.deploymentMemberDiskUsedMetric, i.deploymentMemberDiskTotalMetric, i.deploymentEventQueueMetric, i.deploymentLastBackupMetric,
+		i.deploymentLicenseExpiresMetric)
 }
 
 func (i *inventory) Collect(m chan<- prometheus.Metric) {
@@ -72,12 +92,24 @@ func (i *inventory) Collect(m chan<- prometheus.Metric) {
 	for _, deployments := range i.deployments {
 		for _, deployment := range deployments {
 			p.Push(i.deploymentsMetric.Gauge(1, deployment.GetNamespace(), deployment.GetName()))
+			p.Push(i.deploymentEventQueueMetric.Gauge(float64(len(deployment.eventCh)), deployment.GetNamespace(), deployment.GetName()))
+
+			// Timestamp is 0 when there is no ready backup, so the staleness alert fires as well
+			p.Push(i.deploymentLastBackupMetric.Gauge(float64(atomic.LoadInt64(&deployment.lastBackupTimestamp)), deployment.GetNamespace(), deployment.GetName()))
 
 			spec := deployment.GetSpec()
 			status, _ := deployment.GetStatus()
 
+			if l := status.License; l != nil && l.Expires != nil {
+				p.Push(i.deploymentLicenseExpiresMetric.Gauge(float64(l.Expires.Unix()), deployment.GetNamespace(), deployment.GetName(), l.Type))
+			}
+
+			health := deployment.GetMembersState().Health()
+
 			for _, member := range status.Members.AsList() {
 				p.Push(i.deploymentMetricsMembersMetric.Gauge(1, deployment.GetNamespace(), deployment.GetName(), member.Group.AsRole(), member.Member.ID))
+
+				i.collectMemberState(p, deployment, member, health)
 			}
 
 			if spec.Mode.Get().HasAgents() {
@@ -124,6 +156,45 @@ func (i *inventory) Collect(m chan<- prometheus.Metric) {
 	}
 }
 
+// collectMemberState pushes the state of the member collected by the member state inspector
+func (i *inventory) collectMemberState(p metrics.PushMetric, deployment *Deployment, member api.DeploymentStatusMemberElement, health memberState.Health) {
+	namespace, name, role, id := deployment.GetNamespace(), deployment.GetName(), member.Group.AsRole(), member.Member.ID
+
+	// Strings reported by the member are exposed only by the info metric, so state metrics keep their series
+	var version, license, status string
+	known := false
+
+	if state, ok := deployment.GetMembersState().MemberState(id); ok {
+		if state.IsReachable() {
+			p.Push(i.deploymentMemberReachableMetric.Gauge(1, namespace, name, role, id))
+			version, license, known = string(state.Version.Version), state.Version.License, true
+
+			if state.DiskTotal > 0 {
+				p.Push(i.deploymentMemberDiskUsedMetric.Gauge(float64(state.DiskUsed), namespace, name, role, id))
+				p.Push(i.deploymentMemberDiskTotalMetric.Gauge(float64(state.DiskTotal), namespace, name, role, id))
+			}
+		} else {
+			p.Push(i.deploymentMemberReachableMetric.Gauge(0, namespace, name, role, id))
+		}
+	}
+
+	if health.Error == nil && health.Members != nil {
+		if h, ok := health.Members[driver.ServerID(id)]; ok {
+			value := 0.0
+			if h.Status == driver.ServerStatusGood {
+				value = 1
+			}
+
+			p.Push(i.deploymentMemberHealthMetric.Gauge(value, namespace, name, role, id))
+			status, known = string(h.Status), true
+		}
+	}
+
+	if known {
+		p.Push(i.deploymentMemberInfoMetric.Gauge(1, namespace, name, role, id, version, license, status))
+	}
+}
+
 func (i *inventory) Add(d *Deployment) {
 	i.lock.Lock()
 	defer i.lock.Unlock()
@@ -0,0 +1,128 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package pod
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/interfaces"
+
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	AuditLogFileName      = "audit.log"
+	AuditLogContainerName = "audit-log"
+)
+
+// IsAuditLogEnabled returns true when audit log is enabled and supported by the member
+func IsAuditLogEnabled(i Input) bool {
+	return i.Enterprise && i.Group.IsArangod() && i.Deployment.AuditLog.IsEnabled()
+}
+
+// IsAuditLogFileOutput returns true when audit events are written into the file on the shared volume
+func IsAuditLogFileOutput(i Input) bool {
+	return IsAuditLogEnabled(i) && i.Deployment.AuditLog.GetOutput() == api.AuditLogOutputFile
+}
+
+func AuditLog() Builder {
+	return auditLog{}
+}
+
+type auditLog struct{}
+
+func (a auditLog) Envs(i Input) []core.EnvVar {
+	return nil
+}
+
+func (a auditLog) Args(i Input) k8sutil.OptionPairs {
+	if !IsAuditLogEnabled(i) {
+		return nil
+	}
+
+	spec := i.Deployment.AuditLog
+
+	opts := k8sutil.CreateOptionPairs()
+
+	if IsAuditLogFileOutput(i) {
+		opts.Add("--audit.output", fmt.Sprintf("file://%s", filepath.Join(k8sutil.AuditLogVolumeMountDir, AuditLogFileName)))
+	} else {
+		opts.Add("--audit.output", "-")
+	}
+
+	if hostname := spec.GetHostname(); hostname != "" {
+		opts.Add("--audit.hostname", hostname)
+	}
+
+	topics := make([]string, 0, len(spec.Topics))
+	for topic := range spec.Topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	for _, topic := range topics {
+		opts.Addf("--log.level", "%s=%s", topic, spec.Topics[topic])
+	}
+
+	return opts
+}
+
+func (a auditLog) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
+	if !IsAuditLogFileOutput(i) {
+		return nil, nil
+	}
+
+	return []core.Volume{k8sutil.CreateVolumeEmptyDir(k8sutil.AuditLogVolumeName)},
+		[]core.VolumeMount{{Name: k8sutil.AuditLogVolumeName, MountPath: k8sutil.AuditLogVolumeMountDir}}
+}
+
+func (a auditLog) Verify(i Input, cachedStatus interfaces.Inspector) error {
+	return nil
+}
+
+// AuditLogSidecar returns the container which collects the audit log file, nil when collector is not defined
+func AuditLogSidecar(i Input) *core.Container {
+	if !IsAuditLogFileOutput(i) {
+		return nil
+	}
+
+	s := i.Deployment.AuditLog.Sidecar
+	if s == nil {
+		return nil
+	}
+
+	c := s.DeepCopy()
+	if c.Name == "" {
+		c.Name = AuditLogContainerName
+	}
+
+	c.VolumeMounts = append(c.VolumeMounts, core.VolumeMount{
+		Name:      k8sutil.AuditLogVolumeName,
+		MountPath: k8sutil.AuditLogVolumeMountDir,
+		ReadOnly:  true,
+	})
+
+	return c
+}
@@ -0,0 +1,80 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package pod
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/interfaces"
+
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	ArangodLogFileName = "arangod.log"
+)
+
+func Directories() Builder {
+	return directories{}
+}
+
+type directories struct{}
+
+func (d directories) Envs(i Input) []core.EnvVar {
+	return nil
+}
+
+func (d directories) Args(i Input) k8sutil.OptionPairs {
+	opts := k8sutil.CreateOptionPairs()
+
+	if i.GroupSpec.LogVolume.IsEnabled() {
+		opts.Add("--log.output", fmt.Sprintf("file://%s", filepath.Join(k8sutil.ArangodLogsVolumeMountDir, ArangodLogFileName)))
+	}
+
+	if i.GroupSpec.AppsVolume.IsEnabled() {
+		opts.Add("--javascript.app-path", k8sutil.ArangodAppsVolumeMountDir)
+	}
+
+	return opts
+}
+
+func (d directories) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
+	var v []core.Volume
+	var vm []core.VolumeMount
+
+	if spec := i.GroupSpec.LogVolume; spec.IsEnabled() {
+		v = append(v, core.Volume{Name: k8sutil.ArangodLogsVolumeName, VolumeSource: spec.VolumeSource()})
+		vm = append(vm, core.VolumeMount{Name: k8sutil.ArangodLogsVolumeName, MountPath: k8sutil.ArangodLogsVolumeMountDir})
+	}
+
+	if spec := i.GroupSpec.AppsVolume; spec.IsEnabled() {
+		v = append(v, core.Volume{Name: k8sutil.ArangodAppsVolumeName, VolumeSource: spec.VolumeSource()})
+		vm = append(vm, core.VolumeMount{Name: k8sutil.ArangodAppsVolumeName, MountPath: k8sutil.ArangodAppsVolumeMountDir})
+	}
+
+	return v, vm
+}
+
+func (d directories) Verify(i Input, cachedStatus interfaces.Inspector) error {
+	return nil
+}
@@ -115,6 +115,11 @@ func IsEncryptionEnabled(i Input) bool {
 	return i.Deployment.RocksDB.IsEncrypted()
 }
 
+// IsEncryptionSourcedFromVault returns true when encryption key is provided to the pods by Vault
+func IsEncryptionSourcedFromVault(i Input) bool {
+	return IsEncryptionEnabled(i) && i.Deployment.Vault.IsEncryptionSourced()
+}
+
 func MultiFileMode(i Input) bool {
 	return features.EncryptionRotation().Supported(i.Version, i.Enterprise)
 }
@@ -133,6 +138,12 @@ func (e encryption) Args(i Input) k8sutil.OptionPairs {
 	if !IsEncryptionEnabled(i) {
 		return nil
 	}
+	if IsEncryptionSourcedFromVault(i) {
+		return k8sutil.NewOptionPair(k8sutil.OptionPair{
+			Key:   "--rocksdb.encryption-keyfile",
+			Value: vaultSecretFile(i, VaultEncryptionKeyName, k8sutil.RocksDBEncryptionVolumeMountDir, constants.SecretEncryptionKey),
+		})
+	}
 	if !MultiFileMode(i) {
 		keyPath := filepath.Join(k8sutil.RocksDBEncryptionVolumeMountDir, constants.SecretEncryptionKey)
 		return k8sutil.NewOptionPair(k8sutil.OptionPair{
@@ -151,6 +162,9 @@ func (e encryption) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
 	if !IsEncryptionEnabled(i) {
 		return nil, nil
 	}
+	if IsEncryptionSourcedFromVault(i) {
+		return vaultSecretVolumes(i, i.Deployment.Vault.Encryption, k8sutil.RocksdbEncryptionVolumeName, k8sutil.RocksdbEncryptionReadOnlyVolumeMount())
+	}
 	if !MultiFileMode(i) {
 		vol := k8sutil.CreateVolumeWithSecret(k8sutil.RocksdbEncryptionVolumeName, i.Deployment.RocksDB.Encryption.GetKeySecretName())
 		return []core.Volume{vol}, []core.VolumeMount{k8sutil.RocksdbEncryptionVolumeMount()}
@@ -165,7 +179,7 @@ func (e encryption) Verify(i Input, cachedStatus interfaces.Inspector) error {
 		return nil
 	}
 
-	if !GroupEncryptionSupported(i.Deployment.GetMode(), i.Group) {
+	if !GroupEncryptionSupported(i.Deployment.GetMode(), i.Group) || IsEncryptionSourcedFromVault(i) {
 		return nil
 	}
 
@@ -43,6 +43,11 @@ func IsAuthenticated(i Input) bool {
 	return i.Deployment.IsAuthenticated()
 }
 
+// IsJWTSourcedFromVault returns true when JWT secret is provided to the pods by Vault
+func IsJWTSourcedFromVault(i Input) bool {
+	return IsAuthenticated(i) && i.Deployment.Vault.IsJWTSourced()
+}
+
 func JWTSecretFolder(name string) string {
 	return fmt.Sprintf("%s-jwt-folder", name)
 }
@@ -71,7 +76,9 @@ func (e jwt) Args(i Input) k8sutil.OptionPairs {
 
 	options.Add("--server.authentication", "true")
 
-	if VersionHasJWTSecretKeyfolder(i.Version, i.Enterprise) {
+	if IsJWTSourcedFromVault(i) {
+		options.Add("--server.jwt-secret-keyfile", vaultSecretFile(i, VaultJWTSecretName, k8sutil.ClusterJWTSecretVolumeMountDir, constants.SecretKeyToken))
+	} else if VersionHasJWTSecretKeyfolder(i.Version, i.Enterprise) {
 		options.Add("--server.jwt-secret-folder", k8sutil.ClusterJWTSecretVolumeMountDir)
 	} else {
 		keyPath := filepath.Join(k8sutil.ClusterJWTSecretVolumeMountDir, constants.SecretKeyToken)
@@ -86,6 +93,10 @@ func (e jwt) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
 		return nil, nil
 	}
 
+	if IsJWTSourcedFromVault(i) {
+		return vaultSecretVolumes(i, i.Deployment.Vault.JWT, k8sutil.ClusterJWTSecretVolumeName, k8sutil.ClusterJWTVolumeMount())
+	}
+
 	var vol core.Volume
 	if VersionHasJWTSecretKeyfolder(i.Version, i.Enterprise) {
 		vol = k8sutil.CreateVolumeWithSecret(k8sutil.ClusterJWTSecretVolumeName, JWTSecretFolder(i.ApiObject.GetName()))
@@ -96,7 +107,7 @@ func (e jwt) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
 }
 
 func (e jwt) Verify(i Input, cachedStatus interfaces.Inspector) error {
-	if !IsAuthenticated(i) {
+	if !IsAuthenticated(i) || IsJWTSourcedFromVault(i) {
 		return nil
 	}
 
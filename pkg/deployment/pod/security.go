@@ -38,7 +38,10 @@ func (s security) Args(i Input) k8sutil.OptionPairs {
 
 	if features.EphemeralVolumes().Enabled() {
 		opts.Add("--temp.path", "/ephemeral/app")
-		opts.Add("--javascript.app-path", "/ephemeral/tmp")
+		if !i.GroupSpec.AppsVolume.IsEnabled() {
+			// Dedicated apps volume takes precedence
+			opts.Add("--javascript.app-path", "/ephemeral/tmp")
+		}
 	}
 
 	return opts
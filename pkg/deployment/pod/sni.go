@@ -81,7 +81,7 @@ func (s sni) Verify(i Input, cachedStatus interfaces.Inspector) error {
 		return nil
 	}
 
-	for _, secret := range util.SortKeys(i.Deployment.TLS.GetSNI().Mapping) {
+	for _, secret := range util.SortKeys(i.Deployment.GetTLSSNI().Mapping) {
 		kubeSecret, exists := cachedStatus.Secret(secret)
 		if !exists {
 			return errors.Newf("SNI Secret not found %s", secret)
@@ -100,7 +100,7 @@ func (s sni) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
 		return nil, nil
 	}
 
-	sni := i.Deployment.TLS.GetSNI()
+	sni := i.Deployment.GetTLSSNI()
 	volumes := make([]core.Volume, 0, len(sni.Mapping))
 	volumeMounts := make([]core.VolumeMount, 0, len(sni.Mapping))
 
@@ -138,8 +138,10 @@ func (s sni) Args(i Input) k8sutil.OptionPairs {
 
 	opts := k8sutil.CreateOptionPairs()
 
-	for _, volume := range util.SortKeys(i.Deployment.TLS.GetSNI().Mapping) {
-		servers, ok := i.Deployment.TLS.SNI.Mapping[volume]
+	mapping := i.Deployment.GetTLSSNI().Mapping
+
+	for _, volume := range util.SortKeys(mapping) {
+		servers, ok := mapping[volume]
 		if !ok {
 			continue
 		}
@@ -34,6 +34,13 @@ import (
 	core "k8s.io/api/core/v1"
 )
 
+const (
+	// sslProtocolTLSv12 is the arangod --ssl.protocol value of TLS 1.2
+	sslProtocolTLSv12 = "5"
+	// sslProtocolTLSv13 is the arangod --ssl.protocol value of TLS 1.3
+	sslProtocolTLSv13 = "6"
+)
+
 func IsRuntimeTLSKeyfileUpdateSupported(i Input) bool {
 	return IsTLSEnabled(i) && features.TLSRotation().Supported(i.Version, i.Enterprise) &&
 		i.Deployment.TLS.Mode.Get() == api.TLSRotateModeInPlace
@@ -85,5 +92,16 @@ func (s tls) Args(i Input) k8sutil.OptionPairs {
 	opts.Add("--ssl.keyfile", keyPath)
 	opts.Add("--ssl.ecdh-curve", "") // This way arangod accepts curves other than P256 as well.
 
+	switch i.Deployment.TLS.MinVersion.Get() {
+	case api.TLSVersion12:
+		opts.Add("--ssl.protocol", sslProtocolTLSv12)
+	case api.TLSVersion13:
+		opts.Add("--ssl.protocol", sslProtocolTLSv13)
+	}
+
+	if ciphers := i.Deployment.TLS.GetCiphers(); ciphers != "" {
+		opts.Add("--ssl.cipher-list", ciphers)
+	}
+
 	return opts
 }
@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package pod
+
+import (
+	"fmt"
+	"path/filepath"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	VaultAgentSecretsDir = "/vault/secrets"
+
+	VaultJWTSecretName     = "arangodb-jwt"
+	VaultEncryptionKeyName = "arangodb-encryption-key"
+
+	VaultAnnotationAgentInject         = "vault.hashicorp.com/agent-inject"
+	VaultAnnotationAgentInitFirst      = "vault.hashicorp.com/agent-init-first"
+	VaultAnnotationRole                = "vault.hashicorp.com/role"
+	VaultAnnotationAgentInjectSecret   = "vault.hashicorp.com/agent-inject-secret-"
+	VaultAnnotationAgentInjectTemplate = "vault.hashicorp.com/agent-inject-template-"
+)
+
+// VaultAnnotations returns annotations which instruct the Vault Agent injector to render secret material into the pod.
+func VaultAnnotations(spec api.DeploymentSpec, group api.ServerGroup) map[string]string {
+	v := spec.Vault
+	if !group.IsArangod() || v.GetMode() != api.VaultModeAgent {
+		return nil
+	}
+
+	annotations := map[string]string{}
+
+	add := func(name string, source *api.VaultSecretSpec, decode bool) {
+		if source == nil {
+			return
+		}
+
+		annotations[VaultAnnotationAgentInjectSecret+name] = source.GetPath()
+		annotations[VaultAnnotationAgentInjectTemplate+name] = vaultAgentTemplate(source, decode)
+	}
+
+	if spec.IsAuthenticated() {
+		add(VaultJWTSecretName, v.JWT, false)
+	}
+	if spec.RocksDB.IsEncrypted() {
+		add(VaultEncryptionKeyName, v.Encryption, true)
+	}
+
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	annotations[VaultAnnotationAgentInject] = "true"
+	// Secrets need to be rendered before any of the operator init containers starts arangod
+	annotations[VaultAnnotationAgentInitFirst] = "true"
+	annotations[VaultAnnotationRole] = v.GetRole()
+
+	return annotations
+}
+
+// vaultAgentTemplate returns the Vault Agent template which renders single field of the KV v2 secret.
+func vaultAgentTemplate(source *api.VaultSecretSpec, decode bool) string {
+	if t := source.GetTemplate(); t != "" {
+		return t
+	}
+
+	value := fmt.Sprintf("index .Data.data %q", source.GetKey())
+	if decode {
+		value = fmt.Sprintf("%s | base64Decode", value)
+	}
+
+	return fmt.Sprintf("{{- with secret %q -}}{{ %s }}{{- end -}}", source.GetPath(), value)
+}
+
+// vaultSecretFile returns the path of the file with secret material sourced from Vault.
+func vaultSecretFile(i Input, agentName, csiDir, csiKey string) string {
+	if i.Deployment.Vault.GetMode() == api.VaultModeAgent {
+		return filepath.Join(VaultAgentSecretsDir, agentName)
+	}
+
+	return filepath.Join(csiDir, csiKey)
+}
+
+// vaultSecretVolumes returns volumes with secret material sourced from Vault.
+// In agent mode files are provided by the injected Vault Agent, so no volumes are required.
+func vaultSecretVolumes(i Input, source *api.VaultSecretSpec, volumeName string, mount core.VolumeMount) ([]core.Volume, []core.VolumeMount) {
+	if i.Deployment.Vault.GetMode() == api.VaultModeAgent {
+		return nil, nil
+	}
+
+	return []core.Volume{k8sutil.CreateVolumeWithSecretProviderClass(volumeName, source.GetSecretProviderClass())},
+		[]core.VolumeMount{mount}
+}
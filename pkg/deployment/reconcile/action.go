@@ -27,6 +27,7 @@ import (
 	"time"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 	"github.com/rs/zerolog"
 )
 
@@ -91,6 +92,22 @@ func getActionReloadCachedStatus(a Action) bool {
 	}
 }
 
+// ActionReloadCachedStatusResources limits CachedStatus reloading to resources modified by the action
+type ActionReloadCachedStatusResources interface {
+	ActionReloadCachedStatus
+
+	// ReloadCachedStatusResources reloads resources modified by the action
+	ReloadCachedStatusResources(ctx context.Context, cachedStatus inspectorInterface.Inspector) error
+}
+
+func reloadActionCachedStatus(ctx context.Context, a Action, cachedStatus inspectorInterface.Inspector) error {
+	if c, ok := a.(ActionReloadCachedStatusResources); ok {
+		return c.ReloadCachedStatusResources(ctx, cachedStatus)
+	}
+
+	return cachedStatus.Refresh(ctx)
+}
+
 // ActionStartFailureGracePeriod extend action definition to allow specifying start failure grace period
 type ActionStartFailureGracePeriod interface {
 	Action
@@ -25,6 +25,7 @@ import (
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/resources"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 	"github.com/rs/zerolog/log"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
@@ -46,7 +47,7 @@ func newArangoMemberUpdatePodSpecAction(log zerolog.Logger, action api.Action, a
 	return a
 }
 
-var _ ActionReloadCachedStatus = &actionArangoMemberUpdatePodSpec{}
+var _ ActionReloadCachedStatusResources = &actionArangoMemberUpdatePodSpec{}
 
 // actionArangoMemberUpdatePodSpec implements an ArangoMemberUpdatePodSpec.
 type actionArangoMemberUpdatePodSpec struct {
@@ -158,3 +159,8 @@ func (a *actionArangoMemberUpdatePodSpec) Start(ctx context.Context) (bool, erro
 func (a *actionArangoMemberUpdatePodSpec) ReloadCachedStatus() bool {
 	return true
 }
+
+// ReloadCachedStatusResources reloads only resources modified by the action.
+func (a *actionArangoMemberUpdatePodSpec) ReloadCachedStatusResources(ctx context.Context, cachedStatus inspectorInterface.Inspector) error {
+	return cachedStatus.RefreshArangoMembers(ctx)
+}
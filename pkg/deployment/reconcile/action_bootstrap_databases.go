@@ -0,0 +1,139 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	"github.com/rs/zerolog"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+func init() {
+	registerAction(api.ActionTypeBootstrapDatabases, newBootstrapDatabasesAction, defaultTimeout)
+}
+
+func newBootstrapDatabasesAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &actionBootstrapDatabases{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx)
+
+	return a
+}
+
+// actionBootstrapDatabases creates databases and collections defined in spec.bootstrap.databases
+// and grants access to them. Existing databases and collections are kept as they are.
+type actionBootstrapDatabases struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a actionBootstrapDatabases) Start(ctx context.Context) (bool, error) {
+	spec := a.actionCtx.GetSpec()
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+	client, err := a.actionCtx.GetDatabaseClient(ctxChild)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	for _, database := range spec.Bootstrap.Databases {
+		if err := a.ensureDatabase(ctx, client, database); err != nil {
+			return false, errors.Wrapf(err, "Unable to bootstrap database %s", database.Name)
+		}
+	}
+
+	return true, nil
+}
+
+func (a actionBootstrapDatabases) ensureDatabase(ctx context.Context, client driver.Client, database api.BootstrapDatabase) error {
+	var db driver.Database
+	err := globals.GetGlobalTimeouts().ArangoD().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		exists, err := client.DatabaseExists(ctxChild, database.Name)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			db, err = client.Database(ctxChild, database.Name)
+			return err
+		}
+
+		a.log.Info().Str("database", database.Name).Msg("Creating database")
+		db, err = client.CreateDatabase(ctxChild, database.Name, nil)
+		return err
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, collection := range database.Collections {
+		if err := a.ensureCollection(ctx, db, collection); err != nil {
+			return errors.Wrapf(err, "Unable to create collection %s", collection.Name)
+		}
+	}
+
+	for user, grant := range database.Users {
+		err := globals.GetGlobalTimeouts().ArangoD().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			u, err := client.User(ctxChild, user)
+			if err != nil {
+				return err
+			}
+
+			return u.SetDatabaseAccess(ctxChild, db, driver.Grant(grant))
+		})
+		if err != nil {
+			return errors.Wrapf(err, "Unable to grant access to user %s", user)
+		}
+	}
+
+	return nil
+}
+
+func (a actionBootstrapDatabases) ensureCollection(ctx context.Context, db driver.Database, collection api.BootstrapCollection) error {
+	return globals.GetGlobalTimeouts().ArangoD().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		exists, err := db.CollectionExists(ctxChild, collection.Name)
+		if err != nil || exists {
+			return err
+		}
+
+		opts := &driver.CreateCollectionOptions{}
+		if collection.Type.Get() == api.BootstrapCollectionTypeEdge {
+			opts.Type = driver.CollectionTypeEdge
+		}
+		if a.actionCtx.GetMode() == api.DeploymentModeCluster {
+			opts.NumberOfShards = util.IntOrDefault(collection.NumberOfShards)
+			opts.ReplicationFactor = util.IntOrDefault(collection.ReplicationFactor)
+		}
+
+		a.log.Info().Str("database", db.Name()).Str("collection", collection.Name).Msg("Creating collection")
+		_, err = db.CreateCollection(ctxChild, collection.Name, opts)
+		return err
+	})
+}
@@ -63,6 +63,7 @@ type ActionContext interface {
 	reconciler.DeploymentInfoGetter
 	reconciler.DeploymentClient
 	reconciler.DeploymentSyncClient
+	reconciler.KubernetesEventGenerator
 
 	member.StateInspectorGetter
 
@@ -122,6 +123,8 @@ type ActionContext interface {
 	UpdateClusterCondition(ctx context.Context, conditionType api.ConditionType, status bool, reason, message string) error
 	// GetBackup receives information about a backup resource
 	GetBackup(ctx context.Context, backup string) (*backupApi.ArangoBackup, error)
+	// CreateBackup creates a backup resource in the namespace of the deployment
+	CreateBackup(ctx context.Context, backup *backupApi.ArangoBackup) error
 	// GetName receives information about a deployment name
 	GetName() string
 	// SelectImage select currently used image by pod
@@ -220,6 +223,10 @@ func (ac *actionContext) GetBackup(ctx context.Context, backup string) (*backupA
 	return ac.context.GetBackup(ctx, backup)
 }
 
+func (ac *actionContext) CreateBackup(ctx context.Context, backup *backupApi.ArangoBackup) error {
+	return ac.context.CreateBackup(ctx, backup)
+}
+
 func (ac *actionContext) WithStatusUpdateErr(ctx context.Context, action reconciler.DeploymentStatusUpdateErrFunc, force ...bool) error {
 	return ac.context.WithStatusUpdateErr(ctx, action, force...)
 }
@@ -0,0 +1,104 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arangodb/go-driver"
+	"github.com/rs/zerolog"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+func init() {
+	registerAction(api.ActionTypeInitFromBackup, newInitFromBackupAction, backupRestoreTimeout)
+}
+
+func newInitFromBackupAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &actionInitFromBackup{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx)
+
+	return a
+}
+
+// actionInitFromBackup restores the new deployment from the backup of spec.initFrom
+// and sets the InitializedFromBackup condition.
+type actionInitFromBackup struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a actionInitFromBackup) Start(ctx context.Context) (bool, error) {
+	if a.actionCtx.GetStatusSnapshot().Conditions.IsTrue(api.ConditionTypeInitializedFromBackup) {
+		return true, nil
+	}
+
+	name, ok := a.action.GetParam(initFromBackupParamBackup)
+	if !ok {
+		a.log.Warn().Msgf("Backup param is not set in action")
+		return true, nil
+	}
+
+	backup, err := a.actionCtx.GetBackup(ctx, name)
+	if err != nil {
+		return false, errors.WithStack(errors.Wrapf(err, "Unable to find backup %s", name))
+	}
+
+	if backup.Status.Backup == nil {
+		return false, errors.WithStack(errors.Newf("Backup ID of %s is not set", name))
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+	dbc, err := a.actionCtx.GetDatabaseClient(ctxChild)
+	if err != nil {
+		return false, err
+	}
+
+	// The below action can take a while so the full parent timeout context is used.
+	restoreError := dbc.Backup().Restore(ctx, driver.BackupID(backup.Status.Backup.ID), nil)
+
+	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		if restoreError != nil {
+			return s.Conditions.Update(api.ConditionTypeInitializedFromBackup, false, "Restore failed", restoreError.Error())
+		}
+
+		return s.Conditions.Update(api.ConditionTypeInitializedFromBackup, true, "Restored from backup",
+			fmt.Sprintf("Deployment has been initialized from backup %s (%s)", name, backup.Status.Backup.ID))
+	}); err != nil {
+		return false, err
+	}
+
+	if restoreError != nil {
+		return false, errors.WithStack(errors.Wrapf(restoreError, "Restore of backup %s failed", name))
+	}
+
+	a.log.Info().Str("backup", name).Msg("Deployment initialized from backup")
+
+	return true, nil
+}
@@ -0,0 +1,91 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func init() {
+	registerAction(api.ActionTypeInitFromBackupDownload, newInitFromBackupDownloadAction, defaultTimeout)
+}
+
+func newInitFromBackupDownloadAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &actionInitFromBackupDownload{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx)
+
+	return a
+}
+
+// actionInitFromBackupDownload creates the ArangoBackup which downloads the backup of spec.initFrom
+// into the deployment. The ArangoBackup is owned by the deployment.
+type actionInitFromBackupDownload struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a actionInitFromBackupDownload) Start(ctx context.Context) (bool, error) {
+	id, ok := a.action.GetParam(initFromBackupParamID)
+	if !ok {
+		a.log.Warn().Msgf("Backup ID param is not set in action")
+		return true, nil
+	}
+
+	repositoryURL, _ := a.action.GetParam(initFromBackupParamRepositoryURL)
+	credentialsSecretName, _ := a.action.GetParam(initFromBackupParamCredentialsSecretName)
+
+	apiObject := a.actionCtx.GetAPIObject()
+
+	backup := &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{
+			Name:            initFromBackupDownloadName(apiObject.GetName()),
+			OwnerReferences: []meta.OwnerReference{apiObject.AsOwner()},
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: apiObject.GetName(),
+			},
+			Download: &backupApi.ArangoBackupSpecDownload{
+				ArangoBackupSpecOperation: backupApi.ArangoBackupSpecOperation{
+					RepositoryURL:         repositoryURL,
+					CredentialsSecretName: credentialsSecretName,
+				},
+				ID: id,
+			},
+		},
+	}
+
+	if err := a.actionCtx.CreateBackup(ctx, backup); err != nil && !k8sutil.IsAlreadyExists(err) {
+		return false, err
+	}
+
+	return true, nil
+}
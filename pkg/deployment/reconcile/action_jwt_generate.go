@@ -0,0 +1,117 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/rs/zerolog"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	jwtRotationTrigger = "trigger"
+)
+
+func init() {
+	registerAction(api.ActionTypeJWTGenerate, newJWTGenerate, defaultTimeout)
+}
+
+func newJWTGenerate(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &jwtGenerateAction{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx)
+
+	return a
+}
+
+// jwtGenerateAction replaces token in the JWT secret with a newly generated one.
+// Propagation of the new token is handled by the JWT key update plan.
+type jwtGenerateAction struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *jwtGenerateAction) Start(ctx context.Context) (bool, error) {
+	folder, err := ensureJWTFolderSupportFromAction(a.actionCtx)
+	if err != nil {
+		a.log.Error().Err(err).Msgf("Action not supported")
+		return true, nil
+	}
+
+	if !folder {
+		a.log.Error().Msgf("Action not supported")
+		return true, nil
+	}
+
+	currentToken, exists := a.action.Params[checksum]
+	if !exists {
+		a.log.Warn().Msgf("Key %s is missing in action", checksum)
+		return true, nil
+	}
+
+	s, ok := a.actionCtx.GetCachedStatus().Secret(a.actionCtx.GetSpec().Authentication.GetJWTSecretName())
+	if !ok {
+		a.log.Error().Msgf("JWT Secret is missing, no rotation will take place")
+		return true, nil
+	}
+
+	if jwt, ok := s.Data[constants.SecretKeyToken]; !ok || util.SHA256(jwt) != currentToken {
+		a.log.Info().Msgf("JWT Secret changed")
+		return true, nil
+	}
+
+	tokenData := make([]byte, 32)
+	if _, err := rand.Read(tokenData); err != nil {
+		return false, errors.Wrapf(err, "Unable to generate JWT token")
+	}
+
+	s = s.DeepCopy()
+	s.Data[constants.SecretKeyToken] = []byte(hex.EncodeToString(tokenData))
+
+	if s.Annotations == nil {
+		s.Annotations = map[string]string{}
+	}
+	s.Annotations[deployment.ArangoDeploymentJWTRotatedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if trigger, ok := a.action.Params[jwtRotationTrigger]; ok {
+		s.Annotations[deployment.ArangoDeploymentJWTRotationTriggerAnnotation] = trigger
+	}
+
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := a.actionCtx.SecretsModInterface().Update(ctxChild, s, meta.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to update secret: %s", s.GetName())
+	}
+
+	return true, nil
+}
@@ -54,6 +54,10 @@ func ensureJWTFolderSupport(spec api.DeploymentSpec, status api.DeploymentStatus
 		return false, errors.Newf("Authentication is disabled")
 	}
 
+	if spec.Vault.IsJWTSourced() {
+		return false, errors.Newf("JWT is sourced from Vault")
+	}
+
 	if image := status.CurrentImage; image == nil {
 		return false, errors.Newf("Missing image info")
 	} else {
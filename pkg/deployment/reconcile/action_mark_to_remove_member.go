@@ -24,6 +24,7 @@ import (
 	"context"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/rs/zerolog"
 )
 
@@ -31,6 +32,11 @@ func init() {
 	registerAction(api.ActionTypeMarkToRemoveMember, newMarkToRemoveMemberAction, addMemberTimeout)
 }
 
+const (
+	// actionMarkToRemoveCannotExpandVolume is set when the member is replaced because its PVC cannot be expanded
+	actionMarkToRemoveCannotExpandVolume = "cannotExpandVolume"
+)
+
 func newMarkToRemoveMemberAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
 	a := &actionMarkToRemove{}
 
@@ -52,7 +58,8 @@ func (a *actionMarkToRemove) Start(ctx context.Context) (bool, error) {
 		return true, nil
 	}
 
-	return true, a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+	marked := false
+	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
 		member, group, ok := s.Members.ElementByID(a.action.MemberID)
 		if !ok {
 			return false
@@ -71,6 +78,16 @@ func (a *actionMarkToRemove) Start(ctx context.Context) (bool, error) {
 			return false
 		}
 
+		marked = true
 		return true
-	})
+	}); err != nil {
+		return true, err
+	}
+
+	if v, ok := a.action.GetParam(actionMarkToRemoveCannotExpandVolume); marked && ok && v == "true" {
+		a.actionCtx.CreateEvent(k8sutil.NewCannotExpandVolumeEvent(a.actionCtx.GetAPIObject(), a.action.MemberID,
+			a.action.Group.AsRole(), "StorageClass does not allow volume expansion, replacing member"))
+	}
+
+	return true, nil
 }
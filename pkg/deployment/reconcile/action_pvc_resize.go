@@ -26,6 +26,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	core "k8s.io/api/core/v1"
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/rs/zerolog"
@@ -35,6 +36,11 @@ func init() {
 	registerAction(api.ActionTypePVCResize, newPVCResizeAction, pvcResizeTimeout)
 }
 
+const (
+	// actionPVCResizeSize holds the requested size of the PVC. When not set, size from the group spec is used
+	actionPVCResizeSize = "size"
+)
+
 // newRotateMemberAction creates a new Action that implements the given
 // planned RotateMember action.
 func newPVCResizeAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
@@ -79,7 +85,14 @@ func (a *actionPVCResize) Start(ctx context.Context) (bool, error) {
 	}
 
 	var res core.ResourceList
-	if groupSpec.HasVolumeClaimTemplate() {
+	if size, ok := a.action.GetParam(actionPVCResizeSize); ok {
+		q, err := resource.ParseQuantity(size)
+		if err != nil {
+			log.Error().Err(err).Str("size", size).Msg("Invalid requested size")
+			return true, nil
+		}
+		res = core.ResourceList{core.ResourceStorage: q}
+	} else if groupSpec.HasVolumeClaimTemplate() {
 		res = groupSpec.GetVolumeClaimTemplate().Spec.Resources.Requests
 	} else {
 		res = groupSpec.Resources.Requests
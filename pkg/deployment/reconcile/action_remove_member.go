@@ -22,6 +22,7 @@ package reconcile
 
 import (
 	"context"
+	"time"
 
 	"github.com/arangodb/go-driver"
 	"github.com/rs/zerolog"
@@ -32,6 +33,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 func init() {
@@ -112,9 +114,17 @@ func (a *actionRemoveMember) Start(ctx context.Context) (bool, error) {
 			}
 		}
 	}
-	// Remove the pvc (if any)
+	// Remove or retain the pvc (if any)
 	if m.PersistentVolumeClaimName != "" {
-		if err := a.actionCtx.DeletePvc(ctx, m.PersistentVolumeClaimName); err != nil {
+		if a.actionCtx.GetSpec().GetServerGroupSpec(a.action.Group).PVCDeletionPolicy.Get() == api.PVCDeletionPolicyRetain {
+			a.log.Info().Str("pvc", m.PersistentVolumeClaimName).Msg("Retaining PVC of removed member")
+			err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+				return k8sutil.RetainPersistentVolumeClaim(ctxChild, a.actionCtx.PersistentVolumeClaimsModInterface(), m.PersistentVolumeClaimName, m.ID, time.Now())
+			})
+			if err != nil {
+				return false, errors.WithStack(err)
+			}
+		} else if err := a.actionCtx.DeletePvc(ctx, m.PersistentVolumeClaimName); err != nil {
 			if !apiErrors.IsNotFound(err) {
 				return false, errors.WithStack(err)
 			}
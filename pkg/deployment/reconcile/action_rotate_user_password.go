@@ -0,0 +1,97 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+func init() {
+	registerAction(api.ActionTypeRotateUserPassword, newRotateUserPasswordAction, defaultTimeout)
+}
+
+func newRotateUserPasswordAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &actionRotateUserPassword{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx)
+
+	return a
+}
+
+// actionRotateUserPassword sets the password of the user from its secret, after the bootstrap is completed.
+// The secret is created with a random password if it does not exist.
+type actionRotateUserPassword struct {
+	actionBootstrapSetPassword
+}
+
+func (a actionRotateUserPassword) Start(ctx context.Context) (bool, error) {
+	spec := a.actionCtx.GetSpec()
+
+	user, ok := a.action.GetParam("user")
+	if !ok {
+		a.log.Warn().Msgf("User param is not set in action")
+		return true, nil
+	}
+
+	secret, ok := spec.Bootstrap.PasswordSecretNames[user]
+	if !ok || secret.IsNone() {
+		a.log.Warn().Str("user", user).Msgf("Password secret of user is not set")
+		return true, nil
+	}
+
+	ctxChild, cancel := globals.GetGlobals().Timeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+
+	password, err := a.setUserPassword(ctxChild, user, secret.Get())
+	if err != nil {
+		return false, err
+	}
+
+	passwordSha := util.SHA256FromString(password)
+
+	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		if s.SecretHashes == nil {
+			s.SecretHashes = &api.SecretHashes{}
+		}
+
+		if s.SecretHashes.Users == nil {
+			s.SecretHashes.Users = map[string]string{}
+		}
+
+		s.SecretHashes.Users[user] = passwordSha
+		s.Conditions.UpdateWithHash(api.ConditionTypePasswordRotated, true, "Password rotated",
+			fmt.Sprintf("Password of user %s has been rotated using secret %s", user, secret.Get()), passwordSha)
+		return true
+	}); err != nil {
+		return false, err
+	}
+
+	a.log.Info().Str("user", user).Str("secret", secret.Get()).Msg("Password rotated")
+
+	return true, nil
+}
@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -48,7 +49,7 @@ func runtimeContainerArgsUpdate(log zerolog.Logger, action api.Action, actionCtx
 	return a
 }
 
-var _ ActionReloadCachedStatus = &actionRuntimeContainerArgsUpdate{}
+var _ ActionReloadCachedStatusResources = &actionRuntimeContainerArgsUpdate{}
 var _ ActionPost = &actionRuntimeContainerArgsUpdate{}
 
 type actionRuntimeContainerArgsUpdate struct {
@@ -121,6 +122,15 @@ func (a actionRuntimeContainerArgsUpdate) ReloadCachedStatus() bool {
 	return true
 }
 
+// ReloadCachedStatusResources reloads only resources modified by the action.
+func (a actionRuntimeContainerArgsUpdate) ReloadCachedStatusResources(ctx context.Context, cachedStatus inspectorInterface.Inspector) error {
+	if err := cachedStatus.RefreshPods(ctx); err != nil {
+		return err
+	}
+
+	return cachedStatus.RefreshArangoMembers(ctx)
+}
+
 // Start starts the action for changing conditions on the provided member.
 func (a actionRuntimeContainerArgsUpdate) Start(ctx context.Context) (bool, error) {
 
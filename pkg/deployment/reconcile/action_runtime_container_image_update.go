@@ -25,6 +25,7 @@ import (
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/rotation"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 	"github.com/rs/zerolog"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -44,7 +45,7 @@ func runtimeContainerImageUpdate(log zerolog.Logger, action api.Action, actionCt
 	return a
 }
 
-var _ ActionReloadCachedStatus = &actionRuntimeContainerImageUpdate{}
+var _ ActionReloadCachedStatusResources = &actionRuntimeContainerImageUpdate{}
 var _ ActionPost = &actionRuntimeContainerImageUpdate{}
 
 type actionRuntimeContainerImageUpdate struct {
@@ -112,6 +113,15 @@ func (a actionRuntimeContainerImageUpdate) ReloadCachedStatus() bool {
 	return true
 }
 
+// ReloadCachedStatusResources reloads only resources modified by the action.
+func (a actionRuntimeContainerImageUpdate) ReloadCachedStatusResources(ctx context.Context, cachedStatus inspectorInterface.Inspector) error {
+	if err := cachedStatus.RefreshPods(ctx); err != nil {
+		return err
+	}
+
+	return cachedStatus.RefreshArangoMembers(ctx)
+}
+
 func (a actionRuntimeContainerImageUpdate) getContainerDetails() (string, string, bool) {
 	container, ok := a.action.GetParam(rotation.ContainerName)
 	if !ok {
@@ -57,12 +57,13 @@ func (t *tlsSNIUpdate) CheckProgress(ctx context.Context) (bool, bool, error) {
 		return true, false, nil
 	}
 
-	sni := spec.TLS.SNI
-	if sni == nil {
+	if spec.TLS.SNI == nil && spec.ExternalAccess.TLS.GetSecretName() == "" {
 		return true, false, nil
 	}
 
-	fetchedSecrets, err := mapTLSSNIConfig(*sni, t.actionCtx.GetCachedStatus())
+	sni := spec.GetTLSSNI()
+
+	fetchedSecrets, err := mapTLSSNIConfig(sni, t.actionCtx.GetCachedStatus())
 	if err != nil {
 		t.log.Warn().Err(err).Msg("Unable to get SNI desired state")
 		return true, false, nil
@@ -142,7 +142,8 @@ func isStorageClassChanged(_ context.Context, log zerolog.Logger, apiObject k8su
 	// From here on it is known that the member requires replacement, so `true` must be returned.
 	// If pod does not exist then it will try next time.
 	if pod, ok := cachedStatus.Pod(member.PodName); ok {
-		if _, ok := pod.GetAnnotations()[deployment.ArangoDeploymentPodReplaceAnnotation]; !ok {
+		if _, ok := pod.GetAnnotations()[deployment.ArangoDeploymentPodReplaceAnnotation]; !ok &&
+			groupSpec.StorageClassChangeMode.Get() != api.StorageClassChangeModeReplace {
 			log.Warn().
 				Str("pod-name", member.PodName).
 				Str("server-group", group.AsRole()).
@@ -88,6 +88,8 @@ type Context interface {
 	EnableScalingCluster(ctx context.Context) error
 	// GetBackup receives information about a backup resource
 	GetBackup(ctx context.Context, backup string) (*backupApi.ArangoBackup, error)
+	// CreateBackup creates a backup resource in the namespace of the deployment
+	CreateBackup(ctx context.Context, backup *backupApi.ArangoBackup) error
 	// GetAuthentication return authentication for members
 	GetAuthentication() conn.Auth
 }
@@ -22,9 +22,11 @@ package reconcile
 
 import (
 	"context"
+	"sort"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 	"github.com/rs/zerolog"
@@ -58,5 +60,62 @@ func createBootstrapPlan(ctx context.Context,
 		return api.Plan{actions.NewClusterAction(api.ActionTypeBootstrapSetPassword, "Updating password").AddParam("user", user)}
 	}
 
+	// Users are created before databases, so access can be granted to them
+	if len(spec.Bootstrap.Databases) > 0 {
+		return api.Plan{
+			actions.NewClusterAction(api.ActionTypeBootstrapDatabases, "Creating databases"),
+			actions.NewClusterAction(api.ActionTypeBootstrapUpdate, "Finalizing bootstrap"),
+		}
+	}
+
 	return api.Plan{actions.NewClusterAction(api.ActionTypeBootstrapUpdate, "Finalizing bootstrap")}
 }
+
+// createRotateUserPasswordPlan rotates the password of a user when the content of its password secret
+// or the secret name in spec.bootstrap.passwordSecretNames has changed after the bootstrap.
+func createRotateUserPasswordPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
+	if !status.Conditions.IsTrue(api.ConditionTypeReady) {
+		return nil
+	}
+
+	if !status.Conditions.IsTrue(api.ConditionTypeBootstrapCompleted) {
+		return nil
+	}
+
+	users := make([]string, 0, len(spec.Bootstrap.PasswordSecretNames))
+	for user := range spec.Bootstrap.PasswordSecretNames {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	for _, user := range users {
+		secretName := spec.Bootstrap.PasswordSecretNames[user]
+		if secretName.IsNone() {
+			continue
+		}
+
+		var expected string
+		if s := status.SecretHashes; s != nil && s.Users != nil {
+			expected = s.Users[user]
+		}
+
+		if secret, ok := cachedStatus.Secret(secretName.Get()); ok {
+			_, password, err := k8sutil.GetSecretAuthCredentials(secret)
+			if err != nil {
+				log.Warn().Err(err).Str("user", user).Str("secret", secretName.Get()).Msg("Invalid password secret")
+				continue
+			}
+
+			if util.SHA256FromString(password) == expected {
+				continue
+			}
+		}
+
+		return api.Plan{actions.NewClusterAction(api.ActionTypeRotateUserPassword, "Rotating password").AddParam("user", user)}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+)
+
+func Test_CreateRotateUserPasswordPlan(t *testing.T) {
+	log := zerolog.Nop()
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "test_depl",
+			Namespace: "test",
+		},
+	}
+
+	secret := func(name, password string) *core.Secret {
+		return &core.Secret{
+			ObjectMeta: meta.ObjectMeta{Name: name},
+			Data: map[string][]byte{
+				constants.SecretUsername: []byte("user"),
+				constants.SecretPassword: []byte(password),
+			},
+		}
+	}
+
+	i := inspector.NewInspectorFromData(nil, map[string]*core.Secret{
+		"root-new": secret("root-new", "new"),
+		"app":      secret("app", "app"),
+	}, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+
+	spec := api.DeploymentSpec{
+		Bootstrap: api.BootstrapSpec{
+			PasswordSecretNames: map[string]api.PasswordSecretName{
+				api.UserNameRoot: "root-new",
+				"app":            "app",
+				"skipped":        api.PasswordSecretNameNone,
+			},
+		},
+	}
+
+	var status api.DeploymentStatus
+	status.Conditions.Update(api.ConditionTypeReady, true, "", "")
+	status.SecretHashes = &api.SecretHashes{
+		Users: map[string]string{
+			api.UserNameRoot: util.SHA256FromString("old"),
+			"app":            util.SHA256FromString("app"),
+		},
+	}
+
+	t.Run("Bootstrap not completed", func(t *testing.T) {
+		require.Empty(t, createRotateUserPasswordPlan(context.Background(), log, depl, spec, status, i, &testContext{}))
+	})
+
+	status.Conditions.Update(api.ConditionTypeBootstrapCompleted, true, "", "")
+
+	t.Run("Password changed", func(t *testing.T) {
+		plan := createRotateUserPasswordPlan(context.Background(), log, depl, spec, status, i, &testContext{})
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeRotateUserPassword, plan[0].Type)
+		require.Equal(t, api.UserNameRoot, plan[0].Params["user"])
+	})
+
+	t.Run("Passwords up to date", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.SecretHashes.Users[api.UserNameRoot] = util.SHA256FromString("new")
+
+		require.Empty(t, createRotateUserPasswordPlan(context.Background(), log, depl, spec, *s, i, &testContext{}))
+	})
+
+	t.Run("Missing secret", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.SecretHashes.Users[api.UserNameRoot] = util.SHA256FromString("new")
+
+		sp := spec.DeepCopy()
+		sp.Bootstrap.PasswordSecretNames["app"] = "app-missing"
+
+		plan := createRotateUserPasswordPlan(context.Background(), log, depl, *sp, *s, i, &testContext{})
+
+		require.Len(t, plan, 1)
+		require.Equal(t, "app", plan[0].Params["user"])
+	})
+}
@@ -46,6 +46,11 @@ func skipEncryptionPlan(spec api.DeploymentSpec, status api.DeploymentStatus) bo
 		return true
 	}
 
+	if spec.Vault.IsEncryptionSourced() {
+		// Keys are managed in Vault
+		return true
+	}
+
 	if i := status.CurrentImage; i == nil || !features.EncryptionRotation().Supported(i.ArangoDBVersion, i.Enterprise) {
 		return true
 	}
@@ -58,6 +58,7 @@ func createHighPlan(ctx context.Context, log zerolog.Logger, apiObject k8sutil.A
 		ApplyIfEmptyWithBackOff(LicenseCheck, 30*time.Second, updateClusterLicense).
 		ApplyIfEmpty(createTopologyMemberConditionPlan).
 		ApplyIfEmpty(createRebalancerCheckPlan).
+		ApplyIfEmpty(createExternalAccessTLSConditionPlan).
 		ApplyWithBackOff(BackOffCheck, time.Minute, emptyPlanBuilder))
 
 	return r.Plan(), r.BackOff(), true
@@ -0,0 +1,141 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+)
+
+const (
+	initFromBackupParamBackup                = "backup"
+	initFromBackupParamID                    = "id"
+	initFromBackupParamRepositoryURL         = "repositoryURL"
+	initFromBackupParamCredentialsSecretName = "credentialsSecretName"
+)
+
+// initFromBackupDownloadName returns the name of the ArangoBackup which downloads the backup of spec.initFrom
+func initFromBackupDownloadName(deploymentName string) string {
+	return fmt.Sprintf("%s-init-from-backup", deploymentName)
+}
+
+// createInitFromBackupPlan restores a new deployment from the backup of spec.initFrom once all members are ready.
+// Backups from a remote repository or uploaded backups of other deployments are downloaded first.
+func createInitFromBackupPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
+	if spec.InitFrom == nil || status.Conditions.IsTrue(api.ConditionTypeInitializedFromBackup) {
+		return nil
+	}
+
+	if !status.Members.AllMembersReady(spec.GetMode(), spec.Sync.IsEnabled()) {
+		return nil
+	}
+
+	var download *backupApi.ArangoBackupSpecDownload
+	var backup *backupApi.ArangoBackup
+
+	if spec.InitFrom.IsDownload() {
+		download = &backupApi.ArangoBackupSpecDownload{
+			ArangoBackupSpecOperation: backupApi.ArangoBackupSpecOperation{
+				RepositoryURL:         spec.InitFrom.Download.RepositoryURL,
+				CredentialsSecretName: spec.InitFrom.Download.CredentialsSecretName,
+			},
+			ID: spec.InitFrom.GetBackup(),
+		}
+	} else {
+		source, err := context.GetBackup(ctx, spec.InitFrom.GetBackup())
+		if err != nil {
+			log.Warn().Err(err).Msg("Backup not found")
+			return nil
+		}
+
+		if source.Spec.Deployment.Name == apiObject.GetName() {
+			backup = source
+		} else {
+			// Backups of other deployments are not present in this deployment, they are downloaded from the upload repository
+			if source.Spec.Upload == nil {
+				log.Warn().Str("backup", source.GetName()).Msg("Backup of another deployment needs to be uploaded")
+				return nil
+			}
+
+			if b := source.Status.Backup; b == nil || b.Uploaded == nil || !*b.Uploaded {
+				log.Debug().Str("backup", source.GetName()).Msg("Backup not yet uploaded")
+				return nil
+			}
+
+			download = &backupApi.ArangoBackupSpecDownload{
+				ArangoBackupSpecOperation: *source.Spec.Upload,
+				ID:                        source.Status.Backup.ID,
+			}
+		}
+	}
+
+	if download != nil {
+		b, err := context.GetBackup(ctx, initFromBackupDownloadName(apiObject.GetName()))
+		if err != nil {
+			if k8sutil.IsNotFound(err) {
+				return api.Plan{actions.NewClusterAction(api.ActionTypeInitFromBackupDownload, "Downloading backup").
+					AddParam(initFromBackupParamID, download.ID).
+					AddParam(initFromBackupParamRepositoryURL, download.RepositoryURL).
+					AddParam(initFromBackupParamCredentialsSecretName, download.CredentialsSecretName)}
+			}
+
+			log.Warn().Err(err).Msg("Unable to get download backup")
+			return nil
+		}
+
+		backup = b
+	}
+
+	if backup.Status.Backup == nil || !backup.Status.Available {
+		log.Debug().Str("backup", backup.GetName()).Msg("Backup not yet ready")
+		return nil
+	}
+
+	if spec.RocksDB.IsEncrypted() {
+		if ok, p := createRestorePlanEncryption(ctx, log, spec, status, context); !ok {
+			return nil
+		} else if !p.IsEmpty() {
+			return p
+		}
+	}
+
+	p := api.Plan{
+		actions.NewClusterAction(api.ActionTypeInitFromBackup, "Initializing from backup").AddParam(initFromBackupParamBackup, backup.GetName()),
+	}
+
+	switch spec.Mode.Get() {
+	case api.DeploymentModeActiveFailover:
+		p = withMaintenance(p...)
+	}
+
+	return p
+}
@@ -0,0 +1,178 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_CreateInitFromBackupPlan(t *testing.T) {
+	log := zerolog.Nop()
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "depl",
+			Namespace: "test",
+		},
+	}
+
+	backup := func(name, deployment string, available bool) *backupApi.ArangoBackup {
+		b := &backupApi.ArangoBackup{
+			ObjectMeta: meta.ObjectMeta{Name: name},
+			Spec: backupApi.ArangoBackupSpec{
+				Deployment: backupApi.ArangoBackupSpecDeployment{Name: deployment},
+			},
+		}
+
+		if available {
+			b.Status.Backup = &backupApi.ArangoBackupDetails{ID: name + "-id"}
+			b.Status.Available = true
+		}
+
+		return b
+	}
+
+	uploaded := backup("uploaded", "other", true)
+	uploaded.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3://bucket/backups", CredentialsSecretName: "s3"}
+	uploaded.Status.Backup.Uploaded = util.NewBool(true)
+
+	c := &testContext{
+		Backups: map[string]*backupApi.ArangoBackup{
+			"local":    backup("local", "depl", true),
+			"pending":  backup("pending", "depl", false),
+			"foreign":  backup("foreign", "other", true),
+			"uploaded": uploaded,
+		},
+	}
+
+	m := api.MemberStatus{ID: "single"}
+	m.Conditions.Update(api.ConditionTypeReady, true, "", "")
+
+	var status api.DeploymentStatus
+	status.Members.Single = api.MemberStatusList{m}
+
+	spec := func(initFrom *api.DeploymentInitFromSpec) api.DeploymentSpec {
+		return api.DeploymentSpec{
+			Mode:     api.NewMode(api.DeploymentModeSingle),
+			InitFrom: initFrom,
+		}
+	}
+
+	t.Run("Not set", func(t *testing.T) {
+		require.Empty(t, createInitFromBackupPlan(context.Background(), log, depl, spec(nil), status, nil, c))
+	})
+
+	t.Run("Members not ready", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.Members.Single[0].Conditions.Remove(api.ConditionTypeReady)
+
+		require.Empty(t, createInitFromBackupPlan(context.Background(), log, depl,
+			spec(&api.DeploymentInitFromSpec{Backup: util.NewString("local")}), *s, nil, c))
+	})
+
+	t.Run("Already initialized", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.Conditions.Update(api.ConditionTypeInitializedFromBackup, true, "", "")
+
+		require.Empty(t, createInitFromBackupPlan(context.Background(), log, depl,
+			spec(&api.DeploymentInitFromSpec{Backup: util.NewString("local")}), *s, nil, c))
+	})
+
+	t.Run("Backup of the deployment", func(t *testing.T) {
+		plan := createInitFromBackupPlan(context.Background(), log, depl,
+			spec(&api.DeploymentInitFromSpec{Backup: util.NewString("local")}), status, nil, c)
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeInitFromBackup, plan[0].Type)
+		require.Equal(t, "local", plan[0].Params[initFromBackupParamBackup])
+	})
+
+	t.Run("Backup not ready", func(t *testing.T) {
+		require.Empty(t, createInitFromBackupPlan(context.Background(), log, depl,
+			spec(&api.DeploymentInitFromSpec{Backup: util.NewString("pending")}), status, nil, c))
+	})
+
+	t.Run("Backup not found", func(t *testing.T) {
+		require.Empty(t, createInitFromBackupPlan(context.Background(), log, depl,
+			spec(&api.DeploymentInitFromSpec{Backup: util.NewString("missing")}), status, nil, c))
+	})
+
+	t.Run("Not uploaded backup of other deployment", func(t *testing.T) {
+		require.Empty(t, createInitFromBackupPlan(context.Background(), log, depl,
+			spec(&api.DeploymentInitFromSpec{Backup: util.NewString("foreign")}), status, nil, c))
+	})
+
+	t.Run("Uploaded backup of other deployment", func(t *testing.T) {
+		plan := createInitFromBackupPlan(context.Background(), log, depl,
+			spec(&api.DeploymentInitFromSpec{Backup: util.NewString("uploaded")}), status, nil, c)
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeInitFromBackupDownload, plan[0].Type)
+		require.Equal(t, "uploaded-id", plan[0].Params[initFromBackupParamID])
+		require.Equal(t, "s3://bucket/backups", plan[0].Params[initFromBackupParamRepositoryURL])
+		require.Equal(t, "s3", plan[0].Params[initFromBackupParamCredentialsSecretName])
+	})
+
+	remote := &api.DeploymentInitFromSpec{
+		Backup:   util.NewString("remote-id"),
+		Download: &api.DeploymentInitFromDownloadSpec{RepositoryURL: "s3://bucket/backups"},
+	}
+
+	t.Run("Remote backup", func(t *testing.T) {
+		plan := createInitFromBackupPlan(context.Background(), log, depl, spec(remote), status, nil, c)
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeInitFromBackupDownload, plan[0].Type)
+		require.Equal(t, "remote-id", plan[0].Params[initFromBackupParamID])
+	})
+
+	t.Run("Remote backup downloading", func(t *testing.T) {
+		c := &testContext{
+			Backups: map[string]*backupApi.ArangoBackup{
+				"depl-init-from-backup": backup("depl-init-from-backup", "depl", false),
+			},
+		}
+
+		require.Empty(t, createInitFromBackupPlan(context.Background(), log, depl, spec(remote), status, nil, c))
+	})
+
+	t.Run("Remote backup downloaded", func(t *testing.T) {
+		c := &testContext{
+			Backups: map[string]*backupApi.ArangoBackup{
+				"depl-init-from-backup": backup("depl-init-from-backup", "depl", true),
+			},
+		}
+
+		plan := createInitFromBackupPlan(context.Background(), log, depl, spec(remote), status, nil, c)
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeInitFromBackup, plan[0].Type)
+		require.Equal(t, "depl-init-from-backup", plan[0].Params[initFromBackupParamBackup])
+	})
+}
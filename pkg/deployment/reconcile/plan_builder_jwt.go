@@ -36,6 +36,7 @@ import (
 	"github.com/rs/zerolog/log"
 	core "k8s.io/api/core/v1"
 
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
@@ -116,6 +117,52 @@ func createJWTKeyUpdate(ctx context.Context,
 	return addJWTPropagatedPlanAction(status)
 }
 
+func createJWTRotationPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
+	if folder, err := ensureJWTFolderSupport(spec, status); err != nil || !folder {
+		return nil
+	}
+
+	if !status.Hashes.JWT.Propagated || len(status.Hashes.JWT.Passive) > 0 {
+		// Previous rotation is still in progress
+		return nil
+	}
+
+	s, ok := cachedStatus.Secret(spec.Authentication.GetJWTSecretName())
+	if !ok {
+		return nil
+	}
+
+	jwt, ok := s.Data[constants.SecretKeyToken]
+	if !ok {
+		return nil
+	}
+
+	trigger := apiObject.GetAnnotations()[deployment.ArangoDeploymentJWTRotateAnnotation]
+	if trigger != "" && trigger != s.GetAnnotations()[deployment.ArangoDeploymentJWTRotationTriggerAnnotation] {
+		return api.Plan{actions.NewClusterAction(api.ActionTypeJWTGenerate, "JWT rotation requested with annotation").
+			AddParam(checksum, util.SHA256(jwt)).AddParam(jwtRotationTrigger, trigger)}
+	}
+
+	if period := spec.Authentication.Rotation.GetPeriod(); period > 0 {
+		rotatedAt := s.GetCreationTimestamp().Time
+		if v, ok := s.GetAnnotations()[deployment.ArangoDeploymentJWTRotatedAtAnnotation]; ok {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				rotatedAt = t
+			}
+		}
+
+		if time.Since(rotatedAt) > period {
+			return api.Plan{actions.NewClusterAction(api.ActionTypeJWTGenerate, "JWT rotation period exceeded").
+				AddParam(checksum, util.SHA256(jwt))}
+		}
+	}
+
+	return nil
+}
+
 func createJWTStatusUpdate(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -0,0 +1,141 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_CreateJWTRotationPlan(t *testing.T) {
+	enabled := features.JWTRotation().Enabled()
+	defer func() {
+		*features.JWTRotation().EnabledPointer() = enabled
+	}()
+	*features.JWTRotation().EnabledPointer() = true
+
+	jwtSecret := func(created time.Time, annotations map[string]string) map[string]*core.Secret {
+		return map[string]*core.Secret{
+			"jwt": {
+				ObjectMeta: meta.ObjectMeta{
+					Name:              "jwt",
+					CreationTimestamp: meta.Time{Time: created},
+					Annotations:       annotations,
+				},
+				Data: map[string][]byte{
+					constants.SecretKeyToken: []byte("token"),
+				},
+			},
+		}
+	}
+
+	spec := func(period string) api.DeploymentSpec {
+		s := api.DeploymentSpec{
+			Authentication: api.AuthenticationSpec{
+				JWTSecretName: util.NewString("jwt"),
+			},
+		}
+
+		if period != "" {
+			s.Authentication.Rotation = &api.AuthenticationRotationSpec{Period: api.NewDuration(api.Duration(period))}
+		}
+
+		return s
+	}
+
+	status := api.DeploymentStatus{
+		CurrentImage: &api.ImageInfo{
+			ArangoDBVersion: "3.8.0",
+			Enterprise:      true,
+		},
+		Hashes: api.DeploymentStatusHashes{
+			JWT: api.DeploymentStatusHashesJWT{
+				Propagated: true,
+			},
+		},
+	}
+
+	depl := func(annotations map[string]string) *api.ArangoDeployment {
+		return &api.ArangoDeployment{
+			ObjectMeta: meta.ObjectMeta{
+				Name:        "test",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	plan := func(t *testing.T, d *api.ArangoDeployment, spec api.DeploymentSpec, status api.DeploymentStatus, secrets map[string]*core.Secret) api.Plan {
+		i := inspector.NewInspectorFromData(nil, secrets, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+		return createJWTRotationPlan(context.Background(), log.Logger, d, spec, status, i, nil)
+	}
+
+	t.Run("Rotation disabled", func(t *testing.T) {
+		require.Empty(t, plan(t, depl(nil), spec(""), status, jwtSecret(time.Now().Add(-time.Hour), nil)))
+	})
+
+	t.Run("Period not exceeded", func(t *testing.T) {
+		require.Empty(t, plan(t, depl(nil), spec("2h"), status, jwtSecret(time.Now().Add(-time.Hour), nil)))
+	})
+
+	t.Run("Period exceeded", func(t *testing.T) {
+		p := plan(t, depl(nil), spec("30m"), status, jwtSecret(time.Now().Add(-time.Hour), nil))
+		require.Len(t, p, 1)
+		require.Equal(t, api.ActionTypeJWTGenerate, p[0].Type)
+		require.Equal(t, util.SHA256([]byte("token")), p[0].Params[checksum])
+	})
+
+	t.Run("Period not exceeded since last rotation", func(t *testing.T) {
+		require.Empty(t, plan(t, depl(nil), spec("30m"), status, jwtSecret(time.Now().Add(-time.Hour), map[string]string{
+			deployment.ArangoDeploymentJWTRotatedAtAnnotation: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+		})))
+	})
+
+	t.Run("Rotation in progress", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.Hashes.JWT.Propagated = false
+		require.Empty(t, plan(t, depl(nil), spec("30m"), *s, jwtSecret(time.Now().Add(-time.Hour), nil)))
+	})
+
+	t.Run("Rotation requested with annotation", func(t *testing.T) {
+		p := plan(t, depl(map[string]string{deployment.ArangoDeploymentJWTRotateAnnotation: "1"}), spec(""), status, jwtSecret(time.Now(), nil))
+		require.Len(t, p, 1)
+		require.Equal(t, api.ActionTypeJWTGenerate, p[0].Type)
+		require.Equal(t, "1", p[0].Params[jwtRotationTrigger])
+	})
+
+	t.Run("Rotation requested with annotation already handled", func(t *testing.T) {
+		require.Empty(t, plan(t, depl(map[string]string{deployment.ArangoDeploymentJWTRotateAnnotation: "1"}), spec(""), status, jwtSecret(time.Now(), map[string]string{
+			deployment.ArangoDeploymentJWTRotationTriggerAnnotation: "1",
+		})))
+	})
+}
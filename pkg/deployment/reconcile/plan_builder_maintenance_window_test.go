@@ -0,0 +1,48 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsMaintenanceWindowActive(t *testing.T) {
+	check := func(windows api.DeploymentMaintenanceWindowList) bool {
+		return isMaintenanceWindowActive(context.Background(), zerolog.Nop(), nil, api.DeploymentSpec{MaintenanceWindows: windows}, api.DeploymentStatus{}, nil, nil)
+	}
+
+	t.Run("No windows", func(t *testing.T) {
+		require.True(t, check(nil))
+	})
+
+	t.Run("Active window", func(t *testing.T) {
+		require.True(t, check(api.DeploymentMaintenanceWindowList{{Schedule: "* * * * *", Duration: "5m"}}))
+	})
+
+	t.Run("Inactive window", func(t *testing.T) {
+		require.False(t, check(api.DeploymentMaintenanceWindowList{{Schedule: "0 0 1 1 *", Duration: "1s"}}))
+	})
+}
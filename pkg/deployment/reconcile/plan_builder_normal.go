@@ -60,29 +60,34 @@ func createNormalPlan(ctx context.Context, log zerolog.Logger, apiObject k8sutil
 		ApplyIfEmpty(createJWTStatusUpdate).
 		// Check for cleaned out dbserver in created state
 		ApplyIfEmpty(createRemoveCleanedDBServersPlan).
-		// Check for members to be removed
-		ApplyIfEmpty(createReplaceMemberPlan).
+		// Check for members to be removed. Disruptive operations are executed only within maintenance windows
+		ApplyWithConditionIfEmpty(isMaintenanceWindowActive, createReplaceMemberPlan).
 		// Check for the need to rotate one or more members
-		ApplyIfEmpty(createMarkToRemovePlan).
-		ApplyIfEmpty(createRotateOrUpgradePlan).
+		ApplyWithConditionIfEmpty(isMaintenanceWindowActive, createMarkToRemovePlan).
+		ApplyWithConditionIfEmpty(isMaintenanceWindowActive, createStorageClassMigrationPlan).
+		ApplyWithConditionIfEmpty(isMaintenanceWindowActive, createRotateOrUpgradePlan).
 		// Disable maintenance if upgrade process was done. Upgrade task throw IDLE Action if upgrade is pending
 		ApplyIfEmpty(createMaintenanceManagementPlan).
 		// Add keys
 		ApplySubPlanIfEmpty(createEncryptionKeyStatusPropagatedFieldUpdate, createEncryptionKey).
 		ApplyIfEmpty(createJWTKeyUpdate).
+		ApplyIfEmpty(createJWTRotationPlan).
 		ApplySubPlanIfEmpty(createTLSStatusPropagatedFieldUpdate, createCARenewalPlan).
 		ApplySubPlanIfEmpty(createTLSStatusPropagatedFieldUpdate, createCAAppendPlan).
 		ApplyIfEmpty(createKeyfileRenewalPlan).
-		ApplyIfEmpty(createRotateServerStorageResizePlan).
+		ApplyWithConditionIfEmpty(isMaintenanceWindowActive, createRotateServerStorageResizePlan).
+		ApplyWithConditionIfEmpty(isMaintenanceWindowActive, createVolumeAutoExpansionPlan).
 		ApplySubPlanIfEmpty(createTLSStatusPropagatedFieldUpdate, createRotateTLSServerSNIPlan).
+		ApplyIfEmpty(createInitFromBackupPlan).
 		ApplyIfEmpty(createRestorePlan).
 		ApplySubPlanIfEmpty(createEncryptionKeyStatusPropagatedFieldUpdate, createEncryptionKeyCleanPlan).
 		ApplySubPlanIfEmpty(createTLSStatusPropagatedFieldUpdate, createCACleanPlan).
 		ApplyIfEmpty(createClusterOperationPlan).
-		ApplyIfEmpty(createRebalancerGeneratePlan).
+		ApplyWithConditionIfEmpty(isMaintenanceWindowActive, createRebalancerGeneratePlan).
 		// Final
 		ApplyIfEmpty(createTLSStatusPropagated).
-		ApplyIfEmpty(createBootstrapPlan))
+		ApplyIfEmpty(createBootstrapPlan).
+		ApplyIfEmpty(createRotateUserPasswordPlan))
 
 	return r.Plan(), r.BackOff(), true
 }
@@ -123,6 +128,13 @@ func createMemberFailedRestorePlan(ctx context.Context,
 				// Everything is fine, proceed
 			}
 
+			if policy := spec.Recovery.Get().GetLocalStoragePolicy(); policy != "" && isMemberVolumeNodeGone(cachedStatus, m) {
+				// Data of the member is accessible only on nodes which are gone
+				memberLog.Str("policy", policy.String()).Msg("Nodes of the member volume are gone")
+				plan = append(plan, createLocalStorageRecoveryPlan(policy, group, m, spec.GetAllowMemberRecreation(group))...)
+				continue
+			}
+
 			switch group {
 			case api.ServerGroupAgents:
 				// For agents just recreate member do not rotate ID, do not remove PVC or service
@@ -162,6 +174,57 @@ func createMemberFailedRestorePlan(ctx context.Context,
 	return plan
 }
 
+// createLocalStorageRecoveryPlan creates a plan for the failed member, which volume can be used
+// only on nodes which are gone. Depending on the policy, the member waits for the nodes to come back,
+// or it is replaced by a new member with a fresh PVC, if recreation of members is allowed in the group.
+// DBServers which still hold shards are handled before, they are never replaced.
+func createLocalStorageRecoveryPlan(policy api.LocalStoragePolicy, group api.ServerGroup, m api.MemberStatus, allowRecreation bool) api.Plan {
+	switch group {
+	case api.ServerGroupDBServers:
+		if policy == api.LocalStoragePolicyRecreate && allowRecreation {
+			return api.Plan{
+				actions.NewAction(api.ActionTypeRemoveMember, group, m, "Local volume node is gone"),
+				actions.NewAction(api.ActionTypeAddMember, group, withPredefinedMember("")),
+				actions.NewAction(api.ActionTypeWaitForMemberUp, group, withPredefinedMember(api.MemberIDPreviousAction)),
+			}
+		}
+	}
+
+	// Agents and Single servers keep their data, even if the policy allows recreation
+	return api.Plan{
+		actions.NewAction(api.ActionTypeRecreateMember, group, m, "Waiting for local volume node"),
+	}
+}
+
+// isMemberVolumeNodeGone returns true if the volume of the member can be used only on nodes which are gone or unschedulable.
+func isMemberVolumeNodeGone(cachedStatus inspectorInterface.Inspector, m api.MemberStatus) bool {
+	if m.PersistentVolumeClaimName == "" {
+		return false
+	}
+
+	pvc, ok := cachedStatus.PersistentVolumeClaim(m.PersistentVolumeClaimName)
+	if !ok || pvc.Spec.VolumeName == "" {
+		return false
+	}
+
+	pvs, ok := cachedStatus.GetPersistentVolumes()
+	if !ok {
+		return false
+	}
+
+	pv, ok := pvs.PersistentVolume(pvc.Spec.VolumeName)
+	if !ok {
+		return false
+	}
+
+	nodes, ok := cachedStatus.GetNodes()
+	if !ok {
+		return false
+	}
+
+	return k8sutil.IsPersistentVolumeNodeGone(pv, nodes.Nodes())
+}
+
 func createRemoveCleanedDBServersPlan(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -0,0 +1,73 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func Test_CreateLocalStorageRecoveryPlan(t *testing.T) {
+	m := api.MemberStatus{ID: "id"}
+
+	t.Run("Wait for the node", func(t *testing.T) {
+		plan := createLocalStorageRecoveryPlan(api.LocalStoragePolicyWait, api.ServerGroupDBServers, m, true)
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeRecreateMember, plan[0].Type)
+		require.Equal(t, "id", plan[0].MemberID)
+	})
+
+	t.Run("Replace DBServer", func(t *testing.T) {
+		plan := createLocalStorageRecoveryPlan(api.LocalStoragePolicyRecreate, api.ServerGroupDBServers, m, true)
+
+		require.Len(t, plan, 3)
+		require.Equal(t, api.ActionTypeRemoveMember, plan[0].Type)
+		require.Equal(t, "id", plan[0].MemberID)
+		require.Equal(t, api.ActionTypeAddMember, plan[1].Type)
+		require.Equal(t, "", plan[1].MemberID)
+		require.Equal(t, api.ActionTypeWaitForMemberUp, plan[2].Type)
+	})
+
+	t.Run("DBServer recreation is not allowed", func(t *testing.T) {
+		plan := createLocalStorageRecoveryPlan(api.LocalStoragePolicyRecreate, api.ServerGroupDBServers, m, false)
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeRecreateMember, plan[0].Type)
+	})
+
+	t.Run("Agent is never replaced", func(t *testing.T) {
+		plan := createLocalStorageRecoveryPlan(api.LocalStoragePolicyRecreate, api.ServerGroupAgents, m, true)
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeRecreateMember, plan[0].Type)
+	})
+
+	t.Run("Single server is never replaced", func(t *testing.T) {
+		plan := createLocalStorageRecoveryPlan(api.LocalStoragePolicyRecreate, api.ServerGroupSingle, m, true)
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeRecreateMember, plan[0].Type)
+	})
+}
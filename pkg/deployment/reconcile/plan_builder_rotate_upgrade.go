@@ -22,6 +22,7 @@ package reconcile
 
 import (
 	"context"
+	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/rotation"
 
@@ -75,7 +76,12 @@ func createRotateOrUpgradePlan(ctx context.Context,
 	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
 	var plan api.Plan
 
-	newPlan, idle := createRotateOrUpgradePlanInternal(log, apiObject, spec, status, cachedStatus, context)
+	skewPlan, skewErr := createUpgradeVersionSkewPlan(ctx, log, apiObject, spec, status, context)
+	if !skewPlan.IsEmpty() {
+		return skewPlan
+	}
+
+	newPlan, idle := createRotateOrUpgradePlanInternal(log, apiObject, spec, status, cachedStatus, context, skewErr)
 	if idle {
 		plan = append(plan,
 			actions.NewClusterAction(api.ActionTypeIdle))
@@ -119,10 +125,14 @@ func createMarkToRemovePlan(ctx context.Context,
 	return plan
 }
 
-func createRotateOrUpgradePlanInternal(log zerolog.Logger, apiObject k8sutil.APIObject, spec api.DeploymentSpec, status api.DeploymentStatus, cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) (api.Plan, bool) {
+func createRotateOrUpgradePlanInternal(log zerolog.Logger, apiObject k8sutil.APIObject, spec api.DeploymentSpec, status api.DeploymentStatus, cachedStatus inspectorInterface.Inspector, context PlanBuilderContext, skewErr error) (api.Plan, bool) {
 	decision := createRotateOrUpgradeDecision(log, spec, status, context)
 
 	if decision.IsUpgrade() {
+		if skewErr != nil {
+			// Upgrade is blocked, reason is reported in the UpgradeBlocked condition
+			return nil, false
+		}
 
 		for _, m := range status.Members.AsList() {
 			// Pre-check
@@ -260,14 +270,8 @@ func podNeedsUpgrading(log zerolog.Logger, status api.MemberStatus, spec api.Dep
 	// Image changed, check if change is allowed
 	specVersion := currentImage.ArangoDBVersion
 	memberVersion := memberImage.ArangoDBVersion
-	asLicense := func(info api.ImageInfo) upgraderules.License {
-		if info.Enterprise {
-			return upgraderules.LicenseEnterprise
-		}
-		return upgraderules.LicenseCommunity
-	}
-	specLicense := asLicense(currentImage)
-	memberLicense := asLicense(memberImage)
+	specLicense := asUpgradeLicense(currentImage)
+	memberLicense := asUpgradeLicense(memberImage)
 	if err := upgraderules.CheckUpgradeRulesWithLicense(memberVersion, specVersion, memberLicense, specLicense); err != nil {
 		// E.g. 3.x -> 4.x, we cannot allow automatically
 		return upgradeDecision{
@@ -383,8 +387,12 @@ func arangoMemberPodTemplateNeedsUpdate(ctx context.Context, log zerolog.Logger,
 // groupReadyForRestart returns true if the cluster is ready for the next update, that is:
 // 	- all shards are in sync
 // 	- all members are ready and fine
-func groupReadyForRestart(context PlanBuilderContext, status api.DeploymentStatus, member api.MemberStatus, group api.ServerGroup) (bool, string) {
+func groupReadyForRestart(context PlanBuilderContext, spec api.DeploymentSpec, status api.DeploymentStatus, member api.MemberStatus, group api.ServerGroup) (bool, string) {
 	if group == api.ServerGroupSingle {
+		if id, lag, ok := followerReplicationLagExceeded(spec, status, member); ok {
+			return false, fmt.Sprintf("Replication lag %s of member %s exceeds the limit", lag, id)
+		}
+
 		return true, "Restart always in single mode"
 	}
 
@@ -408,6 +416,17 @@ func groupReadyForRestart(context PlanBuilderContext, status api.DeploymentStatu
 	}
 
 	switch group {
+	case api.ServerGroupAgents:
+		agencyState, ok := context.GetAgencyCache()
+		if !ok {
+			// Unable to get agency state, do not restart
+			return false, "Unable to get agency cache"
+		}
+
+		if agencyState.Leader == "" {
+			// Agency has no leader, restart of another agent can break the quorum
+			return false, "Agency leader is not known"
+		}
 	case api.ServerGroupDBServers:
 		agencyState, ok := context.GetAgencyCache()
 		if !ok {
@@ -425,6 +444,31 @@ func groupReadyForRestart(context PlanBuilderContext, status api.DeploymentStatu
 	return true, "Restart allowed"
 }
 
+// followerReplicationLagExceeded returns the first follower (other than the given member) which replication lag
+// exceeds the limit defined in the spec. Lag is checked only in the active failover mode.
+func followerReplicationLagExceeded(spec api.DeploymentSpec, status api.DeploymentStatus, member api.MemberStatus) (string, time.Duration, bool) {
+	if spec.GetMode() != api.DeploymentModeActiveFailover {
+		return "", 0, false
+	}
+
+	limit := spec.Timeouts.GetReplicationLag()
+	if limit <= 0 {
+		return "", 0, false
+	}
+
+	for _, m := range status.Members.Single {
+		if m.ID == member.ID {
+			continue
+		}
+
+		if lag := m.Server.GetReplicationLag(); lag > limit {
+			return m.ID, lag, true
+		}
+	}
+
+	return "", 0, false
+}
+
 // createUpgradeMemberPlan creates a plan to upgrade (stop-recreateWithAutoUpgrade-stop-start) an existing
 // member.
 func createUpgradeMemberPlan(log zerolog.Logger, member api.MemberStatus,
@@ -84,7 +84,7 @@ func createRotateOrUpgradeDecisionMember(log zerolog.Logger, spec api.Deployment
 		}
 	}
 
-	d.updateAllowed, d.updateMessage = groupReadyForRestart(context, status, element.Member, element.Group)
+	d.updateAllowed, d.updateMessage = groupReadyForRestart(context, spec, status, element.Member, element.Group)
 	d.unsafeUpdateAllowed = util.BoolOrDefault(spec.AllowUnsafeUpgrade, false)
 
 	if rotation.CheckPossible(element.Member) {
@@ -22,12 +22,14 @@ package reconcile
 
 import (
 	"testing"
+	"time"
 
 	"github.com/arangodb/go-driver"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Test_RotateUpgrade_Condition(t *testing.T) {
@@ -106,3 +108,53 @@ func Test_RotateUpgrade_Condition(t *testing.T) {
 		})
 	}
 }
+
+func Test_FollowerReplicationLagExceeded(t *testing.T) {
+	newSpec := func(mode api.DeploymentMode, limit time.Duration) api.DeploymentSpec {
+		t := api.NewTimeout(limit)
+		return api.DeploymentSpec{
+			Mode:     api.NewMode(mode),
+			Timeouts: &api.Timeouts{ReplicationLag: &t},
+		}
+	}
+
+	newMember := func(id string, lag time.Duration) api.MemberStatus {
+		return api.MemberStatus{
+			ID:     id,
+			Server: &api.MemberServerStatus{ReplicationLag: &meta.Duration{Duration: lag}},
+		}
+	}
+
+	status := api.DeploymentStatus{
+		Members: api.DeploymentStatusMembers{
+			Single: api.MemberStatusList{newMember("leader", 0), newMember("follower", time.Minute)},
+		},
+	}
+
+	t.Run("Lag exceeded", func(t *testing.T) {
+		id, lag, ok := followerReplicationLagExceeded(newSpec(api.DeploymentModeActiveFailover, time.Second), status, status.Members.Single[0])
+		require.True(t, ok)
+		require.Equal(t, "follower", id)
+		require.Equal(t, time.Minute, lag)
+	})
+
+	t.Run("Lag of restarted member is ignored", func(t *testing.T) {
+		_, _, ok := followerReplicationLagExceeded(newSpec(api.DeploymentModeActiveFailover, time.Second), status, status.Members.Single[1])
+		require.False(t, ok)
+	})
+
+	t.Run("Lag within limit", func(t *testing.T) {
+		_, _, ok := followerReplicationLagExceeded(newSpec(api.DeploymentModeActiveFailover, time.Hour), status, status.Members.Single[0])
+		require.False(t, ok)
+	})
+
+	t.Run("Limit not set", func(t *testing.T) {
+		_, _, ok := followerReplicationLagExceeded(api.DeploymentSpec{Mode: api.NewMode(api.DeploymentModeActiveFailover)}, status, status.Members.Single[0])
+		require.False(t, ok)
+	})
+
+	t.Run("Single mode", func(t *testing.T) {
+		_, _, ok := followerReplicationLagExceeded(newSpec(api.DeploymentModeSingle, time.Second), status, status.Members.Single[0])
+		require.False(t, ok)
+	})
+}
@@ -22,12 +22,15 @@ package reconcile
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 	"github.com/rs/zerolog"
+	core "k8s.io/api/core/v1"
 )
 
 func createScaleUPMemberPlan(ctx context.Context,
@@ -44,41 +47,49 @@ func createScaleMemberPlan(ctx context.Context,
 
 	var plan api.Plan
 
+	retained := getRetainedPVCMembers(log, apiObject, status, cachedStatus)
+
 	switch spec.GetMode() {
 	case api.DeploymentModeSingle:
 		// Never scale down
-		plan = append(plan, createScalePlan(log, status, status.Members.Single, api.ServerGroupSingle, 1).Filter(filterScaleUP)...)
+		plan = append(plan, createScalePlan(log, status, status.Members.Single, api.ServerGroupSingle, retained[api.ServerGroupSingle], 1).Filter(filterScaleUP)...)
 	case api.DeploymentModeActiveFailover:
 		// Only scale agents & singles
 		if a := status.Agency; a != nil && a.Size != nil {
-			plan = append(plan, createScalePlan(log, status, status.Members.Agents, api.ServerGroupAgents, int(*a.Size)).Filter(filterScaleUP)...)
+			plan = append(plan, createScalePlan(log, status, status.Members.Agents, api.ServerGroupAgents, retained[api.ServerGroupAgents], int(*a.Size)).Filter(filterScaleUP)...)
 		}
-		plan = append(plan, createScalePlan(log, status, status.Members.Single, api.ServerGroupSingle, spec.Single.GetCount())...)
+		plan = append(plan, createScalePlan(log, status, status.Members.Single, api.ServerGroupSingle, retained[api.ServerGroupSingle], spec.Single.GetCount())...)
 	case api.DeploymentModeCluster:
 		// Scale agents, dbservers, coordinators
 		if a := status.Agency; a != nil && a.Size != nil {
-			plan = append(plan, createScalePlan(log, status, status.Members.Agents, api.ServerGroupAgents, int(*a.Size)).Filter(filterScaleUP)...)
+			plan = append(plan, createScalePlan(log, status, status.Members.Agents, api.ServerGroupAgents, retained[api.ServerGroupAgents], int(*a.Size)).Filter(filterScaleUP)...)
 		}
-		plan = append(plan, createScalePlan(log, status, status.Members.DBServers, api.ServerGroupDBServers, spec.DBServers.GetCount())...)
-		plan = append(plan, createScalePlan(log, status, status.Members.Coordinators, api.ServerGroupCoordinators, spec.Coordinators.GetCount())...)
+		plan = append(plan, createScalePlan(log, status, status.Members.DBServers, api.ServerGroupDBServers, retained[api.ServerGroupDBServers], spec.DBServers.GetCount())...)
+		plan = append(plan, createScalePlan(log, status, status.Members.Coordinators, api.ServerGroupCoordinators, retained[api.ServerGroupCoordinators], spec.Coordinators.GetCount())...)
 	}
 	if spec.GetMode().SupportsSync() {
 		// Scale syncmasters & syncworkers
-		plan = append(plan, createScalePlan(log, status, status.Members.SyncMasters, api.ServerGroupSyncMasters, spec.SyncMasters.GetCount())...)
-		plan = append(plan, createScalePlan(log, status, status.Members.SyncWorkers, api.ServerGroupSyncWorkers, spec.SyncWorkers.GetCount())...)
+		plan = append(plan, createScalePlan(log, status, status.Members.SyncMasters, api.ServerGroupSyncMasters, retained[api.ServerGroupSyncMasters], spec.SyncMasters.GetCount())...)
+		plan = append(plan, createScalePlan(log, status, status.Members.SyncWorkers, api.ServerGroupSyncWorkers, retained[api.ServerGroupSyncWorkers], spec.SyncWorkers.GetCount())...)
 	}
 
 	return plan
 }
 
-// createScalePlan creates a scaling plan for a single server group
-func createScalePlan(log zerolog.Logger, status api.DeploymentStatus, members api.MemberStatusList, group api.ServerGroup, count int) api.Plan {
+// createScalePlan creates a scaling plan for a single server group.
+// On scale up, members with retained PVCs are brought back first.
+func createScalePlan(log zerolog.Logger, status api.DeploymentStatus, members api.MemberStatusList, group api.ServerGroup, retained []string, count int) api.Plan {
 	var plan api.Plan
 	if len(members) < count {
 		// Scale up
 		toAdd := count - len(members)
 		for i := 0; i < toAdd; i++ {
-			plan = append(plan, actions.NewAction(api.ActionTypeAddMember, group, withPredefinedMember("")))
+			id := ""
+			if i < len(retained) {
+				id = retained[i]
+				log.Debug().Str("member-id", id).Str("role", group.AsRole()).Msg("Reusing member with retained PVC")
+			}
+			plan = append(plan, actions.NewAction(api.ActionTypeAddMember, group, withPredefinedMember(id)))
 		}
 		log.Debug().
 			Int("count", count).
@@ -108,6 +119,70 @@ func createScalePlan(log zerolog.Logger, status api.DeploymentStatus, members ap
 	return plan
 }
 
+// isRetainedPVCReusable returns true if removed members of the group can be brought back with their retained PVCs.
+// Agents and DBServers are removed from the agency (RemoveServer, cleanout), so their old data must never be reused.
+func isRetainedPVCReusable(group api.ServerGroup) bool {
+	switch group {
+	case api.ServerGroupSingle, api.ServerGroupCoordinators:
+		return true
+	default:
+		return false
+	}
+}
+
+// getRetainedPVCMembers returns IDs of removed members with retained PVCs, most recently removed first.
+func getRetainedPVCMembers(log zerolog.Logger, apiObject k8sutil.APIObject, status api.DeploymentStatus, cachedStatus inspectorInterface.Inspector) map[api.ServerGroup][]string {
+	type retainedMember struct {
+		id         string
+		retainedAt time.Time
+	}
+
+	members := map[api.ServerGroup][]retainedMember{}
+	if err := cachedStatus.IteratePersistentVolumeClaims(func(pvc *core.PersistentVolumeClaim) error {
+		id, retainedAt, ok := k8sutil.GetPersistentVolumeClaimRetention(pvc)
+		if !ok || k8sutil.IsPersistentVolumeClaimMarkedForDeletion(pvc) {
+			return nil
+		}
+
+		if _, _, found := status.Members.ElementByID(id); found {
+			return nil
+		}
+
+		group := api.ServerGroupFromRole(pvc.GetLabels()[k8sutil.LabelKeyRole])
+		if !isRetainedPVCReusable(group) {
+			return nil
+		}
+
+		if pvc.GetName() != k8sutil.CreatePersistentVolumeClaimName(apiObject.GetName(), group.AsRole(), id) {
+			// PVC would not be used by the member with this ID
+			return nil
+		}
+
+		members[group] = append(members[group], retainedMember{id: id, retainedAt: retainedAt})
+		return nil
+	}, func(pvc *core.PersistentVolumeClaim) bool {
+		return pvc.GetLabels()[k8sutil.LabelKeyArangoDeployment] == apiObject.GetName()
+	}); err != nil {
+		log.Warn().Err(err).Msg("Failed to list retained PVCs")
+	}
+
+	result := make(map[api.ServerGroup][]string, len(members))
+	for group, list := range members {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].retainedAt.Equal(list[j].retainedAt) {
+				return list[i].id < list[j].id
+			}
+			return list[i].retainedAt.After(list[j].retainedAt)
+		})
+
+		for _, m := range list {
+			result[group] = append(result[group], m.id)
+		}
+	}
+
+	return result
+}
+
 func createReplaceMemberPlan(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func Test_CreateScaleMemberPlan_RetainedPVC(t *testing.T) {
+	log := zerolog.Nop()
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "depl",
+			Namespace: "test",
+		},
+	}
+
+	pvc := func(role, id string, retainedAt time.Time) *core.PersistentVolumeClaim {
+		return &core.PersistentVolumeClaim{
+			ObjectMeta: meta.ObjectMeta{
+				Name:   k8sutil.CreatePersistentVolumeClaimName(depl.GetName(), role, id),
+				Labels: k8sutil.LabelsForDeployment(depl.GetName(), role),
+				Annotations: map[string]string{
+					constants.AnnotationPVCRetainedMember: id,
+					constants.AnnotationPVCRetainedAt:     retainedAt.Format(time.RFC3339),
+				},
+			},
+		}
+	}
+
+	now := time.Now()
+	pvcs := map[string]*core.PersistentVolumeClaim{}
+	for _, p := range []*core.PersistentVolumeClaim{
+		pvc(api.ServerGroupSingleString, "SNGL-old", now.Add(-time.Hour)),
+		pvc(api.ServerGroupSingleString, "SNGL-new", now),
+		pvc(api.ServerGroupDBServersString, "PRMR-old", now),
+	} {
+		pvcs[p.GetName()] = p
+	}
+	i := inspector.NewInspectorFromData(nil, nil, pvcs, nil, nil, nil, nil, nil, nil, nil, nil, "")
+
+	spec := api.DeploymentSpec{
+		Mode:   api.NewMode(api.DeploymentModeActiveFailover),
+		Single: api.ServerGroupSpec{Count: util.NewInt(4)},
+	}
+
+	var status api.DeploymentStatus
+	status.Members.Single = api.MemberStatusList{{ID: "SNGL-a"}}
+
+	t.Run("Members with retained PVCs are reused", func(t *testing.T) {
+		plan := createScaleMemberPlan(context.Background(), log, depl, spec, status, i, &testContext{})
+
+		require.Len(t, plan, 3)
+		require.Equal(t, "SNGL-new", plan[0].MemberID)
+		require.Equal(t, "SNGL-old", plan[1].MemberID)
+		require.Equal(t, "", plan[2].MemberID)
+	})
+
+	t.Run("Existing members are not reused", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.Members.Single = append(s.Members.Single, api.MemberStatus{ID: "SNGL-new"})
+
+		plan := createScaleMemberPlan(context.Background(), log, depl, spec, *s, i, &testContext{})
+
+		require.Len(t, plan, 2)
+		require.Equal(t, "SNGL-old", plan[0].MemberID)
+		require.Equal(t, "", plan[1].MemberID)
+	})
+
+	t.Run("DBServers are never reused", func(t *testing.T) {
+		spec := api.DeploymentSpec{
+			Mode:      api.NewMode(api.DeploymentModeCluster),
+			DBServers: api.ServerGroupSpec{Count: util.NewInt(2)},
+		}
+
+		var status api.DeploymentStatus
+		status.Members.DBServers = api.MemberStatusList{{ID: "PRMR-a"}}
+
+		plan := createScaleMemberPlan(context.Background(), log, depl, spec, status, i, &testContext{})
+
+		require.Len(t, plan, 1)
+		require.Equal(t, "", plan[0].MemberID)
+	})
+}
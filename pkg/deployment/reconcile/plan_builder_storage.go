@@ -25,10 +25,12 @@ import (
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 	"github.com/rs/zerolog"
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // createRotateServerStorageResizePlan creates plan to resize storage
@@ -38,7 +40,11 @@ func createRotateServerStorageResizePlan(ctx context.Context,
 	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
 	var plan api.Plan
 
+	canExpand := newVolumeExpansionChecker(log, cachedStatus)
+
 	status.Members.ForeachServerGroup(func(group api.ServerGroup, members api.MemberStatusList) error {
+		replacementInProgress := isMemberReplacementInProgress(members)
+
 		for _, m := range members {
 			if m.Phase != api.MemberPhaseCreated {
 				// Only make changes when phase is created
@@ -75,7 +81,26 @@ func createRotateServerStorageResizePlan(ctx context.Context,
 				if volumeSize, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]; ok {
 					cmp := volumeSize.Cmp(requestedSize)
 					if cmp < 0 {
-						plan = append(plan, pvcResizePlan(log, group, groupSpec, m)...)
+						if canExpand(util.StringOrDefault(pvc.Spec.StorageClassName)) {
+							plan = append(plan, pvcResizePlan(log, group, groupSpec, m)...)
+						} else {
+							if replacementInProgress {
+								// Wait for the previous replacement to finish
+								return nil
+							}
+
+							if ready, reason := groupReadyForRestart(context, spec, status, m, group); !ready {
+								log.Debug().Str("role", group.AsRole()).Str("id", m.ID).Str("reason", reason).
+									Msg("Member is not ready to be replaced")
+								return nil
+							}
+
+							if p := pvcReplacePlan(log, group, m); len(p) > 0 {
+								plan = append(plan, p...)
+								// Only 1 replacement at a time
+								return nil
+							}
+						}
 					}
 				}
 			}
@@ -86,6 +111,86 @@ func createRotateServerStorageResizePlan(ctx context.Context,
 	return plan
 }
 
+// createVolumeAutoExpansionPlan creates plan to expand PVCs of members, which volumes are almost full,
+// in groups with enabled automatic volume expansion.
+func createVolumeAutoExpansionPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
+	var plan api.Plan
+
+	canExpand := newVolumeExpansionChecker(log, cachedStatus)
+
+	status.Members.ForeachServerGroup(func(group api.ServerGroup, members api.MemberStatusList) error {
+		groupSpec := spec.GetServerGroupSpec(group)
+		if !groupSpec.VolumeAutoExpansion.IsEnabled() {
+			return nil
+		}
+
+		for _, m := range members {
+			if !plan.IsEmpty() {
+				// Only 1 expansion at a time
+				return nil
+			}
+
+			if m.Phase != api.MemberPhaseCreated || m.PersistentVolumeClaimName == "" ||
+				!m.Conditions.IsTrue(api.ConditionTypeDiskUsageHigh) {
+				continue
+			}
+
+			pvc, exists := cachedStatus.PersistentVolumeClaim(m.PersistentVolumeClaimName)
+			if !exists {
+				continue
+			}
+
+			size, ok := getVolumeAutoExpansionSize(groupSpec, m, pvc)
+			if !ok || !canExpand(util.StringOrDefault(pvc.Spec.StorageClassName)) {
+				continue
+			}
+
+			log.Info().Str("role", group.AsRole()).Str("id", m.ID).Str("size", size.String()).
+				Msg("Expanding almost full volume")
+
+			p := pvcResizePlan(log, group, groupSpec, m)
+			for id := range p {
+				if p[id].Type == api.ActionTypePVCResize {
+					p[id] = p[id].AddParam(actionPVCResizeSize, size.String())
+				}
+			}
+			plan = append(plan, p...)
+		}
+
+		return nil
+	})
+
+	return plan
+}
+
+// getVolumeAutoExpansionSize returns the size to which the almost full volume of the member is expanded.
+// Returns false when a previous expansion is not finished yet, or the volume cannot be expanded anymore.
+func getVolumeAutoExpansionSize(groupSpec api.ServerGroupSpec, m api.MemberStatus, pvc *core.PersistentVolumeClaim) (resource.Quantity, bool) {
+	if k8sutil.IsPersistentVolumeClaimFileSystemResizePending(pvc) {
+		return resource.Quantity{}, false
+	}
+
+	requested, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]
+	if !ok {
+		return resource.Quantity{}, false
+	}
+
+	if capacity, ok := pvc.Status.Capacity[core.ResourceStorage]; !ok || capacity.Cmp(requested) < 0 {
+		// Volume is being resized
+		return resource.Quantity{}, false
+	}
+
+	if m.Server == nil || m.Server.Disk == nil || m.Server.Disk.Total.Value() < requested.Value()*9/10 {
+		// Filesystem was not yet grown to the size of the volume, usage is not accurate
+		return resource.Quantity{}, false
+	}
+
+	return groupSpec.VolumeAutoExpansion.GetExpandedSize(requested)
+}
+
 func createRotateServerStoragePVCPendingResizeConditionPlan(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -116,6 +221,131 @@ func createRotateServerStoragePVCPendingResizeConditionPlan(ctx context.Context,
 	return plan
 }
 
+// createStorageClassMigrationPlan marks members, which PVC uses an outdated StorageClass, for replacement
+// in groups with the replace StorageClass change mode. Members are replaced one at a time, the next member is marked
+// when the previous replacement is finished, all members of the group are ready and data is in sync.
+func createStorageClassMigrationPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
+	if spec.GetMode() == api.DeploymentModeSingle {
+		// Storage cannot be changed in single server deployments.
+		return nil
+	}
+
+	var plan api.Plan
+
+	status.Members.ForeachServerInGroups(func(group api.ServerGroup, members api.MemberStatusList) error {
+		if !plan.IsEmpty() {
+			return nil
+		}
+
+		groupSpec := spec.GetServerGroupSpec(group)
+		if groupSpec.StorageClassChangeMode.Get() != api.StorageClassChangeModeReplace {
+			return nil
+		}
+
+		storageClassName := groupSpec.GetStorageClassName()
+		if storageClassName == "" {
+			return nil
+		}
+
+		if isMemberReplacementInProgress(members) {
+			// Wait for the previous replacement to finish
+			return nil
+		}
+
+		if !members.AllMembersReady() {
+			return nil
+		}
+
+		for _, m := range members {
+			if m.PersistentVolumeClaimName == "" {
+				continue
+			}
+
+			pvc, ok := cachedStatus.PersistentVolumeClaim(m.PersistentVolumeClaimName)
+			if !ok {
+				continue
+			}
+
+			if pvcClassName := util.StringOrDefault(pvc.Spec.StorageClassName); pvcClassName == "" || pvcClassName == storageClassName {
+				continue
+			}
+
+			if ready, reason := groupReadyForRestart(context, spec, status, m, group); !ready {
+				log.Debug().Str("role", group.AsRole()).Str("id", m.ID).Str("reason", reason).
+					Msg("Member is not ready to be moved to the new StorageClass")
+				return nil
+			}
+
+			plan = append(plan, actions.NewAction(api.ActionTypeMarkToRemoveMember, group, m, "StorageClass has changed"))
+			return nil
+		}
+
+		return nil
+	}, api.ServerGroupAgents, api.ServerGroupDBServers)
+
+	return plan
+}
+
+// isMemberReplacementInProgress returns true if any member of the group is marked to be removed or is not yet created.
+func isMemberReplacementInProgress(members api.MemberStatusList) bool {
+	for _, m := range members {
+		if m.Phase != api.MemberPhaseCreated || m.Conditions.IsTrue(api.ConditionTypeMarkedToRemove) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pvcReplacePlan returns plan replacing the member when its PVC cannot be expanded.
+// The event about the replacement is emitted by the action, once the member is marked to be removed.
+func pvcReplacePlan(log zerolog.Logger, group api.ServerGroup, member api.MemberStatus) api.Plan {
+	if member.Conditions.IsTrue(api.ConditionTypeMarkedToRemove) {
+		// Replacement is already in progress
+		return nil
+	}
+
+	switch group {
+	case api.ServerGroupDBServers, api.ServerGroupAgents:
+		return api.Plan{
+			actions.NewAction(api.ActionTypeMarkToRemoveMember, group, member, "PVC cannot be expanded").
+				AddParam(actionMarkToRemoveCannotExpandVolume, "true"),
+		}
+	default:
+		log.Warn().
+			Str("role", group.AsRole()).
+			Str("id", member.ID).
+			Msg("StorageClass does not allow volume expansion and member cannot be replaced")
+		return nil
+	}
+}
+
+// volumeExpansionChecker checks if volumes of the StorageClass with the given name can be expanded.
+type volumeExpansionChecker func(storageClassName string) bool
+
+// newVolumeExpansionChecker returns a checker which takes StorageClasses from the inspector.
+// Expansion is assumed to be possible when StorageClasses are not accessible, so the resize is attempted.
+func newVolumeExpansionChecker(log zerolog.Logger, cachedStatus inspectorInterface.Inspector) volumeExpansionChecker {
+	storageClasses, accessible := cachedStatus.GetStorageClasses()
+
+	return func(storageClassName string) bool {
+		if storageClassName == "" || !accessible {
+			return true
+		}
+
+		sc, ok := storageClasses.StorageClass(storageClassName)
+		if !ok {
+			log.Debug().Str("storage-class", storageClassName).Msg("StorageClass not found")
+			return true
+		}
+
+		return util.BoolOrDefault(sc.AllowVolumeExpansion, false)
+	}
+}
+
 func pvcResizePlan(log zerolog.Logger, group api.ServerGroup, groupSpec api.ServerGroupSpec, member api.MemberStatus) api.Plan {
 	mode := groupSpec.VolumeResizeMode.Get()
 	switch mode {
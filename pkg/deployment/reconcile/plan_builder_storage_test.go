@@ -0,0 +1,223 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_PVCReplacePlan(t *testing.T) {
+	log := zerolog.Nop()
+
+	t.Run("DBServer is replaced", func(t *testing.T) {
+		plan := pvcReplacePlan(log, api.ServerGroupDBServers, api.MemberStatus{ID: "id"})
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeMarkToRemoveMember, plan[0].Type)
+		require.Equal(t, "id", plan[0].MemberID)
+		v, ok := plan[0].GetParam(actionMarkToRemoveCannotExpandVolume)
+		require.True(t, ok)
+		require.Equal(t, "true", v)
+	})
+
+	t.Run("Replacement in progress", func(t *testing.T) {
+		m := api.MemberStatus{ID: "id"}
+		m.Conditions.Update(api.ConditionTypeMarkedToRemove, true, "", "")
+
+		require.Empty(t, pvcReplacePlan(log, api.ServerGroupDBServers, m))
+	})
+
+	t.Run("Single server cannot be replaced", func(t *testing.T) {
+		require.Empty(t, pvcReplacePlan(log, api.ServerGroupSingle, api.MemberStatus{ID: "id"}))
+	})
+}
+
+func Test_CreateStorageClassMigrationPlan(t *testing.T) {
+	log := zerolog.Nop()
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "test_depl",
+			Namespace: "test",
+		},
+	}
+
+	pvc := func(name, storageClassName string) *core.PersistentVolumeClaim {
+		return &core.PersistentVolumeClaim{
+			ObjectMeta: meta.ObjectMeta{Name: name},
+			Spec:       core.PersistentVolumeClaimSpec{StorageClassName: util.NewString(storageClassName)},
+		}
+	}
+
+	member := func(id string) api.MemberStatus {
+		m := api.MemberStatus{ID: id, Phase: api.MemberPhaseCreated, PersistentVolumeClaimName: id}
+		m.Conditions.Update(api.ConditionTypeReady, true, "", "")
+		return m
+	}
+
+	i := inspector.NewInspectorFromData(nil, nil, map[string]*core.PersistentVolumeClaim{
+		"a1": pvc("a1", "new"),
+		"a2": pvc("a2", "old"),
+		"a3": pvc("a3", "old"),
+	}, nil, nil, nil, nil, nil, nil, nil, nil, "")
+
+	spec := api.DeploymentSpec{
+		Mode: api.NewMode(api.DeploymentModeCluster),
+		Agents: api.ServerGroupSpec{
+			StorageClassName: util.NewString("new"),
+		},
+	}
+
+	var status api.DeploymentStatus
+	status.Members.Agents = api.MemberStatusList{member("a1"), member("a2"), member("a3")}
+
+	t.Run("Manual mode", func(t *testing.T) {
+		require.Empty(t, createStorageClassMigrationPlan(context.Background(), log, depl, spec, status, i, &testContext{}))
+	})
+
+	spec.Agents.StorageClassChangeMode = new(api.StorageClassChangeMode)
+	*spec.Agents.StorageClassChangeMode = api.StorageClassChangeModeReplace
+
+	t.Run("First outdated member is replaced", func(t *testing.T) {
+		plan := createStorageClassMigrationPlan(context.Background(), log, depl, spec, status, i, &testContext{})
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeMarkToRemoveMember, plan[0].Type)
+		require.Equal(t, "a2", plan[0].MemberID)
+	})
+
+	t.Run("Wait for replacement", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.Members.Agents[1].Conditions.Update(api.ConditionTypeMarkedToRemove, true, "", "")
+
+		require.Empty(t, createStorageClassMigrationPlan(context.Background(), log, depl, spec, *s, i, &testContext{}))
+	})
+
+	t.Run("Wait for ready members", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.Members.Agents[0].Conditions.Remove(api.ConditionTypeReady)
+
+		require.Empty(t, createStorageClassMigrationPlan(context.Background(), log, depl, spec, *s, i, &testContext{}))
+	})
+}
+
+func Test_CreateVolumeAutoExpansionPlan(t *testing.T) {
+	log := zerolog.Nop()
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "test_depl",
+			Namespace: "test",
+		},
+	}
+
+	pvc := func(name string, requested, capacity string) *core.PersistentVolumeClaim {
+		p := &core.PersistentVolumeClaim{
+			ObjectMeta: meta.ObjectMeta{Name: name},
+		}
+		p.Spec.Resources.Requests = core.ResourceList{core.ResourceStorage: resource.MustParse(requested)}
+		p.Status.Capacity = core.ResourceList{core.ResourceStorage: resource.MustParse(capacity)}
+		return p
+	}
+
+	member := func(id, diskTotal string, almostFull bool) api.MemberStatus {
+		m := api.MemberStatus{ID: id, Phase: api.MemberPhaseCreated, PersistentVolumeClaimName: id}
+		m.Server = &api.MemberServerStatus{Disk: &api.MemberServerDiskStatus{Total: resource.MustParse(diskTotal), UsedPercent: 95}}
+		if almostFull {
+			m.Conditions.Update(api.ConditionTypeDiskUsageHigh, true, "", "")
+		}
+		return m
+	}
+
+	i := inspector.NewInspectorFromData(nil, nil, map[string]*core.PersistentVolumeClaim{
+		"d1": pvc("d1", "10Gi", "10Gi"),
+		"d2": pvc("d2", "10Gi", "10Gi"),
+		"d3": pvc("d3", "20Gi", "10Gi"),
+	}, nil, nil, nil, nil, nil, nil, nil, nil, "")
+
+	spec := api.DeploymentSpec{
+		Mode: api.NewMode(api.DeploymentModeCluster),
+	}
+
+	var status api.DeploymentStatus
+	status.Members.DBServers = api.MemberStatusList{member("d1", "10Gi", false), member("d2", "10Gi", true)}
+
+	t.Run("Expansion disabled", func(t *testing.T) {
+		require.Empty(t, createVolumeAutoExpansionPlan(context.Background(), log, depl, spec, status, i, &testContext{}))
+	})
+
+	spec.DBServers.VolumeAutoExpansion = &api.ServerGroupVolumeAutoExpansionSpec{Enabled: util.NewBool(true)}
+
+	t.Run("Almost full volume is expanded", func(t *testing.T) {
+		plan := createVolumeAutoExpansionPlan(context.Background(), log, depl, spec, status, i, &testContext{})
+
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypePVCResize, plan[0].Type)
+		require.Equal(t, "d2", plan[0].MemberID)
+		size, ok := plan[0].GetParam(actionPVCResizeSize)
+		require.True(t, ok)
+		q := resource.MustParse(size)
+		require.Equal(t, int64(12*1024*1024*1024), q.Value())
+	})
+
+	t.Run("Wait for previous expansion", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.Members.DBServers = api.MemberStatusList{member("d3", "10Gi", true)}
+
+		require.Empty(t, createVolumeAutoExpansionPlan(context.Background(), log, depl, spec, *s, i, &testContext{}))
+	})
+
+	t.Run("Wait for filesystem resize", func(t *testing.T) {
+		s := status.DeepCopy()
+		s.Members.DBServers = api.MemberStatusList{member("d2", "5Gi", true)}
+
+		require.Empty(t, createVolumeAutoExpansionPlan(context.Background(), log, depl, spec, *s, i, &testContext{}))
+	})
+
+	t.Run("Maximum size reached", func(t *testing.T) {
+		maxSize := resource.MustParse("10Gi")
+		spec.DBServers.VolumeAutoExpansion.MaxSize = &maxSize
+
+		require.Empty(t, createVolumeAutoExpansionPlan(context.Background(), log, depl, spec, status, i, &testContext{}))
+	})
+}
+
+func Test_IsMemberReplacementInProgress(t *testing.T) {
+	created := api.MemberStatus{ID: "a", Phase: api.MemberPhaseCreated}
+
+	marked := api.MemberStatus{ID: "b", Phase: api.MemberPhaseCreated}
+	marked.Conditions.Update(api.ConditionTypeMarkedToRemove, true, "", "")
+
+	pending := api.MemberStatus{ID: "c", Phase: api.MemberPhaseNone}
+
+	require.False(t, isMemberReplacementInProgress(api.MemberStatusList{created}))
+	require.True(t, isMemberReplacementInProgress(api.MemberStatusList{created, marked}))
+	require.True(t, isMemberReplacementInProgress(api.MemberStatusList{created, pending}))
+}
@@ -80,6 +80,7 @@ type testContext struct {
 	PVC              *core.PersistentVolumeClaim
 	PVCErr           error
 	RecordedEvent    *k8sutil.Event
+	Backups          map[string]*backupApi.ArangoBackup
 
 	Inspector inspectorInterface.Inspector
 }
@@ -249,6 +250,14 @@ func (c *testContext) GetName() string {
 }
 
 func (c *testContext) GetBackup(_ context.Context, backup string) (*backupApi.ArangoBackup, error) {
+	if b, ok := c.Backups[backup]; ok {
+		return b, nil
+	}
+
+	return nil, apiErrors.NewNotFound(backupApi.Resource("arangobackups"), backup)
+}
+
+func (c *testContext) CreateBackup(_ context.Context, backup *backupApi.ArangoBackup) error {
 	panic("implement me")
 }
 
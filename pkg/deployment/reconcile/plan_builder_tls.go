@@ -47,8 +47,6 @@ import (
 	"github.com/rs/zerolog"
 )
 
-const CertificateRenewalMargin = 7 * 24 * time.Hour
-
 func createTLSStatusPropagatedFieldUpdate(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -226,7 +224,7 @@ func createCARenewalPlan(ctx context.Context,
 	}
 
 	for _, ca := range cas {
-		if time.Now().Add(CertificateRenewalMargin).After(ca.NotAfter) {
+		if time.Now().Add(spec.TLS.GetRenewalMargin()).After(ca.NotAfter) {
 			// CA will expire soon, renewal needed
 			return api.Plan{actions.NewClusterAction(api.ActionTypeRenewTLSCACertificate, "Renew CA Certificate")}
 		}
@@ -407,7 +405,7 @@ func createKeyfileRenewalPlanMode(
 }
 
 func checkServerValidCertRequest(ctx context.Context, context PlanBuilderContext, apiObject k8sutil.APIObject, group api.ServerGroup, member api.MemberStatus, ca resources.Certificates) (*tls.ConnectionState, error) {
-	endpoint := fmt.Sprintf("https://%s:%d", k8sutil.CreatePodDNSNameWithDomain(apiObject, context.GetSpec().ClusterDomain, group.AsRole(), member.ID), k8sutil.ArangoPort)
+	endpoint := fmt.Sprintf("https://%s:%d", k8sutil.CreatePodDNSNameWithDomain(apiObject, context.GetSpec().ClusterDomain, group.AsRole(), member.ID), context.GetSpec().GetServerGroupPort(group))
 
 	tlsConfig := &tls.Config{
 		RootCAs: ca.AsCertPool(),
@@ -470,6 +468,14 @@ func keyfileRenewalRequired(ctx context.Context,
 		return false, false
 	}
 
+	// Check if keyfile certificate is not going to expire
+	if expiry := member.TLSCertificateExpiry; expiry != nil {
+		if time.Now().Add(spec.TLS.GetRenewalMargin()).After(expiry.Time) {
+			log.Info().Time("expiry", expiry.Time).Msg("Keyfile renewal margin exceeded")
+			return true, true
+		}
+	}
+
 	res, err := checkServerValidCertRequest(ctx, context, apiObject, group, member, ca)
 	if err != nil {
 		switch v := err.(type) {
@@ -497,7 +503,7 @@ func keyfileRenewalRequired(ctx context.Context,
 			continue
 		}
 
-		if time.Now().Add(CertificateRenewalMargin).After(cert.NotAfter) {
+		if time.Now().Add(spec.TLS.GetRenewalMargin()).After(cert.NotAfter) {
 			log.Info().Msg("Renewal margin exceeded")
 			return true, true
 		}
@@ -37,6 +37,54 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// createExternalAccessTLSConditionPlan keeps the ExternalAccessTLSIgnored condition in line with the support
+// of TLS SNI, which is required to serve the certificate of the external access.
+func createExternalAccessTLSConditionPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, planCtx PlanBuilderContext) api.Plan {
+	reason := getExternalAccessTLSIgnoredReason(spec, status)
+
+	if reason == "" {
+		if _, ok := status.Conditions.Get(api.ConditionTypeExternalAccessTLSIgnored); ok {
+			return api.Plan{removeConditionActionV2("External access certificate is served", api.ConditionTypeExternalAccessTLSIgnored)}
+		}
+		return nil
+	}
+
+	if c, ok := status.Conditions.Get(api.ConditionTypeExternalAccessTLSIgnored); ok && c.IsTrue() && c.Message == reason {
+		return nil
+	}
+
+	log.Warn().Str("reason", reason).Msg("Certificate of the external access is ignored")
+
+	return api.Plan{updateConditionActionV2("External access certificate is ignored", api.ConditionTypeExternalAccessTLSIgnored, true, "TLS SNI Not Supported", reason, "")}
+}
+
+// getExternalAccessTLSIgnoredReason returns the reason why the certificate of the external access can not be served.
+// Returns empty string when certificate is not set, is served or the image is not yet discovered.
+func getExternalAccessTLSIgnoredReason(spec api.DeploymentSpec, status api.DeploymentStatus) string {
+	if spec.ExternalAccess.TLS.GetSecretName() == "" || !spec.TLS.IsSecure() {
+		// Not set, or rejected by the spec validation
+		return ""
+	}
+
+	i := status.CurrentImage
+	if i == nil {
+		return ""
+	}
+
+	if !features.TLSSNI().Enabled() {
+		return "TLS SNI feature is disabled in the operator"
+	}
+
+	if !features.TLSSNI().Supported(i.ArangoDBVersion, i.Enterprise) {
+		return "TLS SNI requires ArangoDB Enterprise " + string(features.TLSSNI().Version()) + " or newer"
+	}
+
+	return ""
+}
+
 func createRotateTLSServerSNIPlan(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -49,12 +97,13 @@ func createRotateTLSServerSNIPlan(ctx context.Context,
 		return nil
 	}
 
-	sni := spec.TLS.SNI
-	if sni == nil {
+	if spec.TLS.SNI == nil && spec.ExternalAccess.TLS.GetSecretName() == "" {
 		return nil
 	}
 
-	fetchedSecrets, err := mapTLSSNIConfig(*sni, cachedStatus)
+	sni := spec.GetTLSSNI()
+
+	fetchedSecrets, err := mapTLSSNIConfig(sni, cachedStatus)
 	if err != nil {
 		log.Warn().Err(err).Msg("Unable to get SNI desired state")
 		return nil
@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_GetExternalAccessTLSIgnoredReason(t *testing.T) {
+	spec := api.DeploymentSpec{
+		ExternalAccess: api.ExternalAccessSpec{
+			TLS: &api.ExternalAccessTLSSpec{
+				SecretName: util.NewString("public"),
+			},
+		},
+	}
+
+	t.Run("Not set", func(t *testing.T) {
+		require.Empty(t, getExternalAccessTLSIgnoredReason(api.DeploymentSpec{}, api.DeploymentStatus{
+			CurrentImage: &api.ImageInfo{ArangoDBVersion: "3.9.0"},
+		}))
+	})
+
+	t.Run("Image not discovered", func(t *testing.T) {
+		require.Empty(t, getExternalAccessTLSIgnoredReason(spec, api.DeploymentStatus{}))
+	})
+
+	t.Run("Community", func(t *testing.T) {
+		require.NotEmpty(t, getExternalAccessTLSIgnoredReason(spec, api.DeploymentStatus{
+			CurrentImage: &api.ImageInfo{ArangoDBVersion: "3.9.0"},
+		}))
+	})
+}
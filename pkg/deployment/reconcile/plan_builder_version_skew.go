@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	upgraderules "github.com/arangodb/go-upgrade-rules"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/rs/zerolog"
+)
+
+// createUpgradeVersionSkewPlan keeps the UpgradeBlocked condition in line with the version skew check.
+// Returns the error of the check when the upgrade needs to be blocked.
+func createUpgradeVersionSkewPlan(ctx context.Context, log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus, context PlanBuilderContext) (api.Plan, error) {
+	err := checkUpgradeVersionSkew(spec, status)
+	if err == nil {
+		if status.Conditions.IsTrue(api.ConditionTypeUpgradeBlocked) {
+			return api.Plan{removeConditionActionV2("Version skew resolved", api.ConditionTypeUpgradeBlocked)}, nil
+		}
+		return nil, nil
+	}
+
+	if c, ok := status.Conditions.Get(api.ConditionTypeUpgradeBlocked); ok && c.IsTrue() && c.Message == err.Error() {
+		return nil, err
+	}
+
+	log.Warn().Err(err).Msg("Upgrade blocked due to unsupported version skew")
+	context.CreateEvent(k8sutil.NewUpgradeBlockedEvent(apiObject, err.Error()))
+
+	return api.Plan{updateConditionActionV2("Version skew detected", api.ConditionTypeUpgradeBlocked, true, "Version Skew", err.Error(), "")}, err
+}
+
+// checkUpgradeVersionSkew verifies that the upgrade to the spec image does not produce an unsupported version skew
+// between members. It returns an error describing the violated rule.
+func checkUpgradeVersionSkew(spec api.DeploymentSpec, status api.DeploymentStatus) error {
+	target, ok := currentImageInfo(spec, status.Images)
+	if !ok {
+		// Image is not yet discovered
+		return nil
+	}
+
+	members := status.Members.AsList()
+
+	// Every member needs to be upgradable to the target version
+	for _, m := range members {
+		if m.Member.Image == nil || m.Member.Image.Image == target.Image {
+			continue
+		}
+
+		from := *m.Member.Image
+		if err := upgraderules.CheckUpgradeRulesWithLicense(from.ArangoDBVersion, target.ArangoDBVersion, asUpgradeLicense(from), asUpgradeLicense(target)); err != nil {
+			return errors.Newf("member %s (%s) can not be upgraded from %s to %s: %s", m.Member.ID, m.Group.AsRole(),
+				from.ArangoDBVersion, target.ArangoDBVersion, err.Error())
+		}
+	}
+
+	// Members are upgraded in order of groups, so later groups can not run a newer minor version than earlier groups
+	for i, a := range members {
+		if a.Member.Image == nil {
+			continue
+		}
+
+		for _, b := range members[i+1:] {
+			if b.Member.Image == nil || a.Group == b.Group {
+				continue
+			}
+
+			av, bv := a.Member.Image.ArangoDBVersion, b.Member.Image.ArangoDBVersion
+			if bv.Major() > av.Major() || (bv.Major() == av.Major() && bv.Minor() > av.Minor()) {
+				return errors.Newf("member %s (%s) runs %s which is newer than %s of member %s (%s): %s members need to be upgraded before %s members",
+					b.Member.ID, b.Group.AsRole(), bv, av, a.Member.ID, a.Group.AsRole(), a.Group.AsRole(), b.Group.AsRole())
+			}
+		}
+	}
+
+	return nil
+}
+
+func asUpgradeLicense(info api.ImageInfo) upgraderules.License {
+	if info.Enterprise {
+		return upgraderules.LicenseEnterprise
+	}
+	return upgraderules.LicenseCommunity
+}
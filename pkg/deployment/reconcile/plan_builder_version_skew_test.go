@@ -0,0 +1,91 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckUpgradeVersionSkew(t *testing.T) {
+	images := api.ImageInfoList{
+		{Image: "arangodb:3.8.5", ImageID: "arangodb:3.8.5", ArangoDBVersion: "3.8.5"},
+		{Image: "arangodb:3.9.1", ImageID: "arangodb:3.9.1", ArangoDBVersion: "3.9.1"},
+		{Image: "arangodb:3.10.0", ImageID: "arangodb:3.10.0", ArangoDBVersion: "3.10.0"},
+		{Image: "arangodb-ee:3.9.1", ImageID: "arangodb-ee:3.9.1", ArangoDBVersion: "3.9.1", Enterprise: true},
+	}
+
+	newStatus := func(t *testing.T, versions map[api.ServerGroup]string) api.DeploymentStatus {
+		status := api.DeploymentStatus{Images: images}
+		for group, image := range versions {
+			i, ok := images.GetByImage(image)
+			require.True(t, ok)
+			require.NoError(t, status.Members.Add(api.MemberStatus{ID: group.AsRoleAbbreviated(), Image: &i}, group))
+		}
+		return status
+	}
+
+	check := func(t *testing.T, image string, versions map[api.ServerGroup]string) error {
+		return checkUpgradeVersionSkew(api.DeploymentSpec{Image: util.NewString(image)}, newStatus(t, versions))
+	}
+
+	t.Run("Unknown image", func(t *testing.T) {
+		require.NoError(t, check(t, "arangodb:3.11.0", map[api.ServerGroup]string{
+			api.ServerGroupAgents: "arangodb:3.8.5",
+		}))
+	})
+
+	t.Run("Minor upgrade", func(t *testing.T) {
+		require.NoError(t, check(t, "arangodb:3.9.1", map[api.ServerGroup]string{
+			api.ServerGroupAgents:       "arangodb:3.9.1",
+			api.ServerGroupDBServers:    "arangodb:3.8.5",
+			api.ServerGroupCoordinators: "arangodb:3.8.5",
+		}))
+	})
+
+	t.Run("Skipping minor version", func(t *testing.T) {
+		err := check(t, "arangodb:3.10.0", map[api.ServerGroup]string{
+			api.ServerGroupAgents:       "arangodb:3.9.1",
+			api.ServerGroupDBServers:    "arangodb:3.8.5",
+			api.ServerGroupCoordinators: "arangodb:3.9.1",
+		})
+		require.EqualError(t, err, "member prmr (dbserver) can not be upgraded from 3.8.5 to 3.10.0: Minor versions may only increment by 1")
+	})
+
+	t.Run("Enterprise to Community", func(t *testing.T) {
+		err := check(t, "arangodb:3.9.1", map[api.ServerGroup]string{
+			api.ServerGroupAgents: "arangodb-ee:3.9.1",
+		})
+		require.EqualError(t, err, "member agnt (agent) can not be upgraded from 3.9.1 to 3.9.1: Upgrade from Enterprise to Community edition is not possible")
+	})
+
+	t.Run("Group order", func(t *testing.T) {
+		err := check(t, "arangodb:3.9.1", map[api.ServerGroup]string{
+			api.ServerGroupAgents:       "arangodb:3.8.5",
+			api.ServerGroupDBServers:    "arangodb:3.8.5",
+			api.ServerGroupCoordinators: "arangodb:3.9.1",
+		})
+		require.EqualError(t, err, "member crdn (coordinator) runs 3.9.1 which is newer than 3.8.5 of member agnt (agent): agent members need to be upgraded before coordinator members")
+	})
+}
@@ -23,6 +23,7 @@ package reconcile
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -30,9 +31,11 @@ import (
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/metrics"
+	"github.com/arangodb/kube-arangodb/pkg/util/dryrun"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 )
 
 var (
@@ -98,6 +101,9 @@ func (p plannerHigh) Set(deployment *api.DeploymentStatus, plan api.Plan) bool {
 // Returns true when it has to be called again soon.
 // False otherwise.
 func (d *Reconciler) ExecutePlan(ctx context.Context, cachedStatus inspectorInterface.Inspector) (bool, error) {
+	ctx, span := tracing.Start(ctx, "ExecutePlan")
+	defer span.End()
+
 	var callAgain bool
 
 	if again, err := d.executePlanStatus(ctx, cachedStatus, d.log, plannerHigh{}); err != nil {
@@ -124,6 +130,20 @@ func (d *Reconciler) executePlanStatus(ctx context.Context, cachedStatus inspect
 		return false, nil
 	}
 
+	if dryrun.IsEnabled() {
+		// Actions are only reported, plan stays in the status as it is
+		for _, action := range plan {
+			log.Info().
+				Str("action-id", action.ID).
+				Str("action-type", string(action.Type)).
+				Str("group", action.Group.AsRole()).
+				Str("member-id", action.MemberID).
+				Str("reason", action.Reason).
+				Msgf("Dry-run: %s plan action is not executed", pg.Type())
+		}
+		return false, nil
+	}
+
 	newPlan, callAgain, err := d.executePlan(ctx, cachedStatus, log, plan, pg)
 
 	// Refresh current status
@@ -173,6 +193,14 @@ func (d *Reconciler) executePlan(ctx context.Context, cachedStatus inspectorInte
 
 		log := logContext.Logger()
 
+		if planAction.IsHandedOver() {
+			// The action was in progress while the previous operator was shutting down.
+			// Continue it without counting the time without an operator into its timeout.
+			log.Info().Time("handover-time", planAction.HandoverTime.Time).Msg("Resuming action handed over by the previous operator")
+			plan[0] = planAction.Resume(time.Now())
+			planAction = plan[0]
+		}
+
 		action := d.createAction(log, planAction, cachedStatus)
 
 		done, abort, recall, retry, err := d.executeAction(ctx, log, planAction, action)
@@ -194,6 +222,8 @@ func (d *Reconciler) executePlan(ctx context.Context, cachedStatus inspectorInte
 				planAction.Type.String(), pg.Type()).Set(0.0)
 
 			actionsFailedMetrics.WithLabelValues(d.context.GetName(), planAction.Type.String(), pg.Type()).Inc()
+
+			d.markUpgradeFailed(ctx, log, planAction)
 			return nil, true, nil
 		}
 
@@ -219,7 +249,7 @@ func (d *Reconciler) executePlan(ctx context.Context, cachedStatus inspectorInte
 
 			if getActionReloadCachedStatus(action) {
 				log.Info().Msgf("Reloading cached status")
-				if err := cachedStatus.Refresh(ctx); err != nil {
+				if err := reloadActionCachedStatus(ctx, action, cachedStatus); err != nil {
 					log.Warn().Err(err).Msgf("Unable to reload cached status")
 					return plan, recall, nil
 				}
@@ -251,6 +281,16 @@ func (d *Reconciler) executePlan(ctx context.Context, cachedStatus inspectorInte
 }
 
 func (d *Reconciler) executeAction(ctx context.Context, log zerolog.Logger, planAction api.Action, action Action) (done, abort, callAgain, retry bool, err error) {
+	ctx, span := tracing.Start(ctx, "Action "+planAction.Type.String(),
+		tracing.String("action-id", planAction.ID),
+		tracing.String("group", planAction.Group.AsRole()),
+		tracing.String("member-id", planAction.MemberID),
+		tracing.String("started", strconv.FormatBool(planAction.IsStarted())))
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+
 	if !planAction.IsStarted() {
 		// Not started yet
 		ready, err := action.Start(ctx)
@@ -292,7 +332,7 @@ func (d *Reconciler) executeAction(ctx context.Context, log zerolog.Logger, plan
 		log.Warn().Msg("Action aborted. Removing the entire plan")
 		d.context.CreateEvent(k8sutil.NewPlanAbortedEvent(d.context.GetAPIObject(), string(planAction.Type), planAction.MemberID, planAction.Group.AsRole()))
 		return false, true, false, false, nil
-	} else if time.Now().After(planAction.CreationTime.Add(GetActionTimeout(d.context.GetSpec(), planAction.Type))) {
+	} else if time.Now().After(planAction.Deadline(GetActionTimeout(d.context.GetSpec(), planAction.Type))) {
 		log.Warn().Msg("Action not finished in time. Removing the entire plan")
 		d.context.CreateEvent(k8sutil.NewPlanTimeoutEvent(d.context.GetAPIObject(), string(planAction.Type), planAction.MemberID, planAction.Group.AsRole()))
 		return false, true, false, false, nil
@@ -313,3 +353,16 @@ func (d *Reconciler) createAction(log zerolog.Logger, action api.Action, cachedS
 
 	return f(log, action, actionCtx)
 }
+
+// markUpgradeFailed marks the upgrade in progress as failed, when aborted action was executed on a member
+func (d *Reconciler) markUpgradeFailed(ctx context.Context, log zerolog.Logger, planAction api.Action) {
+	if planAction.MemberID == "" {
+		return
+	}
+
+	if err := d.context.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		return s.MarkUpgradeFailed(fmt.Sprintf("Action %s on member %s aborted", planAction.Type, planAction.MemberID))
+	}); err != nil {
+		log.Warn().Err(err).Msg("Unable to mark upgrade as failed")
+	}
+}
@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+)
+
+// getAdvertisedEndpoint returns the endpoint advertised by coordinators/single servers or empty string when none.
+func getAdvertisedEndpoint(spec api.DeploymentSpec, status api.DeploymentStatus) string {
+	if spec.ExternalAccess.HasAdvertisedEndpoint() {
+		return spec.ExternalAccess.GetAdvertisedEndpointURL(spec.IsSecure(), spec.GetDatabasePort())
+	}
+
+	if spec.ExternalAccess.IsAutoAdvertisedEndpoint() {
+		return status.AdvertisedEndpoint
+	}
+
+	return ""
+}
+
+// advertisedEndpointFromService returns the endpoint of the load-balancer assigned to the external access service.
+func advertisedEndpointFromService(svc *core.Service, secure bool) (string, bool) {
+	if svc.Spec.Type != core.ServiceTypeLoadBalancer || len(svc.Spec.Ports) == 0 {
+		return "", false
+	}
+
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		host := ingress.Hostname
+		if host == "" {
+			host = ingress.IP
+		}
+
+		if host == "" {
+			continue
+		}
+
+		scheme := "tcp"
+		if secure {
+			scheme = "ssl"
+		}
+
+		return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, strconv.Itoa(int(svc.Spec.Ports[0].Port)))), true
+	}
+
+	return "", false
+}
+
+// EnsureAdvertisedEndpoint keeps the advertised endpoint in status in sync with the external access.
+// When the endpoint is discovered from the load-balancer, the last known endpoint is kept while no load-balancer
+// is assigned, to avoid needless rotations of members.
+func (r *Resources) EnsureAdvertisedEndpoint(ctx context.Context, cachedStatus inspectorInterface.Inspector) error {
+	spec := r.context.GetSpec()
+	status, lastVersion := r.context.GetStatus()
+
+	endpoint := status.AdvertisedEndpoint
+
+	switch {
+	case spec.ExternalAccess.HasAdvertisedEndpoint():
+		endpoint = getAdvertisedEndpoint(spec, status)
+	case spec.ExternalAccess.IsAutoAdvertisedEndpoint():
+		if svc, ok := cachedStatus.Service(k8sutil.CreateDatabaseExternalAccessServiceName(r.context.GetAPIObject().GetName())); ok {
+			if ep, ok := advertisedEndpointFromService(svc, spec.IsSecure()); ok {
+				endpoint = ep
+			}
+		}
+	default:
+		endpoint = ""
+	}
+
+	if endpoint == status.AdvertisedEndpoint {
+		return nil
+	}
+
+	r.log.Info().Str("from", status.AdvertisedEndpoint).Str("to", endpoint).Msg("Advertised endpoint changed")
+
+	status.AdvertisedEndpoint = endpoint
+
+	return r.context.UpdateStatus(ctx, status, lastVersion)
+}
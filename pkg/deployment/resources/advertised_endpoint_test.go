@@ -0,0 +1,72 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_AdvertisedEndpointFromService(t *testing.T) {
+	svc := &core.Service{
+		Spec: core.ServiceSpec{
+			Type:  core.ServiceTypeLoadBalancer,
+			Ports: []core.ServicePort{{Port: 8529}},
+		},
+	}
+
+	_, ok := advertisedEndpointFromService(svc, true)
+	require.False(t, ok, "no load-balancer assigned")
+
+	svc.Status.LoadBalancer.Ingress = []core.LoadBalancerIngress{{IP: "10.0.0.1"}}
+	ep, ok := advertisedEndpointFromService(svc, true)
+	require.True(t, ok)
+	require.Equal(t, "ssl://10.0.0.1:8529", ep)
+
+	svc.Status.LoadBalancer.Ingress = []core.LoadBalancerIngress{{Hostname: "lb.example.com", IP: "10.0.0.1"}}
+	ep, ok = advertisedEndpointFromService(svc, false)
+	require.True(t, ok)
+	require.Equal(t, "tcp://lb.example.com:8529", ep)
+
+	svc.Spec.Type = core.ServiceTypeNodePort
+	_, ok = advertisedEndpointFromService(svc, false)
+	require.False(t, ok, "only load-balancer endpoints are discovered")
+}
+
+func Test_GetAdvertisedEndpoint(t *testing.T) {
+	status := api.DeploymentStatus{AdvertisedEndpoint: "ssl://10.0.0.1:8529"}
+
+	require.Equal(t, "", getAdvertisedEndpoint(api.DeploymentSpec{}, status))
+	require.Equal(t, "ssl://10.0.0.1:8529", getAdvertisedEndpoint(api.DeploymentSpec{
+		ExternalAccess: api.ExternalAccessSpec{AutoAdvertisedEndpoint: util.NewBool(true)},
+	}, status))
+	require.Equal(t, "tcp://db.example.com:8529", getAdvertisedEndpoint(api.DeploymentSpec{
+		ExternalAccess: api.ExternalAccessSpec{
+			AdvertisedEndpoint:     util.NewString("tcp://db.example.com:8529"),
+			AutoAdvertisedEndpoint: util.NewBool(true),
+		},
+	}, status))
+}
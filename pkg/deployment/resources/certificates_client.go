@@ -0,0 +1,230 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	certificates "github.com/arangodb-helper/go-certificates"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/secret"
+	"github.com/rs/zerolog"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClientCertificateChecksumAnnotation keeps checksum of the spec used to issue the client certificate
+	ClientCertificateChecksumAnnotation = "deployment.arangodb.com/client-certificate-checksum"
+)
+
+// GetTLSClientCertificateChecksum returns checksum of the client certificate spec, certificate is reissued when it changes.
+func GetTLSClientCertificateChecksum(spec api.TLSSpec, cert api.TLSClientCertificateSpec) (string, error) {
+	return util.SHA256FromJSON([]interface{}{cert.GetCommonName(), cert.AltNames, cert.GetTTL(spec.GetTTL())})
+}
+
+// IsTLSClientCertificateRenewalRequired returns true when the client certificate stored in the secret needs to be reissued.
+// It happens when spec changes, certificate is close to expiration or it is not signed by the current CA.
+func IsTLSClientCertificateRenewalRequired(log zerolog.Logger, s *core.Secret, caCert []byte, checksum string, margin time.Duration) bool {
+	if s.GetAnnotations()[ClientCertificateChecksumAnnotation] != checksum {
+		return true
+	}
+
+	if string(s.Data[constants.SecretCACertificate]) != string(caCert) {
+		return true
+	}
+
+	certs := GetCertsFromData(log, s.Data[core.TLSCertKey])
+	if len(certs) == 0 {
+		return true
+	}
+
+	if time.Now().Add(margin).After(certs[0].NotAfter) {
+		return true
+	}
+
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(caCert)
+
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return true
+	}
+
+	return false
+}
+
+// ensureTLSClientCertificates issues client certificates defined in spec.tls.clientCertificates,
+// renews them before expiration and removes the ones which are not defined anymore.
+func (r *Resources) ensureTLSClientCertificates(ctx context.Context, log zerolog.Logger, cachedStatus inspectorInterface.Inspector, secrets secret.ModInterface, spec api.TLSSpec) error {
+	deploymentName := r.context.GetAPIObject().GetName()
+	wanted := make(map[string]bool, len(spec.ClientCertificates))
+	changed := false
+
+	if len(spec.ClientCertificates) > 0 {
+		caSecret, exists := cachedStatus.Secret(spec.GetCASecretName())
+		if !exists {
+			return errors.Newf("CA Secret %s does not exist", spec.GetCASecretName())
+		}
+
+		caCert, caKey, _, err := k8sutil.GetCAFromSecret(caSecret, nil)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		ca, err := certificates.LoadCAFromPEM(caCert, caKey)
+		if err != nil {
+			return errors.WithStack(errors.Wrapf(err, "Failed to decode CA certificate"))
+		}
+
+		for _, cert := range spec.ClientCertificates {
+			wanted[cert.SecretName] = true
+
+			certLog := log.With().Str("secret", cert.SecretName).Logger()
+
+			checksum, err := GetTLSClientCertificateChecksum(spec, cert)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			s, exists := cachedStatus.Secret(cert.SecretName)
+			if exists {
+				if _, ok := s.GetLabels()[k8sutil.LabelKeyArangoClientCertificate]; !ok {
+					certLog.Warn().Msg("Secret is not managed by the operator, client certificate is not issued")
+					continue
+				}
+
+				if !IsTLSClientCertificateRenewalRequired(certLog, s, []byte(caCert), checksum, spec.GetRenewalMargin()) {
+					continue
+				}
+			}
+
+			hosts, emails, err := cert.GetParsedAltNames()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			options := certificates.CreateCertificateOptions{
+				CommonName:     cert.GetCommonName(),
+				Hosts:          hosts,
+				EmailAddresses: emails,
+				ValidFrom:      time.Now(),
+				ValidFor:       cert.GetTTL(spec.GetTTL()).AsDuration(),
+				IsClientAuth:   true,
+				ECDSACurve:     clientAuthECDSACurve,
+			}
+			certPem, keyPem, err := certificates.CreateCertificate(options, &ca)
+			if err != nil {
+				return errors.WithStack(errors.Wrapf(err, "Failed to create client certificate"))
+			}
+
+			data := map[string][]byte{
+				core.TLSCertKey:               []byte(certPem),
+				core.TLSPrivateKeyKey:         []byte(keyPem),
+				constants.SecretCACertificate: []byte(caCert),
+			}
+
+			if exists {
+				s = s.DeepCopy()
+				s.Data = data
+				if s.Annotations == nil {
+					s.Annotations = map[string]string{}
+				}
+				s.Annotations[ClientCertificateChecksumAnnotation] = checksum
+
+				if err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+					_, err := secrets.Update(ctxChild, s, meta.UpdateOptions{})
+					return err
+				}); err != nil {
+					return errors.WithStack(err)
+				}
+
+				certLog.Info().Msg("Renewed client certificate")
+				changed = true
+				continue
+			}
+
+			if err := r.createSecretWithMod(ctx, secrets, cert.SecretName, func(s *core.Secret) {
+				s.Type = core.SecretTypeTLS
+				s.Data = data
+				s.Labels = k8sutil.LabelsForDeployment(deploymentName, "")
+				s.Labels[k8sutil.LabelKeyArangoClientCertificate] = "true"
+				s.Annotations = map[string]string{
+					ClientCertificateChecksumAnnotation: checksum,
+				}
+			}); err != nil && !errors.IsReconcile(err) {
+				if k8sutil.IsAlreadyExists(err) {
+					continue
+				}
+				return errors.WithStack(err)
+			}
+
+			certLog.Info().Msg("Created client certificate")
+			changed = true
+		}
+	}
+
+	// Remove client certificates which are not defined anymore
+	var obsolete []string
+	if err := cachedStatus.IterateSecrets(func(s *core.Secret) error {
+		obsolete = append(obsolete, s.GetName())
+		return nil
+	}, func(s *core.Secret) bool {
+		if s.GetLabels()[k8sutil.LabelKeyArangoDeployment] != deploymentName {
+			return false
+		}
+
+		if _, ok := s.GetLabels()[k8sutil.LabelKeyArangoClientCertificate]; !ok {
+			return false
+		}
+
+		return !wanted[s.GetName()]
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, name := range obsolete {
+		if err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return secrets.Delete(ctxChild, name, meta.DeleteOptions{})
+		}); err != nil && !k8sutil.IsNotFound(err) {
+			return errors.WithStack(err)
+		}
+
+		log.Info().Str("secret", name).Msg("Removed client certificate")
+		changed = true
+	}
+
+	if changed {
+		return errors.Reconcile()
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	certificates "github.com/arangodb-helper/go-certificates"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_IsTLSClientCertificateRenewalRequired(t *testing.T) {
+	newCA := func(t *testing.T) (string, certificates.CA) {
+		caCert, caKey, err := certificates.CreateCertificate(certificates.CreateCertificateOptions{
+			CommonName: "CA",
+			ValidFrom:  time.Now(),
+			ValidFor:   caTTL,
+			IsCA:       true,
+			ECDSACurve: tlsECDSACurve,
+		}, nil)
+		require.NoError(t, err)
+
+		ca, err := certificates.LoadCAFromPEM(caCert, caKey)
+		require.NoError(t, err)
+
+		return caCert, ca
+	}
+
+	caCert, ca := newCA(t)
+	otherCACert, otherCA := newCA(t)
+
+	spec := api.TLSSpec{TTL: api.NewDuration("720h")}
+	checksum, err := GetTLSClientCertificateChecksum(spec, api.TLSClientCertificateSpec{SecretName: "app"})
+	require.NoError(t, err)
+
+	newSecret := func(t *testing.T, caCert string, ca certificates.CA, validFor time.Duration) *core.Secret {
+		cert, key, err := certificates.CreateCertificate(certificates.CreateCertificateOptions{
+			CommonName:   "app",
+			ValidFrom:    time.Now(),
+			ValidFor:     validFor,
+			IsClientAuth: true,
+			ECDSACurve:   clientAuthECDSACurve,
+		}, &ca)
+		require.NoError(t, err)
+
+		return &core.Secret{
+			ObjectMeta: meta.ObjectMeta{
+				Annotations: map[string]string{
+					ClientCertificateChecksumAnnotation: checksum,
+				},
+			},
+			Data: map[string][]byte{
+				core.TLSCertKey:               []byte(cert),
+				core.TLSPrivateKeyKey:         []byte(key),
+				constants.SecretCACertificate: []byte(caCert),
+			},
+		}
+	}
+
+	t.Run("Valid certificate", func(t *testing.T) {
+		s := newSecret(t, caCert, ca, 720*time.Hour)
+		require.False(t, IsTLSClientCertificateRenewalRequired(log.Logger, s, []byte(caCert), checksum, 168*time.Hour))
+	})
+
+	t.Run("Spec changed", func(t *testing.T) {
+		s := newSecret(t, caCert, ca, 720*time.Hour)
+		require.True(t, IsTLSClientCertificateRenewalRequired(log.Logger, s, []byte(caCert), "other", 168*time.Hour))
+	})
+
+	t.Run("Close to expiration", func(t *testing.T) {
+		s := newSecret(t, caCert, ca, 24*time.Hour)
+		require.True(t, IsTLSClientCertificateRenewalRequired(log.Logger, s, []byte(caCert), checksum, 168*time.Hour))
+	})
+
+	t.Run("CA changed", func(t *testing.T) {
+		s := newSecret(t, caCert, ca, 720*time.Hour)
+		require.True(t, IsTLSClientCertificateRenewalRequired(log.Logger, s, []byte(otherCACert), checksum, 168*time.Hour))
+	})
+
+	t.Run("Signed by other CA", func(t *testing.T) {
+		s := newSecret(t, caCert, otherCA, 720*time.Hour)
+		require.True(t, IsTLSClientCertificateRenewalRequired(log.Logger, s, []byte(caCert), checksum, 168*time.Hour))
+	})
+
+	t.Run("Missing certificate", func(t *testing.T) {
+		s := newSecret(t, caCert, ca, 720*time.Hour)
+		delete(s.Data, core.TLSCertKey)
+		require.True(t, IsTLSClientCertificateRenewalRequired(log.Logger, s, []byte(caCert), checksum, 168*time.Hour))
+	})
+}
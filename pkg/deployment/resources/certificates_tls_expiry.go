@@ -0,0 +1,128 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/rs/zerolog"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	tlsCertificateExpiryGauges = metrics.MustRegisterGaugeVec(metricsComponent, "tls_certificate_expiry", "Expiration time of the member TLS certificate (unix timestamp in sec)", metrics.DeploymentName, metrics.MemberGroup, metrics.MemberID)
+)
+
+// GetTLSKeyfileCertificateExpiry returns the earliest expiration time of server certificates stored in the keyfile secret.
+func GetTLSKeyfileCertificateExpiry(log zerolog.Logger, secret *core.Secret) (time.Time, bool) {
+	keyfile, ok := secret.Data[constants.SecretTLSKeyfile]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	var expiry time.Time
+
+	for _, cert := range GetCertsFromData(log, keyfile) {
+		if cert.IsCA {
+			continue
+		}
+
+		if expiry.IsZero() || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+		}
+	}
+
+	return expiry, !expiry.IsZero()
+}
+
+// inspectTLSKeyfileExpiry propagates expiration time of member keyfile certificates into status and metrics.
+func (r *Resources) inspectTLSKeyfileExpiry(ctx context.Context, log zerolog.Logger, cachedStatus inspectorInterface.Inspector) error {
+	status, lastVersion := r.context.GetStatus()
+	deploymentName := r.context.GetAPIObject().GetName()
+
+	type memberUpdate struct {
+		group  api.ServerGroup
+		member api.MemberStatus
+	}
+
+	var updates []memberUpdate
+
+	exported := map[string]api.ServerGroup{}
+
+	status.Members.ForeachServerGroup(func(group api.ServerGroup, list api.MemberStatusList) error {
+		if !group.IsArangod() {
+			return nil
+		}
+
+		for _, m := range list {
+			var expiry *meta.Time
+
+			if s, ok := cachedStatus.Secret(k8sutil.CreateTLSKeyfileSecretName(deploymentName, group.AsRole(), m.ID)); ok {
+				if t, ok := GetTLSKeyfileCertificateExpiry(log, s); ok {
+					expiry = &meta.Time{Time: t}
+					tlsCertificateExpiryGauges.WithLabelValues(deploymentName, group.AsRole(), m.ID).Set(float64(t.Unix()))
+					exported[m.ID] = group
+				}
+			}
+
+			if expiry == nil && m.TLSCertificateExpiry == nil {
+				continue
+			}
+
+			if expiry != nil && m.TLSCertificateExpiry != nil && expiry.Equal(m.TLSCertificateExpiry) {
+				continue
+			}
+
+			m.TLSCertificateExpiry = expiry
+			updates = append(updates, memberUpdate{group: group, member: m})
+		}
+
+		return nil
+	})
+
+	// Remove series of members which are gone or have no certificate anymore
+	for id, group := range r.tlsExpiryMembers {
+		if _, ok := exported[id]; !ok {
+			tlsCertificateExpiryGauges.DeleteLabelValues(deploymentName, group.AsRole(), id)
+		}
+	}
+	r.tlsExpiryMembers = exported
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	for _, u := range updates {
+		if err := status.Members.Update(u.member, u.group); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return r.context.UpdateStatus(ctx, status, lastVersion)
+}
@@ -0,0 +1,75 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	certificates "github.com/arangodb-helper/go-certificates"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+)
+
+func Test_GetTLSKeyfileCertificateExpiry(t *testing.T) {
+	caCert, caKey, err := certificates.CreateCertificate(certificates.CreateCertificateOptions{
+		CommonName: "CA",
+		ValidFrom:  time.Now(),
+		ValidFor:   caTTL,
+		IsCA:       true,
+		ECDSACurve: tlsECDSACurve,
+	}, nil)
+	require.NoError(t, err)
+
+	ca, err := certificates.LoadCAFromPEM(caCert, caKey)
+	require.NoError(t, err)
+
+	validFrom := time.Now().Truncate(time.Second)
+
+	cert, priv, err := certificates.CreateCertificate(certificates.CreateCertificateOptions{
+		CommonName: "server",
+		Hosts:      []string{"server"},
+		ValidFrom:  validFrom,
+		ValidFor:   time.Hour,
+		ECDSACurve: tlsECDSACurve,
+	}, &ca)
+	require.NoError(t, err)
+
+	t.Run("Missing keyfile", func(t *testing.T) {
+		_, ok := GetTLSKeyfileCertificateExpiry(log.Logger, &core.Secret{})
+		require.False(t, ok)
+	})
+
+	t.Run("Valid keyfile", func(t *testing.T) {
+		s := &core.Secret{
+			Data: map[string][]byte{
+				constants.SecretTLSKeyfile: []byte(strings.TrimSpace(cert) + "\n" + strings.TrimSpace(priv)),
+			},
+		}
+
+		expiry, ok := GetTLSKeyfileCertificateExpiry(log.Logger, s)
+		require.True(t, ok)
+		require.True(t, validFrom.Add(time.Hour).Equal(expiry))
+	})
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/operator/scope"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/vault"
 )
 
 // Context provides all functions needed by the Resources service
@@ -79,6 +80,8 @@ type Context interface {
 	// GetBackup receives information about a backup resource
 	GetBackup(ctx context.Context, backup string) (*backupApi.ArangoBackup, error)
 	GetScope() scope.Scope
+	// GetVaultClient returns the client used to read secrets from Vault, nil when operator does not read secrets from Vault
+	GetVaultClient() vault.Client
 
 	SetCachedStatus(i inspectorInterface.Inspector)
 }
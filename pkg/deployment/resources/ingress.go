@@ -0,0 +1,164 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+// ingressSpec returns the spec of the Ingress which routes traffic to the external access service
+func ingressSpec(serviceName string, port int, spec api.ExternalAccessSpec) networking.IngressSpec {
+	pathType := networking.PathTypePrefix
+	host := spec.Ingress.GetHost()
+
+	ingress := networking.IngressSpec{
+		IngressClassName: spec.Ingress.GetIngressClassName(),
+		Rules: []networking.IngressRule{
+			{
+				Host: host,
+				IngressRuleValue: networking.IngressRuleValue{
+					HTTP: &networking.HTTPIngressRuleValue{
+						Paths: []networking.HTTPIngressPath{
+							{
+								Path:     "/",
+								PathType: &pathType,
+								Backend: networking.IngressBackend{
+									Service: &networking.IngressServiceBackend{
+										Name: serviceName,
+										Port: networking.ServiceBackendPort{
+											Number: int32(port),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if secretName := spec.Ingress.GetTLSSecretName(); secretName != "" {
+		ingress.TLS = []networking.IngressTLS{
+			{
+				Hosts:      []string{host},
+				SecretName: secretName,
+			},
+		}
+	}
+
+	return ingress
+}
+
+// ensureExternalAccessIngress creates, updates or removes the Ingress of the external access service.
+func (r *Resources) ensureExternalAccessIngress(ctx context.Context, cachedStatus inspectorInterface.Inspector, serviceName string, port int, spec api.ExternalAccessSpec) error {
+	log := r.log
+	apiObject := r.context.GetAPIObject()
+	wantIngress := spec.GetType().IsIngress()
+
+	existing, exists := cachedStatus.Ingress(serviceName)
+	if !exists && !wantIngress {
+		// Nothing to remove, or Ingresses are not accessible when they were never requested
+		return nil
+	}
+
+	client, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		return errors.Newf("Client not initialised")
+	}
+
+	ingresses := client.Kubernetes().NetworkingV1().Ingresses(apiObject.GetNamespace())
+
+	if !exists {
+		ingress := &networking.Ingress{
+			ObjectMeta: meta.ObjectMeta{
+				Name:            serviceName,
+				Labels:          k8sutil.LabelsForDeployment(apiObject.GetName(), ""),
+				Annotations:     spec.Ingress.GetAnnotations(),
+				OwnerReferences: []meta.OwnerReference{apiObject.AsOwner()},
+			},
+			Spec: ingressSpec(serviceName, port, spec),
+		}
+
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			_, err := ingresses.Create(ctxChild, ingress, meta.CreateOptions{})
+			return err
+		})
+		if err != nil && !k8sutil.IsAlreadyExists(err) {
+			return errors.WithStack(err)
+		}
+		log.Debug().Str("ingress", serviceName).Msgf("Created external access ingress")
+		return nil
+	}
+
+	if !k8sutil.IsOwner(apiObject.AsOwner(), existing) {
+		log.Debug().Str("ingress", serviceName).Msgf("Found external access ingress, but not owned by us, will not touch it")
+		return nil
+	}
+
+	if !wantIngress {
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return ingresses.Delete(ctxChild, serviceName, meta.DeleteOptions{})
+		})
+		if err != nil && !k8sutil.IsNotFound(err) {
+			return errors.WithStack(err)
+		}
+		log.Info().Str("ingress", serviceName).Msgf("Removed obsolete external access ingress")
+		return nil
+	}
+
+	expected := ingressSpec(serviceName, port, spec)
+	annotations := spec.Ingress.GetAnnotations()
+	if equality.Semantic.DeepEqual(expected, existing.Spec) && equality.Semantic.DeepDerivative(annotations, existing.GetAnnotations()) {
+		return nil
+	}
+
+	ingress := existing.DeepCopy()
+	ingress.Spec = expected
+	if len(annotations) > 0 && ingress.Annotations == nil {
+		ingress.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		ingress.Annotations[k] = v
+	}
+
+	err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := ingresses.Update(ctxChild, ingress, meta.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	log.Debug().Str("ingress", serviceName).Msgf("Updated external access ingress")
+
+	return nil
+}
@@ -0,0 +1,67 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_IngressSpec(t *testing.T) {
+	t.Run("Without TLS", func(t *testing.T) {
+		spec := ingressSpec("example-ea", 8529, api.ExternalAccessSpec{
+			Type: api.NewExternalAccessType(api.ExternalAccessTypeIngress),
+			Ingress: &api.ExternalAccessIngressSpec{
+				Host:             util.NewString("arangodb.example.com"),
+				IngressClassName: util.NewString("nginx"),
+			},
+		})
+
+		require.Equal(t, "nginx", *spec.IngressClassName)
+		require.Empty(t, spec.TLS)
+		require.Len(t, spec.Rules, 1)
+		require.Equal(t, "arangodb.example.com", spec.Rules[0].Host)
+
+		paths := spec.Rules[0].HTTP.Paths
+		require.Len(t, paths, 1)
+		require.Equal(t, "/", paths[0].Path)
+		require.Equal(t, "example-ea", paths[0].Backend.Service.Name)
+		require.EqualValues(t, 8529, paths[0].Backend.Service.Port.Number)
+	})
+
+	t.Run("With TLS", func(t *testing.T) {
+		spec := ingressSpec("example-ea", 8529, api.ExternalAccessSpec{
+			Ingress: &api.ExternalAccessIngressSpec{
+				Host:          util.NewString("arangodb.example.com"),
+				TLSSecretName: util.NewString("arangodb-tls"),
+			},
+		})
+
+		require.Nil(t, spec.IngressClassName)
+		require.Len(t, spec.TLS, 1)
+		require.Equal(t, "arangodb-tls", spec.TLS[0].SecretName)
+		require.Equal(t, []string{"arangodb.example.com"}, spec.TLS[0].Hosts)
+	})
+}
@@ -0,0 +1,145 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+
+	networking "k8s.io/api/networking/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/ingress"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (i *inspector) IterateIngresses(action ingress.Action, filters ...ingress.Filter) error {
+	for _, ingress := range i.Ingresses() {
+		if err := i.iterateIngress(ingress, action, filters...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *inspector) iterateIngress(ingress *networking.Ingress, action ingress.Action, filters ...ingress.Filter) error {
+	for _, filter := range filters {
+		if !filter(ingress) {
+			return nil
+		}
+	}
+
+	return action(ingress)
+}
+
+func (i *inspector) Ingresses() []*networking.Ingress {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	var r []*networking.Ingress
+	for _, ingress := range i.ingresses {
+		r = append(r, ingress)
+	}
+
+	return r
+}
+
+func (i *inspector) Ingress(name string) (*networking.Ingress, bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	ingress, ok := i.ingresses[name]
+	if !ok {
+		return nil, false
+	}
+
+	return ingress, true
+}
+
+func (i *inspector) IngressReadInterface() ingress.ReadInterface {
+	return &ingressReadInterface{i: i}
+}
+
+type ingressReadInterface struct {
+	i *inspector
+}
+
+func (s ingressReadInterface) Get(ctx context.Context, name string, opts meta.GetOptions) (*networking.Ingress, error) {
+	if s, ok := s.i.Ingress(name); !ok {
+		return nil, apiErrors.NewNotFound(schema.GroupResource{
+			Group:    networking.GroupName,
+			Resource: "ingresses",
+		}, name)
+	} else {
+		return s, nil
+	}
+}
+
+// RefreshIngresses reloads only Ingresses from the API server
+func (i *inspector) RefreshIngresses(ctx context.Context) error {
+	return i.refreshResource(kindIngresses, func(n *inspector) func() error {
+		return ingressesToMap(ctx, n, i.client.Kubernetes(), i.namespace)
+	}, func(n *inspector) {
+		i.ingresses = n.ingresses
+	})
+}
+
+func ingressesToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface, namespace string) func() error {
+	return func() error {
+		ingresses := getIngresses(ctx, k, namespace, "")
+
+		ingressMap := map[string]*networking.Ingress{}
+
+		for _, ingress := range ingresses {
+			_, exists := ingressMap[ingress.GetName()]
+			if exists {
+				return errors.Newf("Ingress %s already exists in map, error received", ingress.GetName())
+			}
+
+			ingressMap[ingress.GetName()] = ingress.DeepCopy()
+		}
+
+		inspector.ingresses = ingressMap
+
+		return nil
+	}
+}
+
+// getIngresses returns Ingresses with the deployment label, list is empty when Ingresses are not accessible
+func getIngresses(ctx context.Context, k kubernetes.Interface, namespace, cont string) []networking.Ingress {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	ingresses, err := k.NetworkingV1().Ingresses(namespace).List(ctxChild, meta.ListOptions{
+		Limit:         globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue:      cont,
+		LabelSelector: labelSelector(kindIngresses),
+	})
+
+	if err != nil {
+		return []networking.Ingress{}
+	}
+
+	return ingresses.Items
+}
@@ -28,10 +28,12 @@ import (
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
 	monitoring "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	core "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
 	policy "k8s.io/api/policy/v1beta1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -60,19 +62,23 @@ func newInspector(ctx context.Context, client kclient.Client, namespace string)
 	i.namespace = namespace
 	i.client = client
 
-	if err := util.RunParallel(15,
-		getVersionInfo(ctx, &i, client.Kubernetes(), namespace),
-		podsToMap(ctx, &i, client.Kubernetes(), namespace),
-		secretsToMap(ctx, &i, client.Kubernetes(), namespace),
-		pvcsToMap(ctx, &i, client.Kubernetes(), namespace),
-		servicesToMap(ctx, &i, client.Kubernetes(), namespace),
-		serviceAccountsToMap(ctx, &i, client.Kubernetes(), namespace),
-		podDisruptionBudgetsToMap(ctx, &i, client.Kubernetes(), namespace),
-		serviceMonitorsToMap(ctx, &i, client.Monitoring(), namespace),
-		arangoMembersToMap(ctx, &i, client.Arango(), namespace),
-		nodesToMap(ctx, &i, client.Kubernetes()),
-		arangoClusterSynchronizationsToMap(ctx, &i, client.Arango(), namespace),
-		arangoTasksToMap(ctx, &i, client.Arango(), namespace),
+	if err := util.RunParallel(globals.GetGlobals().Kubernetes().InspectorParallelism().Get(),
+		withMetrics(namespace, kindVersion, &i, getVersionInfo(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindPods, &i, podsToMap(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindSecrets, &i, secretsToMap(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindPersistentVolumeClaims, &i, pvcsToMap(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindServices, &i, servicesToMap(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindServiceAccounts, &i, serviceAccountsToMap(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindPodDisruptionBudgets, &i, podDisruptionBudgetsToMap(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindServiceMonitors, &i, serviceMonitorsToMap(ctx, &i, client.Monitoring(), namespace)),
+		withMetrics(namespace, kindPrometheusRules, &i, prometheusRulesToMap(ctx, &i, client.Monitoring(), namespace)),
+		withMetrics(namespace, kindIngresses, &i, ingressesToMap(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindArangoMembers, &i, arangoMembersToMap(ctx, &i, client.Arango(), namespace)),
+		withMetrics(namespace, kindNodes, &i, nodesToMap(ctx, &i, client.Kubernetes())),
+		withMetrics(namespace, kindPersistentVolumes, &i, persistentVolumesToMap(ctx, &i, client.Kubernetes(), namespace)),
+		withMetrics(namespace, kindStorageClasses, &i, storageClassesToMap(ctx, &i, client.Kubernetes())),
+		withMetrics(namespace, kindArangoClusterSynchronizations, &i, arangoClusterSynchronizationsToMap(ctx, &i, client.Arango(), namespace)),
+		withMetrics(namespace, kindArangoTasks, &i, arangoTasksToMap(ctx, &i, client.Arango(), namespace)),
 	); err != nil {
 		return nil, err
 	}
@@ -154,6 +160,9 @@ type inspector struct {
 
 	client kclient.Client
 
+	// watcher is set when resources are taken from the watcher caches
+	watcher *Watcher
+
 	pods                 map[string]*core.Pod
 	secrets              map[string]*core.Secret
 	pvcs                 map[string]*core.PersistentVolumeClaim
@@ -161,8 +170,12 @@ type inspector struct {
 	serviceAccounts      map[string]*core.ServiceAccount
 	podDisruptionBudgets map[string]*policy.PodDisruptionBudget
 	serviceMonitors      map[string]*monitoring.ServiceMonitor
+	prometheusRules      map[string]*monitoring.PrometheusRule
+	ingresses            map[string]*networking.Ingress
 	arangoMembers        map[string]*api.ArangoMember
 	nodes                *nodeLoader
+	pvs                  *persistentVolumeLoader
+	storageClasses       *storageClassLoader
 	acs                  *arangoClusterSynchronizationLoader
 	at                   *arangoTaskLoader
 	versionInfo          driver.Version
@@ -180,7 +193,13 @@ func (i *inspector) Refresh(ctx context.Context) error {
 		return errors.New("Inspector created from static data")
 	}
 
-	new, err := newInspector(ctx, i.client, i.namespace)
+	var new *inspector
+	var err error
+	if i.watcher != nil && i.watcher.isUsable() {
+		new, err = newInspectorFromWatcher(ctx, i.watcher)
+	} else {
+		new, err = newInspector(ctx, i.client, i.namespace)
+	}
 	if err != nil {
 		return err
 	}
@@ -192,10 +211,46 @@ func (i *inspector) Refresh(ctx context.Context) error {
 	i.serviceAccounts = new.serviceAccounts
 	i.podDisruptionBudgets = new.podDisruptionBudgets
 	i.serviceMonitors = new.serviceMonitors
+	i.prometheusRules = new.prometheusRules
+	i.ingresses = new.ingresses
 	i.arangoMembers = new.arangoMembers
 	i.nodes = new.nodes
+	i.pvs = new.pvs
+	i.storageClasses = new.storageClasses
 	i.acs = new.acs
 	i.versionInfo = new.versionInfo
 
 	return nil
 }
+
+// refreshResource reloads a single kind of resources. Loader fills the given empty inspector,
+// then apply copies loaded resources into the current one.
+func (i *inspector) refreshResource(kind string, loader func(n *inspector) func() error, apply func(n *inspector)) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.namespace == "" {
+		return errors.New("Inspector created from static data")
+	}
+
+	var n inspector
+
+	if i.watcher != nil && isWatchedKind(kind) && i.watcher.isUsable() {
+		// Resource is taken from the watcher cache
+		if err := withMetrics(i.namespace, kindCache, &n, func() error { return i.watcher.fill(&n) })(); err != nil {
+			return err
+		}
+
+		apply(&n)
+
+		return nil
+	}
+
+	if err := withMetrics(i.namespace, kind, &n, loader(&n))(); err != nil {
+		return err
+	}
+
+	apply(&n)
+
+	return nil
+}
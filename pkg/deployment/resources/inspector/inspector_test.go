@@ -0,0 +1,218 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	storage "k8s.io/api/storage/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_Inspector_RefreshResource(t *testing.T) {
+	namespace := "test"
+	c := kclient.NewFakeClient()
+
+	i, err := NewInspector(context.Background(), c, namespace)
+	require.NoError(t, err)
+
+	_, err = c.Kubernetes().CoreV1().Pods(namespace).Create(context.Background(), &core.Pod{
+		ObjectMeta: meta.ObjectMeta{Name: "pod", Namespace: namespace, Labels: k8sutil.LabelsForDeployment("deployment", "")},
+	}, meta.CreateOptions{})
+	require.NoError(t, err)
+
+	_, err = c.Kubernetes().CoreV1().Secrets(namespace).Create(context.Background(), &core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: "secret", Namespace: namespace},
+	}, meta.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, i.RefreshPods(context.Background()))
+
+	_, ok := i.Pod("pod")
+	require.True(t, ok)
+
+	// Other resources are not reloaded
+	_, ok = i.Secret("secret")
+	require.False(t, ok)
+
+	require.NoError(t, i.RefreshSecrets(context.Background()))
+
+	_, ok = i.Secret("secret")
+	require.True(t, ok)
+
+	// Static inspector can not be reloaded
+	require.Error(t, NewEmptyInspector().RefreshPods(context.Background()))
+}
+
+func Test_Inspector_LabelSelector(t *testing.T) {
+	namespace := "test"
+	c := kclient.NewFakeClientBuilder().Kubernetes(
+		&core.Pod{ObjectMeta: meta.ObjectMeta{Name: "managed", Namespace: namespace, Labels: k8sutil.LabelsForDeployment("deployment", "")}},
+		&core.Pod{ObjectMeta: meta.ObjectMeta{Name: "unrelated", Namespace: namespace}},
+		&core.Secret{ObjectMeta: meta.ObjectMeta{Name: "managed", Namespace: namespace, Labels: k8sutil.LabelsForDeployment("deployment", "")}},
+		&core.Secret{ObjectMeta: meta.ObjectMeta{Name: "unrelated", Namespace: namespace}},
+		&core.Secret{ObjectMeta: meta.ObjectMeta{Name: "user", Namespace: namespace, Labels: map[string]string{"app": "arangodb"}}},
+	).Client()
+
+	t.Run("Default", func(t *testing.T) {
+		i, err := NewInspector(context.Background(), c, namespace)
+		require.NoError(t, err)
+
+		_, ok := i.Pod("managed")
+		require.True(t, ok)
+		_, ok = i.Pod("unrelated")
+		require.False(t, ok)
+
+		// Secrets are not scoped without the selector
+		_, ok = i.Secret("managed")
+		require.True(t, ok)
+		_, ok = i.Secret("unrelated")
+		require.True(t, ok)
+	})
+
+	t.Run("Selector", func(t *testing.T) {
+		globals.GetGlobals().Kubernetes().InspectorSelector().Set("app=arangodb")
+		defer globals.GetGlobals().Kubernetes().InspectorSelector().Set(globals.DefaultKubernetesInspectorSelector)
+
+		i, err := NewInspector(context.Background(), c, namespace)
+		require.NoError(t, err)
+
+		// Secrets created by the operator are loaded regardless of the selector
+		_, ok := i.Secret("managed")
+		require.True(t, ok)
+		_, ok = i.Secret("user")
+		require.True(t, ok)
+		_, ok = i.Secret("unrelated")
+		require.False(t, ok)
+	})
+
+	t.Run("Watcher with selector", func(t *testing.T) {
+		globals.GetGlobals().Kubernetes().InspectorSelector().Set("app=arangodb")
+		defer globals.GetGlobals().Kubernetes().InspectorSelector().Set(globals.DefaultKubernetesInspectorSelector)
+
+		w := NewWatcher(log.Logger, c, namespace)
+
+		stopCh := make(chan struct{})
+		defer close(stopCh)
+		w.Run(stopCh)
+
+		require.Eventually(t, w.HasSynced, 5*time.Second, 10*time.Millisecond)
+
+		i, err := NewInspectorFromWatcher(context.Background(), w)
+		require.NoError(t, err)
+
+		require.ElementsMatch(t, []string{"managed", "user"}, secretNames(t, i))
+	})
+}
+
+func Test_Inspector_PersistentVolumes(t *testing.T) {
+	namespace := "test"
+	c := kclient.NewFakeClientBuilder().Kubernetes(
+		&core.PersistentVolume{
+			ObjectMeta: meta.ObjectMeta{Name: "bound"},
+			Spec:       core.PersistentVolumeSpec{ClaimRef: &core.ObjectReference{Namespace: namespace, Name: "pvc"}},
+		},
+		&core.PersistentVolume{
+			ObjectMeta: meta.ObjectMeta{Name: "other"},
+			Spec:       core.PersistentVolumeSpec{ClaimRef: &core.ObjectReference{Namespace: "other", Name: "pvc"}},
+		},
+		&core.PersistentVolume{
+			ObjectMeta: meta.ObjectMeta{Name: "available"},
+		},
+	).Client()
+
+	i, err := NewInspector(context.Background(), c, namespace)
+	require.NoError(t, err)
+
+	pvs, ok := i.GetPersistentVolumes()
+	require.True(t, ok)
+	require.Len(t, pvs.PersistentVolumes(), 1)
+
+	_, ok = pvs.PersistentVolume("bound")
+	require.True(t, ok)
+
+	_, ok = NewEmptyInspector().GetPersistentVolumes()
+	require.False(t, ok)
+}
+
+func Test_Inspector_StorageClasses(t *testing.T) {
+	c := kclient.NewFakeClientBuilder().Kubernetes(&storage.StorageClass{
+		ObjectMeta:           meta.ObjectMeta{Name: "expandable"},
+		AllowVolumeExpansion: util.NewBool(true),
+	}).Client()
+
+	i, err := NewInspector(context.Background(), c, "test")
+	require.NoError(t, err)
+
+	storageClasses, ok := i.GetStorageClasses()
+	require.True(t, ok)
+	require.Len(t, storageClasses.StorageClasses(), 1)
+
+	sc, ok := storageClasses.StorageClass("expandable")
+	require.True(t, ok)
+	require.True(t, *sc.AllowVolumeExpansion)
+
+	_, err = storageClasses.StorageClassReadInterface().Get(context.Background(), "missing", meta.GetOptions{})
+	require.True(t, apiErrors.IsNotFound(err))
+
+	_, ok = NewEmptyInspector().GetStorageClasses()
+	require.False(t, ok)
+}
+
+func Test_Inspector_Ingresses(t *testing.T) {
+	namespace := "test"
+	c := kclient.NewFakeClientBuilder().Kubernetes(
+		&networking.Ingress{
+			ObjectMeta: meta.ObjectMeta{Name: "managed", Namespace: namespace, Labels: map[string]string{k8sutil.LabelKeyArangoDeployment: "deployment"}},
+		},
+		&networking.Ingress{
+			ObjectMeta: meta.ObjectMeta{Name: "unrelated", Namespace: namespace},
+		},
+	).Client()
+
+	i, err := NewInspector(context.Background(), c, namespace)
+	require.NoError(t, err)
+
+	_, ok := i.Ingress("managed")
+	require.True(t, ok)
+	_, ok = i.Ingress("unrelated")
+	require.False(t, ok)
+
+	_, err = c.Kubernetes().NetworkingV1().Ingresses(namespace).Create(context.Background(), &networking.Ingress{
+		ObjectMeta: meta.ObjectMeta{Name: "created", Namespace: namespace, Labels: map[string]string{k8sutil.LabelKeyArangoDeployment: "deployment"}},
+	}, meta.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, i.RefreshIngresses(context.Background()))
+	_, ok = i.Ingress("created")
+	require.True(t, ok)
+}
@@ -98,6 +98,15 @@ func (s arangoMemberReadInterface) Get(ctx context.Context, name string, opts me
 	}
 }
 
+// RefreshArangoMembers reloads only ArangoMembers from the API server
+func (i *inspector) RefreshArangoMembers(ctx context.Context) error {
+	return i.refreshResource(kindArangoMembers, func(n *inspector) func() error {
+		return arangoMembersToMap(ctx, n, i.client.Arango(), i.namespace)
+	}, func(n *inspector) {
+		i.arangoMembers = n.arangoMembers
+	})
+}
+
 func arangoMembersToMap(ctx context.Context, inspector *inspector, k versioned.Interface, namespace string) func() error {
 	return func() error {
 		arangoMembers, err := getArangoMembers(ctx, k, namespace, "")
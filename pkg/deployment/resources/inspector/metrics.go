@@ -0,0 +1,151 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	metricsComponent = "inspector"
+
+	// slowRefreshThreshold defines after which time the load of a resource kind is reported as slow
+	slowRefreshThreshold = time.Second
+)
+
+const (
+	kindVersion                       = "version"
+	kindPods                          = "pods"
+	kindSecrets                       = "secrets"
+	kindPersistentVolumeClaims        = "persistentvolumeclaims"
+	kindServices                      = "services"
+	kindServiceAccounts               = "serviceaccounts"
+	kindPodDisruptionBudgets          = "poddisruptionbudgets"
+	kindServiceMonitors               = "servicemonitors"
+	kindPrometheusRules               = "prometheusrules"
+	kindIngresses                     = "ingresses"
+	kindArangoMembers                 = "arangomembers"
+	kindNodes                         = "nodes"
+	kindPersistentVolumes             = "persistentvolumes"
+	kindStorageClasses                = "storageclasses"
+	kindArangoClusterSynchronizations = "arangoclustersynchronizations"
+	kindArangoTasks                   = "arangotasks"
+
+	// kindCache is used for copying resources from the watcher caches
+	kindCache = "cache"
+)
+
+var (
+	refreshDurationGauges = metrics.MustRegisterGaugeVec(metricsComponent, "refresh_duration", "Amount of time taken by the last load of a resource kind (in sec)", metrics.Namespace, metrics.ResourceKind)
+	objectsGauges         = metrics.MustRegisterGaugeVec(metricsComponent, "objects", "Number of objects of a resource kind loaded by the last refresh", metrics.Namespace, metrics.ResourceKind)
+	refreshErrorsCounters = metrics.MustRegisterCounterVec(metricsComponent, "refresh_errors", "Number of failed loads of a resource kind", metrics.Namespace, metrics.ResourceKind)
+	lastRefreshGauges     = metrics.MustRegisterGaugeVec(metricsComponent, "last_refresh", "Timestamp of the last successful load of a resource kind or of the last change received from its watch", metrics.Namespace, metrics.ResourceKind)
+)
+
+// objectCounts returns the number of loaded objects for each resource kind
+var objectCounts = map[string]func(i *inspector) int{
+	kindPods:                   func(i *inspector) int { return len(i.pods) },
+	kindSecrets:                func(i *inspector) int { return len(i.secrets) },
+	kindPersistentVolumeClaims: func(i *inspector) int { return len(i.pvcs) },
+	kindServices:               func(i *inspector) int { return len(i.services) },
+	kindServiceAccounts:        func(i *inspector) int { return len(i.serviceAccounts) },
+	kindPodDisruptionBudgets:   func(i *inspector) int { return len(i.podDisruptionBudgets) },
+	kindServiceMonitors:        func(i *inspector) int { return len(i.serviceMonitors) },
+	kindPrometheusRules:        func(i *inspector) int { return len(i.prometheusRules) },
+	kindIngresses:              func(i *inspector) int { return len(i.ingresses) },
+	kindArangoMembers:          func(i *inspector) int { return len(i.arangoMembers) },
+	kindNodes: func(i *inspector) int {
+		if i.nodes == nil {
+			return 0
+		}
+		return len(i.nodes.nodes)
+	},
+	kindPersistentVolumes: func(i *inspector) int {
+		if i.pvs == nil {
+			return 0
+		}
+		return len(i.pvs.pvs)
+	},
+	kindStorageClasses: func(i *inspector) int {
+		if i.storageClasses == nil {
+			return 0
+		}
+		return len(i.storageClasses.storageClasses)
+	},
+	kindArangoClusterSynchronizations: func(i *inspector) int {
+		if i.acs == nil {
+			return 0
+		}
+		return len(i.acs.acs)
+	},
+	kindArangoTasks: func(i *inspector) int {
+		if i.at == nil {
+			return 0
+		}
+		return len(i.at.at)
+	},
+}
+
+// withMetrics wraps the loader of a resource kind with metrics and slow refresh diagnostics.
+// Loaded objects are counted in the given inspector.
+func withMetrics(namespace, kind string, i *inspector, loader func() error) func() error {
+	return func() error {
+		start := time.Now()
+		err := loader()
+		duration := time.Since(start)
+
+		refreshDurationGauges.WithLabelValues(namespace, kind).Set(duration.Seconds())
+
+		if duration > slowRefreshThreshold {
+			log.Warn().Str("namespace", namespace).Str("kind", kind).Dur("duration", duration).
+				Msg("Slow refresh of resources, API server may be throttling requests")
+		}
+
+		if err != nil {
+			refreshErrorsCounters.WithLabelValues(namespace, kind).Inc()
+			return err
+		}
+
+		recordObjects(namespace, kind, i)
+
+		// Copy of the watcher caches is not a load, time of the last change is recorded by the watcher
+		if kind != kindCache {
+			recordRefresh(namespace, kind)
+		}
+
+		return nil
+	}
+}
+
+// recordObjects sets the number of loaded objects of a resource kind.
+func recordObjects(namespace, kind string, i *inspector) {
+	if count, ok := objectCounts[kind]; ok {
+		objectsGauges.WithLabelValues(namespace, kind).Set(float64(count(i)))
+	}
+}
+
+// recordRefresh sets the time of the last load of a resource kind, or of the last change received from its watch.
+func recordRefresh(namespace, kind string) {
+	lastRefreshGauges.WithLabelValues(namespace, kind).SetToCurrentTime()
+}
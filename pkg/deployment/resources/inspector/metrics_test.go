@@ -0,0 +1,67 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+)
+
+func Test_WithMetrics(t *testing.T) {
+	namespace := "metrics"
+
+	i := &inspector{}
+	require.NoError(t, withMetrics(namespace, kindSecrets, i, func() error {
+		i.secrets = map[string]*core.Secret{"a": {}, "b": {}}
+		return nil
+	})())
+
+	require.Equal(t, 2.0, testutil.ToFloat64(objectsGauges.WithLabelValues(namespace, kindSecrets)))
+	require.NotZero(t, testutil.ToFloat64(lastRefreshGauges.WithLabelValues(namespace, kindSecrets)))
+	require.Zero(t, testutil.ToFloat64(refreshErrorsCounters.WithLabelValues(namespace, kindSecrets)))
+
+	require.Error(t, withMetrics(namespace, kindSecrets, i, func() error {
+		return errors.Newf("list failed")
+	})())
+
+	require.Equal(t, 1.0, testutil.ToFloat64(refreshErrorsCounters.WithLabelValues(namespace, kindSecrets)))
+}
+
+func Test_WithMetrics_Cache(t *testing.T) {
+	namespace := "metrics-cache"
+
+	i := &inspector{}
+	require.NoError(t, withMetrics(namespace, kindCache, i, func() error {
+		i.secrets = map[string]*core.Secret{"a": {}}
+		return nil
+	})())
+
+	recordObjects(namespace, kindSecrets, i)
+
+	// Copy of the watcher caches does not update the time of the last refresh
+	require.Equal(t, 1.0, testutil.ToFloat64(objectsGauges.WithLabelValues(namespace, kindSecrets)))
+	require.Zero(t, testutil.ToFloat64(lastRefreshGauges.WithLabelValues(namespace, kindSecrets)))
+	require.Zero(t, testutil.ToFloat64(lastRefreshGauges.WithLabelValues(namespace, kindCache)))
+}
@@ -97,22 +97,37 @@ func (s podDisruptionBudgetReadInterface) Get(ctx context.Context, name string,
 	}
 }
 
+// RefreshPodDisruptionBudgets reloads only PodDisruptionBudgets from the API server
+func (i *inspector) RefreshPodDisruptionBudgets(ctx context.Context) error {
+	return i.refreshResource(kindPodDisruptionBudgets, func(n *inspector) func() error {
+		return podDisruptionBudgetsToMap(ctx, n, i.client.Kubernetes(), i.namespace)
+	}, func(n *inspector) {
+		i.podDisruptionBudgets = n.podDisruptionBudgets
+	})
+}
+
 func podDisruptionBudgetsToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface, namespace string) func() error {
 	return func() error {
-		podDisruptionBudgets, err := getPodDisruptionBudgets(ctx, k, namespace, "")
-		if err != nil {
-			return err
-		}
-
 		podDisruptionBudgetMap := map[string]*policy.PodDisruptionBudget{}
 
-		for _, podDisruptionBudget := range podDisruptionBudgets {
-			_, exists := podDisruptionBudgetMap[podDisruptionBudget.GetName()]
-			if exists {
-				return errors.Newf("PodDisruptionBudget %s already exists in map, error received", podDisruptionBudget.GetName())
+		for _, selector := range labelSelectors(kindPodDisruptionBudgets) {
+			podDisruptionBudgets, err := getPodDisruptionBudgets(ctx, k, namespace, selector, "")
+			if err != nil {
+				return err
 			}
 
-			podDisruptionBudgetMap[podDisruptionBudget.GetName()] = podDisruptionBudgetPointer(podDisruptionBudget)
+			selected := map[string]bool{}
+
+			for _, podDisruptionBudget := range podDisruptionBudgets {
+				if selected[podDisruptionBudget.GetName()] {
+					return errors.Newf("PodDisruptionBudget %s already exists in map, error received", podDisruptionBudget.GetName())
+				}
+
+				selected[podDisruptionBudget.GetName()] = true
+
+				// Resources matching more than one selector are loaded once
+				podDisruptionBudgetMap[podDisruptionBudget.GetName()] = podDisruptionBudgetPointer(podDisruptionBudget)
+			}
 		}
 
 		inspector.podDisruptionBudgets = podDisruptionBudgetMap
@@ -125,12 +140,13 @@ func podDisruptionBudgetPointer(podDisruptionBudget policy.PodDisruptionBudget)
 	return &podDisruptionBudget
 }
 
-func getPodDisruptionBudgets(ctx context.Context, k kubernetes.Interface, namespace, cont string) ([]policy.PodDisruptionBudget, error) {
+func getPodDisruptionBudgets(ctx context.Context, k kubernetes.Interface, namespace, selector, cont string) ([]policy.PodDisruptionBudget, error) {
 	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 	defer cancel()
 	podDisruptionBudgets, err := k.PolicyV1beta1().PodDisruptionBudgets(namespace).List(ctxChild, meta.ListOptions{
-		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
-		Continue: cont,
+		Limit:         globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue:      cont,
+		LabelSelector: selector,
 	})
 
 	if err != nil {
@@ -138,7 +154,7 @@ func getPodDisruptionBudgets(ctx context.Context, k kubernetes.Interface, namesp
 	}
 
 	if podDisruptionBudgets.Continue != "" {
-		nextPodDisruptionBudgetsLayer, err := getPodDisruptionBudgets(ctx, k, namespace, podDisruptionBudgets.Continue)
+		nextPodDisruptionBudgetsLayer, err := getPodDisruptionBudgets(ctx, k, namespace, selector, podDisruptionBudgets.Continue)
 		if err != nil {
 			return nil, err
 		}
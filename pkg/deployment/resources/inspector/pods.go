@@ -96,6 +96,15 @@ func (s podReadInterface) Get(ctx context.Context, name string, opts meta.GetOpt
 	}
 }
 
+// RefreshPods reloads only Pods from the API server
+func (i *inspector) RefreshPods(ctx context.Context) error {
+	return i.refreshResource(kindPods, func(n *inspector) func() error {
+		return podsToMap(ctx, n, i.client.Kubernetes(), i.namespace)
+	}, func(n *inspector) {
+		i.pods = n.pods
+	})
+}
+
 func podsToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface, namespace string) func() error {
 	return func() error {
 		pods, err := getPods(ctx, k, namespace, "")
@@ -128,8 +137,9 @@ func getPods(ctx context.Context, k kubernetes.Interface, namespace, cont string
 	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 	defer cancel()
 	pods, err := k.CoreV1().Pods(namespace).List(ctxChild, meta.ListOptions{
-		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
-		Continue: cont,
+		Limit:         globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue:      cont,
+		LabelSelector: labelSelector(kindPods),
 	})
 
 	if err != nil {
@@ -0,0 +1,144 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/prometheusrule"
+	monitoringGroup "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
+	monitoring "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringClient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (i *inspector) IteratePrometheusRules(action prometheusrule.Action, filters ...prometheusrule.Filter) error {
+	for _, prometheusRule := range i.PrometheusRules() {
+		if err := i.iteratePrometheusRule(prometheusRule, action, filters...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *inspector) iteratePrometheusRule(prometheusRule *monitoring.PrometheusRule, action prometheusrule.Action, filters ...prometheusrule.Filter) error {
+	for _, filter := range filters {
+		if !filter(prometheusRule) {
+			return nil
+		}
+	}
+
+	return action(prometheusRule)
+}
+
+func (i *inspector) PrometheusRules() []*monitoring.PrometheusRule {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	var r []*monitoring.PrometheusRule
+	for _, rule := range i.prometheusRules {
+		r = append(r, rule)
+	}
+
+	return r
+}
+
+func (i *inspector) PrometheusRule(name string) (*monitoring.PrometheusRule, bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	prometheusRule, ok := i.prometheusRules[name]
+	if !ok {
+		return nil, false
+	}
+
+	return prometheusRule, true
+}
+
+func (i *inspector) PrometheusRuleReadInterface() prometheusrule.ReadInterface {
+	return &prometheusRuleReadInterface{i: i}
+}
+
+type prometheusRuleReadInterface struct {
+	i *inspector
+}
+
+func (s prometheusRuleReadInterface) Get(ctx context.Context, name string, opts meta.GetOptions) (*monitoring.PrometheusRule, error) {
+	if s, ok := s.i.PrometheusRule(name); !ok {
+		return nil, apiErrors.NewNotFound(schema.GroupResource{
+			Group:    monitoringGroup.GroupName,
+			Resource: "prometheusrules",
+		}, name)
+	} else {
+		return s, nil
+	}
+}
+
+// RefreshPrometheusRules reloads only PrometheusRules from the API server
+func (i *inspector) RefreshPrometheusRules(ctx context.Context) error {
+	return i.refreshResource(kindPrometheusRules, func(n *inspector) func() error {
+		return prometheusRulesToMap(ctx, n, i.client.Monitoring(), i.namespace)
+	}, func(n *inspector) {
+		i.prometheusRules = n.prometheusRules
+	})
+}
+
+func prometheusRulesToMap(ctx context.Context, inspector *inspector, m monitoringClient.Interface, namespace string) func() error {
+	return func() error {
+		prometheusRules := getPrometheusRules(ctx, m, namespace, "")
+
+		prometheusRuleMap := map[string]*monitoring.PrometheusRule{}
+
+		for _, prometheusRule := range prometheusRules {
+			_, exists := prometheusRuleMap[prometheusRule.GetName()]
+			if exists {
+				return errors.Newf("PrometheusRule %s already exists in map, error received", prometheusRule.GetName())
+			}
+
+			prometheusRuleMap[prometheusRule.GetName()] = prometheusRule
+		}
+
+		inspector.prometheusRules = prometheusRuleMap
+
+		return nil
+	}
+}
+
+func getPrometheusRules(ctx context.Context, m monitoringClient.Interface, namespace, cont string) []*monitoring.PrometheusRule {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	prometheusRules, err := m.MonitoringV1().PrometheusRules(namespace).List(ctxChild, meta.ListOptions{
+		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue: cont,
+	})
+
+	if err != nil {
+		return []*monitoring.PrometheusRule{}
+	}
+
+	return prometheusRules.Items
+}
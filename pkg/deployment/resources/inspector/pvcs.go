@@ -97,6 +97,15 @@ func (s persistentVolumeClaimReadInterface) Get(ctx context.Context, name string
 	}
 }
 
+// RefreshPersistentVolumeClaims reloads only PersistentVolumeClaims from the API server
+func (i *inspector) RefreshPersistentVolumeClaims(ctx context.Context) error {
+	return i.refreshResource(kindPersistentVolumeClaims, func(n *inspector) func() error {
+		return pvcsToMap(ctx, n, i.client.Kubernetes(), i.namespace)
+	}, func(n *inspector) {
+		i.pvcs = n.pvcs
+	})
+}
+
 func pvcsToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface, namespace string) func() error {
 	return func() error {
 		pvcs, err := getPersistentVolumeClaims(ctx, k, namespace, "")
@@ -129,8 +138,9 @@ func getPersistentVolumeClaims(ctx context.Context, k kubernetes.Interface, name
 	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 	defer cancel()
 	pvcs, err := k.CoreV1().PersistentVolumeClaims(namespace).List(ctxChild, meta.ListOptions{
-		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
-		Continue: cont,
+		Limit:         globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue:      cont,
+		LabelSelector: labelSelector(kindPersistentVolumeClaims),
 	})
 
 	if err != nil {
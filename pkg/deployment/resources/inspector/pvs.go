@@ -0,0 +1,179 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/persistentvolume"
+	core "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+func (i *inspector) GetPersistentVolumes() (persistentvolume.Inspector, bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.pvs == nil {
+		return nil, false
+	}
+
+	return i.pvs, i.pvs.accessible
+}
+
+type persistentVolumeLoader struct {
+	accessible bool
+
+	pvs map[string]*core.PersistentVolume
+}
+
+func (p *persistentVolumeLoader) PersistentVolume(name string) (*core.PersistentVolume, bool) {
+	pv, ok := p.pvs[name]
+	if !ok {
+		return nil, false
+	}
+
+	return pv, true
+}
+
+func (p *persistentVolumeLoader) PersistentVolumes() []*core.PersistentVolume {
+	var r []*core.PersistentVolume
+	for _, pv := range p.pvs {
+		r = append(r, pv)
+	}
+
+	return r
+}
+
+func (p *persistentVolumeLoader) IteratePersistentVolumes(action persistentvolume.Action, filters ...persistentvolume.Filter) error {
+	for _, pv := range p.PersistentVolumes() {
+		if err := p.iteratePersistentVolume(pv, action, filters...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *persistentVolumeLoader) iteratePersistentVolume(pv *core.PersistentVolume, action persistentvolume.Action, filters ...persistentvolume.Filter) error {
+	for _, filter := range filters {
+		if !filter(pv) {
+			return nil
+		}
+	}
+
+	return action(pv)
+}
+
+func (p *persistentVolumeLoader) PersistentVolumeReadInterface() persistentvolume.ReadInterface {
+	return &persistentVolumeReadInterface{i: p}
+}
+
+type persistentVolumeReadInterface struct {
+	i *persistentVolumeLoader
+}
+
+func (s persistentVolumeReadInterface) Get(ctx context.Context, name string, opts meta.GetOptions) (*core.PersistentVolume, error) {
+	if s, ok := s.i.PersistentVolume(name); !ok {
+		return nil, apiErrors.NewNotFound(schema.GroupResource{
+			Group:    core.GroupName,
+			Resource: "persistentvolumes",
+		}, name)
+	} else {
+		return s, nil
+	}
+}
+
+func persistentVolumePointer(pv core.PersistentVolume) *core.PersistentVolume {
+	return &pv
+}
+
+// persistentVolumesToMap loads PersistentVolumes bound to PersistentVolumeClaims in the given namespace.
+func persistentVolumesToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface, namespace string) func() error {
+	return func() error {
+		pvs, err := getPersistentVolumes(ctx, k, "")
+		if err != nil {
+			if apiErrors.IsUnauthorized(err) || apiErrors.IsForbidden(err) {
+				inspector.pvs = &persistentVolumeLoader{
+					accessible: false,
+				}
+				return nil
+			}
+			return err
+		}
+
+		pvsMap := map[string]*core.PersistentVolume{}
+
+		for _, pv := range pvs {
+			if !isPersistentVolumeInNamespace(&pv, namespace) {
+				continue
+			}
+
+			_, exists := pvsMap[pv.GetName()]
+			if exists {
+				return errors.Newf("PersistentVolume %s already exists in map, error received", pv.GetName())
+			}
+
+			pvsMap[pv.GetName()] = persistentVolumePointer(pv)
+		}
+
+		inspector.pvs = &persistentVolumeLoader{
+			accessible: true,
+			pvs:        pvsMap,
+		}
+
+		return nil
+	}
+}
+
+// isPersistentVolumeInNamespace returns true when PersistentVolume is bound to PersistentVolumeClaim in the namespace
+func isPersistentVolumeInNamespace(pv *core.PersistentVolume, namespace string) bool {
+	ref := pv.Spec.ClaimRef
+	return ref != nil && ref.Namespace == namespace
+}
+
+func getPersistentVolumes(ctx context.Context, k kubernetes.Interface, cont string) ([]core.PersistentVolume, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	pvs, err := k.CoreV1().PersistentVolumes().List(ctxChild, meta.ListOptions{
+		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue: cont,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if pvs.Continue != "" {
+		nextPersistentVolumesLayer, err := getPersistentVolumes(ctx, k, pvs.Continue)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(pvs.Items, nextPersistentVolumesLayer...), nil
+	}
+
+	return pvs.Items, nil
+}
@@ -97,22 +97,37 @@ func (s serviceAccountReadInterface) Get(ctx context.Context, name string, opts
 	}
 }
 
+// RefreshServiceAccounts reloads only ServiceAccounts from the API server
+func (i *inspector) RefreshServiceAccounts(ctx context.Context) error {
+	return i.refreshResource(kindServiceAccounts, func(n *inspector) func() error {
+		return serviceAccountsToMap(ctx, n, i.client.Kubernetes(), i.namespace)
+	}, func(n *inspector) {
+		i.serviceAccounts = n.serviceAccounts
+	})
+}
+
 func serviceAccountsToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface, namespace string) func() error {
 	return func() error {
-		serviceAccounts, err := getServiceAccounts(ctx, k, namespace, "")
-		if err != nil {
-			return err
-		}
-
 		serviceAccountMap := map[string]*core.ServiceAccount{}
 
-		for _, serviceAccount := range serviceAccounts {
-			_, exists := serviceAccountMap[serviceAccount.GetName()]
-			if exists {
-				return errors.Newf("ServiceAccount %s already exists in map, error received", serviceAccount.GetName())
+		for _, selector := range labelSelectors(kindServiceAccounts) {
+			serviceAccounts, err := getServiceAccounts(ctx, k, namespace, selector, "")
+			if err != nil {
+				return err
 			}
 
-			serviceAccountMap[serviceAccount.GetName()] = serviceAccountPointer(serviceAccount)
+			selected := map[string]bool{}
+
+			for _, serviceAccount := range serviceAccounts {
+				if selected[serviceAccount.GetName()] {
+					return errors.Newf("ServiceAccount %s already exists in map, error received", serviceAccount.GetName())
+				}
+
+				selected[serviceAccount.GetName()] = true
+
+				// Resources matching more than one selector are loaded once
+				serviceAccountMap[serviceAccount.GetName()] = serviceAccountPointer(serviceAccount)
+			}
 		}
 
 		inspector.serviceAccounts = serviceAccountMap
@@ -125,12 +140,13 @@ func serviceAccountPointer(serviceAccount core.ServiceAccount) *core.ServiceAcco
 	return &serviceAccount
 }
 
-func getServiceAccounts(ctx context.Context, k kubernetes.Interface, namespace, cont string) ([]core.ServiceAccount, error) {
+func getServiceAccounts(ctx context.Context, k kubernetes.Interface, namespace, selector, cont string) ([]core.ServiceAccount, error) {
 	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 	defer cancel()
 	serviceAccounts, err := k.CoreV1().ServiceAccounts(namespace).List(ctxChild, meta.ListOptions{
-		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
-		Continue: cont,
+		Limit:         globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue:      cont,
+		LabelSelector: selector,
 	})
 
 	if err != nil {
@@ -138,7 +154,7 @@ func getServiceAccounts(ctx context.Context, k kubernetes.Interface, namespace,
 	}
 
 	if serviceAccounts.Continue != "" {
-		nextServiceAccountsLayer, err := getServiceAccounts(ctx, k, namespace, serviceAccounts.Continue)
+		nextServiceAccountsLayer, err := getServiceAccounts(ctx, k, namespace, selector, serviceAccounts.Continue)
 		if err != nil {
 			return nil, err
 		}
@@ -67,13 +67,33 @@ func (i *inspector) Secrets() []*core.Secret {
 
 func (i *inspector) Secret(name string) (*core.Secret, bool) {
 	i.lock.Lock()
-	defer i.lock.Unlock()
-
 	secret, ok := i.secrets[name]
+	i.lock.Unlock()
+
+	if i.watcher == nil {
+		return secret, ok
+	}
+
+	if ok {
+		// Secret may be watched by name, it is still in use
+		i.watcher.touchNamedSecret(name)
+		return secret, true
+	}
+
+	// Secret is not watched by the label, it is watched by name from now on
+	secret, ok = i.watcher.namedSecret(name)
 	if !ok {
 		return nil, false
 	}
 
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.secrets == nil {
+		i.secrets = map[string]*core.Secret{}
+	}
+	i.secrets[name] = secret
+
 	return secret, true
 }
 
@@ -96,22 +116,37 @@ func (s secretReadInterface) Get(ctx context.Context, name string, opts meta.Get
 	}
 }
 
+// RefreshSecrets reloads only Secrets from the API server
+func (i *inspector) RefreshSecrets(ctx context.Context) error {
+	return i.refreshResource(kindSecrets, func(n *inspector) func() error {
+		return secretsToMap(ctx, n, i.client.Kubernetes(), i.namespace)
+	}, func(n *inspector) {
+		i.secrets = n.secrets
+	})
+}
+
 func secretsToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface, namespace string) func() error {
 	return func() error {
-		secrets, err := getSecrets(ctx, k, namespace, "")
-		if err != nil {
-			return err
-		}
-
 		secretMap := map[string]*core.Secret{}
 
-		for _, secret := range secrets {
-			_, exists := secretMap[secret.GetName()]
-			if exists {
-				return errors.Newf("Secret %s already exists in map, error received", secret.GetName())
+		for _, selector := range labelSelectors(kindSecrets) {
+			secrets, err := getSecrets(ctx, k, namespace, selector, "")
+			if err != nil {
+				return err
 			}
 
-			secretMap[secret.GetName()] = secretPointer(secret)
+			selected := map[string]bool{}
+
+			for _, secret := range secrets {
+				if selected[secret.GetName()] {
+					return errors.Newf("Secret %s already exists in map, error received", secret.GetName())
+				}
+
+				selected[secret.GetName()] = true
+
+				// Resources matching more than one selector are loaded once
+				secretMap[secret.GetName()] = secretPointer(secret)
+			}
 		}
 
 		inspector.secrets = secretMap
@@ -124,12 +159,13 @@ func secretPointer(pod core.Secret) *core.Secret {
 	return &pod
 }
 
-func getSecrets(ctx context.Context, k kubernetes.Interface, namespace, cont string) ([]core.Secret, error) {
+func getSecrets(ctx context.Context, k kubernetes.Interface, namespace, selector, cont string) ([]core.Secret, error) {
 	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 	defer cancel()
 	secrets, err := k.CoreV1().Secrets(namespace).List(ctxChild, meta.ListOptions{
-		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
-		Continue: cont,
+		Limit:         globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue:      cont,
+		LabelSelector: selector,
 	})
 
 	if err != nil {
@@ -137,7 +173,7 @@ func getSecrets(ctx context.Context, k kubernetes.Interface, namespace, cont str
 	}
 
 	if secrets.Continue != "" {
-		nextSecretsLayer, err := getSecrets(ctx, k, namespace, secrets.Continue)
+		nextSecretsLayer, err := getSecrets(ctx, k, namespace, selector, secrets.Continue)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,52 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// labelSelectors returns the label selectors used to list and watch resources of the given kind.
+// Resources matching any of the selectors are loaded.
+// Pods, PVCs and Ingresses are always created by the operator with the deployment label, so only labeled ones are loaded.
+// Secrets, services, service accounts and PDBs can be provided by the user, so they are scoped only
+// when the selector is configured. Resources created by the operator are labeled with the deployment,
+// so they are loaded regardless of the configured selector. Empty selector means all resources in the namespace.
+func labelSelectors(kind string) []string {
+	switch kind {
+	case kindPods, kindPersistentVolumeClaims, kindIngresses:
+		return []string{k8sutil.LabelKeyArangoDeployment}
+	case kindSecrets, kindServices, kindServiceAccounts, kindPodDisruptionBudgets:
+		if selector := globals.GetGlobals().Kubernetes().InspectorSelector().Get(); selector != "" {
+			return []string{selector, k8sutil.LabelKeyArangoDeployment}
+		}
+		return []string{""}
+	default:
+		return []string{""}
+	}
+}
+
+// labelSelector returns the label selector used to list and watch resources of the given kind
+// when only one selector is used.
+func labelSelector(kind string) string {
+	return labelSelectors(kind)[0]
+}
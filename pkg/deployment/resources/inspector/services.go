@@ -97,22 +97,37 @@ func (i *inspector) Service(name string) (*core.Service, bool) {
 	return service, true
 }
 
+// RefreshServices reloads only Services from the API server
+func (i *inspector) RefreshServices(ctx context.Context) error {
+	return i.refreshResource(kindServices, func(n *inspector) func() error {
+		return servicesToMap(ctx, n, i.client.Kubernetes(), i.namespace)
+	}, func(n *inspector) {
+		i.services = n.services
+	})
+}
+
 func servicesToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface, namespace string) func() error {
 	return func() error {
-		services, err := getServices(ctx, k, namespace, "")
-		if err != nil {
-			return err
-		}
-
 		serviceMap := map[string]*core.Service{}
 
-		for _, service := range services {
-			_, exists := serviceMap[service.GetName()]
-			if exists {
-				return errors.Newf("Service %s already exists in map, error received", service.GetName())
+		for _, selector := range labelSelectors(kindServices) {
+			services, err := getServices(ctx, k, namespace, selector, "")
+			if err != nil {
+				return err
 			}
 
-			serviceMap[service.GetName()] = servicePointer(service)
+			selected := map[string]bool{}
+
+			for _, service := range services {
+				if selected[service.GetName()] {
+					return errors.Newf("Service %s already exists in map, error received", service.GetName())
+				}
+
+				selected[service.GetName()] = true
+
+				// Resources matching more than one selector are loaded once
+				serviceMap[service.GetName()] = servicePointer(service)
+			}
 		}
 
 		inspector.services = serviceMap
@@ -125,12 +140,13 @@ func servicePointer(pod core.Service) *core.Service {
 	return &pod
 }
 
-func getServices(ctx context.Context, k kubernetes.Interface, namespace, cont string) ([]core.Service, error) {
+func getServices(ctx context.Context, k kubernetes.Interface, namespace, selector, cont string) ([]core.Service, error) {
 	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 	defer cancel()
 	services, err := k.CoreV1().Services(namespace).List(ctxChild, meta.ListOptions{
-		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
-		Continue: cont,
+		Limit:         globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue:      cont,
+		LabelSelector: selector,
 	})
 
 	if err != nil {
@@ -138,7 +154,7 @@ func getServices(ctx context.Context, k kubernetes.Interface, namespace, cont st
 	}
 
 	if services.Continue != "" {
-		nextServicesLayer, err := getServices(ctx, k, namespace, services.Continue)
+		nextServicesLayer, err := getServices(ctx, k, namespace, selector, services.Continue)
 		if err != nil {
 			return nil, err
 		}
@@ -98,6 +98,15 @@ func (s serviceMonitorReadInterface) Get(ctx context.Context, name string, opts
 	}
 }
 
+// RefreshServiceMonitors reloads only ServiceMonitors from the API server
+func (i *inspector) RefreshServiceMonitors(ctx context.Context) error {
+	return i.refreshResource(kindServiceMonitors, func(n *inspector) func() error {
+		return serviceMonitorsToMap(ctx, n, i.client.Monitoring(), i.namespace)
+	}, func(n *inspector) {
+		i.serviceMonitors = n.serviceMonitors
+	})
+}
+
 func serviceMonitorsToMap(ctx context.Context, inspector *inspector, m monitoringClient.Interface, namespace string) func() error {
 	return func() error {
 		serviceMonitors := getServiceMonitors(ctx, m, namespace, "")
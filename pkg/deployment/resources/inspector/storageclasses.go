@@ -0,0 +1,150 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/storageclass"
+	storage "k8s.io/api/storage/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+func (i *inspector) GetStorageClasses() (storageclass.Inspector, bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.storageClasses == nil {
+		return nil, false
+	}
+
+	return i.storageClasses, i.storageClasses.accessible
+}
+
+type storageClassLoader struct {
+	accessible bool
+
+	storageClasses map[string]*storage.StorageClass
+}
+
+func (s *storageClassLoader) StorageClass(name string) (*storage.StorageClass, bool) {
+	sc, ok := s.storageClasses[name]
+	if !ok {
+		return nil, false
+	}
+
+	return sc, true
+}
+
+func (s *storageClassLoader) StorageClasses() []*storage.StorageClass {
+	var r []*storage.StorageClass
+	for _, sc := range s.storageClasses {
+		r = append(r, sc)
+	}
+
+	return r
+}
+
+func (s *storageClassLoader) StorageClassReadInterface() storageclass.ReadInterface {
+	return &storageClassReadInterface{i: s}
+}
+
+type storageClassReadInterface struct {
+	i *storageClassLoader
+}
+
+func (s storageClassReadInterface) Get(ctx context.Context, name string, opts meta.GetOptions) (*storage.StorageClass, error) {
+	if s, ok := s.i.StorageClass(name); !ok {
+		return nil, apiErrors.NewNotFound(schema.GroupResource{
+			Group:    storage.GroupName,
+			Resource: "storageclasses",
+		}, name)
+	} else {
+		return s, nil
+	}
+}
+
+func storageClassPointer(sc storage.StorageClass) *storage.StorageClass {
+	return &sc
+}
+
+// storageClassesToMap loads StorageClasses, they are marked as not accessible when the operator is not allowed to list them.
+func storageClassesToMap(ctx context.Context, inspector *inspector, k kubernetes.Interface) func() error {
+	return func() error {
+		storageClasses, err := getStorageClasses(ctx, k, "")
+		if err != nil {
+			if apiErrors.IsUnauthorized(err) || apiErrors.IsForbidden(err) {
+				inspector.storageClasses = &storageClassLoader{
+					accessible: false,
+				}
+				return nil
+			}
+			return err
+		}
+
+		storageClassesMap := map[string]*storage.StorageClass{}
+
+		for _, sc := range storageClasses {
+			_, exists := storageClassesMap[sc.GetName()]
+			if exists {
+				return errors.Newf("StorageClass %s already exists in map, error received", sc.GetName())
+			}
+
+			storageClassesMap[sc.GetName()] = storageClassPointer(sc)
+		}
+
+		inspector.storageClasses = &storageClassLoader{
+			accessible:     true,
+			storageClasses: storageClassesMap,
+		}
+
+		return nil
+	}
+}
+
+func getStorageClasses(ctx context.Context, k kubernetes.Interface, cont string) ([]storage.StorageClass, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	storageClasses, err := k.StorageV1().StorageClasses().List(ctxChild, meta.ListOptions{
+		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue: cont,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if storageClasses.Continue != "" {
+		nextStorageClassesLayer, err := getStorageClasses(ctx, k, storageClasses.Continue)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(storageClasses.Items, nextStorageClassesLayer...), nil
+	}
+
+	return storageClasses.Items, nil
+}
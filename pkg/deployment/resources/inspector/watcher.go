@@ -0,0 +1,605 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangoInformers "github.com/arangodb/kube-arangodb/pkg/generated/informers/externalversions"
+	arangoListers "github.com/arangodb/kube-arangodb/pkg/generated/listers/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+	"github.com/rs/zerolog"
+	core "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+	storage "k8s.io/api/storage/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	coreListers "k8s.io/client-go/listers/core/v1"
+	policyListers "k8s.io/client-go/listers/policy/v1beta1"
+	storageListers "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Watcher maintains caches of namespaced resources from shared informers.
+// Informers track the resourceVersion of each resource kind and receive changes from watches,
+// so inspectors can be created without listing all resources from the API server.
+// Only secrets created by the operator are watched by the label, secrets provided by users
+// are watched by name once they are requested by a deployment, until they are not requested for a while.
+type Watcher struct {
+	lock sync.Mutex
+
+	log zerolog.Logger
+
+	namespace string
+
+	client kclient.Client
+
+	stopCh <-chan struct{}
+
+	// kubernetes keeps informer factories by the label selector
+	kubernetes map[string]informers.SharedInformerFactory
+	arango     arangoInformers.SharedInformerFactory
+	// cluster keeps informers of cluster scoped resources
+	cluster informers.SharedInformerFactory
+
+	synced []cache.InformerSynced
+
+	pods                 coreListers.PodLister
+	secrets              []coreListers.SecretLister
+	pvcs                 coreListers.PersistentVolumeClaimLister
+	services             []coreListers.ServiceLister
+	serviceAccounts      []coreListers.ServiceAccountLister
+	podDisruptionBudgets []policyListers.PodDisruptionBudgetLister
+	arangoMembers        arangoListers.ArangoMemberLister
+
+	// pvs are watched in the whole cluster, the watch is not required to be synced
+	// as the operator can run without permissions to PersistentVolumes
+	pvs       coreListers.PersistentVolumeLister
+	pvsSynced cache.InformerSynced
+
+	// storageClasses are watched in the whole cluster, the same as pvs
+	storageClasses       storageListers.StorageClassLister
+	storageClassesSynced cache.InformerSynced
+
+	// namedSecrets keeps informers of secrets watched by name
+	namedSecrets map[string]*namedSecretInformer
+
+	// failed is set when any of the watches returned an error
+	failed bool
+}
+
+// NewWatcher creates a watcher of resources in the given namespace. Informers are started with Run.
+func NewWatcher(log zerolog.Logger, client kclient.Client, namespace string) *Watcher {
+	w := &Watcher{
+		log:          log,
+		namespace:    namespace,
+		client:       client,
+		kubernetes:   map[string]informers.SharedInformerFactory{},
+		namedSecrets: map[string]*namedSecretInformer{},
+		arango:       arangoInformers.NewSharedInformerFactoryWithOptions(client.Arango(), 0, arangoInformers.WithNamespace(namespace)),
+		cluster:      informers.NewSharedInformerFactory(client.Kubernetes(), 0),
+	}
+
+	pods := w.factory(labelSelector(kindPods)).Core().V1().Pods()
+	w.pods = pods.Lister()
+	w.register(kindPods, pods.Informer())
+
+	for _, selector := range watchedSecretsSelectors() {
+		secrets := w.factory(selector).Core().V1().Secrets()
+		w.secrets = append(w.secrets, secrets.Lister())
+		w.register(kindSecrets, secrets.Informer())
+	}
+
+	pvcs := w.factory(labelSelector(kindPersistentVolumeClaims)).Core().V1().PersistentVolumeClaims()
+	w.pvcs = pvcs.Lister()
+	w.register(kindPersistentVolumeClaims, pvcs.Informer())
+
+	for _, selector := range labelSelectors(kindServices) {
+		services := w.factory(selector).Core().V1().Services()
+		w.services = append(w.services, services.Lister())
+		w.register(kindServices, services.Informer())
+	}
+
+	for _, selector := range labelSelectors(kindServiceAccounts) {
+		serviceAccounts := w.factory(selector).Core().V1().ServiceAccounts()
+		w.serviceAccounts = append(w.serviceAccounts, serviceAccounts.Lister())
+		w.register(kindServiceAccounts, serviceAccounts.Informer())
+	}
+
+	for _, selector := range labelSelectors(kindPodDisruptionBudgets) {
+		podDisruptionBudgets := w.factory(selector).Policy().V1beta1().PodDisruptionBudgets()
+		w.podDisruptionBudgets = append(w.podDisruptionBudgets, podDisruptionBudgets.Lister())
+		w.register(kindPodDisruptionBudgets, podDisruptionBudgets.Informer())
+	}
+
+	arangoMembers := w.arango.Database().V1().ArangoMembers()
+	w.arangoMembers = arangoMembers.Lister()
+	w.register(kindArangoMembers, arangoMembers.Informer())
+
+	pvs := w.cluster.Core().V1().PersistentVolumes()
+	w.pvs = pvs.Lister()
+	w.pvsSynced = pvs.Informer().HasSynced
+	w.registerOptional(kindPersistentVolumes, pvs.Informer())
+
+	storageClasses := w.cluster.Storage().V1().StorageClasses()
+	w.storageClasses = storageClasses.Lister()
+	w.storageClassesSynced = storageClasses.Informer().HasSynced
+	w.registerOptional(kindStorageClasses, storageClasses.Informer())
+
+	return w
+}
+
+// registerOptional registers the informer which is not required to be synced, inspectors list resources
+// by themselves until its cache is synced.
+func (w *Watcher) registerOptional(resource string, informer cache.SharedIndexInformer) {
+	w.recordChanges(resource, informer)
+	if err := informer.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		w.log.Debug().Err(err).Str("resource", resource).Msg("Watch failed, resources will be listed by inspectors")
+		cache.DefaultWatchErrorHandler(r, err)
+	}); err != nil {
+		w.log.Warn().Err(err).Str("resource", resource).Msg("Unable to set watch error handler")
+	}
+}
+
+// factory returns the informer factory for the label selector. Informers of the same kind and selector are shared.
+func (w *Watcher) factory(selector string) informers.SharedInformerFactory {
+	if f, ok := w.kubernetes[selector]; ok {
+		return f
+	}
+
+	f := newSharedInformerFactory(w.client, w.namespace, selector)
+	w.kubernetes[selector] = f
+	return f
+}
+
+// watchedSecretsSelectors returns the label selectors of watched secrets.
+// Without the inspector selector only secrets created by the operator are watched, not all secrets in the namespace.
+func watchedSecretsSelectors() []string {
+	var r []string
+	for _, selector := range labelSelectors(kindSecrets) {
+		if selector == "" {
+			selector = k8sutil.LabelKeyArangoDeployment
+		}
+		r = append(r, selector)
+	}
+	return r
+}
+
+// newSharedInformerFactory creates a factory of informers which list and watch resources matching the label selector.
+func newSharedInformerFactory(client kclient.Client, namespace, selector string) informers.SharedInformerFactory {
+	return informers.NewSharedInformerFactoryWithOptions(client.Kubernetes(), 0, informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *meta.ListOptions) {
+			options.LabelSelector = selector
+		}))
+}
+
+func (w *Watcher) register(resource string, informer cache.SharedIndexInformer) {
+	w.setWatchErrorHandler(resource, informer)
+	w.recordChanges(resource, informer)
+
+	w.synced = append(w.synced, informer.HasSynced)
+}
+
+// recordChanges records the time of the last change received from the watch, copies of the cache taken
+// by inspectors do not update it.
+func (w *Watcher) recordChanges(resource string, informer cache.SharedIndexInformer) {
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) {
+			recordRefresh(w.namespace, resource)
+		},
+		UpdateFunc: func(interface{}, interface{}) {
+			recordRefresh(w.namespace, resource)
+		},
+		DeleteFunc: func(interface{}) {
+			recordRefresh(w.namespace, resource)
+		},
+	})
+}
+
+func (w *Watcher) setWatchErrorHandler(resource string, informer cache.SharedIndexInformer) {
+	if err := informer.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		w.log.Warn().Err(err).Str("resource", resource).Msg("Watch failed, resources will be re-listed")
+		w.setFailed(true)
+		cache.DefaultWatchErrorHandler(r, err)
+	}); err != nil {
+		w.log.Warn().Err(err).Str("resource", resource).Msg("Unable to set watch error handler")
+	}
+}
+
+// Run starts informers. Watches are stopped when the given channel is closed.
+func (w *Watcher) Run(stopCh <-chan struct{}) {
+	w.lock.Lock()
+	w.stopCh = stopCh
+	w.lock.Unlock()
+
+	for _, f := range w.kubernetes {
+		f.Start(stopCh)
+	}
+	w.arango.Start(stopCh)
+	w.cluster.Start(stopCh)
+}
+
+// HasSynced returns true when all informers received the initial list of resources.
+func (w *Watcher) HasSynced() bool {
+	for _, s := range w.synced {
+		if !s() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// namedSecretIdleTimeout defines how long the secret watched by name is watched after it was requested for the last time
+const namedSecretIdleTimeout = 15 * time.Minute
+
+// namedSecretInformer watches the single secret by name
+type namedSecretInformer struct {
+	informer cache.SharedIndexInformer
+
+	// stopCh stops the informer when the secret is not requested anymore
+	stopCh chan struct{}
+
+	lastUsed time.Time
+}
+
+// newNamedSecretInformer starts the informer of the secret with the given name.
+// Informer is stopped with the watcher, or when the secret is evicted.
+func (w *Watcher) newNamedSecretInformer(name string) *namedSecretInformer {
+	n := &namedSecretInformer{
+		informer: informers.NewSharedInformerFactoryWithOptions(w.client.Kubernetes(), 0, informers.WithNamespace(w.namespace),
+			informers.WithTweakListOptions(func(options *meta.ListOptions) {
+				options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+			})).Core().V1().Secrets().Informer(),
+		stopCh:   make(chan struct{}),
+		lastUsed: time.Now(),
+	}
+
+	w.setWatchErrorHandler(kindSecrets, n.informer)
+	w.recordChanges(kindSecrets, n.informer)
+
+	stopCh := make(chan struct{})
+	go func(parent <-chan struct{}) {
+		defer close(stopCh)
+
+		select {
+		case <-parent:
+		case <-n.stopCh:
+		}
+	}(w.stopCh)
+
+	go n.informer.Run(stopCh)
+
+	return n
+}
+
+// touchNamedSecret marks the secret watched by name as requested, so it is not evicted
+func (w *Watcher) touchNamedSecret(name string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if n, ok := w.namedSecrets[name]; ok {
+		n.lastUsed = time.Now()
+	}
+}
+
+// evictNamedSecrets stops informers of secrets which were not requested for namedSecretIdleTimeout.
+// Secrets are watched again by name once they are requested.
+func (w *Watcher) evictNamedSecrets() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for name, n := range w.namedSecrets {
+		if time.Since(n.lastUsed) < namedSecretIdleTimeout {
+			continue
+		}
+
+		close(n.stopCh)
+		delete(w.namedSecrets, name)
+	}
+}
+
+// namedSecret returns the secret with the given name. Secrets which are not watched by the label are watched by name
+// from the first request, so following inspections take them from the cache.
+func (w *Watcher) namedSecret(name string) (*core.Secret, bool) {
+	w.lock.Lock()
+	n, ok := w.namedSecrets[name]
+	if !ok {
+		if w.stopCh == nil {
+			w.lock.Unlock()
+			return nil, false
+		}
+
+		n = w.newNamedSecretInformer(name)
+		w.namedSecrets[name] = n
+	}
+	n.lastUsed = time.Now()
+	informer := n.informer
+	w.lock.Unlock()
+
+	if !informer.HasSynced() {
+		ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+		defer cancel()
+
+		if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+			return nil, false
+		}
+	}
+
+	obj, exists, err := informer.GetStore().GetByKey(w.namespace + "/" + name)
+	if err != nil || !exists {
+		return nil, false
+	}
+
+	s, ok := obj.(*core.Secret)
+	if !ok {
+		return nil, false
+	}
+
+	return s.DeepCopy(), true
+}
+
+// isUsable returns true when resources can be taken from the caches
+func (w *Watcher) isUsable() bool {
+	return w.HasSynced() && !w.isFailed()
+}
+
+func (w *Watcher) isFailed() bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.failed
+}
+
+func (w *Watcher) setFailed(failed bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.failed = failed
+}
+
+// NewInspectorFromWatcher creates an inspector with namespaced resources taken from the watcher caches.
+// Resources are listed from the API server when caches are not synced yet or a watch failed.
+// Refresh of the inspector takes resources from the watcher caches as well.
+func NewInspectorFromWatcher(ctx context.Context, w *Watcher) (inspectorInterface.Inspector, error) {
+	if !w.isUsable() {
+		w.setFailed(false)
+
+		return NewInspector(ctx, w.client, w.namespace)
+	}
+
+	return newInspectorFromWatcher(ctx, w)
+}
+
+func newInspectorFromWatcher(ctx context.Context, w *Watcher) (*inspector, error) {
+	i := &inspector{
+		namespace: w.namespace,
+		client:    w.client,
+		watcher:   w,
+	}
+
+	if err := withMetrics(w.namespace, kindCache, i, func() error { return w.fill(i) })(); err != nil {
+		return nil, err
+	}
+
+	for _, kind := range watchedKinds {
+		recordObjects(w.namespace, kind, i)
+	}
+
+	if err := util.RunParallel(globals.GetGlobals().Kubernetes().InspectorParallelism().Get(),
+		withMetrics(w.namespace, kindVersion, i, getVersionInfo(ctx, i, w.client.Kubernetes(), w.namespace)),
+		withMetrics(w.namespace, kindServiceMonitors, i, serviceMonitorsToMap(ctx, i, w.client.Monitoring(), w.namespace)),
+		withMetrics(w.namespace, kindPrometheusRules, i, prometheusRulesToMap(ctx, i, w.client.Monitoring(), w.namespace)),
+		withMetrics(w.namespace, kindIngresses, i, ingressesToMap(ctx, i, w.client.Kubernetes(), w.namespace)),
+		withMetrics(w.namespace, kindNodes, i, nodesToMap(ctx, i, w.client.Kubernetes())),
+		w.persistentVolumesToMap(ctx, i),
+		w.storageClassesToMap(ctx, i),
+		withMetrics(w.namespace, kindArangoClusterSynchronizations, i, arangoClusterSynchronizationsToMap(ctx, i, w.client.Arango(), w.namespace)),
+		withMetrics(w.namespace, kindArangoTasks, i, arangoTasksToMap(ctx, i, w.client.Arango(), w.namespace)),
+	); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// persistentVolumesToMap takes PersistentVolumes from the watcher cache, they are listed only when the cache is not synced.
+func (w *Watcher) persistentVolumesToMap(ctx context.Context, i *inspector) func() error {
+	if !w.pvsSynced() {
+		return withMetrics(w.namespace, kindPersistentVolumes, i, persistentVolumesToMap(ctx, i, w.client.Kubernetes(), w.namespace))
+	}
+
+	return func() error {
+
+		pvs, err := w.pvs.List(labels.Everything())
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		pvsMap := map[string]*core.PersistentVolume{}
+		for _, pv := range pvs {
+			if !isPersistentVolumeInNamespace(pv, w.namespace) {
+				continue
+			}
+
+			pvsMap[pv.GetName()] = pv.DeepCopy()
+		}
+
+		i.pvs = &persistentVolumeLoader{
+			accessible: true,
+			pvs:        pvsMap,
+		}
+
+		recordObjects(w.namespace, kindPersistentVolumes, i)
+
+		return nil
+	}
+}
+
+// storageClassesToMap takes StorageClasses from the watcher cache, they are listed only when the cache is not synced.
+func (w *Watcher) storageClassesToMap(ctx context.Context, i *inspector) func() error {
+	if !w.storageClassesSynced() {
+		return withMetrics(w.namespace, kindStorageClasses, i, storageClassesToMap(ctx, i, w.client.Kubernetes()))
+	}
+
+	return func() error {
+		storageClasses, err := w.storageClasses.List(labels.Everything())
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		storageClassesMap := make(map[string]*storage.StorageClass, len(storageClasses))
+		for _, sc := range storageClasses {
+			storageClassesMap[sc.GetName()] = sc.DeepCopy()
+		}
+
+		i.storageClasses = &storageClassLoader{
+			accessible:     true,
+			storageClasses: storageClassesMap,
+		}
+
+		recordObjects(w.namespace, kindStorageClasses, i)
+
+		return nil
+	}
+}
+
+// watchedKinds contains kinds of resources taken from the watcher caches
+var watchedKinds = []string{kindPods, kindSecrets, kindPersistentVolumeClaims, kindServices, kindServiceAccounts, kindPodDisruptionBudgets, kindArangoMembers}
+
+// isWatchedKind returns true when resources of the kind are taken from the watcher caches
+func isWatchedKind(kind string) bool {
+	for _, k := range watchedKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// fill copies resources from the watcher caches into the inspector.
+// Objects are copied, so callers are free to modify them.
+func (w *Watcher) fill(i *inspector) error {
+	everything := labels.Everything()
+
+	pods, err := w.pods.Pods(w.namespace).List(everything)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	i.pods = make(map[string]*core.Pod, len(pods))
+	for _, o := range pods {
+		i.pods[o.GetName()] = o.DeepCopy()
+	}
+
+	i.secrets = map[string]*core.Secret{}
+	for _, l := range w.secrets {
+		secrets, err := l.Secrets(w.namespace).List(everything)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, o := range secrets {
+			i.secrets[o.GetName()] = o.DeepCopy()
+		}
+	}
+
+	w.evictNamedSecrets()
+
+	w.lock.Lock()
+	namedSecrets := make([]cache.SharedIndexInformer, 0, len(w.namedSecrets))
+	for _, n := range w.namedSecrets {
+		namedSecrets = append(namedSecrets, n.informer)
+	}
+	w.lock.Unlock()
+
+	for _, informer := range namedSecrets {
+		if !informer.HasSynced() {
+			continue
+		}
+		for _, obj := range informer.GetStore().List() {
+			if o, ok := obj.(*core.Secret); ok {
+				i.secrets[o.GetName()] = o.DeepCopy()
+			}
+		}
+	}
+
+	pvcs, err := w.pvcs.PersistentVolumeClaims(w.namespace).List(everything)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	i.pvcs = make(map[string]*core.PersistentVolumeClaim, len(pvcs))
+	for _, o := range pvcs {
+		i.pvcs[o.GetName()] = o.DeepCopy()
+	}
+
+	i.services = map[string]*core.Service{}
+	for _, l := range w.services {
+		services, err := l.Services(w.namespace).List(everything)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, o := range services {
+			i.services[o.GetName()] = o.DeepCopy()
+		}
+	}
+
+	i.serviceAccounts = map[string]*core.ServiceAccount{}
+	for _, l := range w.serviceAccounts {
+		serviceAccounts, err := l.ServiceAccounts(w.namespace).List(everything)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, o := range serviceAccounts {
+			i.serviceAccounts[o.GetName()] = o.DeepCopy()
+		}
+	}
+
+	i.podDisruptionBudgets = map[string]*policy.PodDisruptionBudget{}
+	for _, l := range w.podDisruptionBudgets {
+		podDisruptionBudgets, err := l.PodDisruptionBudgets(w.namespace).List(everything)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, o := range podDisruptionBudgets {
+			i.podDisruptionBudgets[o.GetName()] = o.DeepCopy()
+		}
+	}
+
+	arangoMembers, err := w.arangoMembers.ArangoMembers(w.namespace).List(everything)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	i.arangoMembers = make(map[string]*api.ArangoMember, len(arangoMembers))
+	for _, o := range arangoMembers {
+		i.arangoMembers[o.GetName()] = o.DeepCopy()
+	}
+
+	return nil
+}
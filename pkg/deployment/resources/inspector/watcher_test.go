@@ -0,0 +1,271 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	storage "k8s.io/api/storage/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_Watcher(t *testing.T) {
+	namespace := "test"
+	c := kclient.NewFakeClientBuilder().Kubernetes(&core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: "existing", Namespace: namespace},
+	}).Client()
+
+	w := NewWatcher(log.Logger, c, namespace)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	w.Run(stopCh)
+
+	require.Eventually(t, w.HasSynced, 5*time.Second, 10*time.Millisecond)
+
+	i, err := NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+
+	s, ok := i.Secret("existing")
+	require.True(t, ok)
+
+	// Objects taken from the cache are copies
+	s.Labels = map[string]string{"a": "b"}
+	i, err = NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+	s, ok = i.Secret("existing")
+	require.True(t, ok)
+	require.Empty(t, s.Labels)
+
+	_, err = c.Kubernetes().CoreV1().Secrets(namespace).Create(context.Background(), &core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: "created", Namespace: namespace},
+	}, meta.CreateOptions{})
+	require.NoError(t, err)
+
+	// Changes are received from the watch
+	require.Eventually(t, func() bool {
+		i, err := NewInspectorFromWatcher(context.Background(), w)
+		require.NoError(t, err)
+		_, ok := i.Secret("created")
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Time of the last refresh is recorded when changes are received
+	require.NotZero(t, testutil.ToFloat64(lastRefreshGauges.WithLabelValues(namespace, kindSecrets)))
+
+	// Resources are listed when a watch failed
+	w.setFailed(true)
+	i, err = NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+	_, ok = i.Secret("created")
+	require.True(t, ok)
+	require.False(t, w.isFailed())
+}
+
+func Test_Watcher_Secrets(t *testing.T) {
+	namespace := "test"
+	c := kclient.NewFakeClientBuilder().Kubernetes(&core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: "managed", Namespace: namespace, Labels: map[string]string{k8sutil.LabelKeyArangoDeployment: "deployment"}},
+	}, &core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: "user", Namespace: namespace},
+	}).Client()
+
+	w := NewWatcher(log.Logger, c, namespace)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	w.Run(stopCh)
+
+	require.Eventually(t, w.HasSynced, 5*time.Second, 10*time.Millisecond)
+
+	i, err := NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+
+	// Only secrets created by the operator are watched by the label
+	require.Equal(t, []string{"managed"}, secretNames(t, i))
+
+	// Other secrets are watched by name once requested
+	_, ok := i.Secret("user")
+	require.True(t, ok)
+	_, ok = i.Secret("missing")
+	require.False(t, ok)
+
+	i, err = NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"managed", "user"}, secretNames(t, i))
+
+	_, err = c.Kubernetes().CoreV1().Secrets(namespace).Create(context.Background(), &core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: "created", Namespace: namespace, Labels: map[string]string{k8sutil.LabelKeyArangoDeployment: "deployment"}},
+	}, meta.CreateOptions{})
+	require.NoError(t, err)
+
+	// Refresh takes resources from the watcher caches
+	require.Eventually(t, func() bool {
+		require.NoError(t, i.Refresh(context.Background()))
+		_, ok := i.Secret("created")
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func Test_Watcher_NamedSecretsEviction(t *testing.T) {
+	namespace := "test"
+	c := kclient.NewFakeClientBuilder().Kubernetes(&core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: "user", Namespace: namespace},
+	}).Client()
+
+	w := NewWatcher(log.Logger, c, namespace)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	w.Run(stopCh)
+
+	require.Eventually(t, w.HasSynced, 5*time.Second, 10*time.Millisecond)
+
+	i, err := NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+
+	_, ok := i.Secret("user")
+	require.True(t, ok)
+
+	// Requested secret is not evicted
+	w.evictNamedSecrets()
+	require.Contains(t, w.namedSecrets, "user")
+
+	w.lock.Lock()
+	n := w.namedSecrets["user"]
+	n.lastUsed = time.Now().Add(-namedSecretIdleTimeout)
+	w.lock.Unlock()
+
+	// Secret which is not requested anymore is not watched
+	i, err = NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+	require.Empty(t, secretNames(t, i))
+	require.NotContains(t, w.namedSecrets, "user")
+
+	select {
+	case <-n.stopCh:
+	default:
+		require.Fail(t, "Informer of the evicted secret is not stopped")
+	}
+
+	// Secret is watched again once requested
+	_, ok = i.Secret("user")
+	require.True(t, ok)
+	require.Contains(t, w.namedSecrets, "user")
+}
+
+func secretNames(t *testing.T, i inspectorInterface.Inspector) []string {
+	var names []string
+	require.NoError(t, i.IterateSecrets(func(s *core.Secret) error {
+		names = append(names, s.GetName())
+		return nil
+	}))
+	return names
+}
+
+func Test_Watcher_PersistentVolumes(t *testing.T) {
+	namespace := "test"
+	c := kclient.NewFakeClientBuilder().Kubernetes(&core.PersistentVolume{
+		ObjectMeta: meta.ObjectMeta{Name: "bound"},
+		Spec:       core.PersistentVolumeSpec{ClaimRef: &core.ObjectReference{Namespace: namespace, Name: "pvc"}},
+	}, &core.PersistentVolume{
+		ObjectMeta: meta.ObjectMeta{Name: "other"},
+		Spec:       core.PersistentVolumeSpec{ClaimRef: &core.ObjectReference{Namespace: "other", Name: "pvc"}},
+	}).Client()
+
+	w := NewWatcher(log.Logger, c, namespace)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	w.Run(stopCh)
+
+	require.Eventually(t, func() bool {
+		return w.HasSynced() && w.pvsSynced()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	i, err := NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+
+	// Only PersistentVolumes bound in the namespace are taken from the cache
+	pvs, ok := i.GetPersistentVolumes()
+	require.True(t, ok)
+	require.Len(t, pvs.PersistentVolumes(), 1)
+	_, ok = pvs.PersistentVolume("bound")
+	require.True(t, ok)
+
+	require.NoError(t, c.Kubernetes().CoreV1().PersistentVolumes().Delete(context.Background(), "bound", meta.DeleteOptions{}))
+
+	// Changes are received from the watch
+	require.Eventually(t, func() bool {
+		i, err := NewInspectorFromWatcher(context.Background(), w)
+		require.NoError(t, err)
+		pvs, ok := i.GetPersistentVolumes()
+		require.True(t, ok)
+		return len(pvs.PersistentVolumes()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func Test_Watcher_StorageClasses(t *testing.T) {
+	c := kclient.NewFakeClientBuilder().Kubernetes(&storage.StorageClass{
+		ObjectMeta: meta.ObjectMeta{Name: "standard"},
+	}).Client()
+
+	w := NewWatcher(log.Logger, c, "test")
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	w.Run(stopCh)
+
+	require.Eventually(t, func() bool {
+		return w.HasSynced() && w.storageClassesSynced()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	i, err := NewInspectorFromWatcher(context.Background(), w)
+	require.NoError(t, err)
+
+	storageClasses, ok := i.GetStorageClasses()
+	require.True(t, ok)
+	_, ok = storageClasses.StorageClass("standard")
+	require.True(t, ok)
+
+	_, err = c.Kubernetes().StorageV1().StorageClasses().Create(context.Background(), &storage.StorageClass{
+		ObjectMeta: meta.ObjectMeta{Name: "expandable"},
+	}, meta.CreateOptions{})
+	require.NoError(t, err)
+
+	// Changes are received from the watch
+	require.Eventually(t, func() bool {
+		i, err := NewInspectorFromWatcher(context.Background(), w)
+		require.NoError(t, err)
+		storageClasses, ok := i.GetStorageClasses()
+		require.True(t, ok)
+		return len(storageClasses.StorageClasses()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -50,6 +50,13 @@ var (
 	cleanupRemovedMembersCounters = metrics.MustRegisterCounterVec(metricsComponent, "cleanup_removed_members", "Number of cleanup-removed-members actions", metrics.DeploymentName, metrics.Result)
 )
 
+// memberPVC identifies the PVC of a removed member
+type memberPVC struct {
+	group    api.ServerGroup
+	memberID string
+	name     string
+}
+
 // CleanupRemovedMembers removes all arangod members that are no longer part of ArangoDB deployment.
 func (r *Resources) CleanupRemovedMembers(ctx context.Context, health memberState.Health) error {
 	// Decide what to do depending on cluster mode
@@ -85,7 +92,8 @@ func (r *Resources) cleanupRemovedClusterMembers(ctx context.Context, health mem
 	// For over all members that can be removed
 	status, lastVersion := r.context.GetStatus()
 	updateStatusNeeded := false
-	var podNamesToRemove, pvcNamesToRemove []string
+	var podNamesToRemove []string
+	var pvcsToRemove []memberPVC
 	status.Members.ForeachServerGroup(func(group api.ServerGroup, list api.MemberStatusList) error {
 		if group != api.ServerGroupCoordinators && group != api.ServerGroupDBServers {
 			// We're not interested in these other groups
@@ -125,7 +133,7 @@ func (r *Resources) cleanupRemovedClusterMembers(ctx context.Context, health mem
 				podNamesToRemove = append(podNamesToRemove, m.PodName)
 			}
 			if m.PersistentVolumeClaimName != "" {
-				pvcNamesToRemove = append(pvcNamesToRemove, m.PersistentVolumeClaimName)
+				pvcsToRemove = append(pvcsToRemove, memberPVC{group: group, memberID: m.ID, name: m.PersistentVolumeClaimName})
 			}
 		}
 		return nil
@@ -147,10 +155,10 @@ func (r *Resources) cleanupRemovedClusterMembers(ctx context.Context, health mem
 		}
 	}
 
-	for _, pvcName := range pvcNamesToRemove {
-		log.Info().Str("pvc", pvcName).Msg("Removing obsolete member PVC")
-		if err := r.context.DeletePvc(ctx, pvcName); err != nil && !k8sutil.IsNotFound(err) {
-			log.Warn().Err(err).Str("pvc", pvcName).Msg("Failed to remove obsolete PVC")
+	for _, pvc := range pvcsToRemove {
+		log.Info().Str("pvc", pvc.name).Msg("Removing obsolete member PVC")
+		if err := r.removeMemberPVC(ctx, log, pvc.group, pvc.memberID, pvc.name); err != nil {
+			log.Warn().Err(err).Str("pvc", pvc.name).Msg("Failed to remove obsolete PVC")
 		}
 	}
 
@@ -0,0 +1,202 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/service"
+)
+
+// memberExternalAccessNodePorts returns node ports already assigned to the per-member external access services
+// of the given members.
+func memberExternalAccessNodePorts(cachedStatus inspectorInterface.Inspector, deploymentName string,
+	group api.ServerGroup, list api.MemberStatusList) map[int32]bool {
+	used := map[int32]bool{}
+
+	for _, m := range list {
+		svc, ok := cachedStatus.Service(k8sutil.CreateMemberExternalAccessServiceName(m.ArangoMemberName(deploymentName, group)))
+		if !ok {
+			continue
+		}
+
+		for _, p := range svc.Spec.Ports {
+			if p.NodePort != 0 {
+				used[p.NodePort] = true
+			}
+		}
+	}
+
+	return used
+}
+
+// allocateMemberNodePort returns the lowest port, starting from base, which is not used yet.
+// Returns 0 when base is not set, so the port is allocated by Kubernetes.
+func allocateMemberNodePort(base int, used map[int32]bool) int32 {
+	if base <= 0 {
+		return 0
+	}
+
+	ports := make([]int, 0, len(used))
+	for p := range used {
+		ports = append(ports, int(p))
+	}
+	sort.Ints(ports)
+
+	port := base
+	for _, p := range ports {
+		if p < port {
+			continue
+		}
+		if p != port {
+			break
+		}
+		port++
+	}
+
+	return int32(port)
+}
+
+// memberExternalAccessServiceSpec returns the spec of the service exposing a single member.
+func memberExternalAccessServiceSpec(spec *api.ServerGroupExternalAccessSpec, selector map[string]string,
+	port, nodePort int32) core.ServiceSpec {
+	s := core.ServiceSpec{
+		Type: spec.GetServiceType(),
+		Ports: []core.ServicePort{
+			{
+				Name:       "server",
+				Protocol:   core.ProtocolTCP,
+				Port:       port,
+				TargetPort: intstr.FromInt(int(port)),
+				NodePort:   nodePort,
+			},
+		},
+		PublishNotReadyAddresses: true,
+		Selector:                 selector,
+	}
+
+	if spec.GetType().IsLoadBalancer() {
+		s.LoadBalancerSourceRanges = spec.GetLoadBalancerSourceRanges()
+	}
+
+	return s
+}
+
+// ensureMemberExternalAccessService creates, updates or removes the service exposing a single member of the group.
+// Once assigned, the node port of the member is kept, so clients can rely on it.
+// Returns true when the service has been changed.
+func (r *Resources) ensureMemberExternalAccessService(ctx context.Context, cachedStatus inspectorInterface.Inspector,
+	svcs service.ModInterface, deploymentName string, group api.ServerGroup, spec *api.ServerGroupExternalAccessSpec,
+	member *api.ArangoMember, memberID string, port int32, usedNodePorts map[int32]bool) (bool, error) {
+	log := r.log
+	selector := k8sutil.LabelsForMember(deploymentName, group.AsRole(), memberID)
+	name := k8sutil.CreateMemberExternalAccessServiceName(member.GetName())
+	existing, exists := cachedStatus.Service(name)
+
+	if exists && (!spec.IsEnabled() || existing.Spec.Type != spec.GetServiceType()) {
+		log.Info().Str("service", name).Msg("Removing obsolete member external access service")
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return svcs.Delete(ctxChild, name, metav1.DeleteOptions{})
+		})
+		if err != nil && !k8sutil.IsNotFound(err) {
+			return false, errors.WithStack(err)
+		}
+		return true, nil
+	}
+
+	if !spec.IsEnabled() {
+		return false, nil
+	}
+
+	if !exists {
+		nodePort := int32(0)
+		if spec.GetType().IsNodePort() {
+			nodePort = allocateMemberNodePort(spec.GetNodePortBase(), usedNodePorts)
+			if nodePort != 0 {
+				usedNodePorts[nodePort] = true
+			}
+		}
+
+		svc := &core.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: member.GetNamespace(),
+				Labels:    k8sutil.LabelsForDeployment(deploymentName, group.AsRole()),
+				OwnerReferences: []metav1.OwnerReference{
+					member.AsOwner(),
+				},
+			},
+			Spec: memberExternalAccessServiceSpec(spec, selector, port, nodePort),
+		}
+
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			_, err := svcs.Create(ctxChild, svc, metav1.CreateOptions{})
+			return err
+		})
+		if err != nil && !k8sutil.IsConflict(err) {
+			return false, errors.WithStack(err)
+		}
+
+		log.Debug().Str("service", name).Msg("Created member external access service")
+		return true, nil
+	}
+
+	nodePort := int32(0)
+	if len(existing.Spec.Ports) > 0 {
+		nodePort = existing.Spec.Ports[0].NodePort
+	}
+
+	expected := memberExternalAccessServiceSpec(spec, selector, port, nodePort)
+	if equality.Semantic.DeepEqual(existing.Spec.Ports, expected.Ports) &&
+		strings.Join(existing.Spec.LoadBalancerSourceRanges, ",") == strings.Join(expected.LoadBalancerSourceRanges, ",") &&
+		equality.Semantic.DeepEqual(existing.Spec.Selector, expected.Selector) &&
+		existing.Spec.PublishNotReadyAddresses {
+		return false, nil
+	}
+
+	svc := existing.DeepCopy()
+	svc.Spec.Ports = expected.Ports
+	svc.Spec.LoadBalancerSourceRanges = expected.LoadBalancerSourceRanges
+	svc.Spec.Selector = expected.Selector
+	svc.Spec.PublishNotReadyAddresses = true
+
+	err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := svcs.Update(ctxChild, svc, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,66 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func Test_AllocateMemberNodePort(t *testing.T) {
+	require.EqualValues(t, 0, allocateMemberNodePort(0, map[int32]bool{30000: true}))
+	require.EqualValues(t, 30000, allocateMemberNodePort(30000, nil))
+	require.EqualValues(t, 30001, allocateMemberNodePort(30000, map[int32]bool{30000: true}))
+	require.EqualValues(t, 30001, allocateMemberNodePort(30000, map[int32]bool{29999: true, 30000: true, 30002: true}))
+	require.EqualValues(t, 30003, allocateMemberNodePort(30000, map[int32]bool{30000: true, 30001: true, 30002: true, 31000: true}))
+}
+
+func Test_MemberExternalAccessServiceSpec(t *testing.T) {
+	selector := map[string]string{"arango_deployment": "example"}
+
+	t.Run("NodePort", func(t *testing.T) {
+		spec := memberExternalAccessServiceSpec(&api.ServerGroupExternalAccessSpec{
+			Type:                     api.NewExternalAccessType(api.ExternalAccessTypeNodePort),
+			LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+		}, selector, 8529, 30001)
+
+		require.Equal(t, core.ServiceTypeNodePort, spec.Type)
+		require.Len(t, spec.Ports, 1)
+		require.EqualValues(t, 8529, spec.Ports[0].Port)
+		require.EqualValues(t, 30001, spec.Ports[0].NodePort)
+		require.Empty(t, spec.LoadBalancerSourceRanges)
+		require.Equal(t, selector, spec.Selector)
+	})
+
+	t.Run("LoadBalancer", func(t *testing.T) {
+		spec := memberExternalAccessServiceSpec(&api.ServerGroupExternalAccessSpec{
+			Type:                     api.NewExternalAccessType(api.ExternalAccessTypeLoadBalancer),
+			LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+		}, selector, 8529, 0)
+
+		require.Equal(t, core.ServiceTypeLoadBalancer, spec.Type)
+		require.Equal(t, []string{"10.0.0.0/8"}, spec.LoadBalancerSourceRanges)
+	})
+}
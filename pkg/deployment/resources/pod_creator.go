@@ -74,7 +74,7 @@ func createArangodArgs(cachedStatus interfaces.Inspector, input pod.Input, addit
 	}
 
 	if input.GroupSpec.GetExternalPortEnabled() {
-		options.Addf("--server.endpoint", "%s://%s:%d", scheme, input.Deployment.GetListenAddr(), k8sutil.ArangoPort)
+		options.Addf("--server.endpoint", "%s://%s:%d", scheme, input.Deployment.GetListenAddr(), input.Deployment.GetServerGroupPort(input.Group))
 	}
 
 	if port := input.GroupSpec.InternalPort; port != nil {
@@ -108,15 +108,19 @@ func createArangodArgs(cachedStatus interfaces.Inspector, input pod.Input, addit
 	options.Add("--database.directory", k8sutil.ArangodVolumeMountDir)
 	options.Add("--log.output", "+")
 
+	options.Merge(pod.Directories().Args(input))
+
 	options.Merge(pod.SNI().Args(input))
 
+	options.Merge(pod.AuditLog().Args(input))
+
 	endpoint, err := pod.GenerateMemberEndpoint(cachedStatus, input.ApiObject, input.Deployment, input.Group, input.Member)
 	if err != nil {
 		return nil, err
 	}
 	endpoint = util.StringOrDefault(input.Member.Endpoint, endpoint)
 
-	myTCPURL := scheme + "://" + net.JoinHostPort(endpoint, strconv.Itoa(k8sutil.ArangoPort))
+	myTCPURL := scheme + "://" + net.JoinHostPort(endpoint, strconv.Itoa(input.Deployment.GetServerGroupPort(input.Group)))
 	addAgentEndpoints := false
 	switch input.Group {
 	case api.ServerGroupAgents:
@@ -133,7 +137,7 @@ func createArangodArgs(cachedStatus interfaces.Inspector, input pod.Input, addit
 				if err != nil {
 					return nil, err
 				}
-				options.Addf("--agency.endpoint", "%s://%s", scheme, net.JoinHostPort(util.StringOrDefault(p.Endpoint, dnsName), strconv.Itoa(k8sutil.ArangoPort)))
+				options.Addf("--agency.endpoint", "%s://%s", scheme, net.JoinHostPort(util.StringOrDefault(p.Endpoint, dnsName), strconv.Itoa(input.Deployment.GetServerGroupPort(api.ServerGroupAgents))))
 			}
 		}
 	case api.ServerGroupDBServers:
@@ -148,8 +152,8 @@ func createArangodArgs(cachedStatus interfaces.Inspector, input pod.Input, addit
 		options.Add("--cluster.my-role", "COORDINATOR")
 		options.Add("--foxx.queues", input.Deployment.Features.GetFoxxQueues())
 		options.Add("--server.statistics", "true")
-		if input.Deployment.ExternalAccess.HasAdvertisedEndpoint() {
-			options.Add("--cluster.my-advertised-endpoint", input.Deployment.ExternalAccess.GetAdvertisedEndpoint())
+		if endpoint := getAdvertisedEndpoint(input.Deployment, input.Status); endpoint != "" {
+			options.Add("--cluster.my-advertised-endpoint", endpoint)
 		}
 	case api.ServerGroupSingle:
 		options.Add("--foxx.queues", input.Deployment.Features.GetFoxxQueues())
@@ -159,8 +163,8 @@ func createArangodArgs(cachedStatus interfaces.Inspector, input pod.Input, addit
 			options.Add("--replication.automatic-failover", "true")
 			options.Add("--cluster.my-address", myTCPURL)
 			options.Add("--cluster.my-role", "SINGLE")
-			if input.Deployment.ExternalAccess.HasAdvertisedEndpoint() {
-				options.Add("--cluster.my-advertised-endpoint", input.Deployment.ExternalAccess.GetAdvertisedEndpoint())
+			if endpoint := getAdvertisedEndpoint(input.Deployment, input.Status); endpoint != "" {
+				options.Add("--cluster.my-advertised-endpoint", endpoint)
 			}
 		}
 	}
@@ -170,7 +174,7 @@ func createArangodArgs(cachedStatus interfaces.Inspector, input pod.Input, addit
 			if err != nil {
 				return nil, err
 			}
-			options.Addf("--cluster.agency-endpoint", "%s://%s", scheme, net.JoinHostPort(util.StringOrDefault(p.Endpoint, dnsName), strconv.Itoa(k8sutil.ArangoPort)))
+			options.Addf("--cluster.agency-endpoint", "%s://%s", scheme, net.JoinHostPort(util.StringOrDefault(p.Endpoint, dnsName), strconv.Itoa(input.Deployment.GetServerGroupPort(api.ServerGroupAgents))))
 		}
 	}
 
@@ -223,7 +227,7 @@ func createArangoSyncArgs(apiObject meta.Object, spec api.DeploymentSpec, group
 		if spec.IsSecure() {
 			scheme = "https"
 		}
-		options.Addf("--cluster.endpoint", "%s://%s:%d", scheme, dbServiceName, k8sutil.ArangoPort)
+		options.Addf("--cluster.endpoint", "%s://%s:%d", scheme, dbServiceName, spec.GetDatabasePort())
 	case api.ServerGroupSyncWorkers:
 		runCmd = "worker"
 		port = k8sutil.ArangoSyncWorkerPort
@@ -399,6 +403,10 @@ func (r *Resources) RenderPodForMember(ctx context.Context, cachedStatus inspect
 		return nil, err
 	}
 
+	if err := applyArangoMemberOverrides(member, pod); err != nil {
+		return nil, errors.WithStack(errors.Wrapf(err, "Unable to apply ArangoMember overrides"))
+	}
+
 	if features.RandomPodNames().Enabled() {
 		// The server will generate the name with some additional suffix after `-`.
 		pod.GenerateName = pod.Name + "-"
@@ -517,7 +525,7 @@ func (r *Resources) createPodForMember(ctx context.Context, cachedStatus inspect
 			// Create TLS secret
 			tlsKeyfileSecretName := k8sutil.CreateTLSKeyfileSecretName(apiObject.GetName(), role, m.ID)
 
-			names, err := tls.GetAltNames(spec.Sync.TLS)
+			names, err := tls.GetMemberAltNames(apiObject, spec.Sync.TLS, group, m.ID)
 			if err != nil {
 				return errors.WithStack(errors.Wrapf(err, "Failed to render alt names"))
 			}
@@ -533,6 +541,7 @@ func (r *Resources) createPodForMember(ctx context.Context, cachedStatus inspect
 					names.AltNames = append(names.AltNames, u.Hostname())
 				}
 			}
+			names.AltNames = append(names.AltNames, spec.Sync.ExternalAccess.AltNames...)
 			owner := apiObject.AsOwner()
 			_, err = createTLSServerCertificate(ctx, log, cachedStatus, r.context.SecretsModInterface(), names, spec.Sync.TLS, tlsKeyfileSecretName, &owner)
 			if err != nil && !k8sutil.IsAlreadyExists(err) {
@@ -721,7 +730,11 @@ func (r *Resources) EnsurePods(ctx context.Context, cachedStatus inspectorInterf
 	}
 
 	if changed {
-		if err := cachedStatus.Refresh(ctx); err != nil {
+		// Pods and TLS keyfile secrets of sync members are created here
+		if err := cachedStatus.RefreshPods(ctx); err != nil {
+			return err
+		}
+		if err := cachedStatus.RefreshSecrets(ctx); err != nil {
 			return err
 		}
 	}
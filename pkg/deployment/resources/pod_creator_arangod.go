@@ -96,20 +96,22 @@ type ArangoVersionCheckContainer struct {
 }
 
 func (a *ArangoDContainer) GetPorts() []core.ContainerPort {
+	port := a.spec.GetServerGroupPort(a.group)
+
 	ports := []core.ContainerPort{
 		{
 			Name:          k8sutil.ServerContainerName,
-			ContainerPort: int32(k8sutil.ArangoPort),
+			ContainerPort: int32(port),
 			Protocol:      core.ProtocolTCP,
 		},
 	}
 
 	if a.spec.Metrics.IsEnabled() {
 		switch a.spec.Metrics.Mode.Get() {
-		case api.MetricsModeInternal:
+		case api.MetricsModeInternal, api.MetricsModeDirect:
 			ports = append(ports, core.ContainerPort{
 				Name:          "exporter",
-				ContainerPort: int32(k8sutil.ArangoPort),
+				ContainerPort: int32(port),
 				Protocol:      core.ProtocolTCP,
 			})
 		}
@@ -242,7 +244,7 @@ func (a *ArangoDContainer) GetResourceRequirements() core.ResourceRequirements {
 
 func (a *ArangoDContainer) GetLifecycle() (*core.Lifecycle, error) {
 	if features.GracefulShutdown().Enabled() {
-		return k8sutil.NewLifecyclePort()
+		return k8sutil.NewLifecyclePort(a.spec.GetServerGroupPort(a.group))
 	}
 	return k8sutil.NewLifecycleFinalizers()
 }
@@ -362,9 +364,12 @@ func (m *MemberArangoDPod) GetServiceAccountName() string {
 
 func (m *MemberArangoDPod) GetSidecars(pod *core.Pod) error {
 	if m.spec.Metrics.IsEnabled() {
+		pod.Labels[k8sutil.LabelKeyArangoExporter] = "yes"
+	}
+
+	if m.spec.Metrics.IsEnabled() && m.spec.Metrics.Mode.Get().HasExporterSidecar() {
 		var c *core.Container
 
-		pod.Labels[k8sutil.LabelKeyArangoExporter] = "yes"
 		if container, err := m.createMetricsExporterSidecarInternalExporter(); err != nil {
 			return err
 		} else {
@@ -375,6 +380,10 @@ func (m *MemberArangoDPod) GetSidecars(pod *core.Pod) error {
 		}
 	}
 
+	if c := m.createAuditLogSidecar(); c != nil {
+		pod.Spec.Containers = append(pod.Spec.Containers, *c)
+	}
+
 	// A sidecar provided by the user
 	sidecars := m.groupSpec.GetSidecars()
 	if len(sidecars) > 0 {
@@ -507,7 +516,7 @@ func (m *MemberArangoDPod) GetContainerCreator() interfaces.ContainerCreator {
 func (m *MemberArangoDPod) createMetricsExporterSidecarInternalExporter() (*core.Container, error) {
 	image := m.GetContainerCreator().GetImage()
 
-	args := createInternalExporterArgs(m.spec, m.groupSpec, m.imageInfo.ArangoDBVersion)
+	args := createInternalExporterArgs(m.spec, m.group, m.groupSpec, m.imageInfo.ArangoDBVersion)
 
 	c, err := ArangodbInternalExporterContainer(image, args,
 		createExporterLivenessProbe(m.spec.IsSecure() && m.spec.Metrics.IsTLS()), m.spec.Metrics.Resources,
@@ -528,6 +537,11 @@ func (m *MemberArangoDPod) createMetricsExporterSidecarInternalExporter() (*core
 	return &c, nil
 }
 
+// createAuditLogSidecar returns the sidecar which collects the audit log file.
+func (m *MemberArangoDPod) createAuditLogSidecar() *core.Container {
+	return pod.AuditLogSidecar(m.AsInput())
+}
+
 func (m *MemberArangoDPod) ApplyPodSpec(p *core.PodSpec) error {
 	p.SecurityContext = m.groupSpec.SecurityContext.NewPodSecurityContext()
 
@@ -539,7 +553,7 @@ func (m *MemberArangoDPod) ApplyPodSpec(p *core.PodSpec) error {
 }
 
 func (m *MemberArangoDPod) Annotations() map[string]string {
-	return collection.MergeAnnotations(m.spec.Annotations, m.groupSpec.Annotations)
+	return collection.MergeAnnotations(m.spec.Annotations, m.groupSpec.Annotations, pod.VaultAnnotations(m.spec, m.group))
 }
 
 func (m *MemberArangoDPod) Labels() map[string]string {
@@ -584,7 +598,7 @@ func CreateArangoDVolumes(status api.MemberStatus, input pod.Input, spec api.Dep
 	// Security
 	volumes.Append(pod.Security(), input)
 
-	if spec.Metrics.IsEnabled() {
+	if spec.Metrics.IsEnabled() && spec.Metrics.Mode.Get().HasExporterSidecar() {
 		token := spec.Metrics.GetJWTTokenSecretName()
 		if spec.Authentication.IsAuthenticated() && token != "" {
 			vol := k8sutil.CreateVolumeWithSecret(k8sutil.ExporterJWTVolumeName, token)
@@ -599,6 +613,12 @@ func CreateArangoDVolumes(status api.MemberStatus, input pod.Input, spec api.Dep
 	// SNI
 	volumes.Append(pod.SNI(), input)
 
+	// Audit log
+	volumes.Append(pod.AuditLog(), input)
+
+	// Log and apps directories
+	volumes.Append(pod.Directories(), input)
+
 	if len(groupSpec.Volumes) > 0 {
 		volumes.AddVolume(groupSpec.Volumes.Volumes()...)
 	}
@@ -0,0 +1,123 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func auditLogTestInput(auditLog *api.AuditLogSpec, enterprise bool) pod.Input {
+	apiObject := &api.ArangoDeployment{
+		Spec: api.DeploymentSpec{
+			Mode:     api.NewMode(api.DeploymentModeSingle),
+			AuditLog: auditLog,
+		},
+	}
+	apiObject.Spec.SetDefaults("test")
+
+	return pod.Input{
+		ApiObject:  apiObject,
+		Deployment: apiObject.Spec,
+		Group:      api.ServerGroupSingle,
+		GroupSpec:  apiObject.Spec.Single,
+		Enterprise: enterprise,
+		Member:     api.MemberStatus{ID: "a1"},
+	}
+}
+
+func TestCreateArangodArgsAuditLog(t *testing.T) {
+	spec := &api.AuditLogSpec{
+		Enabled:  util.NewBool(true),
+		Hostname: util.NewString("arangodb"),
+		Topics: map[string]string{
+			"audit-document":       "info",
+			"audit-authentication": "error",
+		},
+		Sidecar: &core.Container{
+			Image: "fluent/fluent-bit",
+		},
+	}
+
+	t.Run("File output", func(t *testing.T) {
+		input := auditLogTestInput(spec, true)
+
+		i := newInspectorMock().RegisterMemberStatus(t, input.ApiObject.(*api.ArangoDeployment), input.Group, input.Member)
+
+		cmdline, err := createArangodArgs(i.Get(t), input)
+		require.NoError(t, err)
+		assert.Contains(t, cmdline, "--audit.output=file:///var/log/arangodb/audit/audit.log")
+		assert.Contains(t, cmdline, "--audit.hostname=arangodb")
+		assert.Contains(t, cmdline, "--log.level=audit-authentication=error")
+		assert.Contains(t, cmdline, "--log.level=audit-document=info")
+
+		volumes := CreateArangoDVolumes(input.Member, input, input.Deployment, input.GroupSpec)
+		_, ok := k8sutil.GetAnyVolumeByName(volumes.Volumes(), k8sutil.AuditLogVolumeName)
+		assert.True(t, ok)
+
+		sidecar := pod.AuditLogSidecar(input)
+		require.NotNil(t, sidecar)
+		assert.Equal(t, pod.AuditLogContainerName, sidecar.Name)
+		require.Len(t, sidecar.VolumeMounts, 1)
+		assert.Equal(t, k8sutil.AuditLogVolumeName, sidecar.VolumeMounts[0].Name)
+		assert.True(t, sidecar.VolumeMounts[0].ReadOnly)
+		assert.Empty(t, spec.Sidecar.VolumeMounts)
+	})
+
+	t.Run("Stdout output", func(t *testing.T) {
+		stdout := spec.DeepCopy()
+		stdout.Output = api.AuditLogOutputStdout.New()
+		stdout.Sidecar = nil
+		input := auditLogTestInput(stdout, true)
+
+		i := newInspectorMock().RegisterMemberStatus(t, input.ApiObject.(*api.ArangoDeployment), input.Group, input.Member)
+
+		cmdline, err := createArangodArgs(i.Get(t), input)
+		require.NoError(t, err)
+		assert.Contains(t, cmdline, "--audit.output=-")
+
+		volumes := CreateArangoDVolumes(input.Member, input, input.Deployment, input.GroupSpec)
+		_, ok := k8sutil.GetAnyVolumeByName(volumes.Volumes(), k8sutil.AuditLogVolumeName)
+		assert.False(t, ok)
+		assert.Nil(t, pod.AuditLogSidecar(input))
+	})
+
+	t.Run("Community", func(t *testing.T) {
+		input := auditLogTestInput(spec, false)
+
+		i := newInspectorMock().RegisterMemberStatus(t, input.ApiObject.(*api.ArangoDeployment), input.Group, input.Member)
+
+		cmdline, err := createArangodArgs(i.Get(t), input)
+		require.NoError(t, err)
+		for _, arg := range cmdline {
+			assert.NotContains(t, arg, "--audit.")
+		}
+		assert.Nil(t, pod.AuditLogSidecar(input))
+	})
+}
@@ -97,6 +97,69 @@ func TestCreateArangodArgsCoordinator(t *testing.T) {
 		)
 	}
 
+	// Default deployment with TLS version and ciphers
+	{
+		apiObject := &api.ArangoDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "ns",
+			},
+			Spec: api.DeploymentSpec{
+				Mode: api.NewMode(api.DeploymentModeCluster),
+				TLS: api.TLSSpec{
+					MinVersion: api.TLSVersion13.New(),
+					Ciphers:    []string{"ECDHE-ECDSA-AES256-GCM-SHA384", "ECDHE-RSA-AES256-GCM-SHA384"},
+				},
+			},
+		}
+		apiObject.Spec.SetDefaults("test")
+		agents := api.MemberStatusList{
+			api.MemberStatus{ID: "a1"},
+			api.MemberStatus{ID: "a2"},
+			api.MemberStatus{ID: "a3"},
+		}
+		input := pod.Input{
+			ApiObject:   apiObject,
+			Deployment:  apiObject.Spec,
+			Status:      api.DeploymentStatus{Members: api.DeploymentStatusMembers{Agents: agents}},
+			Group:       api.ServerGroupCoordinators,
+			GroupSpec:   apiObject.Spec.Coordinators,
+			Version:     "",
+			Enterprise:  false,
+			AutoUpgrade: false,
+			Member:      api.MemberStatus{ID: "id1"},
+		}
+
+		i := newInspectorMock()
+		i = i.RegisterMemberStatus(t, apiObject, api.ServerGroupAgents, agents...).RegisterMemberStatus(t, apiObject, input.Group, input.Member)
+
+		cmdline, err := createArangodArgs(i.Get(t), input)
+		require.NoError(t, err)
+		assert.Equal(t,
+			[]string{
+				"--cluster.agency-endpoint=ssl://name-agent-a1.name-int.ns.svc:8529",
+				"--cluster.agency-endpoint=ssl://name-agent-a2.name-int.ns.svc:8529",
+				"--cluster.agency-endpoint=ssl://name-agent-a3.name-int.ns.svc:8529",
+				"--cluster.my-address=ssl://name-coordinator-id1.name-int.ns.svc:8529",
+				"--cluster.my-role=COORDINATOR",
+				"--database.directory=/data",
+				"--foxx.queues=true",
+				"--log.level=INFO",
+				"--log.output=+",
+				"--server.authentication=true",
+				"--server.endpoint=ssl://[::]:8529",
+				"--server.jwt-secret-keyfile=" + jwtSecretFile,
+				"--server.statistics=true",
+				"--server.storage-engine=rocksdb",
+				"--ssl.cipher-list=ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384",
+				"--ssl.ecdh-curve=",
+				"--ssl.keyfile=/secrets/tls/tls.keyfile",
+				"--ssl.protocol=6",
+			},
+			cmdline,
+		)
+	}
+
 	// Default+AutoUpgrade deployment
 	{
 		apiObject := &api.ArangoDeployment{
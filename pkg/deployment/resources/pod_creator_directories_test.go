@@ -0,0 +1,87 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func TestCreateArangodArgsDirectories(t *testing.T) {
+	sizeLimit := resource.MustParse("1Gi")
+
+	apiObject := &api.ArangoDeployment{
+		Spec: api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeSingle),
+			Single: api.ServerGroupSpec{
+				LogVolume: &api.ServerGroupDirectoryVolume{
+					EmptyDir: &core.EmptyDirVolumeSource{SizeLimit: &sizeLimit},
+				},
+				AppsVolume: &api.ServerGroupDirectoryVolume{
+					VolumeClaimTemplate: &core.PersistentVolumeClaimTemplate{},
+				},
+			},
+		},
+	}
+	apiObject.Spec.SetDefaults("test")
+
+	input := pod.Input{
+		ApiObject:  apiObject,
+		Deployment: apiObject.Spec,
+		Group:      api.ServerGroupSingle,
+		GroupSpec:  apiObject.Spec.Single,
+		Member:     api.MemberStatus{ID: "a1"},
+	}
+
+	i := newInspectorMock().RegisterMemberStatus(t, apiObject, input.Group, input.Member)
+
+	cmdline, err := createArangodArgs(i.Get(t), input)
+	require.NoError(t, err)
+	assert.Contains(t, cmdline, "--log.output=+")
+	assert.Contains(t, cmdline, "--log.output=file:///var/log/arangodb/server/arangod.log")
+	assert.Contains(t, cmdline, "--javascript.app-path=/apps")
+
+	volumes := CreateArangoDVolumes(input.Member, input, input.Deployment, input.GroupSpec)
+
+	logs, ok := k8sutil.GetAnyVolumeByName(volumes.Volumes(), k8sutil.ArangodLogsVolumeName)
+	require.True(t, ok)
+	require.NotNil(t, logs.EmptyDir)
+	assert.Equal(t, &sizeLimit, logs.EmptyDir.SizeLimit)
+
+	apps, ok := k8sutil.GetAnyVolumeByName(volumes.Volumes(), k8sutil.ArangodAppsVolumeName)
+	require.True(t, ok)
+	assert.NotNil(t, apps.Ephemeral)
+
+	mounts := map[string]string{}
+	for _, m := range volumes.VolumeMounts() {
+		mounts[m.Name] = m.MountPath
+	}
+	assert.Equal(t, k8sutil.ArangodLogsVolumeMountDir, mounts[k8sutil.ArangodLogsVolumeName])
+	assert.Equal(t, k8sutil.ArangodAppsVolumeMountDir, mounts[k8sutil.ArangodAppsVolumeName])
+}
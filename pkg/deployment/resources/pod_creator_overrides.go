@@ -0,0 +1,94 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"encoding/json"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// applyArangoMemberOverrides applies overrides defined in the ArangoMember on top of the rendered pod.
+func applyArangoMemberOverrides(member *api.ArangoMember, pod *core.Pod) error {
+	overrides := member.Spec.Overrides
+
+	if patch := overrides.GetPodTemplatePatch(); patch != nil {
+		if err := applyPodTemplatePatch(pod, patch); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "Unable to apply pod template patch"))
+		}
+	}
+
+	if overrides.GetEntrypointDebug() {
+		applyEntrypointDebug(pod)
+	}
+
+	return nil
+}
+
+// applyPodTemplatePatch applies the strategic merge patch on the metadata and spec of the pod.
+func applyPodTemplatePatch(pod *core.Pod, patch api.ArangoMemberPodTemplatePatch) error {
+	original, err := json.Marshal(core.PodTemplateSpec{
+		ObjectMeta: pod.ObjectMeta,
+		Spec:       pod.Spec,
+	})
+	if err != nil {
+		return err
+	}
+
+	patched, err := strategicpatch.StrategicMergePatch(original, patch, core.PodTemplateSpec{})
+	if err != nil {
+		return err
+	}
+
+	var template core.PodTemplateSpec
+	if err := json.Unmarshal(patched, &template); err != nil {
+		return err
+	}
+
+	// Name of the pod is managed by the Operator
+	template.ObjectMeta.Name = pod.GetName()
+	template.ObjectMeta.GenerateName = pod.GetGenerateName()
+
+	pod.ObjectMeta = template.ObjectMeta
+	pod.Spec = template.Spec
+
+	return nil
+}
+
+// applyEntrypointDebug replaces the server entrypoint with sleep and removes probes, so the pod keeps running
+// with all volumes mounted.
+func applyEntrypointDebug(pod *core.Pod) {
+	for id, c := range pod.Spec.Containers {
+		if c.Name != k8sutil.ServerContainerName {
+			continue
+		}
+
+		pod.Spec.Containers[id].Command = append([]string{}, k8sutil.DebugEntrypointCommand...)
+		pod.Spec.Containers[id].Args = nil
+		pod.Spec.Containers[id].LivenessProbe = nil
+		pod.Spec.Containers[id].ReadinessProbe = nil
+		pod.Spec.Containers[id].StartupProbe = nil
+	}
+}
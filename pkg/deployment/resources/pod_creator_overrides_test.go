@@ -0,0 +1,120 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+)
+
+func newOverridesTestPod() *core.Pod {
+	return &core.Pod{
+		Spec: core.PodSpec{
+			Containers: []core.Container{
+				{
+					Name:           k8sutil.ServerContainerName,
+					Command:        []string{ArangoDExecutor, "--server.authentication=true"},
+					LivenessProbe:  &core.Probe{},
+					ReadinessProbe: &core.Probe{},
+				},
+				{
+					Name:    "sidecar",
+					Command: []string{"sidecar"},
+				},
+			},
+		},
+	}
+}
+
+func Test_ApplyArangoMemberOverrides_EntrypointDebug(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		pod := newOverridesTestPod()
+
+		require.NoError(t, applyArangoMemberOverrides(&api.ArangoMember{}, pod))
+
+		require.Equal(t, newOverridesTestPod(), pod)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		pod := newOverridesTestPod()
+
+		member := api.ArangoMember{
+			Spec: api.ArangoMemberSpec{
+				Overrides: &api.ArangoMemberSpecOverrides{
+					EntrypointDebug: util.NewBool(true),
+				},
+			},
+		}
+
+		require.NoError(t, applyArangoMemberOverrides(&member, pod))
+
+		server := pod.Spec.Containers[0]
+		require.Equal(t, k8sutil.DebugEntrypointCommand, server.Command)
+		require.Nil(t, server.LivenessProbe)
+		require.Nil(t, server.ReadinessProbe)
+
+		require.Equal(t, []string{"sidecar"}, pod.Spec.Containers[1].Command)
+	})
+}
+
+func Test_ApplyArangoMemberOverrides_PodTemplatePatch(t *testing.T) {
+	t.Run("Tolerations and image", func(t *testing.T) {
+		pod := newOverridesTestPod()
+		pod.Name = "member"
+		pod.Spec.Tolerations = []core.Toleration{{Key: "existing"}}
+
+		member := api.ArangoMember{
+			Spec: api.ArangoMemberSpec{
+				Overrides: &api.ArangoMemberSpecOverrides{
+					PodTemplatePatch: api.ArangoMemberPodTemplatePatch(`{"metadata":{"name":"other","labels":{"debug":"true"}},"spec":{"tolerations":[{"key":"extra"}],"containers":[{"name":"server","image":"custom"}]}}`),
+				},
+			},
+		}
+
+		require.NoError(t, applyArangoMemberOverrides(&member, pod))
+
+		require.Equal(t, "member", pod.Name)
+		require.Equal(t, "true", pod.Labels["debug"])
+		// Tolerations do not define merge key, so list is replaced
+		require.Equal(t, []core.Toleration{{Key: "extra"}}, pod.Spec.Tolerations)
+		require.Len(t, pod.Spec.Containers, 2)
+		require.Equal(t, "custom", pod.Spec.Containers[0].Image)
+		require.NotNil(t, pod.Spec.Containers[0].LivenessProbe)
+		require.Equal(t, []string{"sidecar"}, pod.Spec.Containers[1].Command)
+	})
+
+	t.Run("Invalid patch", func(t *testing.T) {
+		member := api.ArangoMember{
+			Spec: api.ArangoMemberSpec{
+				Overrides: &api.ArangoMemberSpecOverrides{
+					PodTemplatePatch: api.ArangoMemberPodTemplatePatch(`{"spec":{"containers":"invalid"}}`),
+				},
+			},
+		}
+
+		require.Error(t, applyArangoMemberOverrides(&member, newOverridesTestPod()))
+	})
+}
@@ -222,7 +222,7 @@ func (r *Resources) probeBuilders() map[api.ServerGroup]probeCheckBuilder {
 	}
 }
 
-func (r *Resources) probeCommand(spec api.DeploymentSpec, endpoint string) ([]string, error) {
+func (r *Resources) probeCommand(spec api.DeploymentSpec, group api.ServerGroup, endpoint string) ([]string, error) {
 	binaryPath, err := os.Executable()
 	if err != nil {
 		return nil, err
@@ -243,6 +243,10 @@ func (r *Resources) probeCommand(spec api.DeploymentSpec, endpoint string) ([]st
 		args = append(args, "--auth")
 	}
 
+	if port := spec.GetServerGroupPort(group); port != k8sutil.ArangoPort {
+		args = append(args, fmt.Sprintf("--port=%d", port))
+	}
+
 	return args, nil
 }
 
@@ -263,7 +267,7 @@ func (r *Resources) probeBuilderStartupCoreSelect() probeBuilder {
 }
 
 func (r *Resources) probeBuilderLivenessCoreOperator(spec api.DeploymentSpec, group api.ServerGroup, version driver.Version) (Probe, error) {
-	args, err := r.probeCommand(spec, "/_api/version")
+	args, err := r.probeCommand(spec, group, "/_api/version")
 	if err != nil {
 		return nil, err
 	}
@@ -274,7 +278,7 @@ func (r *Resources) probeBuilderLivenessCoreOperator(spec api.DeploymentSpec, gr
 }
 
 func (r *Resources) probeBuilderStartupCoreOperator(spec api.DeploymentSpec, group api.ServerGroup, version driver.Version) (Probe, error) {
-	args, err := r.probeCommand(spec, "/_api/version")
+	args, err := r.probeCommand(spec, group, "/_api/version")
 	if err != nil {
 		return nil, err
 	}
@@ -312,6 +316,7 @@ func (r *Resources) probeBuilderLivenessCore(spec api.DeploymentSpec, group api.
 		LocalPath:     "/_api/version",
 		Secure:        spec.IsSecure(),
 		Authorization: authorization,
+		Port:          spec.GetServerGroupPort(group),
 	}, nil
 }
 
@@ -341,6 +346,7 @@ func (r *Resources) probeBuilderStartupCore(spec api.DeploymentSpec, group api.S
 		LocalPath:           "/_api/version",
 		Secure:              spec.IsSecure(),
 		Authorization:       authorization,
+		Port:                spec.GetServerGroupPort(group),
 		FailureThreshold:    retries,
 		PeriodSeconds:       5,
 		InitialDelaySeconds: 1,
@@ -401,7 +407,7 @@ func (r *Resources) probeBuilderReadinessCoreSelect() probeBuilder {
 
 func (r *Resources) probeBuilderReadinessCoreOperator(spec api.DeploymentSpec, group api.ServerGroup, version driver.Version) (Probe, error) {
 	// /_admin/server/availability is the way to go, it is available since 3.3.9
-	args, err := r.probeCommand(spec, "/_admin/server/availability")
+	args, err := r.probeCommand(spec, group, "/_admin/server/availability")
 	if err != nil {
 		return nil, err
 	}
@@ -432,6 +438,7 @@ func (r *Resources) probeBuilderReadinessCore(spec api.DeploymentSpec, group api
 		LocalPath:           localPath,
 		Secure:              spec.IsSecure(),
 		Authorization:       authorization,
+		Port:                spec.GetServerGroupPort(group),
 		InitialDelaySeconds: 2,
 		PeriodSeconds:       2,
 	}
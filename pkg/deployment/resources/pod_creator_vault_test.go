@@ -0,0 +1,128 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func vaultTestInput(vault *api.VaultSpec) pod.Input {
+	apiObject := &api.ArangoDeployment{
+		Spec: api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeSingle),
+			RocksDB: api.RocksDBSpec{
+				Encryption: api.RocksDBEncryptionSpec{
+					KeySecretName: util.NewString("encryption"),
+				},
+			},
+			Vault: vault,
+		},
+	}
+	apiObject.Spec.SetDefaults("test")
+
+	return pod.Input{
+		ApiObject:  apiObject,
+		Deployment: apiObject.Spec,
+		Group:      api.ServerGroupSingle,
+		GroupSpec:  apiObject.Spec.Single,
+		Member:     api.MemberStatus{ID: "a1"},
+	}
+}
+
+func TestCreateArangodArgsVaultAgent(t *testing.T) {
+	vault := &api.VaultSpec{
+		Role: util.NewString("arangodb"),
+		JWT: &api.VaultSecretSpec{
+			Path: util.NewString("secret/data/arangodb/jwt"),
+			Key:  util.NewString("token"),
+		},
+		TLS: &api.VaultSecretSpec{
+			Path: util.NewString("secret/data/arangodb/ca"),
+			Key:  util.NewString("bundle"),
+		},
+		Encryption: &api.VaultSecretSpec{
+			Path: util.NewString("secret/data/arangodb/encryption"),
+			Key:  util.NewString("key"),
+		},
+	}
+	input := vaultTestInput(vault)
+
+	i := newInspectorMock().RegisterMemberStatus(t, input.ApiObject.(*api.ArangoDeployment), input.Group, input.Member)
+
+	cmdline, err := createArangodArgs(i.Get(t), input)
+	require.NoError(t, err)
+	assert.Contains(t, cmdline, "--server.jwt-secret-keyfile=/vault/secrets/arangodb-jwt")
+	// Keyfile is signed by the operator with the CA from Vault
+	assert.Contains(t, cmdline, "--ssl.keyfile=/secrets/tls/tls.keyfile")
+	assert.Contains(t, cmdline, "--rocksdb.encryption-keyfile=/vault/secrets/arangodb-encryption-key")
+
+	volumes := CreateArangoDVolumes(input.Member, input, input.Deployment, input.GroupSpec)
+	for _, v := range volumes.Volumes() {
+		assert.NotEqual(t, k8sutil.ClusterJWTSecretVolumeName, v.Name)
+		assert.NotEqual(t, k8sutil.RocksdbEncryptionVolumeName, v.Name)
+	}
+
+	annotations := pod.VaultAnnotations(input.Deployment, input.Group)
+	assert.Equal(t, "true", annotations[pod.VaultAnnotationAgentInject])
+	assert.Equal(t, "true", annotations[pod.VaultAnnotationAgentInitFirst])
+	assert.Equal(t, "arangodb", annotations[pod.VaultAnnotationRole])
+	assert.Equal(t, "secret/data/arangodb/jwt", annotations[pod.VaultAnnotationAgentInjectSecret+pod.VaultJWTSecretName])
+	assert.Equal(t, `{{- with secret "secret/data/arangodb/jwt" -}}{{ index .Data.data "token" }}{{- end -}}`,
+		annotations[pod.VaultAnnotationAgentInjectTemplate+pod.VaultJWTSecretName])
+	assert.NotContains(t, annotations, pod.VaultAnnotationAgentInjectSecret+"arangodb-tls-keyfile")
+	assert.Equal(t, `{{- with secret "secret/data/arangodb/encryption" -}}{{ index .Data.data "key" | base64Decode }}{{- end -}}`,
+		annotations[pod.VaultAnnotationAgentInjectTemplate+pod.VaultEncryptionKeyName])
+
+	assert.Nil(t, pod.VaultAnnotations(input.Deployment, api.ServerGroupSyncMasters))
+}
+
+func TestCreateArangodArgsVaultCSI(t *testing.T) {
+	input := vaultTestInput(&api.VaultSpec{
+		Mode: api.VaultModeCSI.New(),
+		JWT: &api.VaultSecretSpec{
+			SecretProviderClass: util.NewString("arangodb-jwt"),
+		},
+	})
+
+	i := newInspectorMock().RegisterMemberStatus(t, input.ApiObject.(*api.ArangoDeployment), input.Group, input.Member)
+
+	cmdline, err := createArangodArgs(i.Get(t), input)
+	require.NoError(t, err)
+	assert.Contains(t, cmdline, "--server.jwt-secret-keyfile=/secrets/cluster/jwt/token")
+	assert.Contains(t, cmdline, "--ssl.keyfile=/secrets/tls/tls.keyfile")
+
+	volumes := CreateArangoDVolumes(input.Member, input, input.Deployment, input.GroupSpec)
+	vol, ok := k8sutil.GetAnyVolumeByName(volumes.Volumes(), k8sutil.ClusterJWTSecretVolumeName)
+	require.True(t, ok)
+	require.NotNil(t, vol.CSI)
+	assert.Equal(t, k8sutil.SecretsStoreCSIDriver, vol.CSI.Driver)
+	assert.Equal(t, "arangodb-jwt", vol.CSI.VolumeAttributes["secretProviderClass"])
+
+	assert.Nil(t, pod.VaultAnnotations(input.Deployment, input.Group))
+}
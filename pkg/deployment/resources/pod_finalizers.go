@@ -173,12 +173,9 @@ func (r *Resources) inspectFinalizerPodDrainDBServer(ctx context.Context, log ze
 		return errors.WithStack(err)
 	}
 
-	// If this DBServer is cleaned out, we need to remove the PVC.
+	// If this DBServer is cleaned out, we need to remove (or retain) the PVC.
 	if memberStatus.Conditions.IsTrue(api.ConditionTypeCleanedOut) || memberStatus.Phase == api.MemberPhaseDrain {
-		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
-			return r.context.PersistentVolumeClaimsModInterface().Delete(ctxChild, memberStatus.PersistentVolumeClaimName, metav1.DeleteOptions{})
-		})
-		if err != nil && !k8sutil.IsNotFound(err) {
+		if err := r.removeMemberPVC(ctx, log, api.ServerGroupDBServers, memberStatus.ID, memberStatus.PersistentVolumeClaimName); err != nil {
 			log.Warn().Err(err).Msg("Failed to delete PVC for member")
 			return errors.WithStack(err)
 		}
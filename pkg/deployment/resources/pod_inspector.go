@@ -223,11 +223,40 @@ func (r *Resources) InspectPods(ctx context.Context, cachedStatus inspectorInter
 					updateMemberStatusNeeded = true
 					nextInterval = nextInterval.ReduceTo(recheckSoonPodInspectorInterval)
 				}
+
+				if server := state.ServerStatus(memberStatus.Server); !server.Equal(memberStatus.Server) {
+					memberStatus.Server = server
+					updateMemberStatusNeeded = true
+				}
+
+				if state.IsStuck() {
+					if memberStatus.Conditions.Update(api.ConditionTypeMemberStuck, true, "ArangoDB is not able to serve requests", state.Stuck.Error()) {
+						updateMemberStatusNeeded = true
+						nextInterval = nextInterval.ReduceTo(recheckSoonPodInspectorInterval)
+					}
+				} else if !state.StuckUnknown && memberStatus.Conditions.Remove(api.ConditionTypeMemberStuck) {
+					updateMemberStatusNeeded = true
+				}
+
+				if state.DiskTotal > 0 {
+					threshold := spec.GetDiskUsageWarningThreshold()
+					if used := state.DiskUsedPercent(); used >= threshold {
+						if memberStatus.Conditions.Update(api.ConditionTypeDiskUsageHigh, true, "Disk usage is high", fmt.Sprintf("Disk usage %d%% exceeds threshold %d%%", used, threshold)) {
+							updateMemberStatusNeeded = true
+						}
+					} else if memberStatus.Conditions.Remove(api.ConditionTypeDiskUsageHigh) {
+						updateMemberStatusNeeded = true
+					}
+				}
 			} else {
 				if memberStatus.Conditions.Update(api.ConditionTypeReachable, false, "ArangoDB is not reachable", "") {
 					updateMemberStatusNeeded = true
 					nextInterval = nextInterval.ReduceTo(recheckSoonPodInspectorInterval)
 				}
+
+				if memberStatus.Conditions.Remove(api.ConditionTypeMemberStuck) {
+					updateMemberStatusNeeded = true
+				}
 			}
 		}
 
@@ -370,7 +399,13 @@ func (r *Resources) InspectPods(ctx context.Context, cachedStatus inspectorInter
 
 	spec := r.context.GetSpec()
 	allMembersReady := status.Members.AllMembersReady(spec.GetMode(), spec.Sync.IsEnabled())
-	status.Conditions.Update(api.ConditionTypeReady, allMembersReady, "", "")
+	if allMembersReady && spec.InitFrom != nil && !status.Conditions.IsTrue(api.ConditionTypeInitializedFromBackup) {
+		status.Conditions.Update(api.ConditionTypeReady, false, "Waiting for initialization from backup", "")
+	} else if allMembersReady {
+		status.Conditions.Update(api.ConditionTypeReady, true, "All members are ready", "")
+	} else {
+		status.Conditions.Update(api.ConditionTypeReady, false, "Not all members are ready", "")
+	}
 
 	// Update conditions
 	if len(podNamesWithScheduleTimeout) > 0 {
@@ -388,6 +423,16 @@ func (r *Resources) InspectPods(ctx context.Context, cachedStatus inspectorInter
 		}
 	}
 
+	if members := getMembersWithVolumeAlmostFull(status.Members); len(members) > 0 {
+		if status.Conditions.Update(api.ConditionTypeVolumeAlmostFull, true,
+			"Volumes Almost Full",
+			fmt.Sprintf("Data volumes of the following members are almost full: %v", members)) {
+			r.context.CreateEvent(k8sutil.NewVolumeAlmostFullEvent(members, r.context.GetAPIObject()))
+		}
+	} else {
+		status.Conditions.Remove(api.ConditionTypeVolumeAlmostFull)
+	}
+
 	// Save status
 	if err := r.context.UpdateStatus(ctx, status, lastVersion); err != nil {
 		return 0, errors.WithStack(err)
@@ -430,3 +475,15 @@ func anyOf(bools ...bool) bool {
 
 	return false
 }
+
+// getMembersWithVolumeAlmostFull returns IDs of members, which data directory usage crossed the disk usage warning threshold.
+func getMembersWithVolumeAlmostFull(members api.DeploymentStatusMembers) []string {
+	var ids []string
+	for _, e := range members.AsList() {
+		if e.Member.Conditions.IsTrue(api.ConditionTypeDiskUsageHigh) {
+			ids = append(ids, e.Member.ID)
+		}
+	}
+
+	return ids
+}
@@ -79,6 +79,9 @@ func (r *Resources) prepareAgencyPodTermination(ctx context.Context, log zerolog
 	if k8sutil.IsPersistentVolumeClaimMarkedForDeletion(pvc) {
 		agentDataWillBeGone = true
 	}
+	if r.isPersistentVolumeNodeGone(log, pvc) {
+		agentDataWillBeGone = true
+	}
 
 	// Is this a simple pod restart?
 	if !agentDataWillBeGone {
@@ -164,6 +167,9 @@ func (r *Resources) prepareDBServerPodTermination(ctx context.Context, log zerol
 	if k8sutil.IsPersistentVolumeClaimMarkedForDeletion(pvc) {
 		dbserverDataWillBeGone = true
 	}
+	if r.isPersistentVolumeNodeGone(log, pvc) {
+		dbserverDataWillBeGone = true
+	}
 
 	// Once decided to drain the member, never go back
 	if memberStatus.Phase == api.MemberPhaseDrain {
@@ -332,3 +338,29 @@ func (r *Resources) prepareDBServerPodTermination(ctx context.Context, log zerol
 	return errors.WithStack(errors.Newf("Server is not yet cleaned out"))
 
 }
+
+// isPersistentVolumeNodeGone returns true if the volume bound to the PVC can be used only on nodes
+// which are gone or unschedulable, so data stored on it will be lost.
+func (r *Resources) isPersistentVolumeNodeGone(log zerolog.Logger, pvc *v1.PersistentVolumeClaim) bool {
+	if pvc.Spec.VolumeName == "" {
+		return false
+	}
+
+	pvs, ok := r.context.GetCachedStatus().GetPersistentVolumes()
+	if !ok {
+		return false
+	}
+
+	pv, ok := pvs.PersistentVolume(pvc.Spec.VolumeName)
+	if !ok {
+		return false
+	}
+
+	nodes, ok := r.context.GetCachedStatus().GetNodes()
+	if !ok || !k8sutil.IsPersistentVolumeNodeGone(pv, nodes.Nodes()) {
+		return false
+	}
+
+	log.Warn().Str("pv", pv.GetName()).Msg("Nodes of the persistent volume are gone")
+	return true
+}
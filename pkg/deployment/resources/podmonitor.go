@@ -0,0 +1,179 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+
+	coreosv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+func makePodMetricsEndpoint(spec deploymentApi.DeploymentSpec) coreosv1.PodMetricsEndpoint {
+	endpoint := coreosv1.PodMetricsEndpoint{
+		Port:                 "exporter",
+		Interval:             spec.Metrics.ServiceMonitor.GetInterval(),
+		Scheme:               "http",
+		RelabelConfigs:       relabelConfigs(spec.Metrics.ServiceMonitor.GetRelabelings()),
+		MetricRelabelConfigs: relabelConfigs(spec.Metrics.ServiceMonitor.GetMetricRelabelings()),
+	}
+
+	if spec.IsSecure() {
+		endpoint.Scheme = "https"
+		endpoint.TLSConfig = &coreosv1.PodMetricsEndpointTLSConfig{
+			SafeTLSConfig: coreosv1.SafeTLSConfig{
+				InsecureSkipVerify: true,
+			},
+		}
+	}
+
+	return endpoint
+}
+
+// podMonitorSpec returns the spec of the PodMonitor which scrapes the exporters of the deployment members
+func podMonitorSpec(deploymentName string, spec deploymentApi.DeploymentSpec, image *deploymentApi.ImageInfo) (coreosv1.PodMonitorSpec, error) {
+	endpoint := makePodMetricsEndpoint(spec)
+
+	if err := setArangodMetricsEndpoint(spec, image, &endpoint.Path, &endpoint.BearerTokenSecret); err != nil {
+		return coreosv1.PodMonitorSpec{}, err
+	}
+
+	return coreosv1.PodMonitorSpec{
+		JobLabel: "k8s-app",
+		PodMetricsEndpoints: []coreosv1.PodMetricsEndpoint{
+			endpoint,
+		},
+		Selector: metav1.LabelSelector{
+			MatchLabels: k8sutil.LabelsForExporterServiceSelector(deploymentName),
+		},
+	}, nil
+}
+
+// EnsurePodMonitor creates, updates or removes a PodMonitor.
+func (r *Resources) EnsurePodMonitor(ctx context.Context) error {
+	log := r.log
+	apiObject := r.context.GetAPIObject()
+	deploymentName := apiObject.GetName()
+	ns := apiObject.GetNamespace()
+	spec := r.context.GetSpec()
+	status, _ := r.context.GetStatus()
+
+	if !spec.Metrics.ServiceMonitor.IsEnabled() || !spec.Metrics.IsEnabled() {
+		return nil
+	}
+
+	wantMetrics := spec.Metrics.ServiceMonitor.GetKind() == deploymentApi.MetricsServiceMonitorKindPodMonitor
+	podMonitorName := k8sutil.CreateExporterClientServiceName(deploymentName)
+
+	client, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		log.Error().Msgf("Cannot get a monitoring client.")
+		return errors.Newf("Client not initialised")
+	}
+
+	podMonitors := client.Monitoring().MonitoringV1().PodMonitors(ns)
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	podMon, err := podMonitors.Get(ctxChild, podMonitorName, metav1.GetOptions{})
+	if err != nil {
+		if !k8sutil.IsNotFound(err) {
+			log.Error().Err(err).Msgf("Failed to get PodMonitor %s", podMonitorName)
+			return errors.WithStack(err)
+		}
+
+		if !wantMetrics {
+			return nil
+		}
+
+		pmSpec, err := podMonitorSpec(deploymentName, spec, status.CurrentImage)
+		if err != nil {
+			return err
+		}
+
+		podMon = &coreosv1.PodMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            podMonitorName,
+				Labels:          LabelsForExporterServiceMonitor(deploymentName, spec),
+				OwnerReferences: []metav1.OwnerReference{apiObject.AsOwner()},
+			},
+			Spec: pmSpec,
+		}
+
+		err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			_, err := podMonitors.Create(ctxChild, podMon, metav1.CreateOptions{})
+			return err
+		})
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to create PodMonitor %s", podMonitorName)
+			return errors.WithStack(err)
+		}
+		log.Debug().Msgf("PodMonitor %s successfully created.", podMonitorName)
+		return nil
+	}
+
+	// Check if the pod monitor is ours, otherwise we do not touch it
+	if !k8sutil.IsOwner(apiObject.AsOwner(), podMon) {
+		log.Debug().Msgf("Found PodMonitor %s, but not owned by us, will not touch it", podMonitorName)
+		return nil
+	}
+
+	if !wantMetrics {
+		err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return podMonitors.Delete(ctxChild, podMonitorName, metav1.DeleteOptions{})
+		})
+		if err != nil && !k8sutil.IsNotFound(err) {
+			log.Error().Err(err).Msgf("Could not delete PodMonitor %s.", podMonitorName)
+			return errors.WithStack(err)
+		}
+		log.Debug().Msgf("Deleted PodMonitor %s", podMonitorName)
+		return nil
+	}
+
+	pmSpec, err := podMonitorSpec(deploymentName, spec, status.CurrentImage)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepDerivative(pmSpec, podMon.Spec) {
+		return nil
+	}
+
+	podMon.Spec = pmSpec
+
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := podMonitors.Update(ctxChild, podMon, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	log.Debug().Msgf("PodMonitor %s updated.", podMonitorName)
+
+	return nil
+}
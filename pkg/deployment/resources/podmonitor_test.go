@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func Test_PodMonitorSpec(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		spec, err := podMonitorSpec("example", api.DeploymentSpec{
+			TLS: api.TLSSpec{CASecretName: util.NewString(api.CASecretNameDisabled)},
+		}, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, k8sutil.LabelsForExporterServiceSelector("example"), spec.Selector.MatchLabels)
+		require.Len(t, spec.PodMetricsEndpoints, 1)
+
+		endpoint := spec.PodMetricsEndpoints[0]
+		require.Equal(t, "exporter", endpoint.Port)
+		require.Equal(t, api.DefaultMetricsServiceMonitorInterval, endpoint.Interval)
+		require.Equal(t, "http", endpoint.Scheme)
+		require.Nil(t, endpoint.TLSConfig)
+		require.Nil(t, endpoint.RelabelConfigs)
+	})
+
+	t.Run("Custom interval and relabelings", func(t *testing.T) {
+		spec, err := podMonitorSpec("example", api.DeploymentSpec{
+			Metrics: api.MetricsSpec{
+				ServiceMonitor: &api.MetricsServiceMonitorSpec{
+					Interval: util.NewString("30s"),
+					Relabelings: []api.MetricsRelabelConfig{
+						{SourceLabels: []string{"__meta_kubernetes_pod_node_name"}, TargetLabel: "node"},
+					},
+					MetricRelabelings: []api.MetricsRelabelConfig{
+						{SourceLabels: []string{"__name__"}, Regex: "arangodb_v8_.*", Action: "drop"},
+					},
+				},
+			},
+		}, nil)
+		require.NoError(t, err)
+
+		endpoint := spec.PodMetricsEndpoints[0]
+		require.Equal(t, "30s", endpoint.Interval)
+		require.Equal(t, "https", endpoint.Scheme)
+		require.NotNil(t, endpoint.TLSConfig)
+		require.Len(t, endpoint.RelabelConfigs, 1)
+		require.Equal(t, "node", endpoint.RelabelConfigs[0].TargetLabel)
+		require.Len(t, endpoint.MetricRelabelConfigs, 1)
+		require.Equal(t, "drop", endpoint.MetricRelabelConfigs[0].Action)
+	})
+
+	t.Run("Internal mode without token", func(t *testing.T) {
+		mode := api.MetricsModeInternal
+		_, err := podMonitorSpec("example", api.DeploymentSpec{
+			Metrics: api.MetricsSpec{Mode: &mode},
+		}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("Direct mode", func(t *testing.T) {
+		mode := api.MetricsModeDirect
+		image := &api.ImageInfo{ArangoDBVersion: "3.8.5"}
+
+		spec, err := podMonitorSpec("example", api.DeploymentSpec{
+			Authentication: api.AuthenticationSpec{JWTSecretName: util.NewString(api.JWTSecretNameDisabled)},
+			Metrics:        api.MetricsSpec{Mode: &mode},
+		}, image)
+		require.NoError(t, err)
+
+		endpoint := spec.PodMetricsEndpoints[0]
+		require.Equal(t, k8sutil.ArangoExporterInternalEndpointV2, endpoint.Path)
+		require.Empty(t, endpoint.BearerTokenSecret.Name)
+
+		spec, err = podMonitorSpec("example", api.DeploymentSpec{
+			Metrics: api.MetricsSpec{
+				Mode:           &mode,
+				Authentication: api.MetricsAuthenticationSpec{JWTTokenSecretName: util.NewString("example-exporter-jwt-token")},
+			},
+		}, &api.ImageInfo{ArangoDBVersion: "3.7.15"})
+		require.NoError(t, err)
+
+		endpoint = spec.PodMetricsEndpoints[0]
+		require.Equal(t, k8sutil.ArangoExporterInternalEndpoint, endpoint.Path)
+		require.Equal(t, "example-exporter-jwt-token", endpoint.BearerTokenSecret.Name)
+	})
+}
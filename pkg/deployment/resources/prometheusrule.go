@@ -0,0 +1,184 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	coreosv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+const (
+	prometheusRuleSeverityLabel = "severity"
+	prometheusRuleSeverityWarn  = "warning"
+	prometheusRuleSeverityCrit  = "critical"
+)
+
+// CreatePrometheusRuleName returns the name of the PrometheusRule with alerts for the given deployment
+func CreatePrometheusRuleName(deploymentName string) string {
+	return deploymentName + "-alerts"
+}
+
+func newPrometheusRuleAlert(alert, expr, forDuration, severity, summary string) coreosv1.Rule {
+	return coreosv1.Rule{
+		Alert: alert,
+		Expr:  intstr.FromString(expr),
+		For:   forDuration,
+		Labels: map[string]string{
+			prometheusRuleSeverityLabel: severity,
+		},
+		Annotations: map[string]string{
+			"summary": summary,
+		},
+	}
+}
+
+// prometheusRuleSpec returns the curated alerts for the given deployment.
+// Alerts are based on the operator metrics, which are matched by the deployment label.
+func prometheusRuleSpec(namespace, deploymentName string) coreosv1.PrometheusRuleSpec {
+	deploymentSelector := fmt.Sprintf("deployment=%q", deploymentName)
+
+	return coreosv1.PrometheusRuleSpec{
+		Groups: []coreosv1.RuleGroup{
+			{
+				Name: fmt.Sprintf("arangodb.%s.%s", namespace, deploymentName),
+				Rules: []coreosv1.Rule{
+					newPrometheusRuleAlert("ArangoDBMemberDown",
+						fmt.Sprintf("arango_operator_deployment_member_reachable{%s} == 0", deploymentSelector),
+						"5m", prometheusRuleSeverityCrit,
+						"Member {{ $labels.id }} ({{ $labels.role }}) of the ArangoDeployment {{ $labels.deployment }} is not reachable"),
+					newPrometheusRuleAlert("ArangoDBBackupStale",
+						fmt.Sprintf("time() - arango_operator_deployment_last_backup_timestamp{%s} > 86400", deploymentSelector),
+						"15m", prometheusRuleSeverityWarn,
+						"No backup of the ArangoDeployment {{ $labels.deployment }} was created in the last 24 hours"),
+					newPrometheusRuleAlert("ArangoDBUpgradeStuck",
+						fmt.Sprintf("sum by (deployment) (arangodb_operator_deployment_reconciliation_actions_current{%s,name=%q}) > 0", deploymentSelector, deploymentApi.ActionTypeUpgradeMember),
+						"1h", prometheusRuleSeverityWarn,
+						"Upgrade of the ArangoDeployment {{ $labels.deployment }} is in progress for more than 1 hour"),
+					newPrometheusRuleAlert("ArangoDBLicenseExpiring",
+						fmt.Sprintf("arango_operator_deployment_license_expires_timestamp{%s} - time() < 14 * 86400", deploymentSelector),
+						"1h", prometheusRuleSeverityWarn,
+						"License of the ArangoDeployment {{ $labels.deployment }} expires in less than 14 days"),
+					newPrometheusRuleAlert("ArangoDBDiskNearlyFull",
+						fmt.Sprintf("arango_operator_deployment_member_disk_used_bytes{%[1]s} / arango_operator_deployment_member_disk_total_bytes{%[1]s} > 0.9", deploymentSelector),
+						"15m", prometheusRuleSeverityWarn,
+						"Disk of the member {{ $labels.id }} ({{ $labels.role }}) of the ArangoDeployment {{ $labels.deployment }} is more than 90% full"),
+				},
+			},
+		},
+	}
+}
+
+// EnsurePrometheusRule creates, updates or removes a PrometheusRule with curated alerts.
+func (r *Resources) EnsurePrometheusRule(ctx context.Context, cachedStatus inspectorInterface.Inspector) error {
+	log := r.log
+	apiObject := r.context.GetAPIObject()
+	deploymentName := apiObject.GetName()
+	ns := apiObject.GetNamespace()
+	spec := r.context.GetSpec()
+
+	wantRules := spec.Metrics.IsEnabled() && spec.Metrics.IsPrometheusRulesEnabled()
+	ruleName := CreatePrometheusRuleName(deploymentName)
+
+	rule, exists := cachedStatus.PrometheusRule(ruleName)
+	if !exists && !wantRules {
+		// Nothing to do
+		return nil
+	}
+
+	client, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		log.Error().Msgf("Cannot get a monitoring client.")
+		return errors.Newf("Client not initialised")
+	}
+
+	rules := client.Monitoring().MonitoringV1().PrometheusRules(ns)
+
+	if !exists {
+		rule = &coreosv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            ruleName,
+				Labels:          LabelsForExporterServiceMonitor(deploymentName, spec),
+				OwnerReferences: []metav1.OwnerReference{apiObject.AsOwner()},
+			},
+			Spec: prometheusRuleSpec(ns, deploymentName),
+		}
+
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			_, err := rules.Create(ctxChild, rule, metav1.CreateOptions{})
+			return err
+		})
+		if err != nil && !k8sutil.IsAlreadyExists(err) {
+			log.Error().Err(err).Msgf("Failed to create PrometheusRule %s", ruleName)
+			return errors.WithStack(err)
+		}
+		log.Debug().Msgf("PrometheusRule %s successfully created.", ruleName)
+		return nil
+	}
+
+	// Check if the rule is ours, otherwise we do not touch it
+	if !k8sutil.IsOwner(apiObject.AsOwner(), rule) {
+		log.Debug().Msgf("Found PrometheusRule %s, but not owned by us, will not touch it", ruleName)
+		return nil
+	}
+
+	if !wantRules {
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return rules.Delete(ctxChild, ruleName, metav1.DeleteOptions{})
+		})
+		if err != nil && !k8sutil.IsNotFound(err) {
+			log.Error().Err(err).Msgf("Could not delete PrometheusRule %s.", ruleName)
+			return errors.WithStack(err)
+		}
+		log.Debug().Msgf("Deleted PrometheusRule %s", ruleName)
+		return nil
+	}
+
+	ruleSpec := prometheusRuleSpec(ns, deploymentName)
+	if equality.Semantic.DeepDerivative(ruleSpec, rule.Spec) {
+		return nil
+	}
+
+	updated := rule.DeepCopy()
+	updated.Spec = ruleSpec
+
+	err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := rules.Update(ctxChild, updated, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	log.Debug().Msgf("PrometheusRule %s updated.", ruleName)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PrometheusRuleSpec(t *testing.T) {
+	spec := prometheusRuleSpec("ns", "example")
+
+	require.Len(t, spec.Groups, 1)
+	require.Equal(t, "arangodb.ns.example", spec.Groups[0].Name)
+
+	alerts := map[string]string{}
+	for _, rule := range spec.Groups[0].Rules {
+		require.NotEmpty(t, rule.For)
+		require.Contains(t, rule.Labels, prometheusRuleSeverityLabel)
+		require.Contains(t, rule.Annotations, "summary")
+
+		alerts[rule.Alert] = rule.Expr.String()
+	}
+
+	require.Len(t, alerts, 5)
+
+	require.Equal(t, `arango_operator_deployment_member_reachable{deployment="example"} == 0`, alerts["ArangoDBMemberDown"])
+	require.Equal(t, `time() - arango_operator_deployment_last_backup_timestamp{deployment="example"} > 86400`, alerts["ArangoDBBackupStale"])
+	require.Contains(t, alerts["ArangoDBUpgradeStuck"], `{deployment="example",name="UpgradeMember"}`)
+	require.Equal(t, `arango_operator_deployment_license_expires_timestamp{deployment="example"} - time() < 14 * 86400`, alerts["ArangoDBLicenseExpiring"])
+	require.Equal(t, `arango_operator_deployment_member_disk_used_bytes{deployment="example"} / arango_operator_deployment_member_disk_total_bytes{deployment="example"} > 0.9`, alerts["ArangoDBDiskNearlyFull"])
+}
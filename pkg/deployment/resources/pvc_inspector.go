@@ -25,11 +25,14 @@ import (
 	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
 	v1 "k8s.io/api/core/v1"
 
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/metrics"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
@@ -55,6 +58,7 @@ func (r *Resources) InspectPVCs(ctx context.Context, cachedStatus inspectorInter
 
 	// Update member status from all pods found
 	status, _ := r.context.GetStatus()
+	spec := r.context.GetSpec()
 	if err := cachedStatus.IteratePersistentVolumeClaims(func(pvc *v1.PersistentVolumeClaim) error {
 		// PVC belongs to this deployment, update metric
 		inspectedPVCsCounters.WithLabelValues(deploymentName).Inc()
@@ -63,6 +67,21 @@ func (r *Resources) InspectPVCs(ctx context.Context, cachedStatus inspectorInter
 		memberStatus, group, found := status.Members.MemberStatusByPVCName(pvc.GetName())
 		if !found {
 			log.Debug().Str("pvc", pvc.GetName()).Msg("no memberstatus found for PVC")
+			if left, ok := retainedPVCTimeLeft(spec, pvc, time.Now()); ok && !k8sutil.IsPersistentVolumeClaimMarkedForDeletion(pvc) {
+				if left > 0 {
+					nextInterval = nextInterval.ReduceTo(util.Interval(left))
+					return nil
+				}
+				log.Info().Str("pvc", pvc.GetName()).Msg("Retention period of PVC expired. Removing it")
+				err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+					return r.context.PersistentVolumeClaimsModInterface().Delete(ctxChild, pvc.GetName(), meta.DeleteOptions{})
+				})
+				if err != nil && !k8sutil.IsNotFound(err) {
+					log.Debug().Err(err).Msg("Failed to remove retained PVC")
+					return errors.WithStack(err)
+				}
+				return nil
+			}
 			if k8sutil.IsPersistentVolumeClaimMarkedForDeletion(pvc) && len(pvc.GetFinalizers()) > 0 {
 				// Strange, pvc belongs to us, but we have no member for it.
 				// Remove all finalizers, so it can be removed.
@@ -93,3 +112,20 @@ func (r *Resources) InspectPVCs(ctx context.Context, cachedStatus inspectorInter
 
 	return nextInterval, nil
 }
+
+// retainedPVCTimeLeft returns the time left until the retained PVC is removed.
+// Returns false when the PVC is not retained, or is retained until removed manually.
+func retainedPVCTimeLeft(spec api.DeploymentSpec, pvc *v1.PersistentVolumeClaim, now time.Time) (time.Duration, bool) {
+	_, retainedAt, retained := k8sutil.GetPersistentVolumeClaimRetention(pvc)
+	if !retained {
+		return 0, false
+	}
+
+	group := api.ServerGroupFromRole(pvc.GetLabels()[k8sutil.LabelKeyRole])
+	period := spec.GetServerGroupSpec(group).GetPVCRetentionPeriod()
+	if period <= 0 {
+		return 0, false
+	}
+
+	return retainedAt.Add(period).Sub(now), true
+}
@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func Test_RetainedPVCTimeLeft(t *testing.T) {
+	now := time.Now()
+	retainedAt := now.Add(-time.Hour)
+
+	pvc := &core.PersistentVolumeClaim{
+		ObjectMeta: meta.ObjectMeta{
+			Name:   "depl-dbserver-id",
+			Labels: k8sutil.LabelsForDeployment("depl", api.ServerGroupDBServersString),
+			Annotations: map[string]string{
+				constants.AnnotationPVCRetainedMember: "PRMR-id",
+				constants.AnnotationPVCRetainedAt:     retainedAt.Format(time.RFC3339),
+			},
+		},
+	}
+
+	t.Run("Not retained", func(t *testing.T) {
+		spec := api.DeploymentSpec{DBServers: api.ServerGroupSpec{PVCRetentionPeriod: api.NewDuration("2h")}}
+
+		_, ok := retainedPVCTimeLeft(spec, &core.PersistentVolumeClaim{}, now)
+		require.False(t, ok)
+	})
+
+	t.Run("Retained until removed manually", func(t *testing.T) {
+		_, ok := retainedPVCTimeLeft(api.DeploymentSpec{}, pvc, now)
+		require.False(t, ok)
+	})
+
+	t.Run("Retention period not expired", func(t *testing.T) {
+		spec := api.DeploymentSpec{DBServers: api.ServerGroupSpec{PVCRetentionPeriod: api.NewDuration("2h")}}
+
+		left, ok := retainedPVCTimeLeft(spec, pvc, now)
+		require.True(t, ok)
+		require.InDelta(t, time.Hour.Seconds(), left.Seconds(), 1)
+	})
+
+	t.Run("Retention period expired", func(t *testing.T) {
+		spec := api.DeploymentSpec{DBServers: api.ServerGroupSpec{PVCRetentionPeriod: api.NewDuration("30m")}}
+
+		left, ok := retainedPVCTimeLeft(spec, pvc, now)
+		require.True(t, ok)
+		require.True(t, left <= 0)
+	})
+}
@@ -22,8 +22,11 @@ package resources
 
 import (
 	"context"
+	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/rs/zerolog"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
@@ -52,7 +55,16 @@ func (r *Resources) EnsurePVCs(ctx context.Context, cachedStatus inspectorInterf
 				continue
 			}
 
-			if _, exists := cachedStatus.PersistentVolumeClaim(m.PersistentVolumeClaimName); exists {
+			if pvc, exists := cachedStatus.PersistentVolumeClaim(m.PersistentVolumeClaimName); exists {
+				if _, _, retained := k8sutil.GetPersistentVolumeClaimRetention(pvc); retained {
+					// Retained PVC is used by the member again
+					err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+						return k8sutil.ClearPersistentVolumeClaimRetention(ctxChild, r.context.PersistentVolumeClaimsModInterface(), m.PersistentVolumeClaimName)
+					})
+					if err != nil {
+						return errors.WithStack(err)
+					}
+				}
 				continue
 			}
 
@@ -76,3 +88,22 @@ func (r *Resources) EnsurePVCs(ctx context.Context, cachedStatus inspectorInterf
 	}
 	return nil
 }
+
+// removeMemberPVC removes the PVC of a removed member, or marks it as retained
+// when the PVCDeletionPolicy of the group is set to Retain.
+func (r *Resources) removeMemberPVC(ctx context.Context, log zerolog.Logger, group api.ServerGroup, memberID, pvcName string) error {
+	if r.context.GetSpec().GetServerGroupSpec(group).PVCDeletionPolicy.Get() == api.PVCDeletionPolicyRetain {
+		log.Info().Str("pvc", pvcName).Msg("Retaining PVC of removed member")
+		return globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return k8sutil.RetainPersistentVolumeClaim(ctxChild, r.context.PersistentVolumeClaimsModInterface(), pvcName, memberID, time.Now())
+		})
+	}
+
+	err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		return r.context.PersistentVolumeClaimsModInterface().Delete(ctxChild, pvcName, meta.DeleteOptions{})
+	})
+	if err != nil && !k8sutil.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
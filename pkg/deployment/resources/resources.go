@@ -22,6 +22,9 @@ package resources
 
 import (
 	"github.com/rs/zerolog"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
 )
 
 // Resources is a service that creates low level resources for members
@@ -29,6 +32,9 @@ import (
 type Resources struct {
 	log     zerolog.Logger
 	context Context
+
+	// tlsExpiryMembers keeps members (ID to group) with exported certificate expiry metric
+	tlsExpiryMembers map[string]api.ServerGroup
 }
 
 // NewResources creates a new Resources service, used to
@@ -39,3 +45,25 @@ func NewResources(log zerolog.Logger, context Context) *Resources {
 		context: context,
 	}
 }
+
+// CleanupMetrics removes all metric series of the deployment exported by resources.
+// Called when the deployment is no longer managed by this operator.
+func (r *Resources) CleanupMetrics() {
+	deploymentName := r.context.GetAPIObject().GetName()
+
+	for id, group := range r.tlsExpiryMembers {
+		tlsCertificateExpiryGauges.DeleteLabelValues(deploymentName, group.AsRole(), id)
+	}
+	r.tlsExpiryMembers = nil
+
+	inspectedSecretsCounters.DeleteLabelValues(deploymentName)
+	inspectSecretsDurationGauges.DeleteLabelValues(deploymentName)
+	inspectedServicesCounters.DeleteLabelValues(deploymentName)
+	inspectServicesDurationGauges.DeleteLabelValues(deploymentName)
+	inspectedPVCsCounters.DeleteLabelValues(deploymentName)
+	inspectPVCsDurationGauges.DeleteLabelValues(deploymentName)
+	inspectedPodsCounters.DeleteLabelValues(deploymentName)
+	inspectPodsDurationGauges.DeleteLabelValues(deploymentName)
+	cleanupRemovedMembersCounters.DeleteLabelValues(deploymentName, metrics.Success)
+	cleanupRemovedMembersCounters.DeleteLabelValues(deploymentName, metrics.Failed)
+}
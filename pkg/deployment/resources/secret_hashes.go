@@ -43,9 +43,11 @@ import (
 
 // ValidateSecretHashes checks the hash of used secrets
 // against the stored ones.
-// If a hash is different, the deployment is marked
-// with a SecretChangedCondition and the operator will not
-// touch it until this is resolved.
+// If a hash of a secret which is loaded only during startup is different,
+// the members using it are marked with the Restart condition and rotated.
+// If a hash of a secret which cannot be changed safely is different,
+// the deployment is marked with a SecretChangedCondition and the operator
+// will not touch it until this is resolved.
 func (r *Resources) ValidateSecretHashes(ctx context.Context, cachedStatus inspectorInterface.Inspector) error {
 	// validate performs a secret hash comparison for a single secret.
 	// Return true if all is good, false when the SecretChanged condition
@@ -134,14 +136,25 @@ func (r *Resources) ValidateSecretHashes(ctx context.Context, cachedStatus inspe
 		return nil
 	}
 
-	if spec.IsAuthenticated() {
+	// restartOnChange returns an action which marks members of given groups with the Restart condition.
+	// Condition is saved together with the new hash of the secret.
+	restartOnChange := func(filter func(api.ServerGroup, api.MemberStatus) bool, groups ...api.ServerGroup) func(Context, *core.Secret) error {
+		return func(_ Context, secret *core.Secret) error {
+			for _, id := range restartMembersOnSecretChange(&status, secret.GetName(), filter, groups...) {
+				log.Info().Str("secret-name", secret.GetName()).Str("member", id).Msg("Secret has changed, member will be restarted")
+			}
+			return nil
+		}
+	}
+
+	if spec.IsAuthenticated() && !spec.Vault.IsJWTSourced() {
 		if image == nil || !features.JWTRotation().Supported(image.ArangoDBVersion, image.Enterprise) {
 			secretName := spec.Authentication.GetJWTSecretName()
 			getExpectedHash := func() string { return getHashes().AuthJWT }
 			setExpectedHash := func(h string) error {
 				return errors.WithStack(updateHashes(func(dst *api.SecretHashes) { dst.AuthJWT = h }))
 			}
-			if hashOK, err := validate(secretName, getExpectedHash, setExpectedHash, nil); err != nil {
+			if hashOK, err := validate(secretName, getExpectedHash, setExpectedHash, restartOnChange(nil, api.AllServerGroups...)); err != nil {
 				return errors.WithStack(err)
 			} else if !hashOK {
 				badSecretNames = append(badSecretNames, secretName)
@@ -153,7 +166,7 @@ func (r *Resources) ValidateSecretHashes(ctx context.Context, cachedStatus inspe
 				setExpectedHash := func(h string) error {
 					return errors.WithStack(updateHashes(func(dst *api.SecretHashes) { dst.AuthJWT = h }))
 				}
-				if hashOK, err := validate(secretName, getExpectedHash, setExpectedHash, nil); err != nil {
+				if hashOK, err := validate(secretName, getExpectedHash, setExpectedHash, restartOnChange(nil, api.AllServerGroups...)); err != nil {
 					return errors.WithStack(err)
 				} else if !hashOK {
 					badSecretNames = append(badSecretNames, secretName)
@@ -161,7 +174,7 @@ func (r *Resources) ValidateSecretHashes(ctx context.Context, cachedStatus inspe
 			}
 		}
 	}
-	if spec.RocksDB.IsEncrypted() {
+	if spec.RocksDB.IsEncrypted() && !spec.Vault.IsEncryptionSourced() {
 		if image == nil || !features.EncryptionRotation().Supported(image.ArangoDBVersion, image.Enterprise) {
 			secretName := spec.RocksDB.Encryption.GetKeySecretName()
 			getExpectedHash := func() string { return getHashes().RocksDBEncryptionKey }
@@ -194,7 +207,32 @@ func (r *Resources) ValidateSecretHashes(ctx context.Context, cachedStatus inspe
 		setExpectedHash := func(h string) error {
 			return errors.WithStack(updateHashes(func(dst *api.SecretHashes) { dst.SyncTLSCA = h }))
 		}
-		if hashOK, err := validate(secretName, getExpectedHash, setExpectedHash, nil); err != nil {
+		restart := restartOnChange(nil, api.ServerGroupSyncMasters, api.ServerGroupSyncWorkers)
+		if hashOK, err := validate(secretName, getExpectedHash, setExpectedHash, restart); err != nil {
+			return errors.WithStack(err)
+		} else if !hashOK {
+			badSecretNames = append(badSecretNames, secretName)
+		}
+	}
+	if spec.License.HasSecretName() {
+		secretName := spec.License.GetSecretName()
+		getExpectedHash := func() string { return getHashes().License }
+		setExpectedHash := func(h string) error {
+			return errors.WithStack(updateHashes(func(dst *api.SecretHashes) { dst.License = h }))
+		}
+		// License is set at runtime on members in version 3.9.0 or above, so only older
+		// members and ArangoSync members (which read it from the environment) are restarted
+		restart := restartOnChange(func(group api.ServerGroup, member api.MemberStatus) bool {
+			if group.IsArangosync() {
+				return true
+			}
+			i := member.Image
+			if i == nil {
+				i = image
+			}
+			return i == nil || i.ArangoDBVersion.CompareTo("3.9.0") < 0
+		}, api.AllServerGroups...)
+		if hashOK, err := validate(secretName, getExpectedHash, setExpectedHash, restart); err != nil {
 			return errors.WithStack(err)
 		} else if !hashOK {
 			badSecretNames = append(badSecretNames, secretName)
@@ -229,6 +267,27 @@ func (r *Resources) ValidateSecretHashes(ctx context.Context, cachedStatus inspe
 	return nil
 }
 
+// restartMembersOnSecretChange sets the Restart condition on members of given groups accepted by the filter.
+// Returns IDs of members which have been marked.
+func restartMembersOnSecretChange(status *api.DeploymentStatus, secretName string,
+	filter func(api.ServerGroup, api.MemberStatus) bool, groups ...api.ServerGroup) []string {
+	var ids []string
+	for _, e := range status.Members.AsListInGroups(groups...) {
+		if filter != nil && !filter(e.Group, e.Member) {
+			continue
+		}
+
+		m := e.Member
+		if m.Conditions.Update(api.ConditionTypeRestart, true, "Secret changed", fmt.Sprintf("Secret %s has changed", secretName)) {
+			if err := status.Members.Update(m, e.Group); err != nil {
+				continue
+			}
+			ids = append(ids, m.ID)
+		}
+	}
+	return ids
+}
+
 // getSecretHash fetches a secret with given name and returns a hash over its value.
 func (r *Resources) getSecretHash(cachedStatus inspectorInterface.Inspector, secretName string) (*core.Secret, string, bool) {
 	s, exists := cachedStatus.Secret(secretName)
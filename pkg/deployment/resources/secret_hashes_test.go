@@ -0,0 +1,85 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RestartMembersOnSecretChange(t *testing.T) {
+	newStatus := func() api.DeploymentStatus {
+		return api.DeploymentStatus{
+			Members: api.DeploymentStatusMembers{
+				Agents: api.MemberStatusList{
+					{ID: "agent", Image: &api.ImageInfo{ArangoDBVersion: "3.8.6"}},
+				},
+				DBServers: api.MemberStatusList{
+					{ID: "dbserver", Image: &api.ImageInfo{ArangoDBVersion: "3.9.1"}},
+				},
+				SyncMasters: api.MemberStatusList{
+					{ID: "syncmaster"},
+				},
+			},
+		}
+	}
+
+	restarted := func(t *testing.T, status api.DeploymentStatus, id string) bool {
+		m, _, ok := status.Members.ElementByID(id)
+		require.True(t, ok)
+		return m.Conditions.IsTrue(api.ConditionTypeRestart)
+	}
+
+	t.Run("All groups", func(t *testing.T) {
+		status := newStatus()
+
+		ids := restartMembersOnSecretChange(&status, "jwt", nil, api.AllServerGroups...)
+		require.ElementsMatch(t, []string{"agent", "dbserver", "syncmaster"}, ids)
+		require.True(t, restarted(t, status, "agent"))
+		require.True(t, restarted(t, status, "dbserver"))
+		require.True(t, restarted(t, status, "syncmaster"))
+
+		// Members are marked only once
+		require.Empty(t, restartMembersOnSecretChange(&status, "jwt", nil, api.AllServerGroups...))
+	})
+
+	t.Run("Selected groups", func(t *testing.T) {
+		status := newStatus()
+
+		ids := restartMembersOnSecretChange(&status, "sync-ca", nil, api.ServerGroupSyncMasters, api.ServerGroupSyncWorkers)
+		require.Equal(t, []string{"syncmaster"}, ids)
+		require.False(t, restarted(t, status, "agent"))
+		require.False(t, restarted(t, status, "dbserver"))
+	})
+
+	t.Run("Filtered members", func(t *testing.T) {
+		status := newStatus()
+
+		ids := restartMembersOnSecretChange(&status, "license", func(group api.ServerGroup, member api.MemberStatus) bool {
+			return member.Image != nil && member.Image.ArangoDBVersion.CompareTo(driver.Version("3.9.0")) < 0
+		}, api.AllServerGroups...)
+		require.Equal(t, []string{"agent"}, ids)
+		require.False(t, restarted(t, status, "dbserver"))
+	})
+}
@@ -28,6 +28,8 @@ import (
 	"fmt"
 	"time"
 
+	certificates "github.com/arangodb-helper/go-certificates"
+
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/secret"
@@ -92,7 +94,8 @@ func (r *Resources) EnsureSecrets(ctx context.Context, log zerolog.Logger, cache
 
 	reconcileRequired := k8sutil.NewReconcile(cachedStatus)
 
-	if spec.IsAuthenticated() {
+	// Secret material sourced from Vault is delivered directly to the pods, secrets are not managed by the operator
+	if spec.IsAuthenticated() && !spec.Vault.IsJWTSourced() {
 		counterMetric.Inc()
 		if err := reconcileRequired.WithError(r.ensureTokenSecret(ctx, cachedStatus, secrets, spec.Authentication.GetJWTSecretName())); err != nil {
 			return errors.WithStack(err)
@@ -100,7 +103,11 @@ func (r *Resources) EnsureSecrets(ctx context.Context, log zerolog.Logger, cache
 	}
 	if spec.IsSecure() {
 		counterMetric.Inc()
-		if err := reconcileRequired.WithError(r.ensureTLSCACertificateSecret(ctx, cachedStatus, secrets, spec.TLS)); err != nil {
+		if spec.Vault.IsTLSSourced() {
+			if err := reconcileRequired.WithError(r.ensureTLSCACertificateSecretFromVault(ctx, cachedStatus, secrets, spec)); err != nil {
+				return errors.WithStack(err)
+			}
+		} else if err := reconcileRequired.WithError(r.ensureTLSCACertificateSecret(ctx, cachedStatus, secrets, spec.TLS)); err != nil {
 			return errors.WithStack(err)
 		}
 	}
@@ -109,7 +116,7 @@ func (r *Resources) EnsureSecrets(ctx context.Context, log zerolog.Logger, cache
 		return err
 	}
 
-	if spec.IsAuthenticated() {
+	if spec.IsAuthenticated() && !spec.Vault.IsJWTSourced() {
 		if imageFound {
 			if pod.VersionHasJWTSecretKeyfolder(image.ArangoDBVersion, image.Enterprise) {
 				if err := r.ensureTokenSecretFolder(ctx, cachedStatus, secrets, spec.Authentication.GetJWTSecretName(), pod.JWTSecretFolder(deploymentName)); err != nil {
@@ -169,8 +176,16 @@ func (r *Resources) EnsureSecrets(ctx context.Context, log zerolog.Logger, cache
 		}); err != nil {
 			return errors.WithStack(err)
 		}
+
+		if err := r.inspectTLSKeyfileExpiry(ctx, log, cachedStatus); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := reconcileRequired.WithError(r.ensureTLSClientCertificates(ctx, log, cachedStatus, secrets, spec.TLS)); err != nil {
+			return errors.WithStack(err)
+		}
 	}
-	if spec.RocksDB.IsEncrypted() {
+	if spec.RocksDB.IsEncrypted() && !spec.Vault.IsEncryptionSourced() {
 		if i := status.CurrentImage; i != nil && features.EncryptionRotation().Supported(i.ArangoDBVersion, i.Enterprise) {
 			if err := reconcileRequired.WithError(r.ensureEncryptionKeyfolderSecret(ctx, cachedStatus, secrets, spec.RocksDB.Encryption.GetKeySecretName(), pod.GetEncryptionFolderSecretName(deploymentName))); err != nil {
 				return errors.WithStack(err)
@@ -524,6 +539,70 @@ func (r *Resources) ensureTLSCACertificateSecret(ctx context.Context, cachedStat
 	return nil
 }
 
+// ensureTLSCACertificateSecretFromVault keeps the CA secret in sync with the CA bundle kept in Vault.
+// Secret is not owned by the deployment, so the CA is not renewed by the operator.
+func (r *Resources) ensureTLSCACertificateSecretFromVault(ctx context.Context, cachedStatus inspectorInterface.Inspector, secrets secret.ModInterface, spec api.DeploymentSpec) error {
+	c := r.context.GetVaultClient()
+	if c == nil {
+		return errors.Newf("Vault client is not configured")
+	}
+
+	source := spec.Vault.TLS
+
+	var bundle string
+	if err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		var err error
+		bundle, err = c.Read(ctxChild, source.GetPath(), source.GetKey())
+		return err
+	}); err != nil {
+		return errors.Wrapf(err, "Unable to read CA bundle from Vault")
+	}
+
+	keyfile, err := certificates.NewKeyfile(bundle)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to parse CA bundle from Vault")
+	}
+	if err := keyfile.Validate(); err != nil {
+		return errors.Wrapf(err, "CA bundle from Vault is not valid")
+	}
+
+	cert, key := keyfile.EncodeCertificates(), keyfile.EncodePrivateKey()
+
+	s, exists := cachedStatus.Secret(spec.TLS.GetCASecretName())
+	if !exists {
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			return k8sutil.CreateCASecret(ctxChild, secrets, spec.TLS.GetCASecretName(), cert, key, nil)
+		})
+		if k8sutil.IsAlreadyExists(err) {
+			return nil
+		} else if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return operatorErrors.Reconcile()
+	}
+
+	if string(s.Data[constants.SecretCACertificate]) == cert && string(s.Data[constants.SecretCAKey]) == key {
+		return nil
+	}
+
+	n := s.DeepCopy()
+	if n.Data == nil {
+		n.Data = map[string][]byte{}
+	}
+	n.Data[constants.SecretCACertificate] = []byte(cert)
+	n.Data[constants.SecretCAKey] = []byte(key)
+
+	if err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := secrets.Update(ctxChild, n, meta.UpdateOptions{})
+		return err
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return operatorErrors.Reconcile()
+}
+
 // ensureClientAuthCACertificateSecret checks if a secret with given name exists in the namespace
 // of the deployment. If not, it will add such a secret with a generated CA certificate.
 func (r *Resources) ensureClientAuthCACertificateSecret(ctx context.Context, cachedStatus inspectorInterface.Inspector, secrets secret.ModInterface, spec api.SyncAuthenticationSpec) error {
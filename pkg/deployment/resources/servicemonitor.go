@@ -36,6 +36,7 @@ import (
 
 	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
 	coreosv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -59,64 +60,131 @@ func LabelsForExporterServiceMonitorSelector(name string) map[string]string {
 	}
 }
 
-func (r *Resources) makeEndpoint(isSecure bool) coreosv1.Endpoint {
-	if isSecure {
-		return coreosv1.Endpoint{
-			Port:     "exporter",
-			Interval: "10s",
-			Scheme:   "https",
-			TLSConfig: &coreosv1.TLSConfig{
-				SafeTLSConfig: coreosv1.SafeTLSConfig{
-					InsecureSkipVerify: true,
-				},
-			},
+// relabelConfigs converts relabeling rules from the deployment spec
+func relabelConfigs(in []deploymentApi.MetricsRelabelConfig) []*coreosv1.RelabelConfig {
+	if len(in) == 0 {
+		return nil
+	}
+
+	r := make([]*coreosv1.RelabelConfig, len(in))
+	for id, c := range in {
+		r[id] = &coreosv1.RelabelConfig{
+			SourceLabels: c.SourceLabels,
+			Separator:    c.Separator,
+			TargetLabel:  c.TargetLabel,
+			Regex:        c.Regex,
+			Modulus:      c.Modulus,
+			Replacement:  c.Replacement,
+			Action:       c.Action,
 		}
-	} else {
-		return coreosv1.Endpoint{
-			Port:     "exporter",
-			Interval: "10s",
-			Scheme:   "http",
+	}
+
+	return r
+}
+
+func makeEndpoint(spec deploymentApi.DeploymentSpec) coreosv1.Endpoint {
+	endpoint := coreosv1.Endpoint{
+		Port:                 "exporter",
+		Interval:             spec.Metrics.ServiceMonitor.GetInterval(),
+		Scheme:               "http",
+		RelabelConfigs:       relabelConfigs(spec.Metrics.ServiceMonitor.GetRelabelings()),
+		MetricRelabelConfigs: relabelConfigs(spec.Metrics.ServiceMonitor.GetMetricRelabelings()),
+	}
+
+	if spec.IsSecure() {
+		endpoint.Scheme = "https"
+		endpoint.TLSConfig = &coreosv1.TLSConfig{
+			SafeTLSConfig: coreosv1.SafeTLSConfig{
+				InsecureSkipVerify: true,
+			},
 		}
 	}
+
+	return endpoint
 }
 
-func (r *Resources) serviceMonitorSpec() (coreosv1.ServiceMonitorSpec, error) {
-	apiObject := r.context.GetAPIObject()
-	deploymentName := apiObject.GetName()
-	spec := r.context.GetSpec()
+// setArangodMetricsEndpoint configures scraping of the arangod metrics endpoint,
+// used when metrics are not served by the exporter sidecar
+func setArangodMetricsEndpoint(spec deploymentApi.DeploymentSpec, image *deploymentApi.ImageInfo, path *string, token *core.SecretKeySelector) error {
+	mode := spec.Metrics.Mode.Get()
 
-	switch spec.Metrics.Mode.Get() {
+	switch mode {
 	case deploymentApi.MetricsModeInternal:
-		if spec.Metrics.Authentication.JWTTokenSecretName == nil {
-			return coreosv1.ServiceMonitorSpec{}, apiErrors.NewNotFound(schema.GroupResource{Group: "v1/secret"}, "metrics-secret")
+		*path = k8sutil.ArangoExporterInternalEndpoint
+	case deploymentApi.MetricsModeDirect:
+		*path = k8sutil.ArangoExporterInternalEndpoint
+		if image != nil && image.ArangoDBVersion.CompareTo("3.8.0") >= 0 {
+			*path = k8sutil.ArangoExporterInternalEndpointV2
 		}
 
-		endpoint := r.makeEndpoint(spec.IsSecure())
+		if !spec.IsAuthenticated() {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	if spec.Metrics.Authentication.JWTTokenSecretName == nil {
+		return apiErrors.NewNotFound(schema.GroupResource{Group: "v1/secret"}, "metrics-secret")
+	}
 
-		endpoint.BearerTokenSecret.Name = *spec.Metrics.Authentication.JWTTokenSecretName
-		endpoint.BearerTokenSecret.Key = constants.SecretKeyToken
-		endpoint.Path = k8sutil.ArangoExporterInternalEndpoint
+	token.Name = *spec.Metrics.Authentication.JWTTokenSecretName
+	token.Key = constants.SecretKeyToken
 
-		return coreosv1.ServiceMonitorSpec{
-			JobLabel: "k8s-app",
-			Endpoints: []coreosv1.Endpoint{
-				endpoint,
-			},
-			Selector: metav1.LabelSelector{
-				MatchLabels: LabelsForExporterServiceMonitorSelector(r.context.GetName()),
-			},
-		}, nil
-	default:
-		return coreosv1.ServiceMonitorSpec{
-			JobLabel: "k8s-app",
-			Endpoints: []coreosv1.Endpoint{
-				r.makeEndpoint(spec.IsSecure()),
-			},
-			Selector: metav1.LabelSelector{
-				MatchLabels: LabelsForExporterServiceMonitorSelector(deploymentName),
+	return nil
+}
+
+// makeSyncEndpoint returns the endpoint which scrapes the metrics of the sync members.
+// ArangoSync serves metrics always over TLS and requires the monitoring token.
+func makeSyncEndpoint(spec deploymentApi.DeploymentSpec) coreosv1.Endpoint {
+	endpoint := coreosv1.Endpoint{
+		Port:                 k8sutil.SyncExporterPortName,
+		Path:                 k8sutil.ArangoSyncMetricsEndpoint,
+		Interval:             spec.Metrics.ServiceMonitor.GetInterval(),
+		Scheme:               "https",
+		RelabelConfigs:       relabelConfigs(spec.Metrics.ServiceMonitor.GetRelabelings()),
+		MetricRelabelConfigs: relabelConfigs(spec.Metrics.ServiceMonitor.GetMetricRelabelings()),
+		TLSConfig: &coreosv1.TLSConfig{
+			SafeTLSConfig: coreosv1.SafeTLSConfig{
+				InsecureSkipVerify: true,
 			},
-		}, nil
+		},
+	}
+
+	endpoint.BearerTokenSecret.Name = spec.Sync.Monitoring.GetTokenSecretName()
+	endpoint.BearerTokenSecret.Key = constants.SecretKeyToken
+
+	return endpoint
+}
+
+func (r *Resources) serviceMonitorSpec() (coreosv1.ServiceMonitorSpec, error) {
+	status, _ := r.context.GetStatus()
+
+	return serviceMonitorSpec(r.context.GetName(), r.context.GetSpec(), status.CurrentImage)
+}
+
+// serviceMonitorSpec returns the spec of the ServiceMonitor which scrapes the exporter services of the deployment
+func serviceMonitorSpec(deploymentName string, spec deploymentApi.DeploymentSpec, image *deploymentApi.ImageInfo) (coreosv1.ServiceMonitorSpec, error) {
+	endpoint := makeEndpoint(spec)
+	if err := setArangodMetricsEndpoint(spec, image, &endpoint.Path, &endpoint.BearerTokenSecret); err != nil {
+		return coreosv1.ServiceMonitorSpec{}, err
 	}
+
+	endpoints := []coreosv1.Endpoint{
+		endpoint,
+	}
+
+	if spec.Sync.IsEnabled() {
+		endpoints = append(endpoints, makeSyncEndpoint(spec))
+	}
+
+	return coreosv1.ServiceMonitorSpec{
+		JobLabel:  "k8s-app",
+		Endpoints: endpoints,
+		Selector: metav1.LabelSelector{
+			MatchLabels: LabelsForExporterServiceMonitorSelector(deploymentName),
+		},
+	}, nil
 }
 
 // EnsureServiceMonitor creates or updates a ServiceMonitor.
@@ -133,7 +201,7 @@ func (r *Resources) EnsureServiceMonitor(ctx context.Context) error {
 		return nil
 	}
 
-	wantMetrics := spec.Metrics.IsEnabled()
+	wantMetrics := spec.Metrics.ServiceMonitor.GetKind() == deploymentApi.MetricsServiceMonitorKindServiceMonitor
 	serviceMonitorName := k8sutil.CreateExporterClientServiceName(deploymentName)
 
 	client, ok := kclient.GetDefaultFactory().Client()
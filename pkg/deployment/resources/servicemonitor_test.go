@@ -0,0 +1,76 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func Test_ServiceMonitorSpec(t *testing.T) {
+	t.Run("Without sync", func(t *testing.T) {
+		spec, err := serviceMonitorSpec("example", api.DeploymentSpec{
+			TLS: api.TLSSpec{CASecretName: util.NewString(api.CASecretNameDisabled)},
+		}, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, LabelsForExporterServiceMonitorSelector("example"), spec.Selector.MatchLabels)
+		require.Len(t, spec.Endpoints, 1)
+		require.Equal(t, "exporter", spec.Endpoints[0].Port)
+		require.Equal(t, "http", spec.Endpoints[0].Scheme)
+	})
+
+	t.Run("With sync", func(t *testing.T) {
+		spec, err := serviceMonitorSpec("example", api.DeploymentSpec{
+			TLS: api.TLSSpec{CASecretName: util.NewString(api.CASecretNameDisabled)},
+			Metrics: api.MetricsSpec{
+				ServiceMonitor: &api.MetricsServiceMonitorSpec{
+					Interval: util.NewString("30s"),
+				},
+			},
+			Sync: api.SyncSpec{
+				Enabled: util.NewBool(true),
+				Monitoring: api.MonitoringSpec{
+					TokenSecretName: util.NewString("example-sync-mt"),
+				},
+			},
+		}, nil)
+		require.NoError(t, err)
+
+		require.Len(t, spec.Endpoints, 2)
+
+		endpoint := spec.Endpoints[1]
+		require.Equal(t, k8sutil.SyncExporterPortName, endpoint.Port)
+		require.Equal(t, k8sutil.ArangoSyncMetricsEndpoint, endpoint.Path)
+		require.Equal(t, "30s", endpoint.Interval)
+		require.Equal(t, "https", endpoint.Scheme)
+		require.NotNil(t, endpoint.TLSConfig)
+		require.True(t, endpoint.TLSConfig.InsecureSkipVerify)
+		require.Equal(t, "example-sync-mt", endpoint.BearerTokenSecret.Name)
+		require.Equal(t, constants.SecretKeyToken, endpoint.BearerTokenSecret.Key)
+	})
+}
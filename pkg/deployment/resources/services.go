@@ -22,7 +22,6 @@ package resources
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
@@ -40,10 +39,15 @@ import (
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/metrics"
+	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 )
 
+// externalDNSHostnameAnnotation is the annotation used by external-dns to create DNS records for a service
+const externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+
 var (
 	inspectedServicesCounters     = metrics.MustRegisterCounterVec(metricsComponent, "inspected_services", "Number of Service inspections per deployment", metrics.DeploymentName)
 	inspectServicesDurationGauges = metrics.MustRegisterGaugeVec(metricsComponent, "inspect_services_duration", "Amount of time taken by a single inspection of all Services for a deployment (in sec)", metrics.DeploymentName)
@@ -68,14 +72,29 @@ func (r *Resources) EnsureServices(ctx context.Context, cachedStatus inspectorIn
 
 	// Ensure member services
 	if err := status.Members.ForeachServerGroup(func(group api.ServerGroup, list api.MemberStatusList) error {
+		eaSpec := spec.GetServerGroupSpec(group).ExternalAccess
+		eaNodePorts := memberExternalAccessNodePorts(cachedStatus, deploymentName, group, list)
+
 		for _, m := range list {
 			memberName := m.ArangoMemberName(r.context.GetAPIObject().GetName(), group)
 
+			port := int32(k8sutil.ArangoPort)
+			if group.IsArangod() {
+				port = int32(spec.GetServerGroupPort(group))
+			}
+
 			member, ok := cachedStatus.ArangoMember(memberName)
 			if !ok {
 				return errors.Newf("Member %s not found", memberName)
 			}
 
+			if changed, err := r.ensureMemberExternalAccessService(ctx, cachedStatus, svcs, deploymentName, group, eaSpec,
+				member, m.ID, port, eaNodePorts); err != nil {
+				return err
+			} else if changed {
+				reconcileRequired.Required()
+			}
+
 			if s, ok := cachedStatus.Service(member.GetName()); !ok {
 				s = &core.Service{
 					ObjectMeta: metav1.ObjectMeta{
@@ -91,8 +110,8 @@ func (r *Resources) EnsureServices(ctx context.Context, cachedStatus inspectorIn
 							{
 								Name:       "server",
 								Protocol:   "TCP",
-								Port:       k8sutil.ArangoPort,
-								TargetPort: intstr.IntOrString{IntVal: k8sutil.ArangoPort},
+								Port:       port,
+								TargetPort: intstr.IntOrString{IntVal: port},
 							},
 						},
 						PublishNotReadyAddresses: true,
@@ -120,8 +139,8 @@ func (r *Resources) EnsureServices(ctx context.Context, cachedStatus inspectorIn
 					{
 						Name:       "server",
 						Protocol:   "TCP",
-						Port:       k8sutil.ArangoPort,
-						TargetPort: intstr.IntOrString{IntVal: k8sutil.ArangoPort},
+						Port:       port,
+						TargetPort: intstr.IntOrString{IntVal: port},
 					},
 				}
 				spec.PublishNotReadyAddresses = true
@@ -154,7 +173,7 @@ func (r *Resources) EnsureServices(ctx context.Context, cachedStatus inspectorIn
 	if _, exists := cachedStatus.Service(k8sutil.CreateHeadlessServiceName(deploymentName)); !exists {
 		ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 		defer cancel()
-		svcName, newlyCreated, err := k8sutil.CreateHeadlessService(ctxChild, svcs, apiObject, owner)
+		svcName, newlyCreated, err := k8sutil.CreateHeadlessService(ctxChild, svcs, apiObject, spec.GetPort(), owner)
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to create headless service")
 			return errors.WithStack(err)
@@ -170,7 +189,7 @@ func (r *Resources) EnsureServices(ctx context.Context, cachedStatus inspectorIn
 	if _, exists := cachedStatus.Service(k8sutil.CreateDatabaseClientServiceName(deploymentName)); !exists {
 		ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 		defer cancel()
-		svcName, newlyCreated, err := k8sutil.CreateDatabaseClientService(ctxChild, svcs, apiObject, single, owner)
+		svcName, newlyCreated, err := k8sutil.CreateDatabaseClientService(ctxChild, svcs, apiObject, single, spec.GetDatabasePort(), owner)
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to create database client service")
 			return errors.WithStack(err)
@@ -195,7 +214,10 @@ func (r *Resources) EnsureServices(ctx context.Context, cachedStatus inspectorIn
 	if single {
 		role = "single"
 	}
-	if err := r.ensureExternalAccessServices(ctx, cachedStatus, svcs, eaServiceName, role, "database", k8sutil.ArangoPort, false, spec.ExternalAccess, apiObject, log); err != nil {
+	if err := r.ensureExternalAccessServices(ctx, cachedStatus, svcs, eaServiceName, role, "database", spec.GetDatabasePort(), false, spec.ExternalAccess, apiObject, log); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.ensureExternalAccessIngress(ctx, cachedStatus, eaServiceName, spec.GetDatabasePort(), spec.ExternalAccess); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -233,9 +255,63 @@ func (r *Resources) EnsureServices(ctx context.Context, cachedStatus inspectorIn
 		}
 	}
 
+	if err := r.ensureSyncExporterServices(ctx, cachedStatus, svcs, apiObject, spec.Metrics.IsEnabled() && spec.Sync.IsEnabled(), counterMetric); err != nil {
+		return errors.WithStack(err)
+	}
+
 	return reconcileRequired.Reconcile(ctx)
 }
 
+// ensureSyncExporterServices creates services exposing metrics of the sync members when enabled,
+// otherwise removes them.
+func (r *Resources) ensureSyncExporterServices(ctx context.Context, cachedStatus inspectorInterface.Inspector,
+	svcs service.ModInterface, apiObject k8sutil.APIObject, enabled bool, counterMetric prometheus.Counter) error {
+	log := r.log
+
+	for _, group := range []api.ServerGroup{api.ServerGroupSyncMasters, api.ServerGroupSyncWorkers} {
+		port := k8sutil.ArangoSyncMasterPort
+		if group == api.ServerGroupSyncWorkers {
+			port = k8sutil.ArangoSyncWorkerPort
+		}
+
+		if !enabled {
+			name := k8sutil.CreateSyncExporterServiceName(apiObject.GetName(), group.AsRole())
+			if _, exists := cachedStatus.Service(name); !exists {
+				continue
+			}
+
+			counterMetric.Inc()
+			err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+				return svcs.Delete(ctxChild, name, metav1.DeleteOptions{})
+			})
+			if err != nil && !k8sutil.IsNotFound(err) {
+				log.Debug().Err(err).Msgf("Failed to remove %s sync exporter service", name)
+				return errors.WithStack(err)
+			}
+			log.Debug().Str("service", name).Msg("Removed sync exporter service")
+			continue
+		}
+
+		counterMetric.Inc()
+		err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			name, newlyCreated, err := k8sutil.CreateSyncExporterService(ctxChild, cachedStatus, svcs, apiObject, group.AsRole(), port, apiObject.AsOwner())
+			if err != nil {
+				log.Debug().Err(err).Msgf("Failed to create %s sync exporter service", name)
+				return err
+			}
+			if newlyCreated {
+				log.Debug().Str("service", name).Msg("Created sync exporter service")
+			}
+			return nil
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
 // EnsureServices creates all services needed to service the deployment
 func (r *Resources) ensureExternalAccessServices(ctx context.Context, cachedStatus inspectorInterface.Inspector,
 	svcs service.ModInterface, eaServiceName, svcRole, title string, port int, noneIsClusterIP bool,
@@ -248,7 +324,6 @@ func (r *Resources) ensureExternalAccessServices(ctx context.Context, cachedStat
 		// External access service exists
 		updateExternalAccessService := false
 		loadBalancerIP := spec.GetLoadBalancerIP()
-		loadBalancerSourceRanges := spec.LoadBalancerSourceRanges
 		nodePort := spec.GetNodePort()
 		if spec.GetType().IsNone() {
 			if noneIsClusterIP {
@@ -284,15 +359,28 @@ func (r *Resources) ensureExternalAccessServices(ctx context.Context, cachedStat
 				deleteExternalAccessService = true // Remove the current and replace with proper one
 				createExternalAccessService = true
 			}
-			if strings.Join(existing.Spec.LoadBalancerSourceRanges, ",") != strings.Join(loadBalancerSourceRanges, ",") {
-				updateExternalAccessService = true
-				existing.Spec.LoadBalancerSourceRanges = loadBalancerSourceRanges
-			}
 		} else if spec.GetType().IsNodePort() {
 			if existing.Spec.Type != core.ServiceTypeNodePort || len(existing.Spec.Ports) != 1 || (nodePort != 0 && existing.Spec.Ports[0].NodePort != int32(nodePort)) {
 				deleteExternalAccessService = true // Remove the current and replace with proper one
 				createExternalAccessService = true
 			}
+		} else if spec.GetType().IsIngress() {
+			if existing.Spec.Type != core.ServiceTypeClusterIP {
+				deleteExternalAccessService = true // Remove the current and replace with the one exposed by the Ingress
+				createExternalAccessService = true
+			}
+		}
+		if !createExternalAccessService && !deleteExternalAccessService {
+			expected := existing.DeepCopy()
+			externalAccessServiceModifier(spec)(expected)
+			if util.StringOrDefault(existing.Spec.LoadBalancerClass) != util.StringOrDefault(expected.Spec.LoadBalancerClass) {
+				deleteExternalAccessService = true // LoadBalancerClass is immutable, remove the current and replace with proper one
+				createExternalAccessService = true
+			} else if !equality.Semantic.DeepEqual(existing.Spec, expected.Spec) ||
+				!equality.Semantic.DeepEqual(existing.Annotations, expected.Annotations) {
+				updateExternalAccessService = true
+				existing = expected
+			}
 		}
 		if updateExternalAccessService && !createExternalAccessService && !deleteExternalAccessService {
 			err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
@@ -328,7 +416,7 @@ func (r *Resources) ensureExternalAccessServices(ctx context.Context, cachedStat
 		loadBalancerSourceRanges := spec.LoadBalancerSourceRanges
 		ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
 		defer cancel()
-		_, newlyCreated, err := k8sutil.CreateExternalAccessService(ctxChild, svcs, eaServiceName, svcRole, apiObject, eaServiceType, port, nodePort, loadBalancerIP, loadBalancerSourceRanges, apiObject.AsOwner())
+		_, newlyCreated, err := k8sutil.CreateExternalAccessService(ctxChild, svcs, eaServiceName, svcRole, apiObject, eaServiceType, port, nodePort, loadBalancerIP, loadBalancerSourceRanges, apiObject.AsOwner(), externalAccessServiceModifier(spec))
 		if err != nil {
 			log.Debug().Err(err).Msgf("Failed to create %s external access service", title)
 			return errors.WithStack(err)
@@ -339,3 +427,38 @@ func (r *Resources) ensureExternalAccessServices(ctx context.Context, cachedStat
 	}
 	return nil
 }
+
+// externalAccessServiceModifier applies load-balancer, traffic and external-dns settings of the external access to the service.
+// Settings not supported by the type of the service are cleared.
+func externalAccessServiceModifier(spec api.ExternalAccessSpec) k8sutil.ServiceModifier {
+	return func(svc *core.Service) {
+		s := &svc.Spec
+
+		s.SessionAffinity = spec.GetSessionAffinity()
+		s.SessionAffinityConfig = spec.GetSessionAffinityConfig()
+
+		switch s.Type {
+		case core.ServiceTypeLoadBalancer:
+			s.LoadBalancerClass = spec.GetLoadBalancerClass()
+			s.LoadBalancerSourceRanges = spec.LoadBalancerSourceRanges
+			s.ExternalTrafficPolicy = spec.GetExternalTrafficPolicy()
+		case core.ServiceTypeNodePort:
+			s.LoadBalancerClass = nil
+			s.LoadBalancerSourceRanges = nil
+			s.ExternalTrafficPolicy = spec.GetExternalTrafficPolicy()
+		default:
+			s.LoadBalancerClass = nil
+			s.LoadBalancerSourceRanges = nil
+			s.ExternalTrafficPolicy = ""
+		}
+
+		if hostname := spec.GetExternalDNSHostname(); hostname != "" {
+			if svc.Annotations == nil {
+				svc.Annotations = map[string]string{}
+			}
+			svc.Annotations[externalDNSHostnameAnnotation] = hostname
+		} else {
+			delete(svc.Annotations, externalDNSHostnameAnnotation)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_ExternalAccessServiceModifier(t *testing.T) {
+	clientIP := core.ServiceAffinityClientIP
+	local := core.ServiceExternalTrafficPolicyTypeLocal
+
+	spec := api.ExternalAccessSpec{
+		LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+		LoadBalancerClass:        util.NewString("service.k8s.aws/nlb"),
+		SessionAffinity:          &clientIP,
+		ExternalTrafficPolicy:    &local,
+	}
+
+	t.Run("LoadBalancer", func(t *testing.T) {
+		svc := core.Service{Spec: core.ServiceSpec{Type: core.ServiceTypeLoadBalancer}}
+		externalAccessServiceModifier(spec)(&svc)
+		s := svc.Spec
+
+		require.Equal(t, "service.k8s.aws/nlb", *s.LoadBalancerClass)
+		require.Equal(t, []string{"10.0.0.0/8"}, s.LoadBalancerSourceRanges)
+		require.Equal(t, core.ServiceAffinityClientIP, s.SessionAffinity)
+		require.NotNil(t, s.SessionAffinityConfig)
+		require.Equal(t, core.ServiceExternalTrafficPolicyTypeLocal, s.ExternalTrafficPolicy)
+	})
+
+	t.Run("NodePort", func(t *testing.T) {
+		svc := core.Service{Spec: core.ServiceSpec{Type: core.ServiceTypeNodePort, LoadBalancerSourceRanges: []string{"10.0.0.0/8"}}}
+		externalAccessServiceModifier(spec)(&svc)
+		s := svc.Spec
+
+		require.Nil(t, s.LoadBalancerClass)
+		require.Empty(t, s.LoadBalancerSourceRanges)
+		require.Equal(t, core.ServiceExternalTrafficPolicyTypeLocal, s.ExternalTrafficPolicy)
+	})
+
+	t.Run("ClusterIP", func(t *testing.T) {
+		svc := core.Service{Spec: core.ServiceSpec{Type: core.ServiceTypeClusterIP}}
+		externalAccessServiceModifier(api.ExternalAccessSpec{})(&svc)
+		s := svc.Spec
+
+		require.Nil(t, s.LoadBalancerClass)
+		require.Empty(t, s.ExternalTrafficPolicy)
+		require.Equal(t, core.ServiceAffinityNone, s.SessionAffinity)
+		require.Nil(t, s.SessionAffinityConfig)
+	})
+
+	t.Run("ExternalDNS", func(t *testing.T) {
+		svc := core.Service{Spec: core.ServiceSpec{Type: core.ServiceTypeLoadBalancer}}
+		externalAccessServiceModifier(api.ExternalAccessSpec{
+			AdvertisedEndpoint: util.NewString("db.example.com"),
+			ExternalDNS:        util.NewBool(true),
+		})(&svc)
+
+		require.Equal(t, "db.example.com", svc.Annotations[externalDNSHostnameAnnotation])
+
+		externalAccessServiceModifier(api.ExternalAccessSpec{
+			AdvertisedEndpoint: util.NewString("db.example.com"),
+		})(&svc)
+
+		require.NotContains(t, svc.Annotations, externalDNSHostnameAnnotation)
+	})
+}
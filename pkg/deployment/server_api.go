@@ -22,6 +22,7 @@ package deployment
 
 import (
 	"context"
+	"net/http"
 	"sort"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
@@ -30,7 +31,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
 	"github.com/arangodb/kube-arangodb/pkg/server"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
@@ -207,7 +210,7 @@ func (d *Deployment) DatabaseURL() string {
 		return *result, nil
 	}
 	portPredicate := func(p v1.ServicePort) bool {
-		return p.TargetPort.IntValue() == k8sutil.ArangoPort
+		return p.TargetPort.IntValue() == d.GetSpec().GetDatabasePort()
 	}
 	url, err := k8sutil.CreateServiceURL(*svc, scheme, portPredicate, nodeFetcher)
 	if err != nil {
@@ -250,3 +253,40 @@ func (d *Deployment) Members() map[api.ServerGroup][]server.Member {
 	})
 	return result
 }
+
+// AgencyDump returns the agency dump of the deployment with values of sensitive keys redacted.
+func (d *Deployment) AgencyDump(ctx context.Context) (interface{}, error) {
+	if !d.GetMode().HasAgents() {
+		return nil, errors.Newf("agency dump is not available for the %s deployment", d.GetMode())
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+
+	client, err := d.GetDatabaseClient(ctxChild)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := client.Connection()
+	r, err := conn.NewRequest(http.MethodGet, "/_api/cluster/agency-dump")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resp, err := conn.Do(ctxChild, r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var dump interface{}
+	if err := resp.ParseBody("", &dump); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return agency.Sanitize(dump), nil
+}
@@ -40,6 +40,10 @@ func (c *FakeBackupV1) ArangoBackupPolicies(namespace string) v1.ArangoBackupPol
 	return &FakeArangoBackupPolicies{c, namespace}
 }
 
+func (c *FakeBackupV1) ArangoVolumeSnapshotSets(namespace string) v1.ArangoVolumeSnapshotSetInterface {
+	return &FakeArangoVolumeSnapshotSets{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeBackupV1) RESTClient() rest.Interface {
@@ -31,9 +31,13 @@ import (
 type DatabaseV1Interface interface {
 	RESTClient() rest.Interface
 	ArangoClusterSynchronizationsGetter
+	ArangoCollectionsGetter
+	ArangoDatabasesGetter
 	ArangoDeploymentsGetter
+	ArangoFoxxServicesGetter
 	ArangoMembersGetter
 	ArangoTasksGetter
+	ArangoUsersGetter
 }
 
 // DatabaseV1Client is used to interact with features provided by the database.arangodb.com group.
@@ -45,10 +49,22 @@ func (c *DatabaseV1Client) ArangoClusterSynchronizations(namespace string) Arang
 	return newArangoClusterSynchronizations(c, namespace)
 }
 
+func (c *DatabaseV1Client) ArangoCollections(namespace string) ArangoCollectionInterface {
+	return newArangoCollections(c, namespace)
+}
+
+func (c *DatabaseV1Client) ArangoDatabases(namespace string) ArangoDatabaseInterface {
+	return newArangoDatabases(c, namespace)
+}
+
 func (c *DatabaseV1Client) ArangoDeployments(namespace string) ArangoDeploymentInterface {
 	return newArangoDeployments(c, namespace)
 }
 
+func (c *DatabaseV1Client) ArangoFoxxServices(namespace string) ArangoFoxxServiceInterface {
+	return newArangoFoxxServices(c, namespace)
+}
+
 func (c *DatabaseV1Client) ArangoMembers(namespace string) ArangoMemberInterface {
 	return newArangoMembers(c, namespace)
 }
@@ -57,6 +73,10 @@ func (c *DatabaseV1Client) ArangoTasks(namespace string) ArangoTaskInterface {
 	return newArangoTasks(c, namespace)
 }
 
+func (c *DatabaseV1Client) ArangoUsers(namespace string) ArangoUserInterface {
+	return newArangoUsers(c, namespace)
+}
+
 // NewForConfig creates a new DatabaseV1Client for the given config.
 func NewForConfig(c *rest.Config) (*DatabaseV1Client, error) {
 	config := *c
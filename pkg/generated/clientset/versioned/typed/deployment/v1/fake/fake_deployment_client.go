@@ -36,10 +36,22 @@ func (c *FakeDatabaseV1) ArangoClusterSynchronizations(namespace string) v1.Aran
 	return &FakeArangoClusterSynchronizations{c, namespace}
 }
 
+func (c *FakeDatabaseV1) ArangoCollections(namespace string) v1.ArangoCollectionInterface {
+	return &FakeArangoCollections{c, namespace}
+}
+
+func (c *FakeDatabaseV1) ArangoDatabases(namespace string) v1.ArangoDatabaseInterface {
+	return &FakeArangoDatabases{c, namespace}
+}
+
 func (c *FakeDatabaseV1) ArangoDeployments(namespace string) v1.ArangoDeploymentInterface {
 	return &FakeArangoDeployments{c, namespace}
 }
 
+func (c *FakeDatabaseV1) ArangoFoxxServices(namespace string) v1.ArangoFoxxServiceInterface {
+	return &FakeArangoFoxxServices{c, namespace}
+}
+
 func (c *FakeDatabaseV1) ArangoMembers(namespace string) v1.ArangoMemberInterface {
 	return &FakeArangoMembers{c, namespace}
 }
@@ -48,6 +60,10 @@ func (c *FakeDatabaseV1) ArangoTasks(namespace string) v1.ArangoTaskInterface {
 	return &FakeArangoTasks{c, namespace}
 }
 
+func (c *FakeDatabaseV1) ArangoUsers(namespace string) v1.ArangoUserInterface {
+	return &FakeArangoUsers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeDatabaseV1) RESTClient() rest.Interface {
@@ -24,8 +24,16 @@ package v1
 
 type ArangoClusterSynchronizationExpansion interface{}
 
+type ArangoCollectionExpansion interface{}
+
+type ArangoDatabaseExpansion interface{}
+
 type ArangoDeploymentExpansion interface{}
 
+type ArangoFoxxServiceExpansion interface{}
+
 type ArangoMemberExpansion interface{}
 
 type ArangoTaskExpansion interface{}
+
+type ArangoUserExpansion interface{}
@@ -31,9 +31,13 @@ import (
 type DatabaseV2alpha1Interface interface {
 	RESTClient() rest.Interface
 	ArangoClusterSynchronizationsGetter
+	ArangoCollectionsGetter
+	ArangoDatabasesGetter
 	ArangoDeploymentsGetter
+	ArangoFoxxServicesGetter
 	ArangoMembersGetter
 	ArangoTasksGetter
+	ArangoUsersGetter
 }
 
 // DatabaseV2alpha1Client is used to interact with features provided by the database.arangodb.com group.
@@ -45,10 +49,22 @@ func (c *DatabaseV2alpha1Client) ArangoClusterSynchronizations(namespace string)
 	return newArangoClusterSynchronizations(c, namespace)
 }
 
+func (c *DatabaseV2alpha1Client) ArangoCollections(namespace string) ArangoCollectionInterface {
+	return newArangoCollections(c, namespace)
+}
+
+func (c *DatabaseV2alpha1Client) ArangoDatabases(namespace string) ArangoDatabaseInterface {
+	return newArangoDatabases(c, namespace)
+}
+
 func (c *DatabaseV2alpha1Client) ArangoDeployments(namespace string) ArangoDeploymentInterface {
 	return newArangoDeployments(c, namespace)
 }
 
+func (c *DatabaseV2alpha1Client) ArangoFoxxServices(namespace string) ArangoFoxxServiceInterface {
+	return newArangoFoxxServices(c, namespace)
+}
+
 func (c *DatabaseV2alpha1Client) ArangoMembers(namespace string) ArangoMemberInterface {
 	return newArangoMembers(c, namespace)
 }
@@ -57,6 +73,10 @@ func (c *DatabaseV2alpha1Client) ArangoTasks(namespace string) ArangoTaskInterfa
 	return newArangoTasks(c, namespace)
 }
 
+func (c *DatabaseV2alpha1Client) ArangoUsers(namespace string) ArangoUserInterface {
+	return newArangoUsers(c, namespace)
+}
+
 // NewForConfig creates a new DatabaseV2alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*DatabaseV2alpha1Client, error) {
 	config := *c
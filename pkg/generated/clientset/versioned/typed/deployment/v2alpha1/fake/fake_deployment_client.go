@@ -36,10 +36,22 @@ func (c *FakeDatabaseV2alpha1) ArangoClusterSynchronizations(namespace string) v
 	return &FakeArangoClusterSynchronizations{c, namespace}
 }
 
+func (c *FakeDatabaseV2alpha1) ArangoCollections(namespace string) v2alpha1.ArangoCollectionInterface {
+	return &FakeArangoCollections{c, namespace}
+}
+
+func (c *FakeDatabaseV2alpha1) ArangoDatabases(namespace string) v2alpha1.ArangoDatabaseInterface {
+	return &FakeArangoDatabases{c, namespace}
+}
+
 func (c *FakeDatabaseV2alpha1) ArangoDeployments(namespace string) v2alpha1.ArangoDeploymentInterface {
 	return &FakeArangoDeployments{c, namespace}
 }
 
+func (c *FakeDatabaseV2alpha1) ArangoFoxxServices(namespace string) v2alpha1.ArangoFoxxServiceInterface {
+	return &FakeArangoFoxxServices{c, namespace}
+}
+
 func (c *FakeDatabaseV2alpha1) ArangoMembers(namespace string) v2alpha1.ArangoMemberInterface {
 	return &FakeArangoMembers{c, namespace}
 }
@@ -48,6 +60,10 @@ func (c *FakeDatabaseV2alpha1) ArangoTasks(namespace string) v2alpha1.ArangoTask
 	return &FakeArangoTasks{c, namespace}
 }
 
+func (c *FakeDatabaseV2alpha1) ArangoUsers(namespace string) v2alpha1.ArangoUserInterface {
+	return &FakeArangoUsers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeDatabaseV2alpha1) RESTClient() rest.Interface {
@@ -32,6 +32,8 @@ type Interface interface {
 	ArangoBackups() ArangoBackupInformer
 	// ArangoBackupPolicies returns a ArangoBackupPolicyInformer.
 	ArangoBackupPolicies() ArangoBackupPolicyInformer
+	// ArangoVolumeSnapshotSets returns a ArangoVolumeSnapshotSetInformer.
+	ArangoVolumeSnapshotSets() ArangoVolumeSnapshotSetInformer
 }
 
 type version struct {
@@ -54,3 +56,8 @@ func (v *version) ArangoBackups() ArangoBackupInformer {
 func (v *version) ArangoBackupPolicies() ArangoBackupPolicyInformer {
 	return &arangoBackupPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ArangoVolumeSnapshotSets returns a ArangoVolumeSnapshotSetInformer.
+func (v *version) ArangoVolumeSnapshotSets() ArangoVolumeSnapshotSetInformer {
+	return &arangoVolumeSnapshotSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,94 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	deploymentv1 "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	versioned "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/arangodb/kube-arangodb/pkg/generated/informers/externalversions/internalinterfaces"
+	v1 "github.com/arangodb/kube-arangodb/pkg/generated/listers/deployment/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ArangoCollectionInformer provides access to a shared informer and lister for
+// ArangoCollections.
+type ArangoCollectionInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.ArangoCollectionLister
+}
+
+type arangoCollectionInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewArangoCollectionInformer constructs a new informer for ArangoCollection type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewArangoCollectionInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredArangoCollectionInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredArangoCollectionInformer constructs a new informer for ArangoCollection type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredArangoCollectionInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.DatabaseV1().ArangoCollections(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.DatabaseV1().ArangoCollections(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&deploymentv1.ArangoCollection{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *arangoCollectionInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredArangoCollectionInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *arangoCollectionInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&deploymentv1.ArangoCollection{}, f.defaultInformer)
+}
+
+func (f *arangoCollectionInformer) Lister() v1.ArangoCollectionLister {
+	return v1.NewArangoCollectionLister(f.Informer().GetIndexer())
+}
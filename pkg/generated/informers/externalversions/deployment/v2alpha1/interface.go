@@ -30,12 +30,20 @@ import (
 type Interface interface {
 	// ArangoClusterSynchronizations returns a ArangoClusterSynchronizationInformer.
 	ArangoClusterSynchronizations() ArangoClusterSynchronizationInformer
+	// ArangoCollections returns a ArangoCollectionInformer.
+	ArangoCollections() ArangoCollectionInformer
+	// ArangoDatabases returns a ArangoDatabaseInformer.
+	ArangoDatabases() ArangoDatabaseInformer
 	// ArangoDeployments returns a ArangoDeploymentInformer.
 	ArangoDeployments() ArangoDeploymentInformer
+	// ArangoFoxxServices returns a ArangoFoxxServiceInformer.
+	ArangoFoxxServices() ArangoFoxxServiceInformer
 	// ArangoMembers returns a ArangoMemberInformer.
 	ArangoMembers() ArangoMemberInformer
 	// ArangoTasks returns a ArangoTaskInformer.
 	ArangoTasks() ArangoTaskInformer
+	// ArangoUsers returns a ArangoUserInformer.
+	ArangoUsers() ArangoUserInformer
 }
 
 type version struct {
@@ -54,11 +62,26 @@ func (v *version) ArangoClusterSynchronizations() ArangoClusterSynchronizationIn
 	return &arangoClusterSynchronizationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ArangoCollections returns a ArangoCollectionInformer.
+func (v *version) ArangoCollections() ArangoCollectionInformer {
+	return &arangoCollectionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ArangoDatabases returns a ArangoDatabaseInformer.
+func (v *version) ArangoDatabases() ArangoDatabaseInformer {
+	return &arangoDatabaseInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ArangoDeployments returns a ArangoDeploymentInformer.
 func (v *version) ArangoDeployments() ArangoDeploymentInformer {
 	return &arangoDeploymentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ArangoFoxxServices returns a ArangoFoxxServiceInformer.
+func (v *version) ArangoFoxxServices() ArangoFoxxServiceInformer {
+	return &arangoFoxxServiceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ArangoMembers returns a ArangoMemberInformer.
 func (v *version) ArangoMembers() ArangoMemberInformer {
 	return &arangoMemberInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -68,3 +91,8 @@ func (v *version) ArangoMembers() ArangoMemberInformer {
 func (v *version) ArangoTasks() ArangoTaskInformer {
 	return &arangoTaskInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ArangoUsers returns a ArangoUserInformer.
+func (v *version) ArangoUsers() ArangoUserInformer {
+	return &arangoUserInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
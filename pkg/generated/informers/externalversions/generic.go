@@ -71,26 +71,44 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Backup().V1().ArangoBackups().Informer()}, nil
 	case backupv1.SchemeGroupVersion.WithResource("arangobackuppolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Backup().V1().ArangoBackupPolicies().Informer()}, nil
+	case backupv1.SchemeGroupVersion.WithResource("arangovolumesnapshotsets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Backup().V1().ArangoVolumeSnapshotSets().Informer()}, nil
 
 		// Group=database.arangodb.com, Version=v1
 	case deploymentv1.SchemeGroupVersion.WithResource("arangoclustersynchronizations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoClusterSynchronizations().Informer()}, nil
+	case deploymentv1.SchemeGroupVersion.WithResource("arangocollections"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoCollections().Informer()}, nil
+	case deploymentv1.SchemeGroupVersion.WithResource("arangodatabases"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoDatabases().Informer()}, nil
 	case deploymentv1.SchemeGroupVersion.WithResource("arangodeployments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoDeployments().Informer()}, nil
+	case deploymentv1.SchemeGroupVersion.WithResource("arangofoxxservices"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoFoxxServices().Informer()}, nil
 	case deploymentv1.SchemeGroupVersion.WithResource("arangomembers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoMembers().Informer()}, nil
 	case deploymentv1.SchemeGroupVersion.WithResource("arangotasks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoTasks().Informer()}, nil
+	case deploymentv1.SchemeGroupVersion.WithResource("arangousers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoUsers().Informer()}, nil
 
 		// Group=database.arangodb.com, Version=v2alpha1
 	case v2alpha1.SchemeGroupVersion.WithResource("arangoclustersynchronizations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoClusterSynchronizations().Informer()}, nil
+	case v2alpha1.SchemeGroupVersion.WithResource("arangocollections"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoCollections().Informer()}, nil
+	case v2alpha1.SchemeGroupVersion.WithResource("arangodatabases"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoDatabases().Informer()}, nil
 	case v2alpha1.SchemeGroupVersion.WithResource("arangodeployments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoDeployments().Informer()}, nil
+	case v2alpha1.SchemeGroupVersion.WithResource("arangofoxxservices"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoFoxxServices().Informer()}, nil
 	case v2alpha1.SchemeGroupVersion.WithResource("arangomembers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoMembers().Informer()}, nil
 	case v2alpha1.SchemeGroupVersion.WithResource("arangotasks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoTasks().Informer()}, nil
+	case v2alpha1.SchemeGroupVersion.WithResource("arangousers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoUsers().Informer()}, nil
 
 		// Group=replication.database.arangodb.com, Version=v1
 	case replicationv1.SchemeGroupVersion.WithResource("arangodeploymentreplications"):
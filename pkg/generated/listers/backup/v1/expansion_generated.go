@@ -37,3 +37,11 @@ type ArangoBackupPolicyListerExpansion interface{}
 // ArangoBackupPolicyNamespaceListerExpansion allows custom methods to be added to
 // ArangoBackupPolicyNamespaceLister.
 type ArangoBackupPolicyNamespaceListerExpansion interface{}
+
+// ArangoVolumeSnapshotSetListerExpansion allows custom methods to be added to
+// ArangoVolumeSnapshotSetLister.
+type ArangoVolumeSnapshotSetListerExpansion interface{}
+
+// ArangoVolumeSnapshotSetNamespaceListerExpansion allows custom methods to be added to
+// ArangoVolumeSnapshotSetNamespaceLister.
+type ArangoVolumeSnapshotSetNamespaceListerExpansion interface{}
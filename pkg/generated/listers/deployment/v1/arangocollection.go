@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ArangoCollectionLister helps list ArangoCollections.
+// All objects returned here must be treated as read-only.
+type ArangoCollectionLister interface {
+	// List lists all ArangoCollections in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.ArangoCollection, err error)
+	// ArangoCollections returns an object that can list and get ArangoCollections.
+	ArangoCollections(namespace string) ArangoCollectionNamespaceLister
+	ArangoCollectionListerExpansion
+}
+
+// arangoCollectionLister implements the ArangoCollectionLister interface.
+type arangoCollectionLister struct {
+	indexer cache.Indexer
+}
+
+// NewArangoCollectionLister returns a new ArangoCollectionLister.
+func NewArangoCollectionLister(indexer cache.Indexer) ArangoCollectionLister {
+	return &arangoCollectionLister{indexer: indexer}
+}
+
+// List lists all ArangoCollections in the indexer.
+func (s *arangoCollectionLister) List(selector labels.Selector) (ret []*v1.ArangoCollection, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.ArangoCollection))
+	})
+	return ret, err
+}
+
+// ArangoCollections returns an object that can list and get ArangoCollections.
+func (s *arangoCollectionLister) ArangoCollections(namespace string) ArangoCollectionNamespaceLister {
+	return arangoCollectionNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ArangoCollectionNamespaceLister helps list and get ArangoCollections.
+// All objects returned here must be treated as read-only.
+type ArangoCollectionNamespaceLister interface {
+	// List lists all ArangoCollections in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.ArangoCollection, err error)
+	// Get retrieves the ArangoCollection from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.ArangoCollection, error)
+	ArangoCollectionNamespaceListerExpansion
+}
+
+// arangoCollectionNamespaceLister implements the ArangoCollectionNamespaceLister
+// interface.
+type arangoCollectionNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ArangoCollections in the indexer for a given namespace.
+func (s arangoCollectionNamespaceLister) List(selector labels.Selector) (ret []*v1.ArangoCollection, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.ArangoCollection))
+	})
+	return ret, err
+}
+
+// Get retrieves the ArangoCollection from the indexer for a given namespace and name.
+func (s arangoCollectionNamespaceLister) Get(name string) (*v1.ArangoCollection, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("arangocollection"), name)
+	}
+	return obj.(*v1.ArangoCollection), nil
+}
@@ -30,6 +30,22 @@ type ArangoClusterSynchronizationListerExpansion interface{}
 // ArangoClusterSynchronizationNamespaceLister.
 type ArangoClusterSynchronizationNamespaceListerExpansion interface{}
 
+// ArangoCollectionListerExpansion allows custom methods to be added to
+// ArangoCollectionLister.
+type ArangoCollectionListerExpansion interface{}
+
+// ArangoCollectionNamespaceListerExpansion allows custom methods to be added to
+// ArangoCollectionNamespaceLister.
+type ArangoCollectionNamespaceListerExpansion interface{}
+
+// ArangoDatabaseListerExpansion allows custom methods to be added to
+// ArangoDatabaseLister.
+type ArangoDatabaseListerExpansion interface{}
+
+// ArangoDatabaseNamespaceListerExpansion allows custom methods to be added to
+// ArangoDatabaseNamespaceLister.
+type ArangoDatabaseNamespaceListerExpansion interface{}
+
 // ArangoDeploymentListerExpansion allows custom methods to be added to
 // ArangoDeploymentLister.
 type ArangoDeploymentListerExpansion interface{}
@@ -38,6 +54,14 @@ type ArangoDeploymentListerExpansion interface{}
 // ArangoDeploymentNamespaceLister.
 type ArangoDeploymentNamespaceListerExpansion interface{}
 
+// ArangoFoxxServiceListerExpansion allows custom methods to be added to
+// ArangoFoxxServiceLister.
+type ArangoFoxxServiceListerExpansion interface{}
+
+// ArangoFoxxServiceNamespaceListerExpansion allows custom methods to be added to
+// ArangoFoxxServiceNamespaceLister.
+type ArangoFoxxServiceNamespaceListerExpansion interface{}
+
 // ArangoMemberListerExpansion allows custom methods to be added to
 // ArangoMemberLister.
 type ArangoMemberListerExpansion interface{}
@@ -53,3 +77,11 @@ type ArangoTaskListerExpansion interface{}
 // ArangoTaskNamespaceListerExpansion allows custom methods to be added to
 // ArangoTaskNamespaceLister.
 type ArangoTaskNamespaceListerExpansion interface{}
+
+// ArangoUserListerExpansion allows custom methods to be added to
+// ArangoUserLister.
+type ArangoUserListerExpansion interface{}
+
+// ArangoUserNamespaceListerExpansion allows custom methods to be added to
+// ArangoUserNamespaceLister.
+type ArangoUserNamespaceListerExpansion interface{}
@@ -35,6 +35,8 @@ import (
 
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 
 	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
@@ -55,11 +57,14 @@ func newFakeHandler() *handler {
 	k := fake.NewSimpleClientset()
 
 	return &handler{
-		client:     f,
-		kubeClient: k,
+		client:        f,
+		kubeClient:    k,
+		dynamicClient: dynamicFake.NewSimpleDynamicClient(runtime.NewScheme()),
 
 		arangoClientTimeout: defaultArangoClientTimeout,
 		eventRecorder:       newEventInstance(event.NewEventRecorder(log.Logger, "mock", k)),
+
+		log: log.Logger,
 	}
 }
 
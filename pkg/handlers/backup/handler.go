@@ -33,17 +33,18 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util"
 
 	"github.com/arangodb/go-driver"
-	"github.com/arangodb/kube-arangodb/pkg/handlers/utils"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"k8s.io/apimachinery/pkg/util/uuid"
 
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
 	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
@@ -53,8 +54,6 @@ import (
 
 const (
 	defaultArangoClientTimeout = 30 * time.Second
-	retryCount                 = 25
-	retryDelay                 = time.Second
 
 	// StateChange name of the event send when state changed
 	StateChange = "StateChange"
@@ -67,8 +66,9 @@ type handler struct {
 	lock  sync.Mutex
 	locks map[string]*sync.Mutex
 
-	client     arangoClientSet.Interface
-	kubeClient kubernetes.Interface
+	client        arangoClientSet.Interface
+	kubeClient    kubernetes.Interface
+	dynamicClient dynamic.Interface
 
 	eventRecorder event.RecorderInstance
 
@@ -76,6 +76,8 @@ type handler struct {
 	arangoClientTimeout time.Duration
 
 	operator operator.Operator
+
+	log zerolog.Logger
 }
 
 func (h *handler) Start(stopCh <-chan struct{}) {
@@ -91,11 +93,11 @@ func (h *handler) start(stopCh <-chan struct{}) {
 		case <-stopCh:
 			return
 		case <-t.C:
-			log.Debug().Msgf("Refreshing database objects")
+			h.log.Debug().Msgf("Refreshing database objects")
 			if err := h.refresh(); err != nil {
-				log.Error().Err(err).Msgf("Unable to refresh database objects")
+				h.log.Error().Err(err).Msgf("Unable to refresh database objects")
 			}
-			log.Debug().Msgf("Database objects refreshed")
+			h.log.Debug().Msgf("Database objects refreshed")
 		}
 	}
 }
@@ -198,17 +200,21 @@ func (h *handler) Name() string {
 	return backup.ArangoBackupResourceKind
 }
 
+// updateBackupStatus updates the status of the backup, the status is applied again to the reloaded object on conflict
 func (h *handler) updateBackupStatus(b *backupApi.ArangoBackup) error {
-	return utils.Retry(retryCount, retryDelay, func() error {
-		backup, err := h.client.BackupV1().ArangoBackups(b.Namespace).Get(context.Background(), b.Name, meta.GetOptions{})
+	backups := h.client.BackupV1().ArangoBackups(b.Namespace)
+	backup := b.DeepCopy()
+	return k8sutil.RetryOnConflict(func() error {
+		backup.Status = b.Status
+		_, err := backups.UpdateStatus(context.Background(), backup, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := backups.Get(context.Background(), b.Name, meta.GetOptions{})
 		if err != nil {
 			return err
 		}
-
-		backup.Status = b.Status
-
-		_, err = h.client.BackupV1().ArangoBackups(b.Namespace).UpdateStatus(context.Background(), backup, meta.UpdateOptions{})
-		return err
+		backup = current
+		return nil
 	})
 }
 
@@ -242,7 +248,7 @@ func (h *handler) Handle(item operation.Item) error {
 
 	// Check if we should start finalizer
 	if b.DeletionTimestamp != nil {
-		log.Debug().Msgf("Finalizing %s %s/%s",
+		h.log.Debug().Msgf("Finalizing %s %s/%s",
 			item.Kind,
 			item.Namespace,
 			item.Name)
@@ -253,7 +259,7 @@ func (h *handler) Handle(item operation.Item) error {
 	// Add finalizers
 	if !hasFinalizers(b) {
 		b.Finalizers = appendFinalizers(b)
-		log.Info().Msgf("Updating finalizers %s %s/%s",
+		h.log.Info().Msgf("Updating finalizers %s %s/%s",
 			item.Kind,
 			item.Namespace,
 			item.Name)
@@ -295,7 +301,7 @@ func (h *handler) Handle(item operation.Item) error {
 
 	status, err := h.processArangoBackup(b.DeepCopy())
 	if err != nil {
-		log.Warn().Err(err).Msgf("Fail for %s %s/%s",
+		h.log.Warn().Err(err).Msgf("Fail for %s %s/%s",
 			item.Kind,
 			item.Namespace,
 			item.Name)
@@ -350,7 +356,7 @@ func (h *handler) Handle(item operation.Item) error {
 
 	b.Status = *status
 
-	log.Debug().Msgf("Updating %s %s/%s",
+	h.log.Debug().Msgf("Updating %s %s/%s",
 		item.Kind,
 		item.Namespace,
 		item.Name)
@@ -27,7 +27,6 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
 
 	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
-	"github.com/rs/zerolog/log"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -36,17 +35,17 @@ var _ operator.LifecyclePreStart = &handler{}
 // LifecyclePreStart is executed before operator starts to work, additional checks can be placed here
 // Wait for CR to be present
 func (h *handler) LifecyclePreStart() error {
-	log.Info().Msgf("Starting Lifecycle PreStart for %s", h.Name())
+	h.log.Info().Msgf("Starting Lifecycle PreStart for %s", h.Name())
 
 	defer func() {
-		log.Info().Msgf("Lifecycle PreStart for %s completed", h.Name())
+		h.log.Info().Msgf("Lifecycle PreStart for %s completed", h.Name())
 	}()
 
 	for {
 		_, err := h.client.BackupV1().ArangoBackups(h.operator.Namespace()).List(context.Background(), meta.ListOptions{})
 
 		if err != nil {
-			log.Warn().Err(err).Msgf("CR for %s not found", backup.ArangoBackupResourceKind)
+			h.log.Warn().Err(err).Msgf("CR for %s not found", backup.ArangoBackupResourceKind)
 
 			time.Sleep(250 * time.Millisecond)
 			continue
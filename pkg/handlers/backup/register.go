@@ -27,6 +27,8 @@ import (
 	arangoInformer "github.com/arangodb/kube-arangodb/pkg/generated/informers/externalversions"
 	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/rs/zerolog"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -37,7 +39,7 @@ func newEventInstance(recorder event.Recorder) event.RecorderInstance {
 }
 
 // RegisterInformer into operator
-func RegisterInformer(operator operator.Operator, recorder event.Recorder, client arangoClientSet.Interface, kubeClient kubernetes.Interface, informer arangoInformer.SharedInformerFactory) error {
+func RegisterInformer(operator operator.Operator, recorder event.Recorder, client arangoClientSet.Interface, kubeClient kubernetes.Interface, dynamicClient dynamic.Interface, informer arangoInformer.SharedInformerFactory, log zerolog.Logger) error {
 	if err := operator.RegisterInformer(informer.Backup().V1().ArangoBackups().Informer(),
 		backupApi.SchemeGroupVersion.Group,
 		backupApi.SchemeGroupVersion.Version,
@@ -46,14 +48,17 @@ func RegisterInformer(operator operator.Operator, recorder event.Recorder, clien
 	}
 
 	h := &handler{
-		client:     client,
-		kubeClient: kubeClient,
+		client:        client,
+		kubeClient:    kubeClient,
+		dynamicClient: dynamicClient,
 
 		eventRecorder: newEventInstance(recorder),
 
 		operator: operator,
 
 		arangoClientTimeout: defaultArangoClientTimeout,
+
+		log: log,
 	}
 	h.arangoClientFactory = newArangoClientBackupFactory(h)
 
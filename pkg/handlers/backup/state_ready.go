@@ -67,6 +67,16 @@ func stateReadyHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.A
 		)
 	}
 
+	// Snapshot member volumes containing the backup
+	volumeSnapshots, err := h.ensureVolumeSnapshots(deployment, backup)
+	if err != nil {
+		return wrapUpdateStatus(backup,
+			updateStatusState(backupApi.ArangoBackupStateReady, "Unable to create volume snapshots: %s", err.Error()),
+			updateStatusBackup(backupMeta),
+			updateStatusAvailable(true),
+		)
+	}
+
 	// Check if upload flag was specified later in runtime
 	if backup.Spec.Upload != nil &&
 		(backup.Status.Backup.Uploaded == nil || (backup.Status.Backup.Uploaded != nil && !*backup.Status.Backup.Uploaded)) {
@@ -81,6 +91,7 @@ func stateReadyHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.A
 				updateStatusState(backupApi.ArangoBackupStateReady, "Upload process queued"),
 				updateStatusBackup(backupMeta),
 				updateStatusAvailable(true),
+				updateStatusVolumeSnapshots(volumeSnapshots),
 			)
 		}
 
@@ -88,6 +99,7 @@ func stateReadyHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.A
 			updateStatusState(backupApi.ArangoBackupStateUpload, ""),
 			updateStatusBackup(backupMeta),
 			updateStatusAvailable(true),
+			updateStatusVolumeSnapshots(volumeSnapshots),
 		)
 	}
 
@@ -97,11 +109,14 @@ func stateReadyHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.A
 			updateStatusBackup(backupMeta),
 			updateStatusBackupUpload(nil),
 			updateStatusAvailable(true),
+			updateStatusVolumeSnapshots(volumeSnapshots),
 		)
 	}
 
 	return wrapUpdateStatus(backup,
+		updateStatusState(backupApi.ArangoBackupStateReady, ""),
 		updateStatusBackup(backupMeta),
 		updateStatusAvailable(true),
+		updateStatusVolumeSnapshots(volumeSnapshots),
 	)
 }
@@ -59,6 +59,12 @@ func updateStatusState(state state.State, template string, a ...interface{}) upd
 	}
 }
 
+func updateStatusVolumeSnapshots(snapshots *backupApi.ArangoBackupStatusVolumeSnapshots) updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		status.VolumeSnapshots = snapshots
+	}
+}
+
 func updateStatusAvailable(available bool) updateStatusFunc {
 	return func(status *backupApi.ArangoBackupStatus) {
 		status.Available = available
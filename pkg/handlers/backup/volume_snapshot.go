@@ -0,0 +1,182 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var volumeSnapshotResource = schema.GroupVersionResource{
+	Group:    "snapshot.storage.k8s.io",
+	Version:  "v1",
+	Resource: "volumesnapshots",
+}
+
+// ensureVolumeSnapshots ensures that the ArangoVolumeSnapshotSet with VolumeSnapshots of all member volumes
+// exists for the backup and returns its state. Returns nil when volume snapshots are not requested.
+// Once all snapshots are ready, the final state is kept and the snapshots are not checked anymore.
+func (h *handler) ensureVolumeSnapshots(deployment *database.ArangoDeployment, backup *backupApi.ArangoBackup) (*backupApi.ArangoBackupStatusVolumeSnapshots, error) {
+	if backup.Spec.VolumeSnapshot == nil || backup.Status.Backup == nil {
+		return nil, nil
+	}
+
+	if current := backup.Status.VolumeSnapshots; current != nil && current.Ready {
+		return current, nil
+	}
+
+	sets := h.client.BackupV1().ArangoVolumeSnapshotSets(backup.Namespace)
+
+	set, err := sets.Get(context.Background(), backup.Name, meta.GetOptions{})
+	if err != nil {
+		if !apiErrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		set, err = sets.Create(context.Background(), newVolumeSnapshotSet(deployment, backup), meta.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status, err := h.refreshVolumeSnapshots(set)
+	if err != nil {
+		return nil, err
+	}
+
+	if !status.Equal(&set.Status) {
+		set.Status = *status
+		if _, err := sets.UpdateStatus(context.Background(), set, meta.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &backupApi.ArangoBackupStatusVolumeSnapshots{
+		Name:  set.Name,
+		Ready: status.Ready,
+	}, nil
+}
+
+// newVolumeSnapshotSet returns the ArangoVolumeSnapshotSet recording a VolumeSnapshot for each member volume.
+func newVolumeSnapshotSet(deployment *database.ArangoDeployment, backup *backupApi.ArangoBackup) *backupApi.ArangoVolumeSnapshotSet {
+	set := &backupApi.ArangoVolumeSnapshotSet{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      backup.Name,
+			Namespace: backup.Namespace,
+			Labels:    k8sutil.LabelsForDeployment(deployment.Name, ""),
+			OwnerReferences: []meta.OwnerReference{
+				backup.AsOwner(),
+			},
+		},
+		Spec: backupApi.ArangoVolumeSnapshotSetSpec{
+			Deployment: backup.Spec.Deployment,
+			Backup:     backup.Name,
+			BackupID:   backup.Status.Backup.ID,
+			ClassName:  backup.Spec.VolumeSnapshot.GetClassName(),
+		},
+	}
+
+	for _, member := range deployment.Status.Members.AsList() {
+		if member.Member.PersistentVolumeClaimName == "" {
+			continue
+		}
+
+		set.Spec.Snapshots = append(set.Spec.Snapshots, backupApi.ArangoVolumeSnapshotSetSnapshot{
+			Group:                     member.Group.AsRole(),
+			ID:                        member.Member.ID,
+			PersistentVolumeClaimName: member.Member.PersistentVolumeClaimName,
+			VolumeSnapshotName:        strings.ToLower(fmt.Sprintf("%s-%s", backup.Name, member.Member.ID)),
+		})
+	}
+
+	return set
+}
+
+// refreshVolumeSnapshots creates missing VolumeSnapshots of the set and returns their state.
+func (h *handler) refreshVolumeSnapshots(set *backupApi.ArangoVolumeSnapshotSet) (*backupApi.ArangoVolumeSnapshotSetStatus, error) {
+	snapshots := h.dynamicClient.Resource(volumeSnapshotResource).Namespace(set.Namespace)
+
+	status := &backupApi.ArangoVolumeSnapshotSetStatus{
+		Ready: true,
+	}
+
+	for _, s := range set.Spec.Snapshots {
+		vs, err := snapshots.Get(context.Background(), s.VolumeSnapshotName, meta.GetOptions{})
+		if err != nil {
+			if !apiErrors.IsNotFound(err) {
+				return nil, err
+			}
+
+			vs, err = snapshots.Create(context.Background(), newVolumeSnapshot(set, s), meta.CreateOptions{})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		ready, _, _ := unstructured.NestedBool(vs.Object, "status", "readyToUse")
+		message, _, _ := unstructured.NestedString(vs.Object, "status", "error", "message")
+
+		status.Snapshots = append(status.Snapshots, backupApi.ArangoVolumeSnapshotSetSnapshotStatus{
+			Name:  s.VolumeSnapshotName,
+			Ready: ready,
+			Error: message,
+		})
+
+		status.Ready = status.Ready && ready
+	}
+
+	return status, nil
+}
+
+// newVolumeSnapshot returns the VolumeSnapshot of the member volume.
+func newVolumeSnapshot(set *backupApi.ArangoVolumeSnapshotSet, snapshot backupApi.ArangoVolumeSnapshotSetSnapshot) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": snapshot.PersistentVolumeClaimName,
+		},
+	}
+	if set.Spec.ClassName != nil {
+		spec["volumeSnapshotClassName"] = *set.Spec.ClassName
+	}
+
+	vs := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": spec,
+		},
+	}
+	vs.SetAPIVersion(volumeSnapshotResource.GroupVersion().String())
+	vs.SetKind("VolumeSnapshot")
+	vs.SetName(snapshot.VolumeSnapshotName)
+	vs.SetNamespace(set.Namespace)
+	vs.SetLabels(k8sutil.LabelsForMember(set.Spec.Deployment.Name, snapshot.Group, snapshot.ID))
+	vs.SetOwnerReferences([]meta.OwnerReference{set.AsOwner()})
+
+	return vs
+}
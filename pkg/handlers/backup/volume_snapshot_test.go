@@ -0,0 +1,119 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_State_Ready_VolumeSnapshots(t *testing.T) {
+	// Arrange
+	handler, mock := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateReady)
+	obj.Spec.VolumeSnapshot = &backupApi.ArangoBackupSpecVolumeSnapshot{
+		ClassName: util.NewString("csi-snapclass"),
+	}
+
+	deployment.Status.Members.Agents = database.MemberStatusList{
+		{ID: "AGNT-1", PersistentVolumeClaimName: "agent-pvc"},
+	}
+	deployment.Status.Members.DBServers = database.MemberStatusList{
+		{ID: "PRMR-1", PersistentVolumeClaimName: "dbserver-pvc"},
+	}
+	deployment.Status.Members.Coordinators = database.MemberStatusList{
+		{ID: "CRDN-1"},
+	}
+
+	createResponse, err := mock.Create()
+	require.NoError(t, err)
+
+	backupMeta, err := mock.Get(createResponse.ID)
+	require.NoError(t, err)
+
+	obj.Status.Backup = createBackupFromMeta(backupMeta, nil)
+
+	// Act
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+	require.Equal(t, &backupApi.ArangoBackupStatusVolumeSnapshots{Name: obj.Name, Ready: false}, newObj.Status.VolumeSnapshots)
+
+	set, err := handler.client.BackupV1().ArangoVolumeSnapshotSets(obj.Namespace).Get(context.Background(), obj.Name, meta.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, string(createResponse.ID), set.Spec.BackupID)
+	require.Len(t, set.Spec.Snapshots, 2)
+	require.Len(t, set.Status.Snapshots, 2)
+	require.False(t, set.Status.Ready)
+
+	snapshots := handler.dynamicClient.Resource(volumeSnapshotResource).Namespace(obj.Namespace)
+	for _, s := range set.Spec.Snapshots {
+		vs, err := snapshots.Get(context.Background(), s.VolumeSnapshotName, meta.GetOptions{})
+		require.NoError(t, err)
+
+		pvc, _, _ := unstructured.NestedString(vs.Object, "spec", "source", "persistentVolumeClaimName")
+		require.Equal(t, s.PersistentVolumeClaimName, pvc)
+		class, _, _ := unstructured.NestedString(vs.Object, "spec", "volumeSnapshotClassName")
+		require.Equal(t, "csi-snapclass", class)
+
+		// Mark snapshot as ready
+		require.NoError(t, unstructured.SetNestedField(vs.Object, true, "status", "readyToUse"))
+		_, err = snapshots.Update(context.Background(), vs, meta.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	newObj = refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+	require.Equal(t, &backupApi.ArangoBackupStatusVolumeSnapshots{Name: obj.Name, Ready: true}, newObj.Status.VolumeSnapshots)
+
+	set, err = handler.client.BackupV1().ArangoVolumeSnapshotSets(obj.Namespace).Get(context.Background(), obj.Name, meta.GetOptions{})
+	require.NoError(t, err)
+	require.True(t, set.Status.Ready)
+
+	// Ready snapshots are not checked anymore
+	require.NoError(t, handler.client.BackupV1().ArangoVolumeSnapshotSets(obj.Namespace).Delete(context.Background(), obj.Name, meta.DeleteOptions{}))
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	newObj = refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+	require.Equal(t, &backupApi.ArangoBackupStatusVolumeSnapshots{Name: obj.Name, Ready: true}, newObj.Status.VolumeSnapshots)
+
+	_, err = handler.client.BackupV1().ArangoVolumeSnapshotSets(obj.Namespace).Get(context.Background(), obj.Name, meta.GetOptions{})
+	require.Error(t, err)
+}
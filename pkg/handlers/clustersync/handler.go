@@ -63,8 +63,28 @@ func (h *handler) Handle(item operation.Item) error {
 		return err
 	}
 
-	// Update status on object
-	if _, err = h.client.DatabaseV1().ArangoClusterSynchronizations(item.Namespace).UpdateStatus(context.Background(), clusterSync, meta.UpdateOptions{}); err != nil {
+	if err := clusterSync.Spec.Validate(); err != nil {
+		h.eventRecorder.Warning(clusterSync, "Validation", "Spec validation failed: %s", err.Error())
+		return nil
+	}
+
+	status := clusterSync.Status
+
+	// Update status on object, the status is applied again to the reloaded object on conflict
+	clusterSyncs := h.client.DatabaseV1().ArangoClusterSynchronizations(item.Namespace)
+	err = k8sutil.RetryOnConflict(func() error {
+		clusterSync.Status = status
+		_, err := clusterSyncs.UpdateStatus(context.Background(), clusterSync, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := clusterSyncs.Get(context.Background(), clusterSync.GetName(), meta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		clusterSync = current
+		return nil
+	})
+	if err != nil {
 		h.operator.GetLogger().Error().Msgf("ArangoClusterSynchronizations status update error %v", err)
 		return err
 	}
@@ -0,0 +1,45 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package collection
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+// ArangoClientFactory factory type for creating clients
+type ArangoClientFactory func(deployment *api.ArangoDeployment) (ArangoCollectionClient, error)
+
+// ArangoCollectionClient interface with collection management functionality
+type ArangoCollectionClient interface {
+	// Exists returns true when collection exists in the database
+	Exists(ctx context.Context, database, name string) (bool, error)
+	// Create creates the collection with the given options
+	Create(ctx context.Context, database, name string, options *driver.CreateCollectionOptions) error
+	// Indexes returns names of the indexes of the collection
+	Indexes(ctx context.Context, database, name string) ([]string, error)
+	// EnsureIndex creates the index on the collection
+	EnsureIndex(ctx context.Context, database, name string, index api.ArangoCollectionIndex) error
+	// RemoveIndex removes the index from the collection, missing index is not reported as error
+	RemoveIndex(ctx context.Context, database, name, index string) error
+}
@@ -0,0 +1,146 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package collection
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type arangoClientCollectionImpl struct {
+	driver driver.Client
+}
+
+func newArangoClientCollectionFactory(handler *handler) ArangoClientFactory {
+	return func(deployment *api.ArangoDeployment) (ArangoCollectionClient, error) {
+		client, err := arangod.CreateArangodDatabaseClient(context.Background(), handler.kubeClient.CoreV1(), deployment, false)
+		if err != nil {
+			return nil, err
+		}
+
+		return &arangoClientCollectionImpl{
+			driver: client,
+		}, nil
+	}
+}
+
+func (ac *arangoClientCollectionImpl) Exists(ctx context.Context, database, name string) (bool, error) {
+	db, err := ac.driver.Database(ctx, database)
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to get database %s", database)
+	}
+
+	return db.CollectionExists(ctx, name)
+}
+
+func (ac *arangoClientCollectionImpl) Create(ctx context.Context, database, name string, options *driver.CreateCollectionOptions) error {
+	db, err := ac.driver.Database(ctx, database)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get database %s", database)
+	}
+
+	_, err = db.CreateCollection(ctx, name, options)
+	return err
+}
+
+func (ac *arangoClientCollectionImpl) Indexes(ctx context.Context, database, name string) ([]string, error) {
+	col, err := ac.collection(ctx, database, name)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := col.Indexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(indexes))
+	for id, index := range indexes {
+		names[id] = index.UserName()
+	}
+
+	return names, nil
+}
+
+func (ac *arangoClientCollectionImpl) EnsureIndex(ctx context.Context, database, name string, index api.ArangoCollectionIndex) error {
+	col, err := ac.collection(ctx, database, name)
+	if err != nil {
+		return err
+	}
+
+	switch index.Type {
+	case api.ArangoCollectionIndexTypePersistent:
+		_, _, err = col.EnsurePersistentIndex(ctx, index.Fields, &driver.EnsurePersistentIndexOptions{
+			Name:   index.Name,
+			Unique: util.BoolOrDefault(index.Unique),
+			Sparse: util.BoolOrDefault(index.Sparse),
+		})
+	case api.ArangoCollectionIndexTypeTTL:
+		_, _, err = col.EnsureTTLIndex(ctx, index.Fields[0], util.IntOrDefault(index.ExpireAfter), &driver.EnsureTTLIndexOptions{
+			Name: index.Name,
+		})
+	case api.ArangoCollectionIndexTypeGeo:
+		_, _, err = col.EnsureGeoIndex(ctx, index.Fields, &driver.EnsureGeoIndexOptions{
+			Name:    index.Name,
+			GeoJSON: util.BoolOrDefault(index.GeoJSON),
+		})
+	default:
+		return errors.Newf("index type %s is not supported", index.Type)
+	}
+
+	return err
+}
+
+func (ac *arangoClientCollectionImpl) RemoveIndex(ctx context.Context, database, name, index string) error {
+	col, err := ac.collection(ctx, database, name)
+	if err != nil {
+		return err
+	}
+
+	idx, err := col.Index(ctx, index)
+	if err != nil {
+		if driver.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if err := idx.Remove(ctx); err != nil && !driver.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (ac *arangoClientCollectionImpl) collection(ctx context.Context, database, name string) (driver.Collection, error) {
+	db, err := ac.driver.Database(ctx, database)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get database %s", database)
+	}
+
+	return db.Collection(ctx, name)
+}
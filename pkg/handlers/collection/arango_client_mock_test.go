@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package collection
+
+import (
+	"context"
+	"sync"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+func newMockArangoClientCollectionFactory(mock *mockArangoClientCollection) ArangoClientFactory {
+	return func(deployment *api.ArangoDeployment) (ArangoCollectionClient, error) {
+		return mock, nil
+	}
+}
+
+type mockCollection struct {
+	options *driver.CreateCollectionOptions
+	indexes map[string]api.ArangoCollectionIndex
+}
+
+func newMockArangoClientCollection(databases ...string) *mockArangoClientCollection {
+	m := &mockArangoClientCollection{
+		databases: map[string]map[string]*mockCollection{},
+	}
+
+	for _, database := range databases {
+		m.databases[database] = map[string]*mockCollection{}
+	}
+
+	return m
+}
+
+type mockArangoClientCollection struct {
+	lock sync.Mutex
+
+	databases map[string]map[string]*mockCollection
+}
+
+func (m *mockArangoClientCollection) Exists(ctx context.Context, database, name string) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	db, ok := m.databases[database]
+	if !ok {
+		return false, errors.Newf("database %s not found", database)
+	}
+
+	_, ok = db[name]
+	return ok, nil
+}
+
+func (m *mockArangoClientCollection) Create(ctx context.Context, database, name string, options *driver.CreateCollectionOptions) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.databases[database][name] = &mockCollection{
+		options: options,
+		indexes: map[string]api.ArangoCollectionIndex{},
+	}
+	return nil
+}
+
+func (m *mockArangoClientCollection) Indexes(ctx context.Context, database, name string) ([]string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var names []string
+	for index := range m.databases[database][name].indexes {
+		names = append(names, index)
+	}
+
+	return names, nil
+}
+
+func (m *mockArangoClientCollection) EnsureIndex(ctx context.Context, database, name string, index api.ArangoCollectionIndex) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.databases[database][name].indexes[index.Name] = index
+	return nil
+}
+
+func (m *mockArangoClientCollection) RemoveIndex(ctx context.Context, database, name, index string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.databases[database][name].indexes, index)
+	return nil
+}
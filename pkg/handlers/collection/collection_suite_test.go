@@ -0,0 +1,124 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package collection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newFakeHandler(mock *mockArangoClientCollection) *handler {
+	f := fakeClientSet.NewSimpleClientset()
+	k := fake.NewSimpleClientset()
+
+	return &handler{
+		client:              f,
+		kubeClient:          k,
+		eventRecorder:       newEventInstance(event.NewEventRecorder(log.Logger, "mock", k)),
+		operator:            operator.NewOperator(log.Logger, "mock", "mock", "mock"),
+		arangoClientFactory: newMockArangoClientCollectionFactory(mock),
+	}
+}
+
+func newItemFromCollection(o operation.Operation, collection *api.ArangoCollection) operation.Item {
+	return operation.Item{
+		Group:   api.SchemeGroupVersion.Group,
+		Version: api.SchemeGroupVersion.Version,
+		Kind:    deployment.ArangoCollectionResourceKind,
+
+		Operation: o,
+
+		Namespace: collection.Namespace,
+		Name:      collection.Name,
+	}
+}
+
+func newArangoCollection(name, namespace, deploymentName, database string) *api.ArangoCollection {
+	return &api.ArangoCollection{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoCollectionResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+		Spec: api.ArangoCollectionSpec{
+			DeploymentName: deploymentName,
+			Database:       database,
+		},
+	}
+}
+
+func newArangoDeployment(name, namespace string) *api.ArangoDeployment {
+	return &api.ArangoDeployment{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoDeploymentResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+	}
+}
+
+func createArangoCollection(t *testing.T, h *handler, collection *api.ArangoCollection) {
+	_, err := h.client.DatabaseV1().ArangoCollections(collection.Namespace).Create(context.Background(), collection, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func updateArangoCollection(t *testing.T, h *handler, collection *api.ArangoCollection) {
+	_, err := h.client.DatabaseV1().ArangoCollections(collection.Namespace).Update(context.Background(), collection, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func createArangoDeployment(t *testing.T, h *handler, depl *api.ArangoDeployment) {
+	_, err := h.client.DatabaseV1().ArangoDeployments(depl.Namespace).Create(context.Background(), depl, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func refreshArangoCollection(t *testing.T, h *handler, collection *api.ArangoCollection) *api.ArangoCollection {
+	c, err := h.client.DatabaseV1().ArangoCollections(collection.Namespace).Get(context.Background(), collection.Name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	return c
+}
+
+// handle runs the handler on update event
+func handle(t *testing.T, h *handler, collection *api.ArangoCollection) {
+	require.NoError(t, h.Handle(newItemFromCollection(operation.Update, collection)))
+}
@@ -0,0 +1,213 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package collection
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/utils"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	collectionCreated = "ArangoCollectionCreated"
+	indexCreated      = "ArangoCollectionIndexCreated"
+	indexRemoved      = "ArangoCollectionIndexRemoved"
+	collectionError   = "Error"
+
+	defaultArangoClientTimeout = 30 * time.Second
+)
+
+type handler struct {
+	client        arangoClientSet.Interface
+	kubeClient    kubernetes.Interface
+	eventRecorder event.RecorderInstance
+
+	arangoClientFactory ArangoClientFactory
+
+	operator operator.Operator
+}
+
+func (*handler) Name() string {
+	return deployment.ArangoCollectionResourceKind
+}
+
+func (h *handler) Handle(item operation.Item) error {
+	// Do not act on delete event, collection is retained when ArangoCollection is removed
+	if item.Operation == operation.Delete {
+		return nil
+	}
+
+	// Get Collection object. It also covers NotFound case
+	collection, err := h.client.DatabaseV1().ArangoCollections(item.Namespace).Get(context.Background(), item.Name, meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil
+		}
+		h.operator.GetLogger().Error().Msgf("ArangoCollection fetch error %v", err)
+		return err
+	}
+
+	if collection.DeletionTimestamp != nil {
+		return nil
+	}
+
+	status := h.processArangoCollection(collection.DeepCopy())
+	if reflect.DeepEqual(collection.Status, status) {
+		return nil
+	}
+
+	// Update status on object, the status is applied again to the reloaded object on conflict
+	collections := h.client.DatabaseV1().ArangoCollections(item.Namespace)
+	err = k8sutil.RetryOnConflict(func() error {
+		collection.Status = status
+		_, err := collections.UpdateStatus(context.Background(), collection, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := collections.Get(context.Background(), collection.GetName(), meta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		collection = current
+		return nil
+	})
+	if err != nil {
+		h.operator.GetLogger().Error().Msgf("ArangoCollection status update error %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *handler) processArangoCollection(collection *api.ArangoCollection) api.ArangoCollectionStatus {
+	status := *collection.Status.DeepCopy()
+
+	if err := h.reconcileCollection(collection, &status); err != nil {
+		if status.Conditions.Update(api.ConditionTypeReady, false, "Collection reconciliation failed", err.Error()) {
+			h.eventRecorder.Warning(collection, collectionError, "Collection reconciliation failed: %s", err.Error())
+		}
+		return status
+	}
+
+	status.Conditions.Update(api.ConditionTypeReady, true, "Collection is up to date", "")
+	return status
+}
+
+// reconcileCollection ensures that the collection and its indexes exist in the database.
+// Indexes which were created by the operator and removed from the spec are dropped.
+func (h *handler) reconcileCollection(collection *api.ArangoCollection, status *api.ArangoCollectionStatus) error {
+	if err := collection.Spec.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation failed")
+	}
+
+	client, err := h.getArangoClient(collection)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultArangoClientTimeout)
+	defer cancel()
+
+	database, name := collection.Spec.Database, collection.GetCollectionName()
+
+	exists, err := client.Exists(ctx, database, name)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to check if collection exists")
+	}
+
+	if !exists {
+		if err := client.Create(ctx, database, name, collection.Spec.AsCreateOptions()); err != nil {
+			return errors.Wrapf(err, "Unable to create collection")
+		}
+
+		h.eventRecorder.Normal(collection, collectionCreated, "Collection %s has been created in database %s", name, database)
+	}
+
+	status.Created = true
+
+	current, err := client.Indexes(ctx, database, name)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get indexes")
+	}
+
+	var managed []string
+	for _, index := range collection.Spec.Indexes {
+		managed = append(managed, index.Name)
+
+		if utils.StringList(current).Has(index.Name) {
+			continue
+		}
+
+		if err := client.EnsureIndex(ctx, database, name, index); err != nil {
+			return errors.Wrapf(err, "Unable to create index %s", index.Name)
+		}
+
+		h.eventRecorder.Normal(collection, indexCreated, "Index %s has been created", index.Name)
+	}
+
+	for _, index := range status.Indexes {
+		if utils.StringList(managed).Has(index) || !utils.StringList(current).Has(index) {
+			continue
+		}
+
+		if err := client.RemoveIndex(ctx, database, name, index); err != nil {
+			return errors.Wrapf(err, "Unable to remove index %s", index)
+		}
+
+		h.eventRecorder.Normal(collection, indexRemoved, "Index %s has been removed", index)
+	}
+
+	status.Indexes = managed
+
+	return nil
+}
+
+func (h *handler) getArangoClient(collection *api.ArangoCollection) (ArangoCollectionClient, error) {
+	depl, err := h.client.DatabaseV1().ArangoDeployments(collection.Namespace).Get(context.Background(), collection.Spec.DeploymentName, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get ArangoDeployment %s", collection.Spec.DeploymentName)
+	}
+
+	client, err := h.arangoClientFactory(depl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create client for ArangoDeployment %s", collection.Spec.DeploymentName)
+	}
+
+	return client, nil
+}
+
+func (*handler) CanBeHandled(item operation.Item) bool {
+	return item.Group == api.SchemeGroupVersion.Group &&
+		item.Version == api.SchemeGroupVersion.Version &&
+		item.Kind == deployment.ArangoCollectionResourceKind
+}
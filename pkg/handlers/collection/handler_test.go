@@ -0,0 +1,159 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package collection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+func Test_Collection_Create(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientCollection("db")
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	collection := newArangoCollection("test", namespace, depl.Name, "db")
+	collection.Spec.Type = api.ArangoCollectionTypeEdge.New()
+	collection.Spec.NumberOfShards = util.NewInt(6)
+	collection.Spec.ReplicationFactor = util.NewInt(2)
+	collection.Spec.Indexes = []api.ArangoCollectionIndex{
+		{Name: "by-user", Type: api.ArangoCollectionIndexTypePersistent, Fields: []string{"user"}, Unique: util.NewBool(true)},
+		{Name: "expire", Type: api.ArangoCollectionIndexTypeTTL, Fields: []string{"createdAt"}, ExpireAfter: util.NewInt(3600)},
+	}
+
+	createArangoDeployment(t, handler, depl)
+	createArangoCollection(t, handler, collection)
+
+	// Act
+	handle(t, handler, collection)
+
+	// Assert
+	c := refreshArangoCollection(t, handler, collection)
+	require.True(t, c.Status.Created)
+	require.True(t, c.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Equal(t, []string{"by-user", "expire"}, c.Status.Indexes)
+
+	require.Contains(t, mock.databases["db"], "test")
+	col := mock.databases["db"]["test"]
+	require.Equal(t, driver.CollectionTypeEdge, col.options.Type)
+	require.Equal(t, 6, col.options.NumberOfShards)
+	require.Equal(t, 2, col.options.ReplicationFactor)
+	require.Len(t, col.indexes, 2)
+	require.Equal(t, collection.Spec.Indexes[0], col.indexes["by-user"])
+}
+
+func Test_Collection_Indexes(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientCollection("db")
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	collection := newArangoCollection("test", namespace, depl.Name, "db")
+	collection.Spec.Indexes = []api.ArangoCollectionIndex{
+		{Name: "first", Type: api.ArangoCollectionIndexTypePersistent, Fields: []string{"a"}},
+		{Name: "second", Type: api.ArangoCollectionIndexTypeGeo, Fields: []string{"location"}},
+	}
+
+	createArangoDeployment(t, handler, depl)
+	createArangoCollection(t, handler, collection)
+	handle(t, handler, collection)
+
+	// Index created outside of the operator is not managed
+	require.NoError(t, mock.EnsureIndex(context.Background(), "db", "test", api.ArangoCollectionIndex{Name: "external"}))
+
+	// Act
+	c := refreshArangoCollection(t, handler, collection)
+	c.Spec.Indexes = c.Spec.Indexes[1:]
+	updateArangoCollection(t, handler, c)
+
+	handle(t, handler, collection)
+
+	// Assert
+	c = refreshArangoCollection(t, handler, collection)
+	require.True(t, c.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Equal(t, []string{"second"}, c.Status.Indexes)
+
+	indexes := mock.databases["db"]["test"].indexes
+	require.NotContains(t, indexes, "first")
+	require.Contains(t, indexes, "second")
+	require.Contains(t, indexes, "external")
+}
+
+func Test_Collection_InvalidSpec(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientCollection("db")
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	collection := newArangoCollection("test", namespace, depl.Name, "db")
+	collection.Spec.Indexes = []api.ArangoCollectionIndex{
+		{Name: "expire", Type: api.ArangoCollectionIndexTypeTTL, Fields: []string{"a", "b"}, ExpireAfter: util.NewInt(60)},
+	}
+
+	createArangoDeployment(t, handler, depl)
+	createArangoCollection(t, handler, collection)
+
+	// Act
+	handle(t, handler, collection)
+
+	// Assert
+	c := refreshArangoCollection(t, handler, collection)
+	require.False(t, c.Status.Created)
+	require.False(t, c.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Empty(t, mock.databases["db"])
+}
+
+func Test_Collection_DatabaseMissing(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientCollection()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	collection := newArangoCollection("test", namespace, depl.Name, "db")
+
+	createArangoDeployment(t, handler, depl)
+	createArangoCollection(t, handler, collection)
+
+	// Act
+	handle(t, handler, collection)
+
+	// Assert
+	c := refreshArangoCollection(t, handler, collection)
+	require.False(t, c.Status.Created)
+	require.False(t, c.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Len(t, c.Status.Conditions, 1)
+}
@@ -0,0 +1,41 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package database
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+// ArangoClientFactory factory type for creating clients
+type ArangoClientFactory func(deployment *api.ArangoDeployment) (ArangoDatabaseClient, error)
+
+// ArangoDatabaseClient interface with database management functionality
+type ArangoDatabaseClient interface {
+	// Exists returns true when database exists
+	Exists(ctx context.Context, name string) (bool, error)
+	// Create creates the database with the given options
+	Create(ctx context.Context, name string, options *driver.CreateDatabaseOptions) error
+	// Remove removes the database, missing database is not reported as error
+	Remove(ctx context.Context, name string) error
+}
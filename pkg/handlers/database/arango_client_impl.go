@@ -0,0 +1,72 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package database
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
+)
+
+type arangoClientDatabaseImpl struct {
+	driver driver.Client
+}
+
+func newArangoClientDatabaseFactory(handler *handler) ArangoClientFactory {
+	return func(deployment *api.ArangoDeployment) (ArangoDatabaseClient, error) {
+		client, err := arangod.CreateArangodDatabaseClient(context.Background(), handler.kubeClient.CoreV1(), deployment, false)
+		if err != nil {
+			return nil, err
+		}
+
+		return &arangoClientDatabaseImpl{
+			driver: client,
+		}, nil
+	}
+}
+
+func (ac *arangoClientDatabaseImpl) Exists(ctx context.Context, name string) (bool, error) {
+	return ac.driver.DatabaseExists(ctx, name)
+}
+
+func (ac *arangoClientDatabaseImpl) Create(ctx context.Context, name string, options *driver.CreateDatabaseOptions) error {
+	_, err := ac.driver.CreateDatabase(ctx, name, options)
+	return err
+}
+
+func (ac *arangoClientDatabaseImpl) Remove(ctx context.Context, name string) error {
+	db, err := ac.driver.Database(ctx, name)
+	if err != nil {
+		if driver.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if err := db.Remove(ctx); err != nil && !driver.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
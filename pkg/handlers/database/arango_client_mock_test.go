@@ -0,0 +1,71 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package database
+
+import (
+	"context"
+	"sync"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func newMockArangoClientDatabaseFactory(mock *mockArangoClientDatabase) ArangoClientFactory {
+	return func(deployment *api.ArangoDeployment) (ArangoDatabaseClient, error) {
+		return mock, nil
+	}
+}
+
+func newMockArangoClientDatabase() *mockArangoClientDatabase {
+	return &mockArangoClientDatabase{
+		databases: map[string]*driver.CreateDatabaseOptions{},
+	}
+}
+
+type mockArangoClientDatabase struct {
+	lock sync.Mutex
+
+	databases map[string]*driver.CreateDatabaseOptions
+}
+
+func (m *mockArangoClientDatabase) Exists(ctx context.Context, name string) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	_, ok := m.databases[name]
+	return ok, nil
+}
+
+func (m *mockArangoClientDatabase) Create(ctx context.Context, name string, options *driver.CreateDatabaseOptions) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.databases[name] = options
+	return nil
+}
+
+func (m *mockArangoClientDatabase) Remove(ctx context.Context, name string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.databases, name)
+	return nil
+}
@@ -0,0 +1,124 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newFakeHandler(mock *mockArangoClientDatabase) *handler {
+	f := fakeClientSet.NewSimpleClientset()
+	k := fake.NewSimpleClientset()
+
+	return &handler{
+		client:              f,
+		kubeClient:          k,
+		eventRecorder:       newEventInstance(event.NewEventRecorder(log.Logger, "mock", k)),
+		operator:            operator.NewOperator(log.Logger, "mock", "mock", "mock"),
+		arangoClientFactory: newMockArangoClientDatabaseFactory(mock),
+	}
+}
+
+func newItemFromDatabase(o operation.Operation, database *api.ArangoDatabase) operation.Item {
+	return operation.Item{
+		Group:   api.SchemeGroupVersion.Group,
+		Version: api.SchemeGroupVersion.Version,
+		Kind:    deployment.ArangoDatabaseResourceKind,
+
+		Operation: o,
+
+		Namespace: database.Namespace,
+		Name:      database.Name,
+	}
+}
+
+func newArangoDatabase(name, namespace, deploymentName string) *api.ArangoDatabase {
+	return &api.ArangoDatabase{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoDatabaseResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+		Spec: api.ArangoDatabaseSpec{
+			DeploymentName: deploymentName,
+		},
+	}
+}
+
+func newArangoDeployment(name, namespace string) *api.ArangoDeployment {
+	return &api.ArangoDeployment{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoDeploymentResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+	}
+}
+
+func createArangoDatabase(t *testing.T, h *handler, database *api.ArangoDatabase) {
+	_, err := h.client.DatabaseV1().ArangoDatabases(database.Namespace).Create(context.Background(), database, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func updateArangoDatabase(t *testing.T, h *handler, database *api.ArangoDatabase) {
+	_, err := h.client.DatabaseV1().ArangoDatabases(database.Namespace).Update(context.Background(), database, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func createArangoDeployment(t *testing.T, h *handler, depl *api.ArangoDeployment) {
+	_, err := h.client.DatabaseV1().ArangoDeployments(depl.Namespace).Create(context.Background(), depl, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func refreshArangoDatabase(t *testing.T, h *handler, database *api.ArangoDatabase) *api.ArangoDatabase {
+	d, err := h.client.DatabaseV1().ArangoDatabases(database.Namespace).Get(context.Background(), database.Name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	return d
+}
+
+// handle runs the handler twice, first run adds the finalizer
+func handle(t *testing.T, h *handler, database *api.ArangoDatabase) {
+	require.NoError(t, h.Handle(newItemFromDatabase(operation.Update, database)))
+	require.NoError(t, h.Handle(newItemFromDatabase(operation.Update, database)))
+}
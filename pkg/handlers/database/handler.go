@@ -0,0 +1,232 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package database
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/utils"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	databaseCreated = "ArangoDatabaseCreated"
+	databaseRemoved = "ArangoDatabaseRemoved"
+	databaseError   = "Error"
+
+	defaultArangoClientTimeout = 30 * time.Second
+)
+
+type handler struct {
+	client        arangoClientSet.Interface
+	kubeClient    kubernetes.Interface
+	eventRecorder event.RecorderInstance
+
+	arangoClientFactory ArangoClientFactory
+
+	operator operator.Operator
+}
+
+func (*handler) Name() string {
+	return deployment.ArangoDatabaseResourceKind
+}
+
+func (h *handler) Handle(item operation.Item) error {
+	// Do not act on delete event, deletion policy is applied by the finalizer
+	if item.Operation == operation.Delete {
+		return nil
+	}
+
+	// Get Database object. It also covers NotFound case
+	database, err := h.client.DatabaseV1().ArangoDatabases(item.Namespace).Get(context.Background(), item.Name, meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil
+		}
+		h.operator.GetLogger().Error().Msgf("ArangoDatabase fetch error %v", err)
+		return err
+	}
+
+	if database.DeletionTimestamp != nil {
+		return h.finalize(database)
+	}
+
+	if !utils.StringList(database.Finalizers).Has(api.FinalizerArangoDatabase) {
+		database.Finalizers = append(database.Finalizers, api.FinalizerArangoDatabase)
+		if _, err := h.client.DatabaseV1().ArangoDatabases(item.Namespace).Update(context.Background(), database, meta.UpdateOptions{}); err != nil {
+			h.operator.GetLogger().Error().Msgf("ArangoDatabase finalizer update error %v", err)
+			return err
+		}
+
+		// Update triggers next event
+		return nil
+	}
+
+	status := h.processArangoDatabase(database.DeepCopy())
+	if reflect.DeepEqual(database.Status, status) {
+		return nil
+	}
+
+	// Update status on object, the status is applied again to the reloaded object on conflict
+	databases := h.client.DatabaseV1().ArangoDatabases(item.Namespace)
+	err = k8sutil.RetryOnConflict(func() error {
+		database.Status = status
+		_, err := databases.UpdateStatus(context.Background(), database, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := databases.Get(context.Background(), database.GetName(), meta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		database = current
+		return nil
+	})
+	if err != nil {
+		h.operator.GetLogger().Error().Msgf("ArangoDatabase status update error %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *handler) processArangoDatabase(database *api.ArangoDatabase) api.ArangoDatabaseStatus {
+	status := *database.Status.DeepCopy()
+
+	if err := h.reconcileDatabase(database, &status); err != nil {
+		if status.Conditions.Update(api.ConditionTypeReady, false, "Database reconciliation failed", err.Error()) {
+			h.eventRecorder.Warning(database, databaseError, "Database reconciliation failed: %s", err.Error())
+		}
+		return status
+	}
+
+	status.Conditions.Update(api.ConditionTypeReady, true, "Database exists", "")
+	return status
+}
+
+// reconcileDatabase ensures that the database exists in the deployment
+func (h *handler) reconcileDatabase(database *api.ArangoDatabase, status *api.ArangoDatabaseStatus) error {
+	if err := database.Spec.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation failed")
+	}
+
+	client, err := h.getArangoClient(database)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultArangoClientTimeout)
+	defer cancel()
+
+	name := database.GetDatabaseName()
+
+	exists, err := client.Exists(ctx, name)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to check if database exists")
+	}
+
+	if !exists {
+		if err := client.Create(ctx, name, database.Spec.Options.AsCreateOptions()); err != nil {
+			return errors.Wrapf(err, "Unable to create database")
+		}
+
+		h.eventRecorder.Normal(database, databaseCreated, "Database %s has been created", name)
+	}
+
+	status.Created = true
+
+	return nil
+}
+
+func (h *handler) finalize(database *api.ArangoDatabase) error {
+	if !utils.StringList(database.Finalizers).Has(api.FinalizerArangoDatabase) {
+		return nil
+	}
+
+	if database.Spec.GetDeletionPolicy() == api.ArangoDatabaseDeletionPolicyDelete && database.Status.Created {
+		if err := h.removeDatabase(database); err != nil {
+			h.eventRecorder.Warning(database, databaseError, "Database removal failed: %s", err.Error())
+			return err
+		}
+	}
+
+	database.Finalizers = utils.StringList(database.Finalizers).Remove(api.FinalizerArangoDatabase)
+
+	if _, err := h.client.DatabaseV1().ArangoDatabases(database.Namespace).Update(context.Background(), database, meta.UpdateOptions{}); err != nil {
+		h.operator.GetLogger().Error().Msgf("ArangoDatabase finalizer update error %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *handler) removeDatabase(database *api.ArangoDatabase) error {
+	client, err := h.getArangoClient(database)
+	if err != nil {
+		if k8sutil.IsNotFound(errors.Cause(err)) {
+			// Deployment is gone, nothing to remove
+			return nil
+		}
+
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultArangoClientTimeout)
+	defer cancel()
+
+	if err := client.Remove(ctx, database.GetDatabaseName()); err != nil {
+		return err
+	}
+
+	h.eventRecorder.Normal(database, databaseRemoved, "Database %s has been removed", database.GetDatabaseName())
+	return nil
+}
+
+func (h *handler) getArangoClient(database *api.ArangoDatabase) (ArangoDatabaseClient, error) {
+	depl, err := h.client.DatabaseV1().ArangoDeployments(database.Namespace).Get(context.Background(), database.Spec.DeploymentName, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get ArangoDeployment %s", database.Spec.DeploymentName)
+	}
+
+	client, err := h.arangoClientFactory(depl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create client for ArangoDeployment %s", database.Spec.DeploymentName)
+	}
+
+	return client, nil
+}
+
+func (*handler) CanBeHandled(item operation.Item) bool {
+	return item.Group == api.SchemeGroupVersion.Group &&
+		item.Version == api.SchemeGroupVersion.Version &&
+		item.Kind == deployment.ArangoDatabaseResourceKind
+}
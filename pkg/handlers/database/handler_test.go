@@ -0,0 +1,214 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package database
+
+import (
+	"testing"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+func Test_Database_Create(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientDatabase()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	database := newArangoDatabase("test", namespace, depl.Name)
+	database.Spec.Options = &api.ArangoDatabaseOptions{
+		ReplicationFactor: util.NewInt(3),
+		WriteConcern:      util.NewInt(2),
+	}
+
+	createArangoDeployment(t, handler, depl)
+	createArangoDatabase(t, handler, database)
+
+	// Act
+	handle(t, handler, database)
+
+	// Assert
+	d := refreshArangoDatabase(t, handler, database)
+	require.Contains(t, d.Finalizers, api.FinalizerArangoDatabase)
+	require.True(t, d.Status.Created)
+	require.True(t, d.Status.Conditions.IsTrue(api.ConditionTypeReady))
+
+	require.Contains(t, mock.databases, "test")
+	require.Equal(t, 3, mock.databases["test"].Options.ReplicationFactor)
+	require.Equal(t, 2, mock.databases["test"].Options.WriteConcern)
+}
+
+func Test_Database_StatusConflict(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientDatabase()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	database := newArangoDatabase("test", namespace, depl.Name)
+
+	createArangoDeployment(t, handler, depl)
+	createArangoDatabase(t, handler, database)
+
+	// First run adds the finalizer
+	require.NoError(t, handler.Handle(newItemFromDatabase(operation.Update, database)))
+
+	// Object is changed by another writer before the first status update
+	client := handler.client.(*fakeClientSet.Clientset)
+	gvr := api.SchemeGroupVersion.WithResource("arangodatabases")
+	conflicts := 0
+	client.PrependReactor("update", "arangodatabases", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "status" || conflicts > 0 {
+			return false, nil, nil
+		}
+		conflicts++
+
+		obj, err := client.Tracker().Get(gvr, namespace, database.Name)
+		require.NoError(t, err)
+		current := obj.(*api.ArangoDatabase)
+		current.Labels = map[string]string{"changed": "true"}
+		require.NoError(t, client.Tracker().Update(gvr, current, namespace))
+
+		return true, nil, apiErrors.NewConflict(gvr.GroupResource(), database.Name, nil)
+	})
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromDatabase(operation.Update, database)))
+
+	// Assert
+	require.Equal(t, 1, conflicts)
+
+	d := refreshArangoDatabase(t, handler, database)
+	require.True(t, d.Status.Created)
+	require.True(t, d.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Equal(t, "true", d.Labels["changed"])
+}
+
+func Test_Database_InvalidSpec(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientDatabase()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	database := newArangoDatabase("test", namespace, depl.Name)
+	database.Spec.Options = &api.ArangoDatabaseOptions{
+		ReplicationFactor: util.NewInt(1),
+		WriteConcern:      util.NewInt(2),
+	}
+
+	createArangoDeployment(t, handler, depl)
+	createArangoDatabase(t, handler, database)
+
+	// Act
+	handle(t, handler, database)
+
+	// Assert
+	d := refreshArangoDatabase(t, handler, database)
+	require.False(t, d.Status.Created)
+	require.False(t, d.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Empty(t, mock.databases)
+}
+
+func Test_Database_DeploymentMissing(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientDatabase()
+	handler := newFakeHandler(mock)
+
+	database := newArangoDatabase("test", string(uuid.NewUUID()), "missing")
+	createArangoDatabase(t, handler, database)
+
+	// Act
+	handle(t, handler, database)
+
+	// Assert
+	d := refreshArangoDatabase(t, handler, database)
+	require.False(t, d.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Len(t, d.Status.Conditions, 1)
+	require.Empty(t, mock.databases)
+}
+
+func Test_Database_Finalize(t *testing.T) {
+	type testCase struct {
+		policy  *api.ArangoDatabaseDeletionPolicy
+		removed bool
+	}
+
+	testCases := map[string]testCase{
+		"default": {},
+		"retain": {
+			policy: api.ArangoDatabaseDeletionPolicyRetain.New(),
+		},
+		"delete": {
+			policy:  api.ArangoDatabaseDeletionPolicyDelete.New(),
+			removed: true,
+		},
+	}
+
+	for name, c := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			mock := newMockArangoClientDatabase()
+			handler := newFakeHandler(mock)
+
+			namespace := string(uuid.NewUUID())
+			depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+			database := newArangoDatabase("test", namespace, depl.Name)
+			database.Spec.Name = util.NewString("custom")
+			database.Spec.DeletionPolicy = c.policy
+
+			createArangoDeployment(t, handler, depl)
+			createArangoDatabase(t, handler, database)
+			handle(t, handler, database)
+			require.Contains(t, mock.databases, "custom")
+
+			// Act
+			d := refreshArangoDatabase(t, handler, database)
+			now := meta.Now()
+			d.DeletionTimestamp = &now
+			updateArangoDatabase(t, handler, d)
+
+			handle(t, handler, database)
+
+			// Assert
+			if c.removed {
+				require.NotContains(t, mock.databases, "custom")
+			} else {
+				require.Contains(t, mock.databases, "custom")
+			}
+			require.NotContains(t, refreshArangoDatabase(t, handler, database).Finalizers, api.FinalizerArangoDatabase)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package foxx
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+// ArangoClientFactory factory type for creating clients
+type ArangoClientFactory func(deployment *api.ArangoDeployment) (ArangoFoxxServiceClient, error)
+
+// ServiceSource defines the source of the service, either URL or service archive
+type ServiceSource struct {
+	URL     string
+	Archive []byte
+}
+
+// ArangoFoxxServiceClient interface with Foxx service management functionality
+type ArangoFoxxServiceClient interface {
+	// Get returns the version of the service installed on the mount path and true when service exists
+	Get(ctx context.Context, database, mount string) (string, bool, error)
+	// Install installs or replaces the service on the mount path and returns version of the installed service
+	Install(ctx context.Context, database, mount string, source ServiceSource, replace, setup bool) (string, error)
+	// Uninstall removes the service from the mount path, missing service is not reported as error
+	Uninstall(ctx context.Context, database, mount string, teardown bool) error
+}
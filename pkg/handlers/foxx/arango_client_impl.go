@@ -0,0 +1,164 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package foxx
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
+)
+
+const (
+	// foxxServiceNotFound is returned by the server when no service is installed on the mount path
+	foxxServiceNotFound = 3009
+)
+
+type arangoClientFoxxServiceImpl struct {
+	driver driver.Client
+}
+
+func newArangoClientFoxxServiceFactory(handler *handler) ArangoClientFactory {
+	return func(deployment *api.ArangoDeployment) (ArangoFoxxServiceClient, error) {
+		client, err := arangod.CreateArangodDatabaseClient(context.Background(), handler.kubeClient.CoreV1(), deployment, false)
+		if err != nil {
+			return nil, err
+		}
+
+		return &arangoClientFoxxServiceImpl{
+			driver: client,
+		}, nil
+	}
+}
+
+type foxxServiceInfo struct {
+	Version string `json:"version"`
+}
+
+func (ac *arangoClientFoxxServiceImpl) Get(ctx context.Context, database, mount string) (string, bool, error) {
+	req, err := ac.driver.Connection().NewRequest(http.MethodGet, foxxPath(database, "service"))
+	if err != nil {
+		return "", false, err
+	}
+
+	req.SetQuery("mount", mount)
+
+	resp, err := ac.driver.Connection().Do(ctx, req)
+	if err != nil {
+		return "", false, err
+	}
+
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		if driver.IsNotFound(err) || driver.IsArangoErrorWithErrorNum(err, foxxServiceNotFound) {
+			return "", false, nil
+		}
+
+		return "", false, err
+	}
+
+	var info foxxServiceInfo
+	if err := resp.ParseBody("", &info); err != nil {
+		return "", false, err
+	}
+
+	return info.Version, true, nil
+}
+
+func (ac *arangoClientFoxxServiceImpl) Install(ctx context.Context, database, mount string, source ServiceSource, replace, setup bool) (string, error) {
+	method, p, status := http.MethodPost, foxxPath(database), http.StatusCreated
+	if replace {
+		method, p, status = http.MethodPut, foxxPath(database, "service"), http.StatusOK
+	}
+
+	req, err := ac.driver.Connection().NewRequest(method, p)
+	if err != nil {
+		return "", err
+	}
+
+	req.SetQuery("mount", mount)
+	req.SetQuery("setup", strconv.FormatBool(setup))
+
+	if source.Archive != nil {
+		// Content type has to be set before the body to send the archive as binary data
+		req.SetHeader("Content-Type", "application/zip")
+		req, err = req.SetBody(source.Archive)
+	} else {
+		req, err = req.SetBody(struct {
+			Source string `json:"source"`
+		}{
+			Source: source.URL,
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := ac.driver.Connection().Do(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if err := resp.CheckStatus(status); err != nil {
+		return "", err
+	}
+
+	var info foxxServiceInfo
+	if err := resp.ParseBody("", &info); err != nil {
+		return "", err
+	}
+
+	return info.Version, nil
+}
+
+func (ac *arangoClientFoxxServiceImpl) Uninstall(ctx context.Context, database, mount string, teardown bool) error {
+	req, err := ac.driver.Connection().NewRequest(http.MethodDelete, foxxPath(database, "service"))
+	if err != nil {
+		return err
+	}
+
+	req.SetQuery("mount", mount)
+	req.SetQuery("teardown", strconv.FormatBool(teardown))
+
+	resp, err := ac.driver.Connection().Do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if err := resp.CheckStatus(http.StatusNoContent); err != nil {
+		if driver.IsNotFound(err) || driver.IsArangoErrorWithErrorNum(err, foxxServiceNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// foxxPath returns the path of the Foxx API in the database
+func foxxPath(database string, parts ...string) string {
+	return path.Join(append([]string{"_db", url.PathEscape(database), "_api", "foxx"}, parts...)...)
+}
@@ -0,0 +1,91 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package foxx
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func newMockArangoClientFoxxServiceFactory(mock *mockArangoClientFoxxService) ArangoClientFactory {
+	return func(deployment *api.ArangoDeployment) (ArangoFoxxServiceClient, error) {
+		return mock, nil
+	}
+}
+
+type mockService struct {
+	source   ServiceSource
+	setup    bool
+	installs int
+}
+
+func (m mockService) version() string {
+	return fmt.Sprintf("1.0.%d", m.installs)
+}
+
+func newMockArangoClientFoxxService() *mockArangoClientFoxxService {
+	return &mockArangoClientFoxxService{
+		services: map[string]mockService{},
+	}
+}
+
+type mockArangoClientFoxxService struct {
+	lock sync.Mutex
+
+	services map[string]mockService
+}
+
+func (m *mockArangoClientFoxxService) Get(ctx context.Context, database, mount string) (string, bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	s, ok := m.services[path.Join(database, mount)]
+	if !ok {
+		return "", false, nil
+	}
+
+	return s.version(), true, nil
+}
+
+func (m *mockArangoClientFoxxService) Install(ctx context.Context, database, mount string, source ServiceSource, replace, setup bool) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	s := m.services[path.Join(database, mount)]
+	s.source = source
+	s.setup = setup
+	s.installs++
+
+	m.services[path.Join(database, mount)] = s
+	return s.version(), nil
+}
+
+func (m *mockArangoClientFoxxService) Uninstall(ctx context.Context, database, mount string, teardown bool) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.services, path.Join(database, mount))
+	return nil
+}
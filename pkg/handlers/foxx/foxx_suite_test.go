@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package foxx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newFakeHandler(mock *mockArangoClientFoxxService) *handler {
+	f := fakeClientSet.NewSimpleClientset()
+	k := fake.NewSimpleClientset()
+
+	return &handler{
+		client:              f,
+		kubeClient:          k,
+		eventRecorder:       newEventInstance(event.NewEventRecorder(log.Logger, "mock", k)),
+		operator:            operator.NewOperator(log.Logger, "mock", "mock", "mock"),
+		arangoClientFactory: newMockArangoClientFoxxServiceFactory(mock),
+	}
+}
+
+func newItemFromFoxxService(o operation.Operation, service *api.ArangoFoxxService) operation.Item {
+	return operation.Item{
+		Group:   api.SchemeGroupVersion.Group,
+		Version: api.SchemeGroupVersion.Version,
+		Kind:    deployment.ArangoFoxxServiceResourceKind,
+
+		Operation: o,
+
+		Namespace: service.Namespace,
+		Name:      service.Name,
+	}
+}
+
+func newArangoFoxxService(name, namespace, deploymentName string) *api.ArangoFoxxService {
+	return &api.ArangoFoxxService{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoFoxxServiceResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+		Spec: api.ArangoFoxxServiceSpec{
+			DeploymentName: deploymentName,
+			Database:       "db",
+			Mount:          "/app",
+		},
+	}
+}
+
+func newArangoDeployment(name, namespace string) *api.ArangoDeployment {
+	return &api.ArangoDeployment{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoDeploymentResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+	}
+}
+
+func createArangoFoxxService(t *testing.T, h *handler, service *api.ArangoFoxxService) {
+	_, err := h.client.DatabaseV1().ArangoFoxxServices(service.Namespace).Create(context.Background(), service, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func updateArangoFoxxService(t *testing.T, h *handler, service *api.ArangoFoxxService) {
+	_, err := h.client.DatabaseV1().ArangoFoxxServices(service.Namespace).Update(context.Background(), service, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func createConfigMap(t *testing.T, h *handler, configMap *core.ConfigMap) {
+	_, err := h.kubeClient.CoreV1().ConfigMaps(configMap.Namespace).Create(context.Background(), configMap, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func updateConfigMap(t *testing.T, h *handler, configMap *core.ConfigMap) {
+	_, err := h.kubeClient.CoreV1().ConfigMaps(configMap.Namespace).Update(context.Background(), configMap, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func createArangoDeployment(t *testing.T, h *handler, depl *api.ArangoDeployment) {
+	_, err := h.client.DatabaseV1().ArangoDeployments(depl.Namespace).Create(context.Background(), depl, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func refreshArangoFoxxService(t *testing.T, h *handler, service *api.ArangoFoxxService) *api.ArangoFoxxService {
+	s, err := h.client.DatabaseV1().ArangoFoxxServices(service.Namespace).Get(context.Background(), service.Name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	return s
+}
+
+// handle runs the handler twice, first run adds the finalizer
+func handle(t *testing.T, h *handler, service *api.ArangoFoxxService) {
+	require.NoError(t, h.Handle(newItemFromFoxxService(operation.Update, service)))
+	require.NoError(t, h.Handle(newItemFromFoxxService(operation.Update, service)))
+}
@@ -0,0 +1,269 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package foxx
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/utils"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	serviceInstalled   = "ArangoFoxxServiceInstalled"
+	serviceUpdated     = "ArangoFoxxServiceUpdated"
+	serviceUninstalled = "ArangoFoxxServiceUninstalled"
+	serviceError       = "Error"
+
+	defaultArangoClientTimeout = 2 * time.Minute
+)
+
+type handler struct {
+	client        arangoClientSet.Interface
+	kubeClient    kubernetes.Interface
+	eventRecorder event.RecorderInstance
+
+	arangoClientFactory ArangoClientFactory
+
+	operator operator.Operator
+}
+
+func (*handler) Name() string {
+	return deployment.ArangoFoxxServiceResourceKind
+}
+
+func (h *handler) Handle(item operation.Item) error {
+	// Do not act on delete event, service is uninstalled by the finalizer
+	if item.Operation == operation.Delete {
+		return nil
+	}
+
+	// Get FoxxService object. It also covers NotFound case
+	service, err := h.client.DatabaseV1().ArangoFoxxServices(item.Namespace).Get(context.Background(), item.Name, meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil
+		}
+		h.operator.GetLogger().Error().Msgf("ArangoFoxxService fetch error %v", err)
+		return err
+	}
+
+	if service.DeletionTimestamp != nil {
+		return h.finalize(service)
+	}
+
+	if !utils.StringList(service.Finalizers).Has(api.FinalizerArangoFoxxService) {
+		service.Finalizers = append(service.Finalizers, api.FinalizerArangoFoxxService)
+		if _, err := h.client.DatabaseV1().ArangoFoxxServices(item.Namespace).Update(context.Background(), service, meta.UpdateOptions{}); err != nil {
+			h.operator.GetLogger().Error().Msgf("ArangoFoxxService finalizer update error %v", err)
+			return err
+		}
+
+		// Update triggers next event
+		return nil
+	}
+
+	status := h.processArangoFoxxService(service.DeepCopy())
+	if reflect.DeepEqual(service.Status, status) {
+		return nil
+	}
+
+	// Update status on object, the status is applied again to the reloaded object on conflict
+	services := h.client.DatabaseV1().ArangoFoxxServices(item.Namespace)
+	err = k8sutil.RetryOnConflict(func() error {
+		service.Status = status
+		_, err := services.UpdateStatus(context.Background(), service, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := services.Get(context.Background(), service.GetName(), meta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		service = current
+		return nil
+	})
+	if err != nil {
+		h.operator.GetLogger().Error().Msgf("ArangoFoxxService status update error %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *handler) processArangoFoxxService(service *api.ArangoFoxxService) api.ArangoFoxxServiceStatus {
+	status := *service.Status.DeepCopy()
+
+	if err := h.reconcileService(service, &status); err != nil {
+		if status.Conditions.Update(api.ConditionTypeReady, false, "Service reconciliation failed", err.Error()) {
+			h.eventRecorder.Warning(service, serviceError, "Service reconciliation failed: %s", err.Error())
+		}
+		return status
+	}
+
+	status.Conditions.Update(api.ConditionTypeReady, true, "Service is installed", "")
+	return status
+}
+
+// reconcileService installs the service when it is missing and replaces it when the source has changed
+func (h *handler) reconcileService(service *api.ArangoFoxxService, status *api.ArangoFoxxServiceStatus) error {
+	if err := service.Spec.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation failed")
+	}
+
+	source, checksum, err := h.getSource(service)
+	if err != nil {
+		return err
+	}
+
+	client, err := h.getArangoClient(service)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultArangoClientTimeout)
+	defer cancel()
+
+	database, mount := service.Spec.Database, service.Spec.Mount
+
+	version, exists, err := client.Get(ctx, database, mount)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get service")
+	}
+
+	if exists && status.Checksum == checksum {
+		status.Version = version
+		return nil
+	}
+
+	version, err = client.Install(ctx, database, mount, source, exists, service.Spec.GetSetup())
+	if err != nil {
+		return errors.Wrapf(err, "Unable to install service")
+	}
+
+	if exists {
+		h.eventRecorder.Normal(service, serviceUpdated, "Service on %s has been updated to version %s", mount, version)
+	} else {
+		h.eventRecorder.Normal(service, serviceInstalled, "Service version %s has been installed on %s", version, mount)
+	}
+
+	status.Checksum = checksum
+	status.Version = version
+
+	return nil
+}
+
+// getSource returns the source of the service and its checksum
+func (h *handler) getSource(service *api.ArangoFoxxService) (ServiceSource, string, error) {
+	if u := service.Spec.Source.URL; u != nil {
+		return ServiceSource{URL: *u}, util.SHA256FromString(*u), nil
+	}
+
+	cm := service.Spec.Source.ConfigMap
+
+	configMap, err := h.kubeClient.CoreV1().ConfigMaps(service.Namespace).Get(context.Background(), cm.Name, meta.GetOptions{})
+	if err != nil {
+		return ServiceSource{}, "", errors.Wrapf(err, "Unable to get ConfigMap %s", cm.Name)
+	}
+
+	archive, ok := configMap.BinaryData[cm.GetKey()]
+	if !ok {
+		return ServiceSource{}, "", errors.Newf("ConfigMap %s does not contain binary key %s", cm.Name, cm.GetKey())
+	}
+
+	return ServiceSource{Archive: archive}, util.SHA256(archive), nil
+}
+
+func (h *handler) finalize(service *api.ArangoFoxxService) error {
+	if !utils.StringList(service.Finalizers).Has(api.FinalizerArangoFoxxService) {
+		return nil
+	}
+
+	if service.Status.Checksum != "" {
+		if err := h.uninstallService(service); err != nil {
+			h.eventRecorder.Warning(service, serviceError, "Service uninstall failed: %s", err.Error())
+			return err
+		}
+	}
+
+	service.Finalizers = utils.StringList(service.Finalizers).Remove(api.FinalizerArangoFoxxService)
+
+	if _, err := h.client.DatabaseV1().ArangoFoxxServices(service.Namespace).Update(context.Background(), service, meta.UpdateOptions{}); err != nil {
+		h.operator.GetLogger().Error().Msgf("ArangoFoxxService finalizer update error %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *handler) uninstallService(service *api.ArangoFoxxService) error {
+	client, err := h.getArangoClient(service)
+	if err != nil {
+		if k8sutil.IsNotFound(errors.Cause(err)) {
+			// Deployment is gone, nothing to uninstall
+			return nil
+		}
+
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultArangoClientTimeout)
+	defer cancel()
+
+	if err := client.Uninstall(ctx, service.Spec.Database, service.Spec.Mount, service.Spec.GetTeardown()); err != nil {
+		return err
+	}
+
+	h.eventRecorder.Normal(service, serviceUninstalled, "Service on %s has been uninstalled", service.Spec.Mount)
+	return nil
+}
+
+func (h *handler) getArangoClient(service *api.ArangoFoxxService) (ArangoFoxxServiceClient, error) {
+	depl, err := h.client.DatabaseV1().ArangoDeployments(service.Namespace).Get(context.Background(), service.Spec.DeploymentName, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get ArangoDeployment %s", service.Spec.DeploymentName)
+	}
+
+	client, err := h.arangoClientFactory(depl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create client for ArangoDeployment %s", service.Spec.DeploymentName)
+	}
+
+	return client, nil
+}
+
+func (*handler) CanBeHandled(item operation.Item) bool {
+	return item.Group == api.SchemeGroupVersion.Group &&
+		item.Version == api.SchemeGroupVersion.Version &&
+		item.Kind == deployment.ArangoFoxxServiceResourceKind
+}
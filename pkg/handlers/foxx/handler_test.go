@@ -0,0 +1,162 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package foxx
+
+import (
+	"testing"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+func Test_FoxxService_InstallFromURL(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientFoxxService()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	service := newArangoFoxxService("test", namespace, depl.Name)
+	service.Spec.Source.URL = util.NewString("https://example.com/service.zip")
+
+	createArangoDeployment(t, handler, depl)
+	createArangoFoxxService(t, handler, service)
+
+	// Act
+	handle(t, handler, service)
+
+	// Assert
+	s := refreshArangoFoxxService(t, handler, service)
+	require.Contains(t, s.Finalizers, api.FinalizerArangoFoxxService)
+	require.True(t, s.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Equal(t, "1.0.1", s.Status.Version)
+	require.Equal(t, util.SHA256FromString("https://example.com/service.zip"), s.Status.Checksum)
+
+	require.Equal(t, mockService{source: ServiceSource{URL: "https://example.com/service.zip"}, setup: true, installs: 1}, mock.services["db/app"])
+
+	// Act
+	handle(t, handler, service)
+
+	// Assert
+	require.Equal(t, 1, mock.services["db/app"].installs)
+}
+
+func Test_FoxxService_UpdateFromConfigMap(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientFoxxService()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	configMap := &core.ConfigMap{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "service",
+			Namespace: namespace,
+		},
+		BinaryData: map[string][]byte{
+			api.ArangoFoxxServiceConfigMapKeyDefault: []byte("v1"),
+		},
+	}
+
+	service := newArangoFoxxService("test", namespace, depl.Name)
+	service.Spec.Source.ConfigMap = &api.ArangoFoxxServiceConfigMapSource{Name: configMap.Name}
+	service.Spec.Setup = util.NewBool(false)
+
+	createArangoDeployment(t, handler, depl)
+	createConfigMap(t, handler, configMap)
+	createArangoFoxxService(t, handler, service)
+	handle(t, handler, service)
+	require.Equal(t, []byte("v1"), mock.services["db/app"].source.Archive)
+	require.False(t, mock.services["db/app"].setup)
+
+	// Act
+	configMap.BinaryData[api.ArangoFoxxServiceConfigMapKeyDefault] = []byte("v2")
+	updateConfigMap(t, handler, configMap)
+
+	handle(t, handler, service)
+
+	// Assert
+	s := refreshArangoFoxxService(t, handler, service)
+	require.True(t, s.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Equal(t, "1.0.2", s.Status.Version)
+	require.Equal(t, util.SHA256([]byte("v2")), s.Status.Checksum)
+	require.Equal(t, []byte("v2"), mock.services["db/app"].source.Archive)
+}
+
+func Test_FoxxService_ConfigMapMissing(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientFoxxService()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	service := newArangoFoxxService("test", namespace, depl.Name)
+	service.Spec.Source.ConfigMap = &api.ArangoFoxxServiceConfigMapSource{Name: "missing"}
+
+	createArangoDeployment(t, handler, depl)
+	createArangoFoxxService(t, handler, service)
+
+	// Act
+	handle(t, handler, service)
+
+	// Assert
+	s := refreshArangoFoxxService(t, handler, service)
+	require.False(t, s.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Empty(t, s.Status.Checksum)
+	require.Empty(t, mock.services)
+}
+
+func Test_FoxxService_Finalize(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientFoxxService()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	service := newArangoFoxxService("test", namespace, depl.Name)
+	service.Spec.Source.URL = util.NewString("https://example.com/service.zip")
+
+	createArangoDeployment(t, handler, depl)
+	createArangoFoxxService(t, handler, service)
+	handle(t, handler, service)
+	require.Contains(t, mock.services, "db/app")
+
+	// Act
+	s := refreshArangoFoxxService(t, handler, service)
+	now := meta.Now()
+	s.DeletionTimestamp = &now
+	updateArangoFoxxService(t, handler, s)
+
+	handle(t, handler, service)
+
+	// Assert
+	require.Empty(t, mock.services)
+	require.NotContains(t, refreshArangoFoxxService(t, handler, service).Finalizers, api.FinalizerArangoFoxxService)
+}
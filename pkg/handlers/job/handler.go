@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 
 	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
@@ -35,6 +36,7 @@ import (
 	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -48,6 +50,23 @@ const (
 	jobError          = "Error"
 )
 
+const (
+	// envArangoEndpoint holds the endpoint of the external ArangoDB
+	envArangoEndpoint = "ARANGO_ENDPOINT"
+	// envArangoUsername holds the username used to connect to the external ArangoDB
+	envArangoUsername = "ARANGO_USERNAME"
+	// envArangoPassword holds the password used to connect to the external ArangoDB
+	envArangoPassword = "ARANGO_PASSWORD"
+	// envArangoCAFile holds the path of the CA certificate of the external ArangoDB
+	envArangoCAFile = "ARANGO_CA_FILE"
+
+	// cronJobChecksumAnnotation holds the checksum of the CronJob spec prepared from the ArangoJob
+	cronJobChecksumAnnotation = apps.ArangoAppsGroupName + "/checksum"
+
+	externalCAVolumeName = "arango-external-ca"
+	externalCAMountPath  = "/secrets/arango/ca"
+)
+
 type handler struct {
 	client        arangoClientSet.Interface
 	kubeClient    kubernetes.Interface
@@ -81,10 +100,21 @@ func (h *handler) Handle(item operation.Item) error {
 		return nil
 	}
 
-	job.Status = status
-
-	// Update status on object
-	if _, err = h.client.AppsV1().ArangoJobs(item.Namespace).UpdateStatus(context.Background(), job, meta.UpdateOptions{}); err != nil {
+	// Update status on object, the status is applied again to the reloaded object on conflict
+	jobs := h.client.AppsV1().ArangoJobs(item.Namespace)
+	err = k8sutil.RetryOnConflict(func() error {
+		job.Status = status
+		_, err := jobs.UpdateStatus(context.Background(), job, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := jobs.Get(context.Background(), job.GetName(), meta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		job = current
+		return nil
+	})
+	if err != nil {
 		h.operator.GetLogger().Error().Msgf("ArangoJob status update error %v", err)
 		return err
 	}
@@ -106,6 +136,10 @@ func (h *handler) createFailedJobStatusWithEvent(msg string, job *appsApi.Arango
 }
 
 func (h *handler) processArangoJob(job *appsApi.ArangoJob) batchv1.JobStatus {
+	if job.Spec.IsScheduled() {
+		return h.processArangoCronJob(job)
+	}
+
 	existingJob, err := h.kubeClient.BatchV1().Jobs(job.Namespace).Get(context.Background(), job.Name, meta.GetOptions{})
 	if err != nil {
 		if k8sutil.IsNotFound(err) {
@@ -127,45 +161,259 @@ func (h *handler) processArangoJob(job *appsApi.ArangoJob) batchv1.JobStatus {
 	return existingJob.Status
 }
 
+func (h *handler) processArangoCronJob(job *appsApi.ArangoJob) batchv1.JobStatus {
+	existingCronJob, err := h.kubeClient.BatchV1().CronJobs(job.Namespace).Get(context.Background(), job.Name, meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			k8sCronJob, err := h.prepareK8sCronJob(job)
+			if err != nil {
+				return h.createFailedJobStatusWithEvent(fmt.Sprintf("can not prepare k8s CronJob: %s", err.Error()), job)
+			}
+
+			existingCronJob, err = h.kubeClient.BatchV1().CronJobs(job.Namespace).Create(context.Background(), k8sCronJob, meta.CreateOptions{})
+			if err != nil {
+				return h.createFailedJobStatusWithEvent(fmt.Sprintf("can not create k8s CronJob: %s", err.Error()), job)
+			}
+			h.eventRecorder.Normal(job, jobCreatedUpdated, "Arango job has been updated/created")
+		} else {
+			return h.createFailedJobStatusWithEvent(fmt.Sprintf("can not check if k8s CronJob already exist: %s", err.Error()), job)
+		}
+	} else {
+		k8sCronJob, err := h.prepareK8sCronJob(job)
+		if err != nil {
+			return h.createFailedJobStatusWithEvent(fmt.Sprintf("can not prepare k8s CronJob: %s", err.Error()), job)
+		}
+
+		if checksum := k8sCronJob.GetAnnotations()[cronJobChecksumAnnotation]; existingCronJob.GetAnnotations()[cronJobChecksumAnnotation] != checksum {
+			existingCronJob.Spec = k8sCronJob.Spec
+			if existingCronJob.Annotations == nil {
+				existingCronJob.Annotations = map[string]string{}
+			}
+			existingCronJob.Annotations[cronJobChecksumAnnotation] = checksum
+
+			existingCronJob, err = h.kubeClient.BatchV1().CronJobs(job.Namespace).Update(context.Background(), existingCronJob, meta.UpdateOptions{})
+			if err != nil {
+				return h.createFailedJobStatusWithEvent(fmt.Sprintf("can not update k8s CronJob: %s", err.Error()), job)
+			}
+			h.eventRecorder.Normal(job, jobCreatedUpdated, "Arango job has been updated/created")
+		}
+	}
+
+	return cronJobStatus(existingCronJob.Status)
+}
+
+// cronJobStatus converts the status of the CronJob into the status of the ArangoJob
+func cronJobStatus(status batchv1.CronJobStatus) batchv1.JobStatus {
+	return batchv1.JobStatus{
+		Active:         int32(len(status.Active)),
+		StartTime:      status.LastScheduleTime,
+		CompletionTime: status.LastSuccessfulTime,
+	}
+}
+
 func (h *handler) prepareK8sJob(job *appsApi.ArangoJob) (*batchv1.Job, error) {
 	k8sJob := batchv1.Job{}
 	k8sJob.Name = job.Name
 	k8sJob.Namespace = job.Namespace
-	k8sJob.Spec = *job.Spec.JobTemplate
-	k8sJob.Spec.Template.Spec.ServiceAccountName = os.Getenv(constants.EnvArangoJobSAName)
 	k8sJob.SetOwnerReferences(append(job.GetOwnerReferences(), job.AsOwner()))
 
+	spec, err := h.prepareK8sJobSpec(job)
+	k8sJob.Spec = spec
+
+	return &k8sJob, err
+}
+
+func (h *handler) prepareK8sCronJob(job *appsApi.ArangoJob) (*batchv1.CronJob, error) {
+	k8sCronJob := batchv1.CronJob{}
+	k8sCronJob.Name = job.Name
+	k8sCronJob.Namespace = job.Namespace
+	k8sCronJob.Spec.Schedule = job.Spec.Schedule
+	k8sCronJob.SetOwnerReferences(append(job.GetOwnerReferences(), job.AsOwner()))
+
+	spec, err := h.prepareK8sJobSpec(job)
+	if err != nil {
+		return nil, err
+	}
+	k8sCronJob.Spec.JobTemplate.Spec = spec
+
+	// Spec of the CronJob is defaulted by the API server, so changes are detected by the checksum of the prepared spec
+	checksum, err := util.SHA256FromJSON(k8sCronJob.Spec)
+	if err != nil {
+		return nil, err
+	}
+	k8sCronJob.SetAnnotations(map[string]string{
+		cronJobChecksumAnnotation: checksum,
+	})
+
+	return &k8sCronJob, nil
+}
+
+// prepareK8sJobSpec returns the spec of the job with access to the ArangoDeployment
+func (h *handler) prepareK8sJobSpec(job *appsApi.ArangoJob) (batchv1.JobSpec, error) {
+	spec := *job.Spec.JobTemplate.DeepCopy()
+	spec.Template.Spec.ServiceAccountName = os.Getenv(constants.EnvArangoJobSAName)
+
+	if v := job.Spec.BackoffLimit; v != nil {
+		spec.BackoffLimit = util.NewInt32(*v)
+	}
+
+	if v := job.Spec.ActiveDeadlineSeconds; v != nil {
+		spec.ActiveDeadlineSeconds = util.NewInt64(*v)
+	}
+
+	if v := job.Spec.TTLSecondsAfterFinished; v != nil {
+		spec.TTLSecondsAfterFinished = util.NewInt32(*v)
+	}
+
+	if job.Spec.IsExternal() {
+		applyJobExternalEndpoint(&spec.Template.Spec, job.Spec.ExternalEndpoint)
+		applyJobPodCustomization(&spec.Template.Spec, job.Spec)
+		return spec, nil
+	}
+
 	deployment, err := h.client.DatabaseV1().ArangoDeployments(job.Namespace).Get(context.Background(), job.Spec.ArangoDeploymentName, meta.GetOptions{})
 	if err != nil {
 		h.operator.GetLogger().Error().Msgf("ArangoDeployment fetch error %v", err)
-		return &k8sJob, err
+		return spec, err
 	}
 
 	if deployment.Spec.TLS.IsSecure() {
-		k8sJob.Spec.Template.Spec.Volumes = []core.Volume{
-			{
-				Name: k8sutil.TlsKeyfileVolumeName,
-				VolumeSource: core.VolumeSource{
-					Secret: &core.SecretVolumeSource{
-						SecretName: deployment.Spec.TLS.GetCASecretName(),
-					},
+		spec.Template.Spec.Volumes = append(spec.Template.Spec.Volumes, core.Volume{
+			Name: k8sutil.TlsKeyfileVolumeName,
+			VolumeSource: core.VolumeSource{
+				Secret: &core.SecretVolumeSource{
+					SecretName: deployment.Spec.TLS.GetCASecretName(),
 				},
 			},
-		}
+		})
 	}
 
 	executable, err := os.Executable()
 	if err != nil {
 		h.operator.GetLogger().Error().Msgf("reading Operator executable name error %v", err)
-		return &k8sJob, err
+		return spec, err
 	}
 
 	initContainer := k8sutil.ArangodWaiterInitContainer(api.ServerGroupReservedInitContainerNameWait, deployment.Name, executable,
 		h.operator.Image(), deployment.Spec.TLS.IsSecure(), &core.SecurityContext{})
 
-	k8sJob.Spec.Template.Spec.InitContainers = append(k8sJob.Spec.Template.Spec.InitContainers, initContainer)
+	spec.Template.Spec.InitContainers = append(spec.Template.Spec.InitContainers, initContainer)
+
+	// Customization is applied last, so it is not overridden by the settings of the operator
+	applyJobPodCustomization(&spec.Template.Spec, job.Spec)
+
+	return spec, nil
+}
+
+// applyJobPodCustomization applies the pod settings from the ArangoJob spec on the job pod
+func applyJobPodCustomization(pod *core.PodSpec, spec appsApi.ArangoJobSpec) {
+	if len(spec.NodeSelector) > 0 {
+		if pod.NodeSelector == nil {
+			pod.NodeSelector = map[string]string{}
+		}
+
+		for k, v := range spec.NodeSelector {
+			pod.NodeSelector[k] = v
+		}
+	}
+
+	for _, t := range spec.Tolerations {
+		pod.Tolerations = k8sutil.AddTolerationIfNotFound(pod.Tolerations, t)
+	}
+
+	for _, v := range spec.Volumes {
+		if !hasVolume(pod.Volumes, v.Name) {
+			pod.Volumes = append(pod.Volumes, v)
+		}
+	}
+
+	for id := range pod.Containers {
+		c := &pod.Containers[id]
+
+		if spec.Resources != nil && len(c.Resources.Requests) == 0 && len(c.Resources.Limits) == 0 {
+			c.Resources = *spec.Resources.DeepCopy()
+		}
+
+		for _, e := range spec.Env {
+			if !hasEnv(c.Env, e.Name) {
+				c.Env = append(c.Env, e)
+			}
+		}
+	}
+}
+
+// applyJobExternalEndpoint provides the endpoint, credentials and CA of the external ArangoDB to the job containers
+func applyJobExternalEndpoint(pod *core.PodSpec, endpoint *appsApi.ArangoJobExternalEndpoint) {
+	env := []core.EnvVar{
+		{
+			Name:  envArangoEndpoint,
+			Value: endpoint.Endpoint,
+		},
+	}
+
+	if name := endpoint.CredentialsSecretName; name != "" {
+		env = append(env,
+			k8sutil.CreateEnvSecretKeySelector(envArangoUsername, name, constants.SecretUsername),
+			k8sutil.CreateEnvSecretKeySelector(envArangoPassword, name, constants.SecretPassword))
+	}
+
+	var mounts []core.VolumeMount
+
+	if name := endpoint.CASecretName; name != "" {
+		if !hasVolume(pod.Volumes, externalCAVolumeName) {
+			pod.Volumes = append(pod.Volumes, core.Volume{
+				Name: externalCAVolumeName,
+				VolumeSource: core.VolumeSource{
+					Secret: &core.SecretVolumeSource{
+						SecretName: name,
+					},
+				},
+			})
+		}
+
+		mounts = append(mounts, core.VolumeMount{
+			Name:      externalCAVolumeName,
+			MountPath: externalCAMountPath,
+			ReadOnly:  true,
+		})
+
+		env = append(env, core.EnvVar{
+			Name:  envArangoCAFile,
+			Value: filepath.Join(externalCAMountPath, constants.SecretCACertificate),
+		})
+	}
+
+	for id := range pod.Containers {
+		c := &pod.Containers[id]
+
+		for _, e := range env {
+			if !hasEnv(c.Env, e.Name) {
+				c.Env = append(c.Env, e)
+			}
+		}
+
+		c.VolumeMounts = append(c.VolumeMounts, mounts...)
+	}
+}
+
+func hasVolume(volumes []core.Volume, name string) bool {
+	for _, v := range volumes {
+		if v.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasEnv(env []core.EnvVar, name string) bool {
+	for _, e := range env {
+		if e.Name == name {
+			return true
+		}
+	}
 
-	return &k8sJob, nil
+	return false
 }
 
 func (*handler) CanBeHandled(item operation.Item) bool {
@@ -21,10 +21,18 @@
 package job
 
 import (
+	"context"
 	"testing"
 
+	appsApi "github.com/arangodb/kube-arangodb/pkg/apis/apps/v1"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
 
 	"github.com/stretchr/testify/require"
@@ -95,3 +103,201 @@ func Test_Job_Create_Error_If_Deployment_Not_Exist(t *testing.T) {
 	require.True(t, len(newJob.Status.Conditions) == 1)
 	require.True(t, newJob.Status.Conditions[0].Type == batchv1.JobFailed)
 }
+
+func Test_CronJob_Create(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+	deployment := string(uuid.NewUUID())
+
+	job := newArangoJob(name, namespace, deployment)
+	job.Spec.Schedule = "*/5 * * * *"
+	database := newArangoDeployment(deployment, namespace)
+
+	// Act
+	createArangoJob(t, handler, job)
+	createArangoDeployment(t, handler, database)
+	require.NoError(t, handler.Handle(newItemFromJob(operation.Add, job)))
+
+	// Assert
+	newJob := refreshArangoJob(t, handler, job)
+	require.Empty(t, newJob.Status.Conditions)
+
+	cronJob := refreshK8sCronJob(t, handler, job)
+	require.Equal(t, job.Spec.Schedule, cronJob.Spec.Schedule)
+	require.Len(t, cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers, 1)
+	require.Len(t, cronJob.Spec.JobTemplate.Spec.Template.Spec.InitContainers, 1)
+
+	_, err := handler.kubeClient.BatchV1().Jobs(namespace).Get(context.Background(), name, meta.GetOptions{})
+	require.True(t, apiErrors.IsNotFound(err))
+}
+
+func Test_CronJob_Update_Schedule(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+	deployment := string(uuid.NewUUID())
+
+	job := newArangoJob(name, namespace, deployment)
+	job.Spec.Schedule = "*/5 * * * *"
+	database := newArangoDeployment(deployment, namespace)
+
+	createArangoJob(t, handler, job)
+	createArangoDeployment(t, handler, database)
+	require.NoError(t, handler.Handle(newItemFromJob(operation.Add, job)))
+
+	// Act
+	job = refreshArangoJob(t, handler, job)
+	job.Spec.Schedule = "0 * * * *"
+	_, err := handler.client.AppsV1().ArangoJobs(namespace).Update(context.Background(), job, meta.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(newItemFromJob(operation.Update, job)))
+
+	// Assert
+	cronJob := refreshK8sCronJob(t, handler, job)
+	require.Equal(t, "0 * * * *", cronJob.Spec.Schedule)
+}
+
+func Test_CronJob_Update_JobTemplate(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+	deployment := string(uuid.NewUUID())
+
+	job := newArangoJob(name, namespace, deployment)
+	job.Spec.Schedule = "*/5 * * * *"
+	database := newArangoDeployment(deployment, namespace)
+
+	createArangoJob(t, handler, job)
+	createArangoDeployment(t, handler, database)
+	require.NoError(t, handler.Handle(newItemFromJob(operation.Add, job)))
+
+	checksum := refreshK8sCronJob(t, handler, job).GetAnnotations()[cronJobChecksumAnnotation]
+	require.NotEmpty(t, checksum)
+
+	// Act
+	job = refreshArangoJob(t, handler, job)
+	job.Spec.JobTemplate.Template.Spec.Containers[0].Image = "arangodb/other:latest"
+	job.Spec.BackoffLimit = util.NewInt32(2)
+	_, err := handler.client.AppsV1().ArangoJobs(namespace).Update(context.Background(), job, meta.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(newItemFromJob(operation.Update, job)))
+
+	// Assert
+	cronJob := refreshK8sCronJob(t, handler, job)
+	require.Equal(t, "*/5 * * * *", cronJob.Spec.Schedule)
+	require.Equal(t, "arangodb/other:latest", cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image)
+	require.Equal(t, util.NewInt32(2), cronJob.Spec.JobTemplate.Spec.BackoffLimit)
+	require.NotEqual(t, checksum, cronJob.GetAnnotations()[cronJobChecksumAnnotation])
+}
+
+func Test_Job_Create_Overrides(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+	deployment := string(uuid.NewUUID())
+
+	job := newArangoJob(name, namespace, deployment)
+	job.Spec.JobTemplate.BackoffLimit = util.NewInt32(4)
+	job.Spec.JobTemplate.ActiveDeadlineSeconds = util.NewInt64(60)
+	job.Spec.BackoffLimit = util.NewInt32(1)
+	job.Spec.TTLSecondsAfterFinished = util.NewInt32(3600)
+	database := newArangoDeployment(deployment, namespace)
+
+	// Act
+	createArangoJob(t, handler, job)
+	createArangoDeployment(t, handler, database)
+	require.NoError(t, handler.Handle(newItemFromJob(operation.Add, job)))
+
+	// Assert
+	k8sJob := refreshK8sJob(t, handler, job)
+	require.Equal(t, util.NewInt32(1), k8sJob.Spec.BackoffLimit)
+	require.Equal(t, util.NewInt64(60), k8sJob.Spec.ActiveDeadlineSeconds)
+	require.Equal(t, util.NewInt32(3600), k8sJob.Spec.TTLSecondsAfterFinished)
+}
+
+func Test_Job_Create_PodCustomization(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+	deployment := string(uuid.NewUUID())
+
+	job := newArangoJob(name, namespace, deployment)
+	job.Spec.JobTemplate.Template.Spec.Containers[0].Env = []v1.EnvVar{{Name: "MODE", Value: "template"}}
+	job.Spec.Resources = &v1.ResourceRequirements{
+		Limits: v1.ResourceList{
+			v1.ResourceCPU: resource.MustParse("500m"),
+		},
+	}
+	job.Spec.NodeSelector = map[string]string{"pool": "jobs"}
+	job.Spec.Tolerations = []v1.Toleration{{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "jobs", Effect: v1.TaintEffectNoSchedule}}
+	job.Spec.Env = []v1.EnvVar{{Name: "MODE", Value: "spec"}, {Name: "EXTRA", Value: "1"}}
+	job.Spec.Volumes = []v1.Volume{{Name: "scratch", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}
+	database := newArangoDeployment(deployment, namespace)
+
+	// Act
+	createArangoJob(t, handler, job)
+	createArangoDeployment(t, handler, database)
+	require.NoError(t, handler.Handle(newItemFromJob(operation.Add, job)))
+
+	// Assert
+	pod := refreshK8sJob(t, handler, job).Spec.Template.Spec
+	require.Equal(t, map[string]string{"pool": "jobs"}, pod.NodeSelector)
+	require.Equal(t, job.Spec.Tolerations, pod.Tolerations)
+	require.Len(t, pod.Volumes, 2)
+	require.Equal(t, k8sutil.TlsKeyfileVolumeName, pod.Volumes[0].Name)
+	require.Equal(t, "scratch", pod.Volumes[1].Name)
+
+	require.Len(t, pod.Containers, 1)
+	require.Equal(t, *job.Spec.Resources, pod.Containers[0].Resources)
+	require.Equal(t, []v1.EnvVar{{Name: "MODE", Value: "template"}, {Name: "EXTRA", Value: "1"}}, pod.Containers[0].Env)
+	require.Len(t, pod.InitContainers, 1)
+}
+
+func Test_Job_Create_ExternalEndpoint(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+
+	job := newArangoJob(name, namespace, "")
+	job.Spec.ExternalEndpoint = &appsApi.ArangoJobExternalEndpoint{
+		Endpoint:              "https://example.arangodb.cloud:8529",
+		CredentialsSecretName: "credentials",
+		CASecretName:          "ca",
+	}
+
+	// Act
+	createArangoJob(t, handler, job)
+	require.NoError(t, handler.Handle(newItemFromJob(operation.Add, job)))
+
+	// Assert
+	newJob := refreshArangoJob(t, handler, job)
+	require.Empty(t, newJob.Status.Conditions)
+
+	pod := refreshK8sJob(t, handler, job).Spec.Template.Spec
+	require.Empty(t, pod.InitContainers)
+	require.Len(t, pod.Volumes, 1)
+	require.Equal(t, "ca", pod.Volumes[0].Secret.SecretName)
+
+	require.Len(t, pod.Containers, 1)
+	env := pod.Containers[0].Env
+	require.Len(t, env, 4)
+	require.Equal(t, v1.EnvVar{Name: envArangoEndpoint, Value: "https://example.arangodb.cloud:8529"}, env[0])
+	require.Equal(t, "credentials", env[1].ValueFrom.SecretKeyRef.Name)
+	require.Equal(t, "username", env[1].ValueFrom.SecretKeyRef.Key)
+	require.Equal(t, "password", env[2].ValueFrom.SecretKeyRef.Key)
+	require.Equal(t, "/secrets/arango/ca/ca.crt", env[3].Value)
+	require.Len(t, pod.Containers[0].VolumeMounts, 1)
+}
@@ -80,6 +80,20 @@ func refreshArangoJob(t *testing.T, h *handler, job *appsApi.ArangoJob) *appsApi
 	return newJob
 }
 
+func refreshK8sJob(t *testing.T, h *handler, job *appsApi.ArangoJob) *batchv1.Job {
+	k8sJob, err := h.kubeClient.BatchV1().Jobs(job.Namespace).Get(context.Background(), job.Name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	return k8sJob
+}
+
+func refreshK8sCronJob(t *testing.T, h *handler, job *appsApi.ArangoJob) *batchv1.CronJob {
+	cronJob, err := h.kubeClient.BatchV1().CronJobs(job.Namespace).Get(context.Background(), job.Name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	return cronJob
+}
+
 func createArangoJob(t *testing.T, h *handler, jobs ...*appsApi.ArangoJob) {
 	for _, job := range jobs {
 		_, err := h.client.AppsV1().ArangoJobs(job.Namespace).Create(context.Background(), job, meta.CreateOptions{})
@@ -37,6 +37,7 @@ import (
 
 	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
 	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/robfig/cron"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -77,10 +78,21 @@ func (h *handler) Handle(item operation.Item) error {
 		return nil
 	}
 
-	policy.Status = status
-
-	// Update status on object
-	if _, err = h.client.BackupV1().ArangoBackupPolicies(item.Namespace).UpdateStatus(context.Background(), policy, meta.UpdateOptions{}); err != nil {
+	// Update status on object, the status is applied again to the reloaded object on conflict
+	policies := h.client.BackupV1().ArangoBackupPolicies(item.Namespace)
+	err = k8sutil.RetryOnConflict(func() error {
+		policy.Status = status
+		_, err := policies.UpdateStatus(context.Background(), policy, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := policies.Get(context.Background(), policy.GetName(), meta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		policy = current
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -0,0 +1,313 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
+	batchv1 "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	taskAccepted   = "ArangoTaskAccepted"
+	taskJobCreated = "ArangoTaskJobCreated"
+	taskSucceeded  = "ArangoTaskSucceeded"
+	taskError      = "Error"
+
+	dumpContainerName = "task"
+	dumpVolumeName    = "dump"
+	dumpMountPath     = "/data"
+)
+
+type handler struct {
+	client        arangoClientSet.Interface
+	kubeClient    kubernetes.Interface
+	eventRecorder event.RecorderInstance
+
+	operator operator.Operator
+}
+
+func (*handler) Name() string {
+	return deployment.ArangoTaskResourceKind
+}
+
+func (h *handler) Handle(item operation.Item) error {
+	// Do not act on delete event, job is removed together with the task by the owner reference
+	if item.Operation == operation.Delete {
+		return nil
+	}
+
+	// Get Task object. It also covers NotFound case
+	task, err := h.client.DatabaseV1().ArangoTasks(item.Namespace).Get(context.Background(), item.Name, meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil
+		}
+		h.operator.GetLogger().Error().Msgf("ArangoTask fetch error %v", err)
+		return err
+	}
+
+	// Only Dump and Restore tasks are executed by this handler
+	if !task.Spec.Type.IsDumpOrRestore() {
+		return nil
+	}
+
+	status := h.processArangoTask(task.DeepCopy())
+	if reflect.DeepEqual(task.Status, status) {
+		return nil
+	}
+
+	// Update status on object, the status is applied again to the reloaded object on conflict
+	tasks := h.client.DatabaseV1().ArangoTasks(item.Namespace)
+	err = k8sutil.RetryOnConflict(func() error {
+		task.Status = status
+		_, err := tasks.UpdateStatus(context.Background(), task, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := tasks.Get(context.Background(), task.GetName(), meta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		task = current
+		return nil
+	})
+	if err != nil {
+		h.operator.GetLogger().Error().Msgf("ArangoTask status update error %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *handler) processArangoTask(task *api.ArangoTask) api.ArangoTaskStatus {
+	status := *task.Status.DeepCopy()
+
+	switch status.State {
+	case api.ArangoTaskUnknownState:
+		if _, err := getDumpDetails(task.Spec); err != nil {
+			return h.failedStatusWithEvent(task, status, err)
+		}
+
+		status.AcceptedSpec = task.Spec.DeepCopy()
+		status.State = api.ArangoTaskPendingState
+		h.eventRecorder.Normal(task, taskAccepted, "%s task has been accepted", task.Spec.Type)
+	case api.ArangoTaskPendingState:
+		if status.AcceptedSpec == nil {
+			return h.failedStatusWithEvent(task, status, errors.Newf("accepted spec is missing"))
+		}
+
+		job, err := h.prepareK8sJob(task, *status.AcceptedSpec)
+		if err != nil {
+			return h.failedStatusWithEvent(task, status, err)
+		}
+
+		if _, err := h.kubeClient.BatchV1().Jobs(task.Namespace).Create(context.Background(), job, meta.CreateOptions{}); err != nil && !k8sutil.IsAlreadyExists(err) {
+			return h.failedStatusWithEvent(task, status, errors.Wrapf(err, "Unable to create job"))
+		}
+
+		status.State = api.ArangoTaskRunningState
+		h.eventRecorder.Normal(task, taskJobCreated, "Job %s has been created", job.Name)
+	case api.ArangoTaskRunningState:
+		job, err := h.kubeClient.BatchV1().Jobs(task.Namespace).Get(context.Background(), task.Name, meta.GetOptions{})
+		if err != nil {
+			if k8sutil.IsNotFound(err) {
+				return h.failedStatusWithEvent(task, status, errors.Newf("Job %s is missing", task.Name))
+			}
+
+			h.operator.GetLogger().Error().Msgf("Job fetch error %v", err)
+			return status
+		}
+
+		for _, c := range job.Status.Conditions {
+			if c.Status != core.ConditionTrue {
+				continue
+			}
+
+			switch c.Type {
+			case batchv1.JobComplete:
+				status.State = api.ArangoTaskSuccessState
+				h.eventRecorder.Normal(task, taskSucceeded, "%s task has been completed", task.Spec.Type)
+			case batchv1.JobFailed:
+				return h.failedStatusWithEvent(task, status, errors.Newf("Job %s failed: %s", job.Name, c.Message))
+			}
+		}
+	}
+
+	return status
+}
+
+func (h *handler) failedStatusWithEvent(task *api.ArangoTask, status api.ArangoTaskStatus, err error) api.ArangoTaskStatus {
+	h.eventRecorder.Warning(task, taskError, "%s task failed: %s", task.Spec.Type, err.Error())
+
+	status.State = api.ArangoTaskFailedState
+	return status
+}
+
+func getDumpDetails(spec api.ArangoTaskSpec) (api.ArangoTaskDumpDetails, error) {
+	var details api.ArangoTaskDumpDetails
+
+	if err := spec.Details.Get(&details); err != nil {
+		return details, errors.Wrapf(err, "Unable to parse details")
+	}
+
+	if err := details.Validate(); err != nil {
+		return details, errors.Wrapf(err, "Validation failed")
+	}
+
+	return details, nil
+}
+
+// prepareK8sJob returns the job which runs arangodump or arangorestore against the deployment
+func (h *handler) prepareK8sJob(task *api.ArangoTask, spec api.ArangoTaskSpec) (*batchv1.Job, error) {
+	details, err := getDumpDetails(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	depl, err := h.client.DatabaseV1().ArangoDeployments(task.Namespace).Get(context.Background(), details.DeploymentName, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get ArangoDeployment %s", details.DeploymentName)
+	}
+
+	container := core.Container{
+		Name:            dumpContainerName,
+		Image:           getImage(depl, details),
+		ImagePullPolicy: depl.Spec.GetImagePullPolicy(),
+		Command:         []string{"arangodump"},
+		VolumeMounts: []core.VolumeMount{
+			{
+				Name:      dumpVolumeName,
+				MountPath: dumpMountPath,
+				SubPath:   details.SubPath,
+			},
+		},
+	}
+
+	if spec.Type == api.ArangoTaskRestoreType {
+		container.Command = []string{"arangorestore"}
+	}
+
+	container.Args = createArgs(depl, spec.Type, details)
+
+	pod := core.PodSpec{
+		RestartPolicy: core.RestartPolicyNever,
+		Volumes: []core.Volume{
+			{
+				Name:         dumpVolumeName,
+				VolumeSource: *details.Volume.DeepCopy(),
+			},
+		},
+	}
+
+	if depl.Spec.IsAuthenticated() {
+		pod.Volumes = append(pod.Volumes, k8sutil.CreateVolumeWithSecret(k8sutil.ClusterJWTSecretVolumeName, depl.Spec.Authentication.GetJWTSecretName()))
+		container.VolumeMounts = append(container.VolumeMounts, k8sutil.ClusterJWTVolumeMount())
+	}
+
+	for _, s := range depl.Spec.ImagePullSecrets {
+		pod.ImagePullSecrets = append(pod.ImagePullSecrets, core.LocalObjectReference{Name: s})
+	}
+
+	pod.Containers = []core.Container{container}
+
+	job := batchv1.Job{}
+	job.Name = task.Name
+	job.Namespace = task.Namespace
+	job.SetOwnerReferences(append(task.GetOwnerReferences(), task.AsOwner()))
+	job.Spec.Template.Spec = pod
+
+	return &job, nil
+}
+
+// getImage returns the image used to run the tool, defaults to the image currently used by the deployment
+func getImage(depl *api.ArangoDeployment, details api.ArangoTaskDumpDetails) string {
+	if v := details.Image; v != nil {
+		return *v
+	}
+
+	if i := depl.Status.CurrentImage; i != nil && i.Image != "" {
+		return i.Image
+	}
+
+	return depl.Spec.GetImage()
+}
+
+// createArgs returns the arguments of arangodump or arangorestore
+func createArgs(depl *api.ArangoDeployment, taskType api.ArangoTaskType, details api.ArangoTaskDumpDetails) []string {
+	scheme := "tcp"
+	if depl.Spec.IsSecure() {
+		scheme = "ssl"
+	}
+
+	args := []string{
+		fmt.Sprintf("--server.endpoint=%s://%s:%d", scheme, k8sutil.CreateDatabaseClientServiceDNSNameWithDomain(depl, depl.Spec.ClusterDomain), depl.Spec.GetDatabasePort()),
+	}
+
+	if depl.Spec.IsAuthenticated() {
+		args = append(args, fmt.Sprintf("--server.jwt-secret-keyfile=%s", filepath.Join(k8sutil.ClusterJWTSecretVolumeMountDir, constants.SecretKeyToken)))
+	} else {
+		args = append(args, "--server.authentication=false")
+	}
+
+	if taskType == api.ArangoTaskRestoreType {
+		args = append(args, fmt.Sprintf("--input-directory=%s", dumpMountPath), "--create-database=true")
+	} else {
+		args = append(args, fmt.Sprintf("--output-directory=%s", dumpMountPath), "--overwrite=true")
+	}
+
+	if details.Database != "" {
+		args = append(args, fmt.Sprintf("--server.database=%s", details.Database))
+	} else {
+		args = append(args, "--all-databases=true")
+	}
+
+	for _, c := range details.Collections {
+		args = append(args, fmt.Sprintf("--collection=%s", c))
+	}
+
+	if v := details.Threads; v != nil {
+		args = append(args, fmt.Sprintf("--threads=%d", *v))
+	}
+
+	return args
+}
+
+func (*handler) CanBeHandled(item operation.Item) bool {
+	return item.Group == api.SchemeGroupVersion.Group &&
+		item.Version == api.SchemeGroupVersion.Version &&
+		item.Kind == deployment.ArangoTaskResourceKind
+}
@@ -0,0 +1,189 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package task
+
+import (
+	"testing"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+func Test_Task_Dump(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment("deployment", namespace)
+	depl.Spec.Image = util.NewString("arangodb/arangodb:3.8.5")
+
+	task := newArangoTask(t, "dump", namespace, api.ArangoTaskDumpType, api.ArangoTaskDumpDetails{
+		DeploymentName: depl.Name,
+		Database:       "app",
+		Collections:    []string{"users"},
+		Volume: core.VolumeSource{
+			PersistentVolumeClaim: &core.PersistentVolumeClaimVolumeSource{ClaimName: "dump"},
+		},
+		SubPath: "app",
+	})
+
+	createArangoDeployment(t, handler, depl)
+	createArangoTask(t, handler, task)
+
+	// Act
+	handle(t, handler, task)
+
+	// Assert
+	at := refreshArangoTask(t, handler, task)
+	require.Equal(t, api.ArangoTaskPendingState, at.Status.State)
+	require.NotNil(t, at.Status.AcceptedSpec)
+
+	// Act
+	handle(t, handler, task)
+
+	// Assert
+	require.Equal(t, api.ArangoTaskRunningState, refreshArangoTask(t, handler, task).Status.State)
+
+	job := getK8sJob(t, handler, task)
+	require.Equal(t, task.UID, job.OwnerReferences[0].UID)
+
+	pod := job.Spec.Template.Spec
+	require.Equal(t, core.RestartPolicyNever, pod.RestartPolicy)
+	require.Len(t, pod.Containers, 1)
+	require.Equal(t, "arangodb/arangodb:3.8.5", pod.Containers[0].Image)
+	require.Equal(t, []string{"arangodump"}, pod.Containers[0].Command)
+	require.Equal(t, []string{
+		"--server.endpoint=ssl://deployment." + namespace + ".svc:8529",
+		"--server.jwt-secret-keyfile=/secrets/cluster/jwt/token",
+		"--output-directory=/data",
+		"--overwrite=true",
+		"--server.database=app",
+		"--collection=users",
+	}, pod.Containers[0].Args)
+	require.Equal(t, "app", pod.Containers[0].VolumeMounts[0].SubPath)
+	require.Equal(t, "dump", pod.Volumes[0].PersistentVolumeClaim.ClaimName)
+	require.Equal(t, k8sutil.ClusterJWTSecretVolumeName, pod.Volumes[1].Name)
+
+	// Act
+	job.Status.Conditions = []batchv1.JobCondition{
+		{Type: batchv1.JobComplete, Status: core.ConditionTrue},
+	}
+	updateK8sJob(t, handler, job)
+
+	handle(t, handler, task)
+
+	// Assert
+	require.Equal(t, api.ArangoTaskSuccessState, refreshArangoTask(t, handler, task).Status.State)
+}
+
+func Test_Task_Restore(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment("deployment", namespace)
+	depl.Spec.TLS.CASecretName = util.NewString(api.CASecretNameDisabled)
+	depl.Spec.Authentication.JWTSecretName = util.NewString(api.JWTSecretNameDisabled)
+	depl.Spec.Coordinators.Port = util.NewInt(8530)
+
+	task := newArangoTask(t, "restore", namespace, api.ArangoTaskRestoreType, api.ArangoTaskDumpDetails{
+		DeploymentName: depl.Name,
+		Volume: core.VolumeSource{
+			PersistentVolumeClaim: &core.PersistentVolumeClaimVolumeSource{ClaimName: "dump"},
+		},
+		Threads: util.NewInt(4),
+		Image:   util.NewString("arangodb/arangodb:3.9.0"),
+	})
+
+	createArangoDeployment(t, handler, depl)
+	createArangoTask(t, handler, task)
+
+	// Act
+	handle(t, handler, task)
+	handle(t, handler, task)
+
+	// Assert
+	job := getK8sJob(t, handler, task)
+
+	pod := job.Spec.Template.Spec
+	require.Len(t, pod.Volumes, 1)
+	require.Equal(t, "arangodb/arangodb:3.9.0", pod.Containers[0].Image)
+	require.Equal(t, []string{"arangorestore"}, pod.Containers[0].Command)
+	require.Equal(t, []string{
+		"--server.endpoint=tcp://deployment." + namespace + ".svc:8530",
+		"--server.authentication=false",
+		"--input-directory=/data",
+		"--create-database=true",
+		"--all-databases=true",
+		"--threads=4",
+	}, pod.Containers[0].Args)
+
+	// Act
+	job.Status.Conditions = []batchv1.JobCondition{
+		{Type: batchv1.JobFailed, Status: core.ConditionTrue, Message: "BackoffLimitExceeded"},
+	}
+	updateK8sJob(t, handler, job)
+
+	handle(t, handler, task)
+
+	// Assert
+	require.Equal(t, api.ArangoTaskFailedState, refreshArangoTask(t, handler, task).Status.State)
+}
+
+func Test_Task_InvalidDetails(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	task := newArangoTask(t, "dump", string(uuid.NewUUID()), api.ArangoTaskDumpType, api.ArangoTaskDumpDetails{
+		DeploymentName: "deployment",
+	})
+
+	createArangoTask(t, handler, task)
+
+	// Act
+	handle(t, handler, task)
+
+	// Assert
+	at := refreshArangoTask(t, handler, task)
+	require.Equal(t, api.ArangoTaskFailedState, at.Status.State)
+	require.Nil(t, at.Status.AcceptedSpec)
+}
+
+func Test_Task_OtherType(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	task := newArangoTask(t, "other", string(uuid.NewUUID()), "Other", nil)
+
+	createArangoTask(t, handler, task)
+
+	// Act
+	handle(t, handler, task)
+
+	// Assert
+	require.Equal(t, api.ArangoTaskUnknownState, refreshArangoTask(t, handler, task).Status.State)
+}
@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newFakeHandler() *handler {
+	f := fakeClientSet.NewSimpleClientset()
+	k := fake.NewSimpleClientset()
+
+	return &handler{
+		client:        f,
+		kubeClient:    k,
+		eventRecorder: newEventInstance(event.NewEventRecorder(log.Logger, "mock", k)),
+		operator:      operator.NewOperator(log.Logger, "mock", "mock", "mock"),
+	}
+}
+
+func newItemFromTask(o operation.Operation, task *api.ArangoTask) operation.Item {
+	return operation.Item{
+		Group:   api.SchemeGroupVersion.Group,
+		Version: api.SchemeGroupVersion.Version,
+		Kind:    deployment.ArangoTaskResourceKind,
+
+		Operation: o,
+
+		Namespace: task.Namespace,
+		Name:      task.Name,
+	}
+}
+
+func newArangoTask(t *testing.T, name, namespace string, taskType api.ArangoTaskType, details interface{}) *api.ArangoTask {
+	task := &api.ArangoTask{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoTaskResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+		Spec: api.ArangoTaskSpec{
+			Type: taskType,
+		},
+	}
+
+	require.NoError(t, task.Spec.Details.Set(details))
+
+	return task
+}
+
+func newArangoDeployment(name, namespace string) *api.ArangoDeployment {
+	return &api.ArangoDeployment{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoDeploymentResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+	}
+}
+
+func createArangoTask(t *testing.T, h *handler, task *api.ArangoTask) {
+	_, err := h.client.DatabaseV1().ArangoTasks(task.Namespace).Create(context.Background(), task, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func createArangoDeployment(t *testing.T, h *handler, depl *api.ArangoDeployment) {
+	_, err := h.client.DatabaseV1().ArangoDeployments(depl.Namespace).Create(context.Background(), depl, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func refreshArangoTask(t *testing.T, h *handler, task *api.ArangoTask) *api.ArangoTask {
+	at, err := h.client.DatabaseV1().ArangoTasks(task.Namespace).Get(context.Background(), task.Name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	return at
+}
+
+func getK8sJob(t *testing.T, h *handler, task *api.ArangoTask) *batchv1.Job {
+	job, err := h.kubeClient.BatchV1().Jobs(task.Namespace).Get(context.Background(), task.Name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	return job
+}
+
+func updateK8sJob(t *testing.T, h *handler, job *batchv1.Job) {
+	_, err := h.kubeClient.BatchV1().Jobs(job.Namespace).Update(context.Background(), job, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func handle(t *testing.T, h *handler, task *api.ArangoTask) {
+	require.NoError(t, h.Handle(newItemFromTask(operation.Update, task)))
+}
@@ -0,0 +1,50 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package user
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+// ArangoClientFactory factory type for creating clients
+type ArangoClientFactory func(deployment *api.ArangoDeployment) (ArangoUserClient, error)
+
+// ArangoUserClient interface with user management functionality for database
+type ArangoUserClient interface {
+	// Get returns the active flag of the user and false when user does not exist
+	Get(ctx context.Context, name string) (active bool, exists bool, err error)
+	// Create creates the user, password is not set when nil
+	Create(ctx context.Context, name string, password *string, active bool) error
+	// Update updates the user, password and active flag are not changed when nil
+	Update(ctx context.Context, name string, password *string, active *bool) error
+	// Remove removes the user
+	Remove(ctx context.Context, name string) error
+
+	// GetGrant returns the access of the user defined in the grant
+	GetGrant(ctx context.Context, name string, grant api.ArangoUserGrant) (driver.Grant, error)
+	// SetGrant sets the access of the user defined in the grant
+	SetGrant(ctx context.Context, name string, grant api.ArangoUserGrant) error
+	// RemoveGrant removes the access of the user defined in the grant
+	RemoveGrant(ctx context.Context, name string, grant api.ArangoUserGrant) error
+}
@@ -0,0 +1,179 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package user
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
+)
+
+type arangoClientUserImpl struct {
+	driver driver.Client
+}
+
+func newArangoClientUserFactory(handler *handler) ArangoClientFactory {
+	return func(deployment *api.ArangoDeployment) (ArangoUserClient, error) {
+		client, err := arangod.CreateArangodDatabaseClient(context.Background(), handler.kubeClient.CoreV1(), deployment, false)
+		if err != nil {
+			return nil, err
+		}
+
+		return &arangoClientUserImpl{
+			driver: client,
+		}, nil
+	}
+}
+
+func (ac *arangoClientUserImpl) Get(ctx context.Context, name string) (bool, bool, error) {
+	user, err := ac.driver.User(ctx, name)
+	if err != nil {
+		if driver.IsNotFound(err) {
+			return false, false, nil
+		}
+
+		return false, false, err
+	}
+
+	return user.IsActive(), true, nil
+}
+
+func (ac *arangoClientUserImpl) Create(ctx context.Context, name string, password *string, active bool) error {
+	options := driver.UserOptions{
+		Active: &active,
+	}
+
+	if password != nil {
+		options.Password = *password
+	}
+
+	_, err := ac.driver.CreateUser(ctx, name, &options)
+	return err
+}
+
+func (ac *arangoClientUserImpl) Update(ctx context.Context, name string, password *string, active *bool) error {
+	user, err := ac.driver.User(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	options := driver.UserOptions{
+		Active: active,
+	}
+
+	if password != nil {
+		options.Password = *password
+	}
+
+	return user.Update(ctx, options)
+}
+
+func (ac *arangoClientUserImpl) Remove(ctx context.Context, name string) error {
+	user, err := ac.driver.User(ctx, name)
+	if err != nil {
+		if driver.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return user.Remove(ctx)
+}
+
+func (ac *arangoClientUserImpl) GetGrant(ctx context.Context, name string, grant api.ArangoUserGrant) (driver.Grant, error) {
+	req, err := ac.driver.Connection().NewRequest(http.MethodGet, grantPath(name, grant))
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := ac.driver.Connection().Do(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return "", err
+	}
+
+	var data struct {
+		Result driver.Grant `json:"result"`
+	}
+
+	if err := resp.ParseBody("", &data); err != nil {
+		return "", err
+	}
+
+	return data.Result, nil
+}
+
+func (ac *arangoClientUserImpl) SetGrant(ctx context.Context, name string, grant api.ArangoUserGrant) error {
+	req, err := ac.driver.Connection().NewRequest(http.MethodPut, grantPath(name, grant))
+	if err != nil {
+		return err
+	}
+
+	req, err = req.SetBody(struct {
+		Grant driver.Grant `json:"grant"`
+	}{
+		Grant: grant.Access,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := ac.driver.Connection().Do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return resp.CheckStatus(http.StatusOK)
+}
+
+func (ac *arangoClientUserImpl) RemoveGrant(ctx context.Context, name string, grant api.ArangoUserGrant) error {
+	req, err := ac.driver.Connection().NewRequest(http.MethodDelete, grantPath(name, grant))
+	if err != nil {
+		return err
+	}
+
+	resp, err := ac.driver.Connection().Do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return resp.CheckStatus(http.StatusAccepted, http.StatusNotFound)
+}
+
+// grantPath returns the path of the user access API for the database or the collection
+func grantPath(name string, grant api.ArangoUserGrant) string {
+	p := path.Join("_api", "user", url.PathEscape(name), "database", url.PathEscape(grant.Database))
+
+	if grant.Collection != "" {
+		p = path.Join(p, url.PathEscape(grant.Collection))
+	}
+
+	return p
+}
@@ -0,0 +1,136 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package user
+
+import (
+	"context"
+	"sync"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func newMockArangoClientUserFactory(mock *mockArangoClientUser) ArangoClientFactory {
+	return func(deployment *api.ArangoDeployment) (ArangoUserClient, error) {
+		return mock, nil
+	}
+}
+
+type mockUser struct {
+	password string
+	active   bool
+}
+
+func newMockArangoClientUser() *mockArangoClientUser {
+	return &mockArangoClientUser{
+		users:  map[string]mockUser{},
+		grants: map[string]driver.Grant{},
+	}
+}
+
+type mockArangoClientUser struct {
+	lock sync.Mutex
+
+	users  map[string]mockUser
+	grants map[string]driver.Grant
+
+	updates int
+
+	// createError is returned by Create instead of creating the user
+	createError error
+}
+
+func (m *mockArangoClientUser) Get(ctx context.Context, name string) (bool, bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	u, ok := m.users[name]
+	return u.active, ok, nil
+}
+
+func (m *mockArangoClientUser) Create(ctx context.Context, name string, password *string, active bool) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.createError != nil {
+		return m.createError
+	}
+
+	u := mockUser{active: active}
+	if password != nil {
+		u.password = *password
+	}
+
+	m.users[name] = u
+	return nil
+}
+
+func (m *mockArangoClientUser) Update(ctx context.Context, name string, password *string, active *bool) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	u := m.users[name]
+	if password != nil {
+		u.password = *password
+	}
+	if active != nil {
+		u.active = *active
+	}
+
+	m.users[name] = u
+	m.updates++
+	return nil
+}
+
+func (m *mockArangoClientUser) Remove(ctx context.Context, name string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.users, name)
+	return nil
+}
+
+func (m *mockArangoClientUser) GetGrant(ctx context.Context, name string, grant api.ArangoUserGrant) (driver.Grant, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if g, ok := m.grants[grantPath(name, grant)]; ok {
+		return g, nil
+	}
+
+	return driver.GrantNone, nil
+}
+
+func (m *mockArangoClientUser) SetGrant(ctx context.Context, name string, grant api.ArangoUserGrant) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.grants[grantPath(name, grant)] = grant.Access
+	return nil
+}
+
+func (m *mockArangoClientUser) RemoveGrant(ctx context.Context, name string, grant api.ArangoUserGrant) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.grants, grantPath(name, grant))
+	return nil
+}
@@ -0,0 +1,369 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/utils"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	userCreated = "ArangoUserCreated"
+	userUpdated = "ArangoUserUpdated"
+	userRemoved = "ArangoUserRemoved"
+	userError   = "Error"
+
+	defaultArangoClientTimeout = 30 * time.Second
+)
+
+type handler struct {
+	client        arangoClientSet.Interface
+	kubeClient    kubernetes.Interface
+	eventRecorder event.RecorderInstance
+
+	arangoClientFactory ArangoClientFactory
+
+	operator operator.Operator
+}
+
+func (*handler) Name() string {
+	return deployment.ArangoUserResourceKind
+}
+
+func (h *handler) Handle(item operation.Item) error {
+	// Do not act on delete event, user is removed by the finalizer
+	if item.Operation == operation.Delete {
+		return nil
+	}
+
+	// Get User object. It also covers NotFound case
+	user, err := h.client.DatabaseV1().ArangoUsers(item.Namespace).Get(context.Background(), item.Name, meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil
+		}
+		h.operator.GetLogger().Error().Msgf("ArangoUser fetch error %v", err)
+		return err
+	}
+
+	if user.DeletionTimestamp != nil {
+		return h.finalize(user)
+	}
+
+	if !utils.StringList(user.Finalizers).Has(api.FinalizerArangoUser) {
+		user.Finalizers = append(user.Finalizers, api.FinalizerArangoUser)
+		if _, err := h.client.DatabaseV1().ArangoUsers(item.Namespace).Update(context.Background(), user, meta.UpdateOptions{}); err != nil {
+			h.operator.GetLogger().Error().Msgf("ArangoUser finalizer update error %v", err)
+			return err
+		}
+
+		// Update triggers next event
+		return nil
+	}
+
+	status := h.processArangoUser(user.DeepCopy())
+	if reflect.DeepEqual(user.Status, status) {
+		return nil
+	}
+
+	// Update status on object
+	if err := h.updateStatus(user, status); err != nil {
+		h.operator.GetLogger().Error().Msgf("ArangoUser status update error %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// updateStatus updates the status of the user, the status is applied again to the reloaded object on conflict
+func (h *handler) updateStatus(user *api.ArangoUser, status api.ArangoUserStatus) error {
+	users := h.client.DatabaseV1().ArangoUsers(user.Namespace)
+	return k8sutil.RetryOnConflict(func() error {
+		user.Status = status
+		_, err := users.UpdateStatus(context.Background(), user, meta.UpdateOptions{})
+		return err
+	}, func() error {
+		current, err := users.Get(context.Background(), user.GetName(), meta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		user = current
+		return nil
+	})
+}
+
+func (h *handler) processArangoUser(user *api.ArangoUser) api.ArangoUserStatus {
+	status := *user.Status.DeepCopy()
+
+	if err := h.reconcileUser(user, &status); err != nil {
+		if status.Conditions.Update(api.ConditionTypeReady, false, "User reconciliation failed", err.Error()) {
+			h.eventRecorder.Warning(user, userError, "User reconciliation failed: %s", err.Error())
+		}
+		return status
+	}
+
+	status.Conditions.Update(api.ConditionTypeReady, true, "User is up to date", "")
+	return status
+}
+
+// reconcileUser ensures that user and grants in the database are matching the spec, status is updated with applied changes
+func (h *handler) reconcileUser(user *api.ArangoUser, status *api.ArangoUserStatus) error {
+	if err := user.Validate(); err != nil {
+		return errors.Wrapf(err, "Validation failed")
+	}
+
+	client, err := h.getArangoClient(user)
+	if err != nil {
+		return err
+	}
+
+	password, passwordVersion, err := h.getPassword(user)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultArangoClientTimeout)
+	defer cancel()
+
+	name := user.GetUserName()
+
+	active, exists, err := client.Get(ctx, name)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get user")
+	}
+
+	if !exists {
+		if !status.Created {
+			// Ownership is persisted before the user is created, so the user is removed by the finalizer
+			// also when the status update after the creation fails
+			status.Created = true
+			if err := h.updateStatus(user.DeepCopy(), *status); err != nil {
+				return errors.Wrapf(err, "Unable to mark user as created")
+			}
+		}
+
+		if err := client.Create(ctx, name, password, user.Spec.IsActive()); err != nil {
+			if driver.IsConflict(err) {
+				// User was created by someone else in the meantime, it is not owned by the operator
+				status.Created = false
+			}
+			return errors.Wrapf(err, "Unable to create user")
+		}
+
+		status.PasswordSecretVersion = passwordVersion
+		status.Grants = nil
+		h.eventRecorder.Normal(user, userCreated, "User %s has been created", name)
+	} else {
+		var updatePassword *string
+		var updateActive *bool
+
+		if status.PasswordSecretVersion != passwordVersion {
+			updatePassword = password
+		}
+
+		if active != user.Spec.IsActive() {
+			updateActive = util.NewBool(user.Spec.IsActive())
+		}
+
+		if updatePassword != nil || updateActive != nil {
+			if err := client.Update(ctx, name, updatePassword, updateActive); err != nil {
+				return errors.Wrapf(err, "Unable to update user")
+			}
+
+			h.eventRecorder.Normal(user, userUpdated, "User %s has been updated", name)
+		}
+
+		status.PasswordSecretVersion = passwordVersion
+	}
+
+	// Revoke grants which were removed from the spec
+	var applied []api.ArangoUserGrant
+	for _, grant := range status.Grants {
+		if hasGrantTarget(user.Spec.Grants, grant) {
+			applied = append(applied, grant)
+			continue
+		}
+
+		if err := client.RemoveGrant(ctx, name, grant); err != nil {
+			return errors.Wrapf(err, "Unable to revoke access to %s", grantTarget(grant))
+		}
+
+		status.Grants = applied
+		h.eventRecorder.Normal(user, userUpdated, "Access of user %s to %s has been revoked", name, grantTarget(grant))
+	}
+
+	status.Grants = applied
+
+	for _, grant := range user.Spec.Grants {
+		current, err := client.GetGrant(ctx, name, grant)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to get access to %s", grantTarget(grant))
+		}
+
+		if current != grant.Access {
+			if err := client.SetGrant(ctx, name, grant); err != nil {
+				return errors.Wrapf(err, "Unable to set access to %s", grantTarget(grant))
+			}
+
+			h.eventRecorder.Normal(user, userUpdated, "Access of user %s to %s has been set to %s", name, grantTarget(grant), grant.Access)
+		}
+
+		status.Grants = setGrant(status.Grants, grant)
+	}
+
+	return nil
+}
+
+func (h *handler) finalize(user *api.ArangoUser) error {
+	if !utils.StringList(user.Finalizers).Has(api.FinalizerArangoUser) {
+		return nil
+	}
+
+	// Only users created by the operator are removed, existing users are retained
+	if user.Status.Created {
+		if err := h.removeUser(user); err != nil {
+			h.eventRecorder.Warning(user, userError, "User removal failed: %s", err.Error())
+			return err
+		}
+	}
+
+	user.Finalizers = utils.StringList(user.Finalizers).Remove(api.FinalizerArangoUser)
+
+	if _, err := h.client.DatabaseV1().ArangoUsers(user.Namespace).Update(context.Background(), user, meta.UpdateOptions{}); err != nil {
+		h.operator.GetLogger().Error().Msgf("ArangoUser finalizer update error %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *handler) removeUser(user *api.ArangoUser) error {
+	client, err := h.getArangoClient(user)
+	if err != nil {
+		if k8sutil.IsNotFound(errors.Cause(err)) {
+			// Deployment is gone, nothing to remove
+			return nil
+		}
+
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultArangoClientTimeout)
+	defer cancel()
+
+	if err := client.Remove(ctx, user.GetUserName()); err != nil {
+		return err
+	}
+
+	h.eventRecorder.Normal(user, userRemoved, "User %s has been removed", user.GetUserName())
+	return nil
+}
+
+func (h *handler) getArangoClient(user *api.ArangoUser) (ArangoUserClient, error) {
+	depl, err := h.client.DatabaseV1().ArangoDeployments(user.Namespace).Get(context.Background(), user.Spec.DeploymentName, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get ArangoDeployment %s", user.Spec.DeploymentName)
+	}
+
+	client, err := h.arangoClientFactory(depl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create client for ArangoDeployment %s", user.Spec.DeploymentName)
+	}
+
+	return client, nil
+}
+
+// getPassword returns the password and the resource version of the Secret,
+// nil password and empty version are returned when Secret is not defined
+func (h *handler) getPassword(user *api.ArangoUser) (*string, string, error) {
+	name := user.Spec.GetPasswordSecretName()
+	if name == "" {
+		return nil, "", nil
+	}
+
+	secret, err := h.kubeClient.CoreV1().Secrets(user.Namespace).Get(context.Background(), name, meta.GetOptions{})
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "Unable to get password Secret %s", name)
+	}
+
+	data, ok := secret.Data[constants.SecretPassword]
+	if !ok {
+		return nil, "", errors.Newf("No '%s' found in Secret %s", constants.SecretPassword, name)
+	}
+
+	password := string(data)
+
+	return &password, secret.GetResourceVersion(), nil
+}
+
+func hasGrantTarget(grants []api.ArangoUserGrant, grant api.ArangoUserGrant) bool {
+	for _, g := range grants {
+		if g.Database == grant.Database && g.Collection == grant.Collection {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setGrant adds or replaces the grant with the same target
+func setGrant(grants []api.ArangoUserGrant, grant api.ArangoUserGrant) []api.ArangoUserGrant {
+	for id, g := range grants {
+		if g.Database == grant.Database && g.Collection == grant.Collection {
+			grants[id] = grant
+			return grants
+		}
+	}
+
+	return append(grants, grant)
+}
+
+func grantTarget(grant api.ArangoUserGrant) string {
+	if grant.Collection == "" {
+		return fmt.Sprintf("database %s", grant.Database)
+	}
+
+	return fmt.Sprintf("collection %s/%s", grant.Database, grant.Collection)
+}
+
+func (*handler) CanBeHandled(item operation.Item) bool {
+	return item.Group == api.SchemeGroupVersion.Group &&
+		item.Version == api.SchemeGroupVersion.Version &&
+		item.Kind == deployment.ArangoUserResourceKind
+}
@@ -0,0 +1,306 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package user
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+func Test_User_Create(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientUser()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	user := newArangoUser("test", namespace, depl.Name)
+	user.Spec.PasswordSecretName = util.NewString("password")
+	user.Spec.Grants = []api.ArangoUserGrant{
+		{Database: "db", Access: driver.GrantReadWrite},
+		{Database: "db", Collection: "col", Access: driver.GrantReadOnly},
+	}
+
+	createArangoDeployment(t, handler, depl)
+	createSecret(t, handler, newPasswordSecret("password", namespace, "secret"))
+	createArangoUser(t, handler, user)
+
+	// Act
+	handle(t, handler, user)
+
+	// Assert
+	u := refreshArangoUser(t, handler, user)
+	require.Contains(t, u.Finalizers, api.FinalizerArangoUser)
+	require.True(t, u.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.True(t, u.Status.Created)
+	require.Equal(t, "1", u.Status.PasswordSecretVersion)
+	require.Equal(t, user.Spec.Grants, u.Status.Grants)
+
+	require.Equal(t, mockUser{password: "secret", active: true}, mock.users["test"])
+	require.Equal(t, driver.GrantReadWrite, mock.grants["_api/user/test/database/db"])
+	require.Equal(t, driver.GrantReadOnly, mock.grants["_api/user/test/database/db/col"])
+}
+
+func Test_User_ReconcileDrift(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientUser()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	user := newArangoUser("test", namespace, depl.Name)
+	user.Spec.PasswordSecretName = util.NewString("password")
+	user.Spec.Grants = []api.ArangoUserGrant{
+		{Database: "db", Access: driver.GrantReadWrite},
+		{Database: "other", Access: driver.GrantReadOnly},
+	}
+
+	secret := newPasswordSecret("password", namespace, "secret")
+
+	createArangoDeployment(t, handler, depl)
+	createSecret(t, handler, secret)
+	createArangoUser(t, handler, user)
+	handle(t, handler, user)
+
+	t.Run("Nothing changed", func(t *testing.T) {
+		handle(t, handler, user)
+		require.Equal(t, 0, mock.updates)
+	})
+
+	t.Run("Grant changed in database", func(t *testing.T) {
+		mock.grants["_api/user/test/database/db"] = driver.GrantNone
+
+		handle(t, handler, user)
+
+		require.Equal(t, driver.GrantReadWrite, mock.grants["_api/user/test/database/db"])
+	})
+
+	t.Run("User deactivated in database", func(t *testing.T) {
+		mock.users["test"] = mockUser{password: "secret", active: false}
+
+		handle(t, handler, user)
+
+		require.True(t, mock.users["test"].active)
+	})
+
+	t.Run("Password changed", func(t *testing.T) {
+		secret.Data["password"] = []byte("new")
+		updateSecret(t, handler, secret)
+
+		handle(t, handler, user)
+
+		require.Equal(t, "new", mock.users["test"].password)
+		require.Equal(t, "2", refreshArangoUser(t, handler, user).Status.PasswordSecretVersion)
+	})
+
+	t.Run("Grant removed from spec", func(t *testing.T) {
+		u := refreshArangoUser(t, handler, user)
+		u.Spec.Grants = u.Spec.Grants[:1]
+		updateArangoUser(t, handler, u)
+
+		handle(t, handler, user)
+
+		require.NotContains(t, mock.grants, "_api/user/test/database/other")
+		require.Equal(t, u.Spec.Grants, refreshArangoUser(t, handler, user).Status.Grants)
+	})
+}
+
+func Test_User_DeploymentMissing(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientUser()
+	handler := newFakeHandler(mock)
+
+	user := newArangoUser("test", string(uuid.NewUUID()), "missing")
+	createArangoUser(t, handler, user)
+
+	// Act
+	handle(t, handler, user)
+
+	// Assert
+	u := refreshArangoUser(t, handler, user)
+	require.False(t, u.Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Len(t, u.Status.Conditions, 1)
+	require.Empty(t, mock.users)
+}
+
+func Test_User_Finalize(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientUser()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	user := newArangoUser("test", namespace, depl.Name)
+	user.Spec.Name = util.NewString("custom")
+
+	createArangoDeployment(t, handler, depl)
+	createArangoUser(t, handler, user)
+	handle(t, handler, user)
+	require.Contains(t, mock.users, "custom")
+
+	// Act
+	u := refreshArangoUser(t, handler, user)
+	now := meta.Now()
+	u.DeletionTimestamp = &now
+	updateArangoUser(t, handler, u)
+
+	handle(t, handler, user)
+
+	// Assert
+	require.NotContains(t, mock.users, "custom")
+	require.NotContains(t, refreshArangoUser(t, handler, user).Finalizers, api.FinalizerArangoUser)
+}
+
+func Test_User_FinalizeExisting(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientUser()
+	mock.users["existing"] = mockUser{active: true}
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	user := newArangoUser("existing", namespace, depl.Name)
+
+	createArangoDeployment(t, handler, depl)
+	createArangoUser(t, handler, user)
+	handle(t, handler, user)
+	require.False(t, refreshArangoUser(t, handler, user).Status.Created)
+
+	// Act
+	u := refreshArangoUser(t, handler, user)
+	now := meta.Now()
+	u.DeletionTimestamp = &now
+	updateArangoUser(t, handler, u)
+
+	handle(t, handler, user)
+
+	// Assert
+	require.Contains(t, mock.users, "existing")
+	require.NotContains(t, refreshArangoUser(t, handler, user).Finalizers, api.FinalizerArangoUser)
+}
+
+func Test_User_Root(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientUser()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	user := newArangoUser("root", namespace, depl.Name)
+
+	createArangoDeployment(t, handler, depl)
+	createArangoUser(t, handler, user)
+
+	// Act
+	handle(t, handler, user)
+
+	// Assert
+	require.False(t, refreshArangoUser(t, handler, user).Status.Conditions.IsTrue(api.ConditionTypeReady))
+	require.Empty(t, mock.users)
+}
+
+func Test_User_CreatedPersistedBeforeCreation(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientUser()
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	user := newArangoUser("test", namespace, depl.Name)
+
+	createArangoDeployment(t, handler, depl)
+	createArangoUser(t, handler, user)
+
+	// First run adds the finalizer
+	require.NoError(t, handler.Handle(newItemFromUser(operation.Update, user)))
+
+	// Status update after the creation fails
+	statusUpdates := 0
+	handler.client.(*fakeClientSet.Clientset).PrependReactor("update", "arangousers", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "status" {
+			return false, nil, nil
+		}
+
+		statusUpdates++
+		if statusUpdates == 2 {
+			return true, nil, errors.Newf("status update failed")
+		}
+		return false, nil, nil
+	})
+
+	// Act
+	require.Error(t, handler.Handle(newItemFromUser(operation.Update, user)))
+
+	// Assert
+	require.Contains(t, mock.users, "test")
+	u := refreshArangoUser(t, handler, user)
+	require.True(t, u.Status.Created)
+
+	now := meta.Now()
+	u.DeletionTimestamp = &now
+	updateArangoUser(t, handler, u)
+
+	handle(t, handler, user)
+
+	require.NotContains(t, mock.users, "test")
+}
+
+func Test_User_CreateConflict(t *testing.T) {
+	// Arrange
+	mock := newMockArangoClientUser()
+	mock.createError = driver.ArangoError{HasError: true, Code: http.StatusConflict, ErrorMessage: "duplicate user"}
+	handler := newFakeHandler(mock)
+
+	namespace := string(uuid.NewUUID())
+	depl := newArangoDeployment(string(uuid.NewUUID()), namespace)
+
+	user := newArangoUser("test", namespace, depl.Name)
+
+	createArangoDeployment(t, handler, depl)
+	createArangoUser(t, handler, user)
+
+	// Act
+	handle(t, handler, user)
+
+	// Assert
+	u := refreshArangoUser(t, handler, user)
+	require.False(t, u.Status.Created)
+	require.False(t, u.Status.Conditions.IsTrue(api.ConditionTypeReady))
+}
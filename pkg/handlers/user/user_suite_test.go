@@ -0,0 +1,156 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package user
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newFakeHandler(mock *mockArangoClientUser) *handler {
+	f := fakeClientSet.NewSimpleClientset()
+	k := fake.NewSimpleClientset()
+
+	return &handler{
+		client:              f,
+		kubeClient:          k,
+		eventRecorder:       newEventInstance(event.NewEventRecorder(log.Logger, "mock", k)),
+		operator:            operator.NewOperator(log.Logger, "mock", "mock", "mock"),
+		arangoClientFactory: newMockArangoClientUserFactory(mock),
+	}
+}
+
+func newItemFromUser(o operation.Operation, user *api.ArangoUser) operation.Item {
+	return operation.Item{
+		Group:   api.SchemeGroupVersion.Group,
+		Version: api.SchemeGroupVersion.Version,
+		Kind:    deployment.ArangoUserResourceKind,
+
+		Operation: o,
+
+		Namespace: user.Namespace,
+		Name:      user.Name,
+	}
+}
+
+func newArangoUser(name, namespace, deploymentName string) *api.ArangoUser {
+	return &api.ArangoUser{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoUserResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+		Spec: api.ArangoUserSpec{
+			DeploymentName: deploymentName,
+		},
+	}
+}
+
+func newArangoDeployment(name, namespace string) *api.ArangoDeployment {
+	return &api.ArangoDeployment{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       deployment.ArangoDeploymentResourceKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+	}
+}
+
+func newPasswordSecret(name, namespace, password string) *core.Secret {
+	return &core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			constants.SecretPassword: []byte(password),
+		},
+	}
+}
+
+func createArangoUser(t *testing.T, h *handler, user *api.ArangoUser) {
+	_, err := h.client.DatabaseV1().ArangoUsers(user.Namespace).Create(context.Background(), user, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+func updateArangoUser(t *testing.T, h *handler, user *api.ArangoUser) {
+	_, err := h.client.DatabaseV1().ArangoUsers(user.Namespace).Update(context.Background(), user, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func createArangoDeployment(t *testing.T, h *handler, depl *api.ArangoDeployment) {
+	_, err := h.client.DatabaseV1().ArangoDeployments(depl.Namespace).Create(context.Background(), depl, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+// createSecret creates the Secret, resource version is set as by the API server
+func createSecret(t *testing.T, h *handler, secret *core.Secret) {
+	secret.ResourceVersion = "1"
+	_, err := h.kubeClient.CoreV1().Secrets(secret.Namespace).Create(context.Background(), secret, meta.CreateOptions{})
+	require.NoError(t, err)
+}
+
+// updateSecret updates the Secret, resource version is increased as by the API server
+func updateSecret(t *testing.T, h *handler, secret *core.Secret) {
+	version, err := strconv.Atoi(secret.ResourceVersion)
+	require.NoError(t, err)
+	secret.ResourceVersion = strconv.Itoa(version + 1)
+
+	_, err = h.kubeClient.CoreV1().Secrets(secret.Namespace).Update(context.Background(), secret, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func refreshArangoUser(t *testing.T, h *handler, user *api.ArangoUser) *api.ArangoUser {
+	u, err := h.client.DatabaseV1().ArangoUsers(user.Namespace).Get(context.Background(), user.Name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	return u
+}
+
+// handle runs the handler twice, first run adds the finalizer
+func handle(t *testing.T, h *handler, user *api.ArangoUser) {
+	require.NoError(t, h.Handle(newItemFromUser(operation.Update, user)))
+	require.NoError(t, h.Handle(newItemFromUser(operation.Update, user)))
+}
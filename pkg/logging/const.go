@@ -30,6 +30,9 @@ const (
 	LoggerNameProvisioner           = "provisioner"
 	LoggerNameReconciliation        = "reconciliation"
 	LoggerNameEventRecorder         = "event-recorder"
+	LoggerNameResources             = "resources"
+	LoggerNameAgency                = "agency"
+	LoggerNameBackup                = "backup"
 )
 
 func LoggerNames() []string {
@@ -43,5 +46,8 @@ func LoggerNames() []string {
 		LoggerNameProvisioner,
 		LoggerNameReconciliation,
 		LoggerNameEventRecorder,
+		LoggerNameResources,
+		LoggerNameAgency,
+		LoggerNameBackup,
 	}
 }
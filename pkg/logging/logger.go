@@ -40,14 +40,24 @@ type Service interface {
 	MustSetLevel(name, level string)
 	// ConfigureRootLogger calls the given callback to modify the root logger.
 	ConfigureRootLogger(cb func(rootLog zerolog.Logger) zerolog.Logger)
+	// ApplyLevels sets log levels of components at runtime. Loggers created before are affected.
+	// Levels of components missing in the map are restored to the levels the service was created with.
+	// The DefaultLevelKey key sets the level of components without own level.
+	ApplyLevels(levels map[string]string) error
 }
 
+// DefaultLevelKey is the key used in ApplyLevels for the default level
+const DefaultLevelKey = "default"
+
 // loggingService implements Service
 type loggingService struct {
-	mutex        sync.Mutex
+	mutex        sync.RWMutex
 	rootLog      zerolog.Logger
 	defaultLevel zerolog.Level
 	levels       map[string]zerolog.Level
+
+	initialDefaultLevel zerolog.Level
+	initialLevels       map[string]zerolog.Level
 }
 
 // NewRootLogger creates a new zerolog logger with default settings.
@@ -91,6 +101,13 @@ func NewService(defaultLevel string, overrides []string) (Service, error) {
 			return nil, errors.Newf("invalid log definition %s: Length %d is not equal 1 or 2", override, size)
 		}
 	}
+
+	s.initialDefaultLevel = s.defaultLevel
+	s.initialLevels = make(map[string]zerolog.Level, len(s.levels))
+	for name, level := range s.levels {
+		s.initialLevels[name] = level
+	}
+
 	return s, nil
 }
 
@@ -102,16 +119,18 @@ func (s *loggingService) ConfigureRootLogger(cb func(rootLog zerolog.Logger) zer
 	s.rootLog = cb(s.rootLog)
 }
 
-// MustGetLogger creates a logger with given name
+// MustGetLogger creates a logger with given name.
+// Level of the logger is the global level, the lowest level the component can be set to.
+// Events are filtered by the level of the component before they are created, so levels can be changed at runtime
+// and events below the level of the component are not built.
 func (s *loggingService) MustGetLogger(name string) zerolog.Logger {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
 
-	level, found := s.levels[name]
-	if !found {
-		level = s.defaultLevel
-	}
-	return s.rootLog.With().Str("component", name).Logger().Level(level)
+	return s.rootLog.With().Str("component", name).Logger().Level(zerolog.GlobalLevel()).Sample(levelSampler{
+		service: s,
+		name:    name,
+	})
 }
 
 // MustSetLevel sets the log level for the component with given name to given level.
@@ -125,6 +144,58 @@ func (s *loggingService) MustSetLevel(name, level string) {
 	s.levels[name] = l
 }
 
+// ApplyLevels sets log levels of components at runtime.
+func (s *loggingService) ApplyLevels(levels map[string]string) error {
+	defaultLevel := s.initialDefaultLevel
+	newLevels := make(map[string]zerolog.Level, len(s.initialLevels)+len(levels))
+	for name, level := range s.initialLevels {
+		newLevels[name] = level
+	}
+
+	for name, level := range levels {
+		l, err := stringToLevel(strings.TrimSpace(level))
+		if err != nil {
+			return errors.Wrapf(err, "invalid log level of %s", name)
+		}
+
+		if name == DefaultLevelKey {
+			defaultLevel = l
+		} else {
+			newLevels[name] = l
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.defaultLevel = defaultLevel
+	s.levels = newLevels
+
+	return nil
+}
+
+// getLevel returns the current level of the component
+func (s *loggingService) getLevel(name string) zerolog.Level {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if level, ok := s.levels[name]; ok {
+		return level
+	}
+	return s.defaultLevel
+}
+
+// levelSampler rejects events below the current level of the component.
+// Sampler is called by the logger before the event is created.
+type levelSampler struct {
+	service *loggingService
+	name    string
+}
+
+func (l levelSampler) Sample(level zerolog.Level) bool {
+	return level == zerolog.NoLevel || level >= l.service.getLevel(l.name)
+}
+
 // stringToLevel converts a level string to a zerolog level
 func stringToLevel(l string) (zerolog.Level, error) {
 	switch strings.ToLower(l) {
@@ -0,0 +1,75 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Service_ApplyLevels(t *testing.T) {
+	s, err := NewService("info", []string{"agency=warn"})
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	s.ConfigureRootLogger(func(zerolog.Logger) zerolog.Logger {
+		return zerolog.New(&out)
+	})
+
+	agency := s.MustGetLogger(LoggerNameAgency)
+	resources := s.MustGetLogger(LoggerNameResources)
+
+	logged := func(l zerolog.Logger, level zerolog.Level) bool {
+		out.Reset()
+		l.WithLevel(level).Msg("test")
+		return out.Len() > 0
+	}
+
+	require.False(t, logged(agency, zerolog.InfoLevel))
+	require.True(t, logged(agency, zerolog.WarnLevel))
+	require.False(t, logged(resources, zerolog.DebugLevel))
+	require.True(t, logged(resources, zerolog.InfoLevel))
+
+	// Levels are changed for existing loggers
+	require.NoError(t, s.ApplyLevels(map[string]string{
+		LoggerNameAgency: "debug",
+		DefaultLevelKey:  "error",
+	}))
+	require.True(t, logged(agency, zerolog.DebugLevel))
+	require.False(t, logged(agency, zerolog.TraceLevel))
+	require.False(t, logged(resources, zerolog.WarnLevel))
+
+	// Events below the level are not created
+	require.Nil(t, resources.Info())
+	require.NotNil(t, agency.Debug())
+
+	// Invalid levels are not applied
+	require.Error(t, s.ApplyLevels(map[string]string{LoggerNameAgency: "verbose"}))
+	require.True(t, logged(agency, zerolog.DebugLevel))
+
+	// Initial levels are restored
+	require.NoError(t, s.ApplyLevels(nil))
+	require.False(t, logged(agency, zerolog.InfoLevel))
+	require.True(t, logged(resources, zerolog.InfoLevel))
+}
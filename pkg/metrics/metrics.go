@@ -31,6 +31,24 @@ const (
 
 	// DeploymentName is a label key used for the name of a deployment
 	DeploymentName = "deployment"
+	// Namespace is a label key used for the namespace of resources
+	Namespace = "namespace"
+	// ResourceKind is a label key used for the kind of resources
+	ResourceKind = "kind"
+	// LocalStorageName is a label key used for the name of a local storage
+	LocalStorageName = "local_storage"
+	// DeploymentReplicationName is a label key used for the name of a deployment replication
+	DeploymentReplicationName = "deployment_replication"
+	// Endpoint is a label key used for the endpoint of a deployment replication (source|destination)
+	Endpoint = "endpoint"
+	// Database is a label key used for the name of a database
+	Database = "database"
+	// NodeName is a label key used for the name of a node
+	NodeName = "node"
+	// MemberGroup is a label key used for the group of a deployment member
+	MemberGroup = "group"
+	// MemberID is a label key used for the ID of a deployment member
+	MemberID = "member"
 	// ActionName is a label key used for the name of an action
 	ActionName = "action"
 	// ActionPriority is a label key used for the priority of an action
@@ -112,6 +130,23 @@ func MustRegisterSummary(component, name, help string, objectives map[float64]fl
 	return m
 }
 
+// MustRegisterHistogramVec creates and registers a histogram vector.
+// Must be called from `init`.
+func MustRegisterHistogramVec(component, name, help string, buckets []float64, labelNames ...string) *prometheus.HistogramVec {
+	if buckets == nil {
+		buckets = prometheus.DefBuckets
+	}
+	m := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: component,
+		Name:      name,
+		Help:      help,
+		Buckets:   buckets,
+	}, labelNames)
+	prometheus.MustRegister(m)
+	return m
+}
+
 // SetDuration sets a gauge value for the duration since the given start time
 // in seconds.
 func SetDuration(g prometheus.Gauge, startTime time.Time) {
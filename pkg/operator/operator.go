@@ -24,12 +24,15 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	kwatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
@@ -46,8 +49,13 @@ import (
 	arangoInformer "github.com/arangodb/kube-arangodb/pkg/generated/informers/externalversions"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/backup"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/clustersync"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/collection"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/database"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/foxx"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/job"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/policy"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/task"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/user"
 	"github.com/arangodb/kube-arangodb/pkg/logging"
 	"github.com/arangodb/kube-arangodb/pkg/operator/scope"
 	operatorV2 "github.com/arangodb/kube-arangodb/pkg/operatorV2"
@@ -84,8 +92,15 @@ type Operator struct {
 
 	log                    zerolog.Logger
 	deployments            map[string]*deployment.Deployment
+	deploymentWatchers     map[string]*deploymentWatcher
+	deploymentsStop        <-chan struct{}
 	deploymentReplications map[string]*replication.DeploymentReplication
 	localStorages          map[string]*storage.LocalStorage
+
+	deploymentNamespacesSelected map[string]bool
+	deploymentNamespacesSynced   bool
+	deploymentShards             *deploymentShards
+	deploymentsHandedOver        bool
 }
 
 type Config struct {
@@ -105,6 +120,28 @@ type Config struct {
 	ScalingIntegrationEnabled   bool
 	SingleMode                  bool
 	Scope                       scope.Scope
+	// MemberStateRefreshInterval defines how often the state of deployment members is refreshed in the background.
+	MemberStateRefreshInterval time.Duration
+	// WatchNamespaces defines namespaces in which ArangoDeployments are managed.
+	// Namespace of the operator is used when empty.
+	WatchNamespaces []string
+	// WatchNamespaceSelector limits namespaces in which ArangoDeployments are managed to namespaces matching the selector.
+	// All namespaces are watched when WatchNamespaces is empty.
+	WatchNamespaceSelector labels.Selector
+	// DeploymentSharding enables sharing of ArangoDeployments between all operator replicas instead of a single leader.
+	DeploymentSharding bool
+	// EventsQPS defines the number of events per second sent by each handler.
+	EventsQPS float32
+	// EventsBurst defines the number of events sent by each handler at once.
+	EventsBurst int
+	// HandlerWorkers defines the number of dedicated workers per operatorV2 handler name, e.g. ArangoJob.
+	HandlerWorkers map[string]int
+	// RateLimiter defines rate limits of operatorV2 handlers.
+	RateLimiter operatorV2.RateLimiter
+	// HandlerRateLimiters defines rate limits per operatorV2 handler name, e.g. ArangoJob.
+	HandlerRateLimiters map[string]operatorV2.RateLimiter
+	// ShutdownTimeout defines how long the operator waits for deployments to be handed over and leadership to be released.
+	ShutdownTimeout time.Duration
 }
 
 type Dependencies struct {
@@ -127,58 +164,61 @@ func NewOperator(config Config, deps Dependencies) (*Operator, error) {
 		Dependencies:           deps,
 		log:                    deps.LogService.MustGetLogger(logging.LoggerNameOperator),
 		deployments:            make(map[string]*deployment.Deployment),
+		deploymentWatchers:     make(map[string]*deploymentWatcher),
 		deploymentReplications: make(map[string]*replication.DeploymentReplication),
 		localStorages:          make(map[string]*storage.LocalStorage),
+
+		deploymentNamespacesSelected: make(map[string]bool),
+	}
+	if config.DeploymentSharding {
+		o.deploymentShards = &deploymentShards{member: config.ID}
+	}
+	if o.Config.ShutdownTimeout <= 0 {
+		o.Config.ShutdownTimeout = DefaultShutdownTimeout
 	}
 	return o, nil
 }
 
-// Run the operator
-func (o *Operator) Run() {
+// Run the operator till the given context is done.
+// Deployments are handed over to the next operator before the leadership is released.
+func (o *Operator) Run(ctx context.Context) {
+	leaderCtx, releaseLeadership := context.WithCancel(context.Background())
+	defer releaseLeadership()
+
+	var leaders sync.WaitGroup
+	run := func(leaderElection bool, lockName, label string, onStart func(stop <-chan struct{}), readyProbe *probe.ReadyProbe) {
+		leaders.Add(1)
+		go func() {
+			defer leaders.Done()
+			if leaderElection {
+				o.runLeaderElection(leaderCtx, lockName, label, onStart, readyProbe)
+			} else {
+				o.runWithoutLeaderElection(leaderCtx, lockName, label, onStart, readyProbe)
+			}
+		}()
+	}
+
 	if o.Config.EnableDeployment {
-		if !o.Config.SingleMode {
-			go o.runLeaderElection("arango-deployment-operator", constants.LabelRole, o.onStartDeployment, o.Dependencies.DeploymentProbe)
-		} else {
-			go o.runWithoutLeaderElection("arango-deployment-operator", constants.LabelRole, o.onStartDeployment, o.Dependencies.DeploymentProbe)
-		}
+		run(!o.Config.SingleMode && !o.Config.DeploymentSharding, "arango-deployment-operator", constants.LabelRole, o.onStartDeployment, o.Dependencies.DeploymentProbe)
 	}
 	if o.Config.EnableDeploymentReplication {
-		if !o.Config.SingleMode {
-			go o.runLeaderElection("arango-deployment-replication-operator", constants.LabelRole, o.onStartDeploymentReplication, o.Dependencies.DeploymentReplicationProbe)
-		} else {
-			go o.runWithoutLeaderElection("arango-deployment-replication-operator", constants.LabelRole, o.onStartDeploymentReplication, o.Dependencies.DeploymentReplicationProbe)
-		}
+		run(!o.Config.SingleMode, "arango-deployment-replication-operator", constants.LabelRole, o.onStartDeploymentReplication, o.Dependencies.DeploymentReplicationProbe)
 	}
 	if o.Config.EnableStorage {
-		if !o.Config.SingleMode {
-			go o.runLeaderElection("arango-storage-operator", constants.LabelRole, o.onStartStorage, o.Dependencies.StorageProbe)
-		} else {
-			go o.runWithoutLeaderElection("arango-storage-operator", constants.LabelRole, o.onStartStorage, o.Dependencies.StorageProbe)
-		}
+		run(!o.Config.SingleMode, "arango-storage-operator", constants.LabelRole, o.onStartStorage, o.Dependencies.StorageProbe)
 	}
 	if o.Config.EnableBackup {
-		if !o.Config.SingleMode {
-			go o.runLeaderElection("arango-backup-operator", constants.BackupLabelRole, o.onStartBackup, o.Dependencies.BackupProbe)
-		} else {
-			go o.runWithoutLeaderElection("arango-backup-operator", constants.BackupLabelRole, o.onStartBackup, o.Dependencies.BackupProbe)
-		}
+		run(!o.Config.SingleMode, "arango-backup-operator", constants.BackupLabelRole, o.onStartBackup, o.Dependencies.BackupProbe)
 	}
 	if o.Config.EnableApps {
-		if !o.Config.SingleMode {
-			go o.runLeaderElection("arango-apps-operator", constants.AppsLabelRole, o.onStartApps, o.Dependencies.AppsProbe)
-		} else {
-			go o.runWithoutLeaderElection("arango-apps-operator", constants.AppsLabelRole, o.onStartApps, o.Dependencies.AppsProbe)
-		}
+		run(!o.Config.SingleMode, "arango-apps-operator", constants.AppsLabelRole, o.onStartApps, o.Dependencies.AppsProbe)
 	}
 	if o.Config.EnableK2KClusterSync {
-		if !o.Config.SingleMode {
-			go o.runLeaderElection("arango-k2k-cluster-sync-operator", constants.ClusterSyncLabelRole, o.onStartK2KClusterSync, o.Dependencies.K2KClusterSyncProbe)
-		} else {
-			go o.runWithoutLeaderElection("arango-k2k-cluster-sync-operator", constants.ClusterSyncLabelRole, o.onStartK2KClusterSync, o.Dependencies.K2KClusterSyncProbe)
-		}
+		run(!o.Config.SingleMode, "arango-k2k-cluster-sync-operator", constants.ClusterSyncLabelRole, o.onStartK2KClusterSync, o.Dependencies.K2KClusterSyncProbe)
 	}
-	// Wait until process terminates
-	<-context.TODO().Done()
+
+	// Wait until process is requested to terminate
+	o.waitForShutdown(ctx, releaseLeadership, &leaders)
 }
 
 // onStartDeployment starts the deployment operator and run till given channel is closed.
@@ -227,7 +267,16 @@ func (o *Operator) onStartK2KClusterSync(stop <-chan struct{}) {
 // onStartOperatorV2 run the operatorV2 type
 func (o *Operator) onStartOperatorV2(operatorType operatorV2type, stop <-chan struct{}) {
 	operatorName := fmt.Sprintf("arangodb-%s-operator", operatorType)
-	operator := operatorV2.NewOperator(o.Dependencies.LogService.MustGetLogger(logging.LoggerNameReconciliation), operatorName, o.Namespace, o.OperatorImage)
+	var opts []operatorV2.Option
+	for handler, workers := range o.Config.HandlerWorkers {
+		opts = append(opts, operatorV2.WithHandlerWorkers(handler, workers))
+	}
+	opts = append(opts, operatorV2.WithRateLimiter(o.Config.RateLimiter))
+	for handler, limiter := range o.Config.HandlerRateLimiters {
+		opts = append(opts, operatorV2.WithHandlerRateLimiter(handler, limiter))
+	}
+
+	operator := operatorV2.NewOperator(o.Dependencies.LogService.MustGetLogger(logging.LoggerNameReconciliation), operatorName, o.Namespace, o.OperatorImage, opts...)
 
 	rand.Seed(time.Now().Unix())
 
@@ -248,7 +297,8 @@ func (o *Operator) onStartOperatorV2(operatorType operatorV2type, stop <-chan st
 		panic(err)
 	}
 
-	eventRecorder := event.NewEventRecorder(o.Dependencies.LogService.MustGetLogger(logging.LoggerNameEventRecorder), operatorName, kubeClientSet)
+	eventRecorder := event.NewEventRecorder(o.Dependencies.LogService.MustGetLogger(logging.LoggerNameEventRecorder), operatorName, kubeClientSet,
+		event.WithRateLimit(o.Config.EventsQPS, o.Config.EventsBurst))
 
 	arangoInformer := arangoInformer.NewSharedInformerFactoryWithOptions(arangoClientSet, 10*time.Second, arangoInformer.WithNamespace(o.Namespace))
 
@@ -263,6 +313,56 @@ func (o *Operator) onStartOperatorV2(operatorType operatorV2type, stop <-chan st
 		if err = job.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, arangoInformer); err != nil {
 			panic(err)
 		}
+
+		checkFn = func() error {
+			_, err := o.Client.Arango().DatabaseV1().ArangoUsers(o.Namespace).List(context.Background(), meta.ListOptions{})
+			return err
+		}
+		o.waitForCRD(depldef.ArangoUserCRDName, checkFn)
+
+		if err = user.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, arangoInformer); err != nil {
+			panic(err)
+		}
+
+		checkFn = func() error {
+			_, err := o.Client.Arango().DatabaseV1().ArangoDatabases(o.Namespace).List(context.Background(), meta.ListOptions{})
+			return err
+		}
+		o.waitForCRD(depldef.ArangoDatabaseCRDName, checkFn)
+
+		if err = database.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, arangoInformer); err != nil {
+			panic(err)
+		}
+
+		checkFn = func() error {
+			_, err := o.Client.Arango().DatabaseV1().ArangoCollections(o.Namespace).List(context.Background(), meta.ListOptions{})
+			return err
+		}
+		o.waitForCRD(depldef.ArangoCollectionCRDName, checkFn)
+
+		if err = collection.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, arangoInformer); err != nil {
+			panic(err)
+		}
+
+		checkFn = func() error {
+			_, err := o.Client.Arango().DatabaseV1().ArangoFoxxServices(o.Namespace).List(context.Background(), meta.ListOptions{})
+			return err
+		}
+		o.waitForCRD(depldef.ArangoFoxxServiceCRDName, checkFn)
+
+		if err = foxx.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, arangoInformer); err != nil {
+			panic(err)
+		}
+
+		checkFn = func() error {
+			_, err := o.Client.Arango().DatabaseV1().ArangoTasks(o.Namespace).List(context.Background(), meta.ListOptions{})
+			return err
+		}
+		o.waitForCRD(depldef.ArangoTaskCRDName, checkFn)
+
+		if err = task.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, arangoInformer); err != nil {
+			panic(err)
+		}
 	case backupOperator:
 		checkFn := func() error {
 			_, err := o.Client.Arango().BackupV1().ArangoBackups(o.Namespace).List(context.Background(), meta.ListOptions{})
@@ -270,7 +370,13 @@ func (o *Operator) onStartOperatorV2(operatorType operatorV2type, stop <-chan st
 		}
 		o.waitForCRD(backupdef.ArangoBackupCRDName, checkFn)
 
-		if err = backup.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, arangoInformer); err != nil {
+		dynamicClientSet, err := dynamic.NewForConfig(restClient)
+		if err != nil {
+			panic(err)
+		}
+
+		if err = backup.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, dynamicClientSet, arangoInformer,
+			o.Dependencies.LogService.MustGetLogger(logging.LoggerNameBackup)); err != nil {
 			panic(err)
 		}
 
@@ -21,6 +21,12 @@
 package operator
 
 import (
+	"sync"
+
+	"github.com/rs/zerolog"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	deploymentType "github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	"github.com/arangodb/kube-arangodb/pkg/logging"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
@@ -30,35 +36,161 @@ import (
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
 	"github.com/arangodb/kube-arangodb/pkg/metrics"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 var (
-	deploymentsCreated  = metrics.MustRegisterCounter("controller", "deployments_created", "Number of deployments that have been created")
-	deploymentsDeleted  = metrics.MustRegisterCounter("controller", "deployments_deleted", "Number of deployments that have been deleted")
-	deploymentsFailed   = metrics.MustRegisterCounter("controller", "deployments_failed", "Number of deployments that have failed")
-	deploymentsModified = metrics.MustRegisterCounter("controller", "deployments_modified", "Number of deployment modifications")
-	deploymentsCurrent  = metrics.MustRegisterGauge("controller", "deployments", "Number of deployments currently being managed")
+	deploymentsCreated  = metrics.MustRegisterCounterVec("controller", "deployments_created", "Number of deployments that have been created", metrics.Namespace)
+	deploymentsDeleted  = metrics.MustRegisterCounterVec("controller", "deployments_deleted", "Number of deployments that have been deleted", metrics.Namespace)
+	deploymentsFailed   = metrics.MustRegisterCounterVec("controller", "deployments_failed", "Number of deployments that have failed", metrics.Namespace)
+	deploymentsModified = metrics.MustRegisterCounterVec("controller", "deployments_modified", "Number of deployment modifications", metrics.Namespace)
+	deploymentsCurrent  = metrics.MustRegisterGaugeVec("controller", "deployments", "Number of deployments currently being managed", metrics.Namespace)
 )
 
 // run the deployments part of the operator.
-// This registers a listener and waits until the process stops.
+// This registers a listener for every watched namespace and waits until the process stops.
 func (o *Operator) runDeployments(stop <-chan struct{}) {
-	rw := k8sutil.NewResourceWatcher(
-		o.log,
-		o.Client.Arango().DatabaseV1().RESTClient(),
-		deploymentType.ArangoDeploymentResourcePlural,
-		o.Config.Namespace,
-		&api.ArangoDeployment{},
-		cache.ResourceEventHandlerFuncs{
-			AddFunc:    o.onAddArangoDeployment,
-			UpdateFunc: o.onUpdateArangoDeployment,
-			DeleteFunc: o.onDeleteArangoDeployment,
-		})
+	o.Dependencies.LivenessProbe.Lock()
+	o.deploymentsStop = stop
+	o.Dependencies.LivenessProbe.Unlock()
+
+	if o.Config.WatchNamespaceSelector != nil {
+		// Namespaces matching the selector need to be known before ArangoDeployments are handled
+		if !o.runNamespaces(stop) {
+			return
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	if o.deploymentShards != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			o.runDeploymentShards(stop)
+		}()
+	}
+
+	for _, namespace := range o.getDeploymentNamespaces() {
+		rw := k8sutil.NewResourceWatcher(
+			o.log,
+			o.Client.Arango().DatabaseV1().RESTClient(),
+			deploymentType.ArangoDeploymentResourcePlural,
+			namespace,
+			&api.ArangoDeployment{},
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    o.onAddArangoDeployment,
+				UpdateFunc: o.onUpdateArangoDeployment,
+				DeleteFunc: o.onDeleteArangoDeployment,
+			})
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rw.Run(stop)
+		}()
+	}
 
 	o.Dependencies.DeploymentProbe.SetReady()
-	rw.Run(stop)
+	wg.Wait()
+}
+
+// getDeploymentNamespaces returns list of namespaces in which ArangoDeployments are watched.
+// Empty list means the namespace of the operator, "*" means all namespaces.
+func (o *Operator) getDeploymentNamespaces() []string {
+	return deploymentNamespaces(o.Config.Namespace, o.Config.WatchNamespaces, o.Config.WatchNamespaceSelector != nil)
+}
+
+// deploymentNamespaces returns list of watched namespaces.
+// All namespaces are watched when only the namespace selector is provided.
+func deploymentNamespaces(namespace string, watched []string, selector bool) []string {
+	var namespaces []string
+	unique := map[string]bool{}
+
+	for _, n := range watched {
+		if n == "" {
+			continue
+		}
+
+		if n == "*" {
+			return []string{meta.NamespaceAll}
+		}
+
+		if unique[n] {
+			continue
+		}
+
+		unique[n] = true
+		namespaces = append(namespaces, n)
+	}
+
+	if len(namespaces) == 0 {
+		if selector {
+			return []string{meta.NamespaceAll}
+		}
+		return []string{namespace}
+	}
+
+	return namespaces
+}
+
+// deploymentKey returns the key under which the deployment is registered in the operator.
+func deploymentKey(apiObject *api.ArangoDeployment) string {
+	return apiObject.GetNamespace() + "/" + apiObject.GetName()
+}
+
+// deploymentWatcher is the inspector watcher shared by all deployments in the namespace
+type deploymentWatcher struct {
+	watcher *inspector.Watcher
+	// release stops the watcher when the last deployment of the namespace is gone
+	release chan struct{}
+}
+
+// getDeploymentWatcher returns inspector watcher shared by all deployments in the namespace.
+// It has to be called with the LivenessProbe lock held.
+func (o *Operator) getDeploymentWatcher(namespace string, log zerolog.Logger) *inspector.Watcher {
+	if o.deploymentsStop == nil {
+		// Deployments are not handled by the watch loop, each deployment maintains its own watcher.
+		return nil
+	}
+
+	if w, ok := o.deploymentWatchers[namespace]; ok {
+		return w.watcher
+	}
+
+	w := &deploymentWatcher{
+		watcher: inspector.NewWatcher(log, o.Client, namespace),
+		release: make(chan struct{}),
+	}
+
+	stop := make(chan struct{})
+	go func(operatorStop <-chan struct{}) {
+		defer close(stop)
+
+		select {
+		case <-operatorStop:
+		case <-w.release:
+		}
+	}(o.deploymentsStop)
+
+	w.watcher.Run(stop)
+	o.deploymentWatchers[namespace] = w
+
+	return w.watcher
+}
+
+// releaseDeploymentWatcher stops and removes the inspector watcher of the namespace.
+// It has to be called with the LivenessProbe lock held.
+func (o *Operator) releaseDeploymentWatcher(namespace string) {
+	w, ok := o.deploymentWatchers[namespace]
+	if !ok {
+		return
+	}
+
+	close(w.release)
+	delete(o.deploymentWatchers, namespace)
 }
 
 // onAddArangoDeployment deployment addition callback
@@ -68,8 +200,12 @@ func (o *Operator) onAddArangoDeployment(obj interface{}) {
 
 	apiObject := obj.(*api.ArangoDeployment)
 	o.log.Debug().
+		Str("namespace", apiObject.GetNamespace()).
 		Str("name", apiObject.GetObjectMeta().GetName()).
 		Msg("ArangoDeployment added")
+	if !o.isDeploymentNamespaceSelected(apiObject.GetNamespace()) || !o.isDeploymentOwned(deploymentKey(apiObject)) {
+		return
+	}
 	o.syncArangoDeployment(apiObject)
 }
 
@@ -80,8 +216,12 @@ func (o *Operator) onUpdateArangoDeployment(oldObj, newObj interface{}) {
 
 	apiObject := newObj.(*api.ArangoDeployment)
 	o.log.Debug().
+		Str("namespace", apiObject.GetNamespace()).
 		Str("name", apiObject.GetObjectMeta().GetName()).
 		Msg("ArangoDeployment updated")
+	if !o.isDeploymentNamespaceSelected(apiObject.GetNamespace()) || !o.isDeploymentOwned(deploymentKey(apiObject)) {
+		return
+	}
 	o.syncArangoDeployment(apiObject)
 }
 
@@ -105,8 +245,12 @@ func (o *Operator) onDeleteArangoDeployment(obj interface{}) {
 		}
 	}
 	log.Debug().
+		Str("namespace", apiObject.GetNamespace()).
 		Str("name", apiObject.GetObjectMeta().GetName()).
 		Msg("ArangoDeployment deleted")
+	if _, ok := o.deployments[deploymentKey(apiObject)]; !ok && (!o.isDeploymentNamespaceSelected(apiObject.GetNamespace()) || !o.isDeploymentOwned(deploymentKey(apiObject))) {
+		return
+	}
 	ev := &Event{
 		Type:       kwatch.Deleted,
 		Deployment: apiObject,
@@ -129,7 +273,7 @@ func (o *Operator) syncArangoDeployment(apiObject *api.ArangoDeployment) {
 	// re-watch or restart could give ADD event.
 	// If for an ADD event the cluster spec is invalid then it is not added to the local cache
 	// so modifying that deployment will result in another ADD event
-	if _, ok := o.deployments[apiObject.Name]; ok {
+	if _, ok := o.deployments[deploymentKey(apiObject)]; ok {
 		ev.Type = kwatch.Modified
 	}
 
@@ -145,10 +289,16 @@ func (o *Operator) syncArangoDeployment(apiObject *api.ArangoDeployment) {
 func (o *Operator) handleDeploymentEvent(event *Event) error {
 	apiObject := event.Deployment
 
+	if o.deploymentsHandedOver {
+		// Deployments are managed by the next operator
+		return nil
+	}
+
 	if apiObject.Status.Phase.IsFailed() {
-		deploymentsFailed.Inc()
+		deploymentsFailed.WithLabelValues(apiObject.GetNamespace()).Inc()
 		if event.Type == kwatch.Deleted {
-			delete(o.deployments, apiObject.Name)
+			delete(o.deployments, deploymentKey(apiObject))
+			o.refreshDeploymentsCurrent(apiObject.GetNamespace())
 			return nil
 		}
 		return errors.WithStack(errors.Newf("ignore failed deployment (%s). Please delete its CR", apiObject.Name))
@@ -156,7 +306,7 @@ func (o *Operator) handleDeploymentEvent(event *Event) error {
 
 	switch event.Type {
 	case kwatch.Added:
-		if _, ok := o.deployments[apiObject.Name]; ok {
+		if _, ok := o.deployments[deploymentKey(apiObject)]; ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment (%s) was created before but we received event (%s)", apiObject.Name, event.Type))
 		}
 
@@ -167,53 +317,85 @@ func (o *Operator) handleDeploymentEvent(event *Event) error {
 			return errors.WithStack(errors.Wrapf(err, "invalid deployment spec. please fix the following problem with the deployment spec: %v", err))
 		}
 
+		// Verify that all resources of the deployment can be managed in its namespace
+		if err := o.verifyNamespaceAccess(apiObject.GetNamespace()); err != nil {
+			o.Dependencies.EventRecorder.Event(apiObject, core.EventTypeWarning, "Access Denied", err.Error())
+			return errors.WithStack(errors.Wrapf(err, "deployment can not be managed"))
+		}
+
 		cfg, deps := o.makeDeploymentConfigAndDeps(apiObject)
 		nc, err := deployment.New(cfg, deps, apiObject)
 		if err != nil {
+			o.refreshDeploymentsCurrent(apiObject.GetNamespace())
 			return errors.WithStack(errors.Newf("failed to create deployment: %s", err))
 		}
-		o.deployments[apiObject.Name] = nc
+		o.deployments[deploymentKey(apiObject)] = nc
 
-		deploymentsCreated.Inc()
-		deploymentsCurrent.Set(float64(len(o.deployments)))
+		deploymentsCreated.WithLabelValues(apiObject.GetNamespace()).Inc()
+		o.refreshDeploymentsCurrent(apiObject.GetNamespace())
 
 	case kwatch.Modified:
-		depl, ok := o.deployments[apiObject.Name]
+		depl, ok := o.deployments[deploymentKey(apiObject)]
 		if !ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment (%s) was never created but we received event (%s)", apiObject.Name, event.Type))
 		}
+		o.applyDeploymentRateLimit(apiObject)
 		depl.Update(apiObject)
-		deploymentsModified.Inc()
+		deploymentsModified.WithLabelValues(apiObject.GetNamespace()).Inc()
 
 	case kwatch.Deleted:
-		depl, ok := o.deployments[apiObject.Name]
+		depl, ok := o.deployments[deploymentKey(apiObject)]
 		if !ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment (%s) was never created but we received event (%s)", apiObject.Name, event.Type))
 		}
 		depl.Delete()
-		delete(o.deployments, apiObject.Name)
-		deploymentsDeleted.Inc()
-		deploymentsCurrent.Set(float64(len(o.deployments)))
+		delete(o.deployments, deploymentKey(apiObject))
+		deploymentsDeleted.WithLabelValues(apiObject.GetNamespace()).Inc()
+		o.refreshDeploymentsCurrent(apiObject.GetNamespace())
 	}
 	return nil
 }
 
+// refreshDeploymentsCurrent sets the number of deployments managed in the namespace.
+// The inspector watcher of the namespace is released when no deployment is managed in it anymore.
+// It has to be called with the LivenessProbe lock held.
+func (o *Operator) refreshDeploymentsCurrent(namespace string) {
+	count := 0
+	for _, depl := range o.deployments {
+		if depl.GetNamespace() == namespace {
+			count++
+		}
+	}
+	deploymentsCurrent.WithLabelValues(namespace).Set(float64(count))
+
+	if count == 0 {
+		o.releaseDeploymentWatcher(namespace)
+	}
+}
+
 // makeDeploymentConfigAndDeps creates a Config & Dependencies object for a new Deployment.
 func (o *Operator) makeDeploymentConfigAndDeps(apiObject *api.ArangoDeployment) (deployment.Config, deployment.Dependencies) {
 	cfg := deployment.Config{
-		ServiceAccount:            o.Config.ServiceAccount,
-		OperatorImage:             o.Config.OperatorImage,
-		ArangoImage:               o.ArangoImage,
-		AllowChaos:                o.Config.AllowChaos,
-		ScalingIntegrationEnabled: o.Config.ScalingIntegrationEnabled,
-		Scope:                     o.Scope,
+		OperatorPodName:            o.Config.PodName,
+		ServiceAccount:             o.Config.ServiceAccount,
+		OperatorImage:              o.Config.OperatorImage,
+		ArangoImage:                o.ArangoImage,
+		AllowChaos:                 o.Config.AllowChaos,
+		ScalingIntegrationEnabled:  o.Config.ScalingIntegrationEnabled,
+		Scope:                      o.Scope,
+		MemberStateRefreshInterval: o.Config.MemberStateRefreshInterval,
 	}
 	deps := deployment.Dependencies{
 		Log: o.Dependencies.LogService.MustGetLogger(logging.LoggerNameDeployment).With().
 			Str("deployment", apiObject.GetName()).
 			Logger(),
+		LogService:    o.Dependencies.LogService,
 		Client:        o.Client,
 		EventRecorder: o.EventRecorder,
 	}
+	if client, ok := o.applyDeploymentRateLimit(apiObject); ok {
+		deps.Client = client
+	}
+	deps.Watcher = o.getDeploymentWatcher(apiObject.GetNamespace(), deps.Log)
 	return cfg, deps
 }
@@ -0,0 +1,90 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"strconv"
+
+	deploymentType "github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+// deploymentClientName returns the name of the dedicated Kubernetes client and rate limiter of the deployment.
+func deploymentClientName(apiObject *api.ArangoDeployment) string {
+	return "deployment/" + deploymentKey(apiObject)
+}
+
+// deploymentRateLimit returns QPS and burst of the Kubernetes client defined in annotations of the deployment.
+// Values missing in annotations are taken from the defaults. False is returned when no annotation is set.
+func deploymentRateLimit(apiObject *api.ArangoDeployment, defaultQPS float32, defaultBurst int) (float32, int, bool, error) {
+	annotations := apiObject.GetAnnotations()
+
+	qpsValue, qpsOK := annotations[deploymentType.ArangoDeploymentKubernetesQPSAnnotation]
+	burstValue, burstOK := annotations[deploymentType.ArangoDeploymentKubernetesBurstAnnotation]
+
+	if !qpsOK && !burstOK {
+		return 0, 0, false, nil
+	}
+
+	qps, burst := defaultQPS, defaultBurst
+
+	if qpsOK {
+		v, err := strconv.ParseFloat(qpsValue, 32)
+		if err != nil || v <= 0 {
+			return 0, 0, false, errors.Newf("annotation %s needs to be a positive number, got %s", deploymentType.ArangoDeploymentKubernetesQPSAnnotation, qpsValue)
+		}
+		qps = float32(v)
+	}
+
+	if burstOK {
+		v, err := strconv.Atoi(burstValue)
+		if err != nil || v <= 0 {
+			return 0, 0, false, errors.Newf("annotation %s needs to be a positive integer, got %s", deploymentType.ArangoDeploymentKubernetesBurstAnnotation, burstValue)
+		}
+		burst = v
+	}
+
+	return qps, burst, true, nil
+}
+
+// applyDeploymentRateLimit sets rate limits of the dedicated Kubernetes client of the deployment from its annotations.
+// Returns the dedicated client when limits are defined.
+func (o *Operator) applyDeploymentRateLimit(apiObject *api.ArangoDeployment) (kclient.Client, bool) {
+	name := deploymentClientName(apiObject)
+
+	defaultQPS, defaultBurst := kclient.GetDefaultRateLimit()
+	qps, burst, ok, err := deploymentRateLimit(apiObject, defaultQPS, defaultBurst)
+	if err != nil {
+		o.log.Warn().Err(err).Str("namespace", apiObject.GetNamespace()).Str("name", apiObject.GetName()).Msg("Invalid Kubernetes client rate limit")
+		return nil, false
+	}
+
+	if !ok {
+		kclient.ResetRateLimit(name)
+		return nil, false
+	}
+
+	kclient.SetRateLimit(name, qps, burst)
+
+	return kclient.GetNamedClient(name)
+}
@@ -0,0 +1,69 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	deploymentType "github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func Test_DeploymentRateLimit(t *testing.T) {
+	depl := func(annotations map[string]string) *api.ArangoDeployment {
+		return &api.ArangoDeployment{ObjectMeta: meta.ObjectMeta{Namespace: "ns", Name: "depl", Annotations: annotations}}
+	}
+
+	require.Equal(t, "deployment/ns/depl", deploymentClientName(depl(nil)))
+
+	t.Run("Not set", func(t *testing.T) {
+		_, _, ok, err := deploymentRateLimit(depl(nil), 15, 30)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("QPS", func(t *testing.T) {
+		qps, burst, ok, err := deploymentRateLimit(depl(map[string]string{deploymentType.ArangoDeploymentKubernetesQPSAnnotation: "2.5"}), 15, 30)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, float32(2.5), qps)
+		require.Equal(t, 30, burst)
+	})
+
+	t.Run("Burst", func(t *testing.T) {
+		qps, burst, ok, err := deploymentRateLimit(depl(map[string]string{deploymentType.ArangoDeploymentKubernetesBurstAnnotation: "5"}), 15, 30)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, float32(15), qps)
+		require.Equal(t, 5, burst)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, _, _, err := deploymentRateLimit(depl(map[string]string{deploymentType.ArangoDeploymentKubernetesQPSAnnotation: "-1"}), 15, 30)
+		require.Error(t, err)
+
+		_, _, _, err = deploymentRateLimit(depl(map[string]string{deploymentType.ArangoDeploymentKubernetesBurstAnnotation: "many"}), 15, 30)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,87 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+func Test_DeploymentNamespaces(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		require.Equal(t, []string{"operator"}, deploymentNamespaces("operator", nil, false))
+		require.Equal(t, []string{"operator"}, deploymentNamespaces("operator", []string{""}, false))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		require.Equal(t, []string{"a", "b"}, deploymentNamespaces("operator", []string{"a", "b", "a"}, false))
+		require.Equal(t, []string{"a", "b"}, deploymentNamespaces("operator", []string{"a", "b"}, true))
+	})
+
+	t.Run("All", func(t *testing.T) {
+		require.Equal(t, []string{meta.NamespaceAll}, deploymentNamespaces("operator", []string{"a", "*"}, false))
+	})
+
+	t.Run("Selector", func(t *testing.T) {
+		require.Equal(t, []string{meta.NamespaceAll}, deploymentNamespaces("operator", nil, true))
+	})
+}
+
+func Test_DeploymentKey(t *testing.T) {
+	a := &api.ArangoDeployment{ObjectMeta: meta.ObjectMeta{Namespace: "a", Name: "deployment"}}
+	b := &api.ArangoDeployment{ObjectMeta: meta.ObjectMeta{Namespace: "b", Name: "deployment"}}
+
+	require.Equal(t, "a/deployment", deploymentKey(a))
+	require.NotEqual(t, deploymentKey(a), deploymentKey(b))
+}
+
+func Test_DeploymentWatcher(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	o := &Operator{
+		Dependencies: Dependencies{
+			Client: kclient.NewFakeClientBuilder().Client(),
+		},
+		deployments:        map[string]*deployment.Deployment{},
+		deploymentWatchers: map[string]*deploymentWatcher{},
+		deploymentsStop:    stop,
+	}
+
+	w := o.getDeploymentWatcher("a", log.Logger)
+	require.NotNil(t, w)
+	require.Equal(t, w, o.getDeploymentWatcher("a", log.Logger))
+	require.Len(t, o.deploymentWatchers, 1)
+
+	// Last deployment of the namespace is gone
+	o.refreshDeploymentsCurrent("a")
+	require.Empty(t, o.deploymentWatchers)
+
+	require.NotSame(t, w, o.getDeploymentWatcher("a", log.Logger))
+	require.Len(t, o.deploymentWatchers, 1)
+}
@@ -45,9 +45,10 @@ import (
 // the namespace that the operator is deployed in.
 // When the leader election is won, the given callback is called.
 // When the leader election is was won once, but then the leadership is lost, the process is killed.
+// The leadership is released when the given context is done.
 // The given ready probe is set, as soon as this process became the leader, or a new leader
 // is detected.
-func (o *Operator) runLeaderElection(lockName, label string, onStart func(stop <-chan struct{}), readyProbe *probe.ReadyProbe) {
+func (o *Operator) runLeaderElection(ctx context.Context, lockName, label string, onStart func(stop <-chan struct{}), readyProbe *probe.ReadyProbe) {
 	namespace := o.Config.Namespace
 	kubecli := o.Dependencies.Client.Kubernetes()
 	log := o.log.With().Str("lock-name", lockName).Logger()
@@ -70,12 +71,12 @@ func (o *Operator) runLeaderElection(lockName, label string, onStart func(stop <
 		log.Fatal().Err(err).Msg("Failed to create resource lock")
 	}
 
-	ctx := context.Background()
 	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
-		Lock:          rl,
-		LeaseDuration: 15 * time.Second,
-		RenewDeadline: 10 * time.Second,
-		RetryPeriod:   2 * time.Second,
+		Lock:            rl,
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		ReleaseOnCancel: true,
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
 				recordEvent("Leader Election Won", fmt.Sprintf("Pod %s is running as leader", o.Config.PodName))
@@ -87,6 +88,11 @@ func (o *Operator) runLeaderElection(lockName, label string, onStart func(stop <
 				onStart(ctx.Done())
 			},
 			OnStoppedLeading: func() {
+				if ctx.Err() != nil {
+					// Leadership is released during shutdown
+					log.Info().Msg("Leadership released")
+					return
+				}
 				recordEvent("Stop Leading", fmt.Sprintf("Pod %s is stopping to run as leader", o.Config.PodName))
 				log.Info().Msg("Stop leading. Terminating process")
 				os.Exit(1)
@@ -99,7 +105,7 @@ func (o *Operator) runLeaderElection(lockName, label string, onStart func(stop <
 	})
 }
 
-func (o *Operator) runWithoutLeaderElection(lockName, label string, onStart func(stop <-chan struct{}), readyProbe *probe.ReadyProbe) {
+func (o *Operator) runWithoutLeaderElection(ctx context.Context, lockName, label string, onStart func(stop <-chan struct{}), readyProbe *probe.ReadyProbe) {
 	log := o.log.With().Str("lock-name", lockName).Logger()
 	eventTarget := o.getLeaderElectionEventTarget(log)
 	recordEvent := func(reason, message string) {
@@ -107,8 +113,6 @@ func (o *Operator) runWithoutLeaderElection(lockName, label string, onStart func
 			o.Dependencies.EventRecorder.Event(eventTarget, v1.EventTypeNormal, reason, message)
 		}
 	}
-	ctx := context.Background()
-
 	recordEvent("Leader Election Skipped", fmt.Sprintf("Pod %s is running as leader", o.Config.PodName))
 	readyProbe.SetReady()
 	if err := o.setRoleLabel(log, label, constants.LabelRoleLeader); err != nil {
@@ -0,0 +1,220 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authorization "k8s.io/api/authorization/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// namespaceAccessChecks defines resources which need to be accessible by the Operator
+// in the namespace of an ArangoDeployment before the deployment is managed.
+var namespaceAccessChecks = []authorization.ResourceAttributes{
+	{Group: "database.arangodb.com", Resource: "arangodeployments", Verb: "update"},
+	{Group: "database.arangodb.com", Resource: "arangomembers", Verb: "create"},
+	{Resource: "pods", Verb: "create"},
+	{Resource: "pods", Verb: "delete"},
+	{Resource: "services", Verb: "create"},
+	{Resource: "secrets", Verb: "create"},
+	{Resource: "secrets", Verb: "get"},
+	{Resource: "persistentvolumeclaims", Verb: "create"},
+	{Resource: "serviceaccounts", Verb: "create"},
+	{Group: "policy", Resource: "poddisruptionbudgets", Verb: "create"},
+}
+
+// verifyNamespaceAccess checks if the Operator is allowed to manage ArangoDeployment resources in the namespace.
+func (o *Operator) verifyNamespaceAccess(namespace string) error {
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	var denied []string
+
+	for _, check := range namespaceAccessChecks {
+		attributes := check
+		attributes.Namespace = namespace
+
+		review := authorization.SelfSubjectAccessReview{
+			Spec: authorization.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &attributes,
+			},
+		}
+
+		r, err := o.Client.Kubernetes().AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &review, meta.CreateOptions{})
+		if err != nil {
+			return errors.WithStack(errors.Wrapf(err, "unable to check access in namespace %s", namespace))
+		}
+
+		if !r.Status.Allowed {
+			denied = append(denied, accessCheckName(check))
+		}
+	}
+
+	if len(denied) > 0 {
+		return errors.WithStack(errors.Newf("operator is not allowed to %s in namespace %s", strings.Join(denied, ", "), namespace))
+	}
+
+	return nil
+}
+
+func accessCheckName(check authorization.ResourceAttributes) string {
+	if check.Group == "" {
+		return fmt.Sprintf("%s %s", check.Verb, check.Resource)
+	}
+
+	return fmt.Sprintf("%s %s.%s", check.Verb, check.Resource, check.Group)
+}
+
+// runNamespaces starts the watcher of namespaces matching the namespace selector and waits for its cache.
+func (o *Operator) runNamespaces(stop <-chan struct{}) bool {
+	rw := k8sutil.NewResourceWatcher(
+		o.log,
+		o.Client.Kubernetes().CoreV1().RESTClient(),
+		"namespaces",
+		meta.NamespaceAll,
+		&core.Namespace{},
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				o.onNamespace(obj.(*core.Namespace))
+			},
+			UpdateFunc: func(_, newObj interface{}) {
+				o.onNamespace(newObj.(*core.Namespace))
+			},
+			DeleteFunc: o.onDeleteNamespace,
+		})
+
+	go rw.Run(stop)
+
+	if !cache.WaitForCacheSync(stop, rw.HasSynced) {
+		return false
+	}
+
+	o.Dependencies.LivenessProbe.Lock()
+	defer o.Dependencies.LivenessProbe.Unlock()
+
+	o.deploymentNamespacesSynced = true
+
+	return true
+}
+
+// onNamespace namespace addition and update callback
+func (o *Operator) onNamespace(namespace *core.Namespace) {
+	o.setNamespaceSelected(namespace.GetName(), o.Config.WatchNamespaceSelector.Matches(labels.Set(namespace.GetLabels())))
+}
+
+// onDeleteNamespace namespace delete callback
+func (o *Operator) onDeleteNamespace(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	namespace, ok := obj.(*core.Namespace)
+	if !ok {
+		o.log.Error().Interface("event-object", obj).Msg("unknown object from Namespace delete event")
+		return
+	}
+
+	o.setNamespaceSelected(namespace.GetName(), false)
+}
+
+// setNamespaceSelected starts or stops management of ArangoDeployments in the namespace
+// when the namespace starts or stops matching the namespace selector.
+func (o *Operator) setNamespaceSelected(namespace string, selected bool) {
+	o.Dependencies.LivenessProbe.Lock()
+	defer o.Dependencies.LivenessProbe.Unlock()
+
+	if o.deploymentNamespacesSelected[namespace] == selected {
+		return
+	}
+
+	if selected {
+		o.deploymentNamespacesSelected[namespace] = true
+	} else {
+		delete(o.deploymentNamespacesSelected, namespace)
+	}
+
+	if !o.deploymentNamespacesSynced || !o.isDeploymentNamespaceWatched(namespace) {
+		// Initial state is loaded before ArangoDeployments are watched
+		return
+	}
+
+	log := o.log.With().Str("namespace", namespace).Logger()
+
+	if !selected {
+		for key, depl := range o.deployments {
+			if depl.GetNamespace() != namespace {
+				continue
+			}
+
+			log.Info().Str("name", depl.GetName()).Msg("Namespace does not match the selector, ArangoDeployment is not managed anymore")
+			depl.Delete()
+			delete(o.deployments, key)
+		}
+		o.refreshDeploymentsCurrent(namespace)
+		return
+	}
+
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	list, err := o.Client.Arango().DatabaseV1().ArangoDeployments(namespace).List(ctx, meta.ListOptions{})
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to list ArangoDeployments in selected namespace")
+		return
+	}
+
+	log.Info().Int("deployments", len(list.Items)).Msg("Namespace matches the selector, ArangoDeployments are managed")
+
+	for id := range list.Items {
+		o.syncArangoDeployment(&list.Items[id])
+	}
+}
+
+// isDeploymentNamespaceSelected returns true when ArangoDeployments in the namespace are managed by the Operator.
+// It has to be called with the LivenessProbe lock held.
+func (o *Operator) isDeploymentNamespaceSelected(namespace string) bool {
+	if o.Config.WatchNamespaceSelector == nil {
+		return true
+	}
+
+	return o.deploymentNamespacesSelected[namespace]
+}
+
+// isDeploymentNamespaceWatched returns true when ArangoDeployments in the namespace are watched.
+func (o *Operator) isDeploymentNamespaceWatched(namespace string) bool {
+	for _, n := range o.getDeploymentNamespaces() {
+		if n == meta.NamespaceAll || n == namespace {
+			return true
+		}
+	}
+
+	return false
+}
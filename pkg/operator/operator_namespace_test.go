@@ -0,0 +1,97 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authorization "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesFake "k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+	"github.com/arangodb/kube-arangodb/pkg/util/probe"
+)
+
+func newNamespaceAccessOperator(allowed func(namespace string, attributes *authorization.ResourceAttributes) bool) *Operator {
+	k := kubernetesFake.NewSimpleClientset()
+	k.PrependReactor("create", "selfsubjectaccessreviews", func(action ktesting.Action) (bool, runtime.Object, error) {
+		review := action.(ktesting.CreateAction).GetObject().(*authorization.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = allowed(attributes.Namespace, attributes)
+		return true, review, nil
+	})
+
+	return &Operator{
+		Dependencies: Dependencies{
+			Client:        kclient.NewStaticClient(k, nil, nil, nil),
+			LivenessProbe: &probe.LivenessProbe{},
+		},
+		deploymentNamespacesSelected: map[string]bool{},
+	}
+}
+
+func Test_VerifyNamespaceAccess(t *testing.T) {
+	o := newNamespaceAccessOperator(func(namespace string, attributes *authorization.ResourceAttributes) bool {
+		if namespace == "allowed" {
+			return true
+		}
+
+		return attributes.Resource != "secrets" && attributes.Resource != "poddisruptionbudgets"
+	})
+
+	require.NoError(t, o.verifyNamespaceAccess("allowed"))
+
+	err := o.verifyNamespaceAccess("denied")
+	require.EqualError(t, err, "operator is not allowed to create secrets, get secrets, create poddisruptionbudgets.policy in namespace denied")
+}
+
+func Test_NamespaceSelected(t *testing.T) {
+	o := newNamespaceAccessOperator(nil)
+
+	t.Run("Without selector", func(t *testing.T) {
+		require.True(t, o.isDeploymentNamespaceSelected("a"))
+	})
+
+	o.Config.WatchNamespaceSelector = labels.SelectorFromSet(labels.Set{"arangodb": "enabled"})
+
+	t.Run("With selector", func(t *testing.T) {
+		require.False(t, o.isDeploymentNamespaceSelected("a"))
+
+		o.setNamespaceSelected("a", true)
+		require.True(t, o.isDeploymentNamespaceSelected("a"))
+		require.False(t, o.isDeploymentNamespaceSelected("b"))
+
+		o.setNamespaceSelected("a", false)
+		require.False(t, o.isDeploymentNamespaceSelected("a"))
+	})
+
+	t.Run("Watched", func(t *testing.T) {
+		require.True(t, o.isDeploymentNamespaceWatched("a"))
+
+		o.Config.WatchNamespaces = []string{"b"}
+		require.False(t, o.isDeploymentNamespaceWatched("a"))
+		require.True(t, o.isDeploymentNamespaceWatched("b"))
+	})
+}
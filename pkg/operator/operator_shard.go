@@ -0,0 +1,310 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	coordination "k8s.io/api/coordination/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/shard"
+)
+
+const (
+	// deploymentShardLabel is the label of leases of operator replicas sharing ArangoDeployments.
+	deploymentShardLabel = "database.arangodb.com/operator-shard"
+	// deploymentShardLeasePrefix is the name prefix of leases of operator replicas sharing ArangoDeployments.
+	deploymentShardLeasePrefix = "arango-deployment-operator-shard-"
+
+	// deploymentShardLeaseDuration defines how long a replica is a member of the ring after its last renewal.
+	deploymentShardLeaseDuration = 30 * time.Second
+	// deploymentShardSyncInterval defines how often the lease is renewed and the ring is refreshed.
+	deploymentShardSyncInterval = 5 * time.Second
+	// deploymentShardGrace defines how long a new owner waits before a deployment is taken over,
+	// so the previous owner stops managing it first. It has to be greater than the sync interval.
+	deploymentShardGrace = 15 * time.Second
+	// deploymentShardCleanup defines after which time leases of gone replicas are removed.
+	deploymentShardCleanup = 5 * time.Minute
+)
+
+type deploymentShardMember struct {
+	name            string
+	active, expires time.Time
+}
+
+// deploymentShards keeps members of the ring of operator replicas sharing ArangoDeployments.
+type deploymentShards struct {
+	member  string
+	members []deploymentShardMember
+	renewed time.Time
+	state   string
+}
+
+// ring returns the ring of members active at the given time.
+func (s *deploymentShards) ring(t time.Time) shard.Ring {
+	var names []string
+
+	for _, m := range s.members {
+		if !m.active.After(t) && m.expires.After(t) {
+			names = append(names, m.name)
+		}
+	}
+
+	return shard.NewRing(names...)
+}
+
+// fenced returns true when the own lease was not renewed in time, so other replicas might have taken over.
+func (s *deploymentShards) fenced(now time.Time) bool {
+	return s.renewed.IsZero() || !s.renewed.Add(deploymentShardLeaseDuration-deploymentShardSyncInterval).After(now)
+}
+
+// owns returns true when the deployment with given key is managed by this replica.
+// The deployment needs to be owned now and before the grace period, so ownership is never shared.
+func (s *deploymentShards) owns(key string, now time.Time) bool {
+	if s.fenced(now) {
+		return false
+	}
+
+	return s.ring(now).Owner(key) == s.member && s.ring(now.Add(-deploymentShardGrace)).Owner(key) == s.member
+}
+
+// update sets members from the list of leases and returns true when ownership of deployments could have changed.
+func (s *deploymentShards) update(leases []coordination.Lease, now time.Time) bool {
+	s.members = s.members[:0]
+
+	for _, l := range leases {
+		if l.Spec.HolderIdentity == nil || l.Spec.AcquireTime == nil || l.Spec.RenewTime == nil || l.Spec.LeaseDurationSeconds == nil {
+			continue
+		}
+
+		s.members = append(s.members, deploymentShardMember{
+			name:    *l.Spec.HolderIdentity,
+			active:  l.Spec.AcquireTime.Time,
+			expires: l.Spec.RenewTime.Time.Add(time.Duration(*l.Spec.LeaseDurationSeconds) * time.Second),
+		})
+	}
+
+	return s.refresh(now)
+}
+
+// refresh returns true when ownership of deployments could have changed since the last call.
+func (s *deploymentShards) refresh(now time.Time) bool {
+	var state string
+	if !s.fenced(now) {
+		state = strings.Join(s.ring(now).Members(), ",") + "/" + strings.Join(s.ring(now.Add(-deploymentShardGrace)).Members(), ",")
+	}
+
+	if state == s.state {
+		return false
+	}
+
+	s.state = state
+	return true
+}
+
+// isDeploymentOwned returns true when the deployment with given key is managed by this replica.
+// It has to be called with the LivenessProbe lock held.
+func (o *Operator) isDeploymentOwned(key string) bool {
+	if o.deploymentShards == nil {
+		return true
+	}
+
+	return o.deploymentShards.owns(key, time.Now())
+}
+
+// runDeploymentShards renews the lease of this replica and moves ArangoDeployments between replicas
+// when the ring changes, until the given channel is closed.
+func (o *Operator) runDeploymentShards(stop <-chan struct{}) {
+	log := o.log.With().Str("member", o.Config.ID).Logger()
+
+	for {
+		if err := o.renewDeploymentShardLease(); err != nil {
+			log.Warn().Err(err).Msg("Failed to renew shard lease")
+		}
+
+		leases, err := o.listDeploymentShardLeases()
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to list shard leases")
+		}
+
+		o.Dependencies.LivenessProbe.Lock()
+		var changed bool
+		if err == nil {
+			changed = o.deploymentShards.update(leases, time.Now())
+		} else {
+			changed = o.deploymentShards.refresh(time.Now())
+		}
+		if changed {
+			log.Info().Strs("members", o.deploymentShards.ring(time.Now()).Members()).Msg("Shard ring changed")
+			o.reshardDeployments()
+		}
+		o.Dependencies.LivenessProbe.Unlock()
+
+		select {
+		case <-stop:
+			if err := o.releaseDeploymentShardLease(); err != nil {
+				log.Warn().Err(err).Msg("Failed to release shard lease")
+			}
+			return
+		case <-time.After(deploymentShardSyncInterval):
+		}
+	}
+}
+
+// renewDeploymentShardLease creates or renews the lease of this replica.
+func (o *Operator) renewDeploymentShardLease() error {
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	leases := o.Client.Kubernetes().CoordinationV1().Leases(o.Config.Namespace)
+	name := deploymentShardLeasePrefix + o.Config.ID
+	now := meta.NewMicroTime(time.Now())
+
+	lease, err := leases.Get(ctx, name, meta.GetOptions{})
+	if err != nil {
+		if !k8sutil.IsNotFound(err) {
+			return errors.WithStack(err)
+		}
+
+		lease = &coordination.Lease{
+			ObjectMeta: meta.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{deploymentShardLabel: "true"},
+			},
+			Spec: coordination.LeaseSpec{
+				HolderIdentity:       util.NewString(o.Config.ID),
+				LeaseDurationSeconds: util.NewInt32(int32(deploymentShardLeaseDuration / time.Second)),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+
+		if _, err := leases.Create(ctx, lease, meta.CreateOptions{}); err != nil {
+			return errors.WithStack(err)
+		}
+	} else {
+		if lease.Spec.RenewTime == nil || !lease.Spec.RenewTime.Add(deploymentShardLeaseDuration).After(now.Time) {
+			// Lease expired, replica rejoins the ring
+			lease.Spec.AcquireTime = &now
+		}
+		lease.Spec.RenewTime = &now
+
+		if _, err := leases.Update(ctx, lease, meta.UpdateOptions{}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	o.Dependencies.LivenessProbe.Lock()
+	defer o.Dependencies.LivenessProbe.Unlock()
+
+	o.deploymentShards.renewed = now.Time
+
+	return nil
+}
+
+// releaseDeploymentShardLease removes the lease of this replica, so other replicas take over its ArangoDeployments
+// without waiting for the lease to expire.
+func (o *Operator) releaseDeploymentShardLease() error {
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	leases := o.Client.Kubernetes().CoordinationV1().Leases(o.Config.Namespace)
+	if err := leases.Delete(ctx, deploymentShardLeasePrefix+o.Config.ID, meta.DeleteOptions{}); err != nil && !k8sutil.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// listDeploymentShardLeases returns leases of all replicas and removes leases of replicas gone for a long time.
+func (o *Operator) listDeploymentShardLeases() ([]coordination.Lease, error) {
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	leases := o.Client.Kubernetes().CoordinationV1().Leases(o.Config.Namespace)
+
+	list, err := leases.List(ctx, meta.ListOptions{LabelSelector: deploymentShardLabel})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, l := range list.Items {
+		if l.Spec.RenewTime != nil && time.Since(l.Spec.RenewTime.Time) > deploymentShardCleanup {
+			if err := leases.Delete(ctx, l.GetName(), meta.DeleteOptions{}); err != nil && !k8sutil.IsNotFound(err) {
+				o.log.Warn().Err(err).Str("lease", l.GetName()).Msg("Failed to remove expired shard lease")
+			}
+		}
+	}
+
+	return list.Items, nil
+}
+
+// reshardDeployments stops ArangoDeployments which are not owned anymore and starts newly owned ones.
+// It has to be called with the LivenessProbe lock held.
+func (o *Operator) reshardDeployments() {
+	for key, depl := range o.deployments {
+		if o.isDeploymentOwned(key) {
+			continue
+		}
+
+		o.log.Info().Str("namespace", depl.GetNamespace()).Str("name", depl.GetName()).Msg("ArangoDeployment moved to other operator replica")
+		go func(key string, depl *deployment.Deployment) {
+			ctx, cancel := context.WithTimeout(context.Background(), o.Config.ShutdownTimeout)
+			defer cancel()
+
+			o.handoverDeployment(ctx, key, depl)
+		}(key, depl)
+		delete(o.deployments, key)
+		o.refreshDeploymentsCurrent(depl.GetNamespace())
+	}
+
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	for _, namespace := range o.getDeploymentNamespaces() {
+		list, err := o.Client.Arango().DatabaseV1().ArangoDeployments(namespace).List(ctx, meta.ListOptions{})
+		if err != nil {
+			o.log.Warn().Err(err).Str("namespace", namespace).Msg("Failed to list ArangoDeployments")
+			continue
+		}
+
+		for id := range list.Items {
+			apiObject := &list.Items[id]
+			if _, ok := o.deployments[deploymentKey(apiObject)]; ok {
+				continue
+			}
+
+			if !o.isDeploymentNamespaceSelected(apiObject.GetNamespace()) || !o.isDeploymentOwned(deploymentKey(apiObject)) {
+				continue
+			}
+
+			o.syncArangoDeployment(apiObject)
+		}
+	}
+}
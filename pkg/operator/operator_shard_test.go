@@ -0,0 +1,142 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	coordination "k8s.io/api/coordination/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func shardLease(member string, acquired, renewed time.Time) coordination.Lease {
+	a, r := meta.NewMicroTime(acquired), meta.NewMicroTime(renewed)
+	return coordination.Lease{
+		Spec: coordination.LeaseSpec{
+			HolderIdentity:       util.NewString(member),
+			LeaseDurationSeconds: util.NewInt32(int32(deploymentShardLeaseDuration / time.Second)),
+			AcquireTime:          &a,
+			RenewTime:            &r,
+		},
+	}
+}
+
+func shardKeys() []string {
+	r := make([]string, 100)
+	for i := range r {
+		r[i] = fmt.Sprintf("ns/deployment-%d", i)
+	}
+	return r
+}
+
+func Test_DeploymentShards_Startup(t *testing.T) {
+	start := time.Now()
+	s := &deploymentShards{member: "a"}
+
+	require.False(t, s.owns("ns/deployment", start), "not renewed")
+
+	s.renewed = start
+	require.True(t, s.update([]coordination.Lease{shardLease("a", start, start)}, start))
+	require.False(t, s.owns("ns/deployment", start), "in grace period")
+
+	now := start.Add(deploymentShardGrace)
+	s.renewed = now
+	require.True(t, s.refresh(now))
+	require.True(t, s.owns("ns/deployment", now))
+	require.False(t, s.refresh(now))
+}
+
+func Test_DeploymentShards_Join(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	joined := start.Add(time.Minute)
+
+	a := &deploymentShards{member: "a"}
+	b := &deploymentShards{member: "b"}
+
+	leases := []coordination.Lease{shardLease("a", start, joined), shardLease("b", joined, joined)}
+
+	for _, now := range []time.Time{joined, joined.Add(deploymentShardGrace / 2), joined.Add(deploymentShardGrace)} {
+		a.renewed, b.renewed = now, now
+		a.update(leases, now)
+		b.update(leases, now)
+
+		for _, k := range shardKeys() {
+			require.False(t, a.owns(k, now) && b.owns(k, now), "%s shared at %s", k, now)
+		}
+	}
+
+	now := joined.Add(deploymentShardGrace)
+	owners := map[string]int{}
+	for _, k := range shardKeys() {
+		switch {
+		case a.owns(k, now):
+			owners["a"]++
+		case b.owns(k, now):
+			owners["b"]++
+		}
+	}
+	require.Equal(t, len(shardKeys()), owners["a"]+owners["b"], "all keys owned after grace period")
+	require.NotZero(t, owners["b"])
+}
+
+func Test_DeploymentShards_Expired(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	lost := start.Add(time.Minute)
+	expired := lost.Add(deploymentShardLeaseDuration)
+
+	a := &deploymentShards{member: "a"}
+	leases := []coordination.Lease{shardLease("a", start, expired), shardLease("b", start, lost)}
+
+	var owned []string
+	a.renewed = expired
+	a.update(leases, expired)
+	for _, k := range shardKeys() {
+		if !a.owns(k, expired) {
+			owned = append(owned, k)
+		}
+	}
+	require.NotEmpty(t, owned, "keys of b are not taken over before grace period")
+
+	now := expired.Add(deploymentShardGrace)
+	a.renewed = now
+	require.True(t, a.update(leases, now))
+	for _, k := range owned {
+		require.True(t, a.owns(k, now), k)
+	}
+}
+
+func Test_DeploymentShards_Fenced(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	now := start.Add(time.Minute)
+
+	a := &deploymentShards{member: "a", renewed: now}
+	a.update([]coordination.Lease{shardLease("a", start, now)}, now)
+	require.True(t, a.owns("ns/deployment", now))
+
+	later := now.Add(deploymentShardLeaseDuration - deploymentShardSyncInterval)
+	require.True(t, a.refresh(later))
+	require.False(t, a.owns("ns/deployment", later))
+}
@@ -0,0 +1,97 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/deployment"
+)
+
+const (
+	// DefaultShutdownTimeout is the default time given to the operator to hand over deployments and release leadership.
+	DefaultShutdownTimeout = 20 * time.Second
+)
+
+// handoverDeployments hands over all deployments managed by this operator to the next operator.
+// ArangoDeployment events are ignored afterwards, so no new deployments are started.
+func (o *Operator) handoverDeployments(ctx context.Context) {
+	o.Dependencies.LivenessProbe.Lock()
+	o.deploymentsHandedOver = true
+	deployments := make(map[string]*deployment.Deployment, len(o.deployments))
+	for key, depl := range o.deployments {
+		deployments[key] = depl
+	}
+	o.Dependencies.LivenessProbe.Unlock()
+
+	var wg sync.WaitGroup
+	for key, depl := range deployments {
+		wg.Add(1)
+		go func(key string, depl *deployment.Deployment) {
+			defer wg.Done()
+			o.handoverDeployment(ctx, key, depl)
+		}(key, depl)
+	}
+	wg.Wait()
+}
+
+// handoverDeployment hands over a single deployment to the next operator.
+func (o *Operator) handoverDeployment(ctx context.Context, key string, depl *deployment.Deployment) {
+	log := o.log.With().Str("deployment", key).Logger()
+
+	if err := depl.Handover(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to hand over deployment, actions in progress may time out")
+		return
+	}
+
+	log.Info().Msg("Deployment handed over")
+}
+
+// waitForShutdown waits until the given context is done, hands over deployments and releases leadership.
+// Leadership is released only after deployments are handed over, so the next leader does not start
+// while this operator is still executing plans.
+func (o *Operator) waitForShutdown(ctx context.Context, releaseLeadership context.CancelFunc, leaders *sync.WaitGroup) {
+	<-ctx.Done()
+
+	o.log.Info().Dur("timeout", o.Config.ShutdownTimeout).Msg("Shutting down operator")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), o.Config.ShutdownTimeout)
+	defer cancel()
+
+	o.handoverDeployments(shutdownCtx)
+
+	releaseLeadership()
+
+	released := make(chan struct{})
+	go func() {
+		defer close(released)
+		leaders.Wait()
+	}()
+
+	select {
+	case <-released:
+		o.log.Info().Msg("Leadership released")
+	case <-shutdownCtx.Done():
+		o.log.Warn().Msg("Leadership not released in time")
+	}
+}
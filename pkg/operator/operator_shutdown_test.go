@@ -0,0 +1,88 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kwatch "k8s.io/apimachinery/pkg/watch"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/util/probe"
+)
+
+func Test_Shutdown(t *testing.T) {
+	o := &Operator{
+		Config: Config{
+			ShutdownTimeout: time.Second,
+		},
+		Dependencies: Dependencies{
+			LivenessProbe: &probe.LivenessProbe{},
+		},
+		deployments: map[string]*deployment.Deployment{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderCtx, releaseLeadership := context.WithCancel(context.Background())
+
+	var leaders sync.WaitGroup
+	leaders.Add(1)
+	go func() {
+		defer leaders.Done()
+		<-leaderCtx.Done()
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		o.waitForShutdown(ctx, releaseLeadership, &leaders)
+	}()
+
+	select {
+	case <-done:
+		require.Fail(t, "Operator shut down before termination")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.NoError(t, leaderCtx.Err())
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "Operator not shut down in time")
+	}
+	require.Error(t, leaderCtx.Err())
+	require.True(t, o.deploymentsHandedOver)
+
+	// New deployments are not started after the handover
+	require.NoError(t, o.handleDeploymentEvent(&Event{
+		Type:       kwatch.Added,
+		Deployment: &api.ArangoDeployment{ObjectMeta: meta.ObjectMeta{Namespace: "a", Name: "deployment"}},
+	}))
+	require.Empty(t, o.deployments)
+}
@@ -23,35 +23,107 @@ package event
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+
 	core "k8s.io/api/core/v1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// DefaultAggregationWindow defines how long identical events are aggregated into a single event
+	DefaultAggregationWindow = 10 * time.Minute
+	// DefaultQPS defines default number of events per second sent by a recorder instance
+	DefaultQPS float32 = 1
+	// DefaultBurst defines default number of events sent by a recorder instance at once
+	DefaultBurst = 25
 )
 
+type config struct {
+	qps   float32
+	burst int
+
+	aggregationWindow time.Duration
+}
+
+// Option changes the configuration of the recorder or of the recorder instance
+type Option func(c *config)
+
+// WithRateLimit sets the number of events per second and the burst. Events above the limit are dropped,
+// identical events are still counted and the count is sent with the next accepted event.
+func WithRateLimit(qps float32, burst int) Option {
+	return func(c *config) {
+		c.qps = qps
+		c.burst = burst
+	}
+}
+
+// WithAggregationWindow sets how long identical events are aggregated into a single event
+func WithAggregationWindow(window time.Duration) Option {
+	return func(c *config) {
+		c.aggregationWindow = window
+	}
+}
+
+func newConfig(base config, opts ...Option) config {
+	for _, o := range opts {
+		o(&base)
+	}
+
+	return base
+}
+
 // NewEventRecorder creates new event recorder
-func NewEventRecorder(logger zerolog.Logger, name string, kubeClientSet kubernetes.Interface) Recorder {
+func NewEventRecorder(logger zerolog.Logger, name string, kubeClientSet kubernetes.Interface, opts ...Option) Recorder {
 	return &eventRecorder{
 		kubeClientSet: kubeClientSet,
 		name:          name,
 		logger:        logger,
+		config: newConfig(config{
+			qps:               DefaultQPS,
+			burst:             DefaultBurst,
+			aggregationWindow: DefaultAggregationWindow,
+		}, opts...),
+		events: map[eventKey]*core.Event{},
 	}
 }
 
 // Recorder event factory for kubernetes
 type Recorder interface {
-	NewInstance(group, version, kind string) RecorderInstance
+	// NewInstance creates recorder instance for the kind, options override the rate limits of the recorder
+	NewInstance(group, version, kind string, opts ...Option) RecorderInstance
 
-	event(group, version, kind string, object meta.Object, eventType, reason, message string)
+	event(limiter flowcontrol.RateLimiter, group, version, kind string, object meta.Object, eventType, reason, message string)
+}
+
+// eventKey identifies identical events which are aggregated
+type eventKey struct {
+	namespace, name string
+	uid             types.UID
+
+	apiVersion, kind string
+
+	eventType, reason, message string
 }
 
 type eventRecorder struct {
 	name          string
 	kubeClientSet kubernetes.Interface
 	logger        zerolog.Logger
+
+	config config
+
+	lock        sync.Mutex
+	events      map[eventKey]*core.Event
+	lastCleanup time.Time
 }
 
 func (e *eventRecorder) newEvent(group, version, kind string, object meta.Object, eventType, reason, message string) *core.Event {
@@ -69,6 +141,8 @@ func (e *eventRecorder) newEvent(group, version, kind string, object meta.Object
 			Name:      string(uuid.NewUUID()),
 		},
 
+		Count: 1,
+
 		FirstTimestamp: meta.Now(),
 		LastTimestamp:  meta.Now(),
 
@@ -88,30 +162,129 @@ func (e *eventRecorder) newObjectReference(group, version, kind string, object m
 	}
 }
 
-func (e *eventRecorder) event(group, version, kind string, object meta.Object, eventType, reason, message string) {
-	_, err := e.kubeClientSet.CoreV1().Events(object.GetNamespace()).Create(context.Background(), e.newEvent(group, version, kind, object, eventType, reason, message), meta.CreateOptions{})
+func (e *eventRecorder) event(limiter flowcontrol.RateLimiter, group, version, kind string, object meta.Object, eventType, reason, message string) {
+	key := eventKey{
+		namespace:  object.GetNamespace(),
+		name:       object.GetName(),
+		uid:        object.GetUID(),
+		apiVersion: fmt.Sprintf("%s/%s", group, version),
+		kind:       kind,
+		eventType:  eventType,
+		reason:     reason,
+		message:    message,
+	}
+
+	log := e.logger.With().
+		Str("APIVersion", key.apiVersion).
+		Str("Kind", kind).
+		Str("Object", fmt.Sprintf("%s/%s", key.namespace, key.name)).
+		Logger()
+
+	existing, send := e.prepare(limiter, key)
+	if !send {
+		log.Debug().Msgf("Event rate limited %s - %s - %s", eventType, reason, message)
+		return
+	}
+
+	if existing != nil {
+		updated, err := e.kubeClientSet.CoreV1().Events(key.namespace).Update(context.Background(), existing, meta.UpdateOptions{})
+		if err == nil {
+			e.store(key, updated)
+			log.Debug().Msgf("Event updated %s - %s - %s (%d times)", eventType, reason, message, updated.Count)
+			return
+		}
+
+		if !k8sutil.IsNotFound(err) {
+			log.Warn().Err(err).Msgf("Unable to update event")
+			return
+		}
+
+		// Event was removed, new one is created
+		e.remove(key)
+	}
+
+	created, err := e.kubeClientSet.CoreV1().Events(key.namespace).Create(context.Background(), e.newEvent(group, version, kind, object, eventType, reason, message), meta.CreateOptions{})
 	if err != nil {
-		e.logger.Warn().Err(err).
-			Str("APIVersion", fmt.Sprintf("%s/%s", group, version)).
-			Str("Kind", kind).
-			Str("Object", fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName())).
-			Msgf("Unable to send event")
+		log.Warn().Err(err).Msgf("Unable to send event")
 		return
 	}
 
-	e.logger.Info().
-		Str("APIVersion", fmt.Sprintf("%s/%s", group, version)).
-		Str("Kind", kind).
-		Str("Object", fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName())).
-		Msgf("Event send %s - %s - %s", eventType, reason, message)
+	e.store(key, created)
+
+	log.Info().Msgf("Event send %s - %s - %s", eventType, reason, message)
+}
+
+// prepare counts the event in the aggregation and checks the rate limit. It returns copy of the aggregated event
+// which needs to be updated, or nil if new event needs to be created. API calls are done without the lock.
+func (e *eventRecorder) prepare(limiter flowcontrol.RateLimiter, key eventKey) (*core.Event, bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	now := time.Now()
+	e.cleanup(now)
+
+	existing, aggregated := e.events[key]
+	if aggregated {
+		existing.Count++
+		existing.LastTimestamp = meta.NewTime(now)
+	}
+
+	if !limiter.TryAccept() {
+		return nil, false
+	}
+
+	if !aggregated {
+		return nil, true
+	}
+
+	return existing.DeepCopy(), true
+}
+
+// store saves the event returned by the API, counts aggregated in the meantime are preserved
+func (e *eventRecorder) store(key eventKey, event *core.Event) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if current, ok := e.events[key]; ok && current.Count > event.Count {
+		event.Count = current.Count
+		event.LastTimestamp = current.LastTimestamp
+	}
+
+	e.events[key] = event
+}
+
+func (e *eventRecorder) remove(key eventKey) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	delete(e.events, key)
+}
+
+// cleanup removes events which are outside of the aggregation window, lock needs to be acquired
+func (e *eventRecorder) cleanup(now time.Time) {
+	if now.Sub(e.lastCleanup) < e.config.aggregationWindow/10 {
+		return
+	}
+
+	e.lastCleanup = now
+
+	for k, v := range e.events {
+		if now.Sub(v.LastTimestamp.Time) > e.config.aggregationWindow {
+			delete(e.events, k)
+		}
+	}
 }
 
-func (e *eventRecorder) NewInstance(group, version, kind string) RecorderInstance {
+func (e *eventRecorder) NewInstance(group, version, kind string, opts ...Option) RecorderInstance {
+	c := newConfig(e.config, opts...)
+
 	return &eventRecorderInstance{
 		group:   group,
 		version: version,
 		kind:    kind,
 
+		limiter: flowcontrol.NewTokenBucketRateLimiter(c.qps, c.burst),
+
 		eventRecorder: e,
 	}
 }
@@ -127,6 +300,8 @@ type RecorderInstance interface {
 type eventRecorderInstance struct {
 	group, version, kind string
 
+	limiter flowcontrol.RateLimiter
+
 	eventRecorder Recorder
 }
 
@@ -139,5 +314,5 @@ func (e *eventRecorderInstance) Normal(object meta.Object, reason, format string
 }
 
 func (e *eventRecorderInstance) Event(object meta.Object, eventType, reason, format string, a ...interface{}) {
-	e.eventRecorder.event(e.group, e.version, e.kind, object, eventType, reason, fmt.Sprintf(format, a...))
+	e.eventRecorder.event(e.limiter, e.group, e.version, e.kind, object, eventType, reason, fmt.Sprintf(format, a...))
 }
@@ -90,3 +90,80 @@ func Test_Event_Handler(t *testing.T) {
 		})
 	}
 }
+
+func Test_Event_Aggregation(t *testing.T) {
+	// Arrange
+	c := fake.NewSimpleClientset()
+
+	instance := NewEventRecorder(log.Logger, "mock", c).NewInstance("group", "v1", "kind")
+
+	namespace := string(uuid.NewUUID())
+
+	p := &core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "pod",
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+	}
+
+	// Act
+	for i := 0; i < 5; i++ {
+		instance.Warning(p, "Error", "Reconciliation failed")
+	}
+	instance.Warning(p, "Error", "Other failure")
+
+	// Assert
+	events, err := c.CoreV1().Events(namespace).List(context.Background(), meta.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, events.Items, 2)
+
+	counts := map[string]int32{}
+	for _, e := range events.Items {
+		counts[e.Message] = e.Count
+	}
+
+	require.Equal(t, map[string]int32{
+		"Reconciliation failed": 5,
+		"Other failure":         1,
+	}, counts)
+}
+
+func Test_Event_RateLimit(t *testing.T) {
+	// Arrange
+	c := fake.NewSimpleClientset()
+
+	recorder := NewEventRecorder(log.Logger, "mock", c)
+	limited := recorder.NewInstance("group", "v1", "limited", WithRateLimit(0.0001, 2))
+	unlimited := recorder.NewInstance("group", "v1", "unlimited")
+
+	namespace := string(uuid.NewUUID())
+
+	p := &core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "pod",
+			Namespace: namespace,
+			UID:       uuid.NewUUID(),
+		},
+	}
+
+	// Act
+	for i := 0; i < 5; i++ {
+		limited.Warning(p, "Error", "Failure %d", i)
+		unlimited.Warning(p, "Error", "Failure %d", i)
+	}
+
+	// Assert
+	events, err := c.CoreV1().Events(namespace).List(context.Background(), meta.ListOptions{})
+	require.NoError(t, err)
+
+	kinds := map[string]int{}
+	for _, e := range events.Items {
+		kinds[e.InvolvedObject.Kind]++
+	}
+
+	require.Equal(t, map[string]int{
+		"limited":   2,
+		"unlimited": 5,
+	}, kinds)
+}
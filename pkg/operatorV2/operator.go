@@ -21,6 +21,8 @@
 package operator
 
 import (
+	"fmt"
+	"hash/fnv"
 	"sync"
 	"time"
 
@@ -63,16 +65,53 @@ type Operator interface {
 	GetLogger() *zerolog.Logger
 }
 
+// Option changes the configuration of the operator
+type Option func(o *operator)
+
+// WithHandlerWorkers sets the number of workers of the handler with the given name.
+// Items of the handler are sharded by namespace and name into dedicated queues, one per worker,
+// so the same object is always processed by the same worker.
+// Handlers without workers share the queue processed by the operator workers.
+func WithHandlerWorkers(handler string, workers int) Option {
+	return func(o *operator) {
+		o.handlerWorkers[handler] = workers
+	}
+}
+
+// WithRateLimiter sets rate limits of the operator queue and of handler queues without own limits.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(o *operator) {
+		o.rateLimiter = limiter
+	}
+}
+
+// WithHandlerRateLimiter sets rate limits of the handler with the given name.
+// Limits are shared by all workers of the handler and apply only to handlers with dedicated workers.
+func WithHandlerRateLimiter(handler string, limiter RateLimiter) Option {
+	return func(o *operator) {
+		o.handlerRateLimiters[handler] = limiter
+	}
+}
+
 // NewOperator creates new operator
-func NewOperator(logger zerolog.Logger, name, namespace, image string) Operator {
+func NewOperator(logger zerolog.Logger, name, namespace, image string, opts ...Option) Operator {
 	o := &operator{
-		name:      name,
-		namespace: namespace,
-		image:     image,
-		logger:    logger,
-		workqueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name),
+		name:           name,
+		namespace:      namespace,
+		image:          image,
+		logger:         logger,
+		handlerWorkers: map[string]int{},
+
+		rateLimiter:         DefaultRateLimiter(),
+		handlerRateLimiters: map[string]RateLimiter{},
+	}
+
+	for _, opt := range opts {
+		opt(o)
 	}
 
+	o.workqueue = workqueue.NewNamedRateLimitingQueue(o.rateLimiter.newRateLimiter(), name)
+
 	// Declaration of prometheus interface
 	o.prometheusMetrics = newCollector(o)
 
@@ -96,6 +135,16 @@ type operator struct {
 
 	workqueue workqueue.RateLimitingInterface
 
+	// handlerWorkers defines the number of workers per handler name
+	handlerWorkers map[string]int
+	// handlerQueues holds the sharded queues of the handlers, in order of registration
+	handlerQueues [][]workqueue.RateLimitingInterface
+
+	// rateLimiter defines rate limits of queues
+	rateLimiter RateLimiter
+	// handlerRateLimiters defines rate limits per handler name
+	handlerRateLimiters map[string]RateLimiter
+
 	// Implement prometheus collector
 	*prometheusMetrics
 }
@@ -139,7 +188,20 @@ func (o *operator) RegisterHandler(handler Handler) error {
 		}
 	}
 
+	limiter, ok := o.handlerRateLimiters[handler.Name()]
+	if !ok {
+		limiter = o.rateLimiter
+	}
+	rateLimiter := limiter.newRateLimiter()
+
+	var queues []workqueue.RateLimitingInterface
+	for id := 0; id < o.handlerWorkers[handler.Name()]; id++ {
+		queues = append(queues, workqueue.NewNamedRateLimitingQueue(rateLimiter,
+			fmt.Sprintf("%s-%s-%d", o.name, handler.Name(), id)))
+	}
+
 	o.handlers = append(o.handlers, handler)
+	o.handlerQueues = append(o.handlerQueues, queues)
 
 	return nil
 }
@@ -164,7 +226,34 @@ func (o *operator) RegisterStarter(starter Starter) error {
 }
 
 func (o *operator) EnqueueItem(item operation.Item) {
-	o.workqueue.Add(item.String())
+	o.getQueue(item).Add(item.String())
+}
+
+// getQueue returns the queue of the first handler which can handle the item
+func (o *operator) getQueue(item operation.Item) workqueue.RateLimitingInterface {
+	for id, handler := range o.handlers {
+		if !handler.CanBeHandled(item) {
+			continue
+		}
+
+		if queues := o.handlerQueues[id]; len(queues) > 0 {
+			return queues[shard(item, len(queues))]
+		}
+
+		break
+	}
+
+	return o.workqueue
+}
+
+// shard returns the shard of the item based on its namespace and name
+func shard(item operation.Item, shards int) int {
+	h := fnv.New32a()
+	h.Write([]byte(item.Namespace))
+	h.Write([]byte("/"))
+	h.Write([]byte(item.Name))
+
+	return int(h.Sum32() % uint32(shards))
 }
 
 func (o *operator) RegisterInformer(informer cache.SharedIndexInformer, group, version, kind string) error {
@@ -225,7 +314,18 @@ func (o *operator) start(threadiness int, stopCh <-chan struct{}) error {
 
 	o.logger.Info().Msgf("Starting workers")
 	for i := 0; i < threadiness; i++ {
-		go wait.Until(o.worker, time.Second, stopCh)
+		go wait.Until(o.worker(o.workqueue), time.Second, stopCh)
+	}
+
+	for id, queues := range o.handlerQueues {
+		if len(queues) == 0 {
+			continue
+		}
+
+		o.logger.Info().Msgf("Starting %d workers for handler %s", len(queues), o.handlers[id].Name())
+		for _, queue := range queues {
+			go wait.Until(o.worker(queue), time.Second, stopCh)
+		}
 	}
 
 	o.logger.Info().Msgf("Operator started")
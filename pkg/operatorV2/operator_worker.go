@@ -23,15 +23,19 @@ package operator
 import (
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	"k8s.io/client-go/util/workqueue"
 )
 
-func (o *operator) worker() {
-	for o.processNextItem() {
+func (o *operator) worker(queue workqueue.RateLimitingInterface) func() {
+	return func() {
+		for o.processNextItem(queue) {
 
+		}
 	}
 }
 
-func (o *operator) processNextItem() bool {
+func (o *operator) processNextItem(queue workqueue.RateLimitingInterface) bool {
 	defer func() {
 		// Recover from panic to not shutdown whole operator
 		if err := recover(); err != nil {
@@ -52,13 +56,13 @@ func (o *operator) processNextItem() bool {
 		}
 	}()
 
-	obj, shutdown := o.workqueue.Get()
+	obj, shutdown := queue.Get()
 
 	if shutdown {
 		return false
 	}
 
-	err := o.processObject(obj)
+	err := o.processObject(queue, obj)
 
 	if err != nil {
 		o.logger.Error().Err(err).Interface("object", obj).Msgf("Error during object handling")
@@ -68,27 +72,27 @@ func (o *operator) processNextItem() bool {
 	return true
 }
 
-func (o *operator) processObject(obj interface{}) error {
-	defer o.workqueue.Done(obj)
+func (o *operator) processObject(queue workqueue.RateLimitingInterface, obj interface{}) error {
+	defer queue.Done(obj)
 	var item operation.Item
 	var key string
 	var ok bool
 	var err error
 
 	if key, ok = obj.(string); !ok {
-		o.workqueue.Forget(obj)
+		queue.Forget(obj)
 		return nil
 	}
 
 	if item, err = operation.NewItemFromString(key); err != nil {
-		o.workqueue.Forget(obj)
+		queue.Forget(obj)
 		return nil
 	}
 
 	if item.Operation != operation.Update {
 		item.Operation = operation.Update
-		o.workqueue.Forget(obj)
-		o.workqueue.Add(item.String())
+		queue.Forget(obj)
+		queue.Add(item.String())
 		return nil
 	}
 
@@ -103,7 +107,7 @@ func (o *operator) processObject(obj interface{}) error {
 		item.Name)
 
 	if err = o.processItem(item); err != nil {
-		o.workqueue.AddRateLimited(key)
+		queue.AddRateLimited(key)
 		return errors.Newf("error syncing '%s': %s, requeuing", key, err.Error())
 	}
 
@@ -115,7 +119,7 @@ func (o *operator) processObject(obj interface{}) error {
 		item.Namespace,
 		item.Name)
 
-	o.workqueue.Forget(obj)
+	queue.Forget(obj)
 	return nil
 }
 
@@ -21,7 +21,12 @@
 package operator
 
 import (
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
 
 	"github.com/rs/zerolog/log"
 
@@ -146,3 +151,72 @@ func Test_Worker_EnsureObjectIsProcessedBySecondHandler(t *testing.T) {
 	close(i)
 	close(i2)
 }
+
+func Test_Worker_HandlerWorkers(t *testing.T) {
+	// Arrange
+	name := string(uuid.NewUUID())
+	o := NewOperator(log.Logger, name, name, name, WithHandlerWorkers("sharded", 4))
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	var active, maxActive int32
+	release := make(chan struct{})
+	processed := make(chan operation.Item, 1024)
+
+	require.NoError(t, o.RegisterHandler(newMockHandler("sharded", func(item operation.Item) error {
+		current := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+
+		for {
+			m := atomic.LoadInt32(&maxActive)
+			if current <= m || atomic.CompareAndSwapInt32(&maxActive, m, current) {
+				break
+			}
+		}
+
+		<-release
+		processed <- item
+		return nil
+	}, func(item operation.Item) bool {
+		return true
+	})))
+
+	// Act
+	require.NoError(t, o.Start(0, stopCh))
+
+	for i := 0; i < 16; i++ {
+		item := randomItem()
+		item.Operation = operation.Update
+		item.Name = fmt.Sprintf("item-%d", i)
+		o.EnqueueItem(item)
+	}
+
+	// Assert
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&maxActive) > 1
+	}, time.Second, 10*time.Millisecond)
+
+	close(release)
+
+	waitForItems(t, processed, 16)
+	require.LessOrEqual(t, atomic.LoadInt32(&maxActive), int32(4))
+}
+
+func Test_Worker_Shard(t *testing.T) {
+	item := randomItem()
+
+	// Same object is always assigned to the same shard
+	require.Equal(t, shard(item, 8), shard(item, 8))
+
+	shards := map[int]bool{}
+	for i := 0; i < 64; i++ {
+		item.Name = fmt.Sprintf("item-%d", i)
+
+		s := shard(item, 8)
+		require.True(t, s >= 0 && s < 8)
+		shards[s] = true
+	}
+
+	require.Len(t, shards, 8)
+}
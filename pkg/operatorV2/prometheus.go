@@ -21,13 +21,18 @@
 package operator
 
 import (
+	"fmt"
+
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
 )
 
 type prometheusMetrics struct {
 	operator *operator
 
 	objectProcessed prometheus.Counter
+
+	queueDepth *prometheus.Desc
 }
 
 func newCollector(operator *operator) *prometheusMetrics {
@@ -41,9 +46,27 @@ func newCollector(operator *operator) *prometheusMetrics {
 				"operator_name": operator.name,
 			},
 		}),
+
+		queueDepth: prometheus.NewDesc("arango_operator_queue_depth", "Number of items waiting in the work queue",
+			[]string{"queue"}, map[string]string{"operator_name": operator.name}),
 	}
 }
 
+// queues returns the work queue of the operator and queues of all handlers by their names
+func (p *prometheusMetrics) queues() map[string]workqueue.RateLimitingInterface {
+	queues := map[string]workqueue.RateLimitingInterface{
+		p.operator.name: p.operator.workqueue,
+	}
+
+	for id, handlerQueues := range p.operator.handlerQueues {
+		for shard, q := range handlerQueues {
+			queues[fmt.Sprintf("%s-%d", p.operator.handlers[id].Name(), shard)] = q
+		}
+	}
+
+	return queues
+}
+
 func (p *prometheusMetrics) connectors() []prometheus.Collector {
 	return []prometheus.Collector{
 		p.objectProcessed,
@@ -55,6 +78,8 @@ func (p *prometheusMetrics) Describe(r chan<- *prometheus.Desc) {
 		c.Describe(r)
 	}
 
+	r <- p.queueDepth
+
 	for _, h := range p.operator.handlers {
 		if collector, ok := h.(prometheus.Collector); ok {
 			collector.Describe(r)
@@ -67,6 +92,10 @@ func (p *prometheusMetrics) Collect(r chan<- prometheus.Metric) {
 		c.Collect(r)
 	}
 
+	for name, q := range p.queues() {
+		r <- prometheus.MustNewConstMetric(p.queueDepth, prometheus.GaugeValue, float64(q.Len()), name)
+	}
+
 	for _, h := range p.operator.handlers {
 		if collector, ok := h.(prometheus.Collector); ok {
 			collector.Collect(r)
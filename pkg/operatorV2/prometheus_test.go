@@ -0,0 +1,53 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Prometheus_QueueDepth(t *testing.T) {
+	// Arrange
+	o := NewOperator(log.Logger, "test", "test", "test", WithHandlerWorkers("mock", 2)).(*operator)
+
+	m, _ := mockSimpleObject("mock", true)
+	require.NoError(t, o.RegisterHandler(m))
+
+	// Act
+	o.workqueue.Add("a")
+	o.handlerQueues[0][1].Add("b")
+	o.handlerQueues[0][1].Add("c")
+
+	// Assert
+	expected := `
+# HELP arango_operator_queue_depth Number of items waiting in the work queue
+# TYPE arango_operator_queue_depth gauge
+arango_operator_queue_depth{operator_name="test",queue="mock-0"} 0
+arango_operator_queue_depth{operator_name="test",queue="mock-1"} 2
+arango_operator_queue_depth{operator_name="test",queue="test"} 1
+`
+	require.NoError(t, testutil.CollectAndCompare(o, strings.NewReader(expected), "arango_operator_queue_depth"))
+}
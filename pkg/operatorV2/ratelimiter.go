@@ -0,0 +1,66 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	// DefaultRateLimiterBaseDelay is the delay of the first retry of a failed item
+	DefaultRateLimiterBaseDelay = 5 * time.Millisecond
+	// DefaultRateLimiterMaxDelay is the maximum delay of retries of a failed item
+	DefaultRateLimiterMaxDelay = 1000 * time.Second
+	// DefaultRateLimiterQPS is the overall number of retries per second
+	DefaultRateLimiterQPS = 10
+	// DefaultRateLimiterBurst is the overall number of retries at once
+	DefaultRateLimiterBurst = 100
+)
+
+// RateLimiter defines rate limits of operator queues.
+// Failed items are retried with exponential backoff between BaseDelay and MaxDelay,
+// retries of all items of the queue are additionally limited by QPS and Burst.
+type RateLimiter struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	QPS       float64
+	Burst     int
+}
+
+// DefaultRateLimiter returns rate limits equal to the client-go controller defaults.
+func DefaultRateLimiter() RateLimiter {
+	return RateLimiter{
+		BaseDelay: DefaultRateLimiterBaseDelay,
+		MaxDelay:  DefaultRateLimiterMaxDelay,
+		QPS:       DefaultRateLimiterQPS,
+		Burst:     DefaultRateLimiterBurst,
+	}
+}
+
+func (r RateLimiter) newRateLimiter() workqueue.RateLimiter {
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(r.BaseDelay, r.MaxDelay),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(r.QPS), r.Burst)},
+	)
+}
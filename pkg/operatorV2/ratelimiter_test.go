@@ -0,0 +1,62 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+func Test_RateLimiter_Backoff(t *testing.T) {
+	r := RateLimiter{BaseDelay: time.Second, MaxDelay: 4 * time.Second, QPS: 1000, Burst: 1000}.newRateLimiter()
+
+	require.Equal(t, time.Second, r.When("a"))
+	require.Equal(t, 2*time.Second, r.When("a"))
+	require.Equal(t, 4*time.Second, r.When("a"))
+	require.Equal(t, 4*time.Second, r.When("a"))
+	require.Equal(t, time.Second, r.When("b"))
+
+	r.Forget("a")
+	require.Equal(t, time.Second, r.When("a"))
+}
+
+func Test_RateLimiter_Bucket(t *testing.T) {
+	r := RateLimiter{QPS: 1, Burst: 1}.newRateLimiter()
+
+	require.Equal(t, time.Duration(0), r.When("a"))
+	require.InDelta(t, time.Second, r.When("b"), float64(100*time.Millisecond))
+}
+
+func Test_RateLimiter_Options(t *testing.T) {
+	name := string(uuid.NewUUID())
+	limiter := RateLimiter{BaseDelay: time.Second, MaxDelay: time.Minute, QPS: 1, Burst: 2}
+
+	o := NewOperator(log.Logger, name, name, name).(*operator)
+	require.Equal(t, DefaultRateLimiter(), o.rateLimiter)
+
+	o = NewOperator(log.Logger, name, name, name, WithRateLimiter(limiter), WithHandlerRateLimiter("handler", limiter)).(*operator)
+	require.Equal(t, limiter, o.rateLimiter)
+	require.Equal(t, limiter, o.handlerRateLimiters["handler"])
+}
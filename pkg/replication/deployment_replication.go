@@ -85,6 +85,7 @@ type DeploymentReplication struct {
 	inspectTrigger         trigger.Trigger
 	recentInspectionErrors int
 	clientCache            client.ClientCache
+	metricDatabases        map[metricsDatabase]bool // Databases with exported metrics
 }
 
 // New creates a new DeploymentReplication from the given API object.
@@ -150,6 +151,8 @@ func (dr *DeploymentReplication) run() {
 		log.Warn().Err(err).Msg("Failed to add finalizers")
 	}
 
+	defer dr.removeSyncMetrics()
+
 	inspectionInterval := maxInspectionInterval
 	dr.inspectTrigger.Trigger()
 	for {
@@ -251,35 +254,37 @@ func (dr *DeploymentReplication) updateCRStatus() error {
 	log := dr.deps.Log
 	repls := dr.deps.Client.Arango().ReplicationV1().ArangoDeploymentReplications(dr.apiObject.GetNamespace())
 	update := dr.apiObject.DeepCopy()
-	attempt := 0
-	for {
-		attempt++
+	err := k8sutil.RetryOnConflict(func() error {
 		update.Status = dr.status
-		newAPIObject, err := repls.Update(context.Background(), update, metav1.UpdateOptions{})
-		if err == nil {
-			// Update internal object
-			dr.apiObject = newAPIObject
-			return nil
+		newAPIObject, err := repls.UpdateStatus(context.Background(), update, metav1.UpdateOptions{})
+		if k8sutil.IsNotFound(err) {
+			// CRD is installed without the status subresource
+			newAPIObject, err = repls.Update(context.Background(), update, metav1.UpdateOptions{})
 		}
-		if attempt < 10 && k8sutil.IsConflict(err) {
-			// API object may have been changed already,
-			// Reload api object and try again
-			var current *api.ArangoDeploymentReplication
-			current, err = repls.Get(context.Background(), update.GetName(), metav1.GetOptions{})
-			if err == nil {
-				update = current.DeepCopy()
-				continue
-			}
+		if err != nil {
+			return err
 		}
+		// Update internal object
+		dr.apiObject = newAPIObject
+		return nil
+	}, func() error {
+		// API object may have been changed already, reload api object and try again
+		current, err := repls.Get(context.Background(), update.GetName(), metav1.GetOptions{})
 		if err != nil {
-			log.Debug().Err(err).Msg("failed to patch ArangoDeploymentReplication status")
-			return errors.WithStack(errors.Newf("failed to patch ArangoDeploymentReplication status: %v", err))
+			return err
 		}
+		update = current.DeepCopy()
+		return nil
+	})
+	if err != nil {
+		log.Debug().Err(err).Msg("failed to update ArangoDeploymentReplication status")
+		return errors.WithStack(errors.Newf("failed to update ArangoDeploymentReplication status: %v", err))
 	}
+	return nil
 }
 
 // Update the spec part of the API object (d.apiObject)
-// to the given object, while preserving the status.
+// to the given object. The status is written by updateCRStatus.
 // On success, d.apiObject is updated.
 func (dr *DeploymentReplication) updateCRSpec(newSpec api.DeploymentReplicationSpec) error {
 	log := dr.deps.Log
@@ -287,32 +292,29 @@ func (dr *DeploymentReplication) updateCRSpec(newSpec api.DeploymentReplicationS
 
 	// Send update to API server
 	update := dr.apiObject.DeepCopy()
-	attempt := 0
-	for {
-		attempt++
+	err := k8sutil.RetryOnConflict(func() error {
 		update.Spec = newSpec
-		update.Status = dr.status
 		newAPIObject, err := repls.Update(context.Background(), update, metav1.UpdateOptions{})
-		if err == nil {
-			// Update internal object
-			dr.apiObject = newAPIObject
-			return nil
-		}
-		if attempt < 10 && k8sutil.IsConflict(err) {
-			// API object may have been changed already,
-			// Reload api object and try again
-			var current *api.ArangoDeploymentReplication
-			current, err = repls.Get(context.Background(), update.GetName(), metav1.GetOptions{})
-			if err == nil {
-				update = current.DeepCopy()
-				continue
-			}
+		if err != nil {
+			return err
 		}
+		// Update internal object
+		dr.apiObject = newAPIObject
+		return nil
+	}, func() error {
+		// API object may have been changed already, reload api object and try again
+		current, err := repls.Get(context.Background(), update.GetName(), metav1.GetOptions{})
 		if err != nil {
-			log.Debug().Err(err).Msg("failed to patch ArangoDeploymentReplication spec")
-			return errors.WithStack(errors.Newf("failed to patch ArangoDeploymentReplication spec: %v", err))
+			return err
 		}
+		update = current.DeepCopy()
+		return nil
+	})
+	if err != nil {
+		log.Debug().Err(err).Msg("failed to update ArangoDeploymentReplication spec")
+		return errors.WithStack(errors.Newf("failed to update ArangoDeploymentReplication spec: %v", err))
 	}
+	return nil
 }
 
 // failOnError reports the given error and sets the deployment replication status to failed.
@@ -0,0 +1,95 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+)
+
+const (
+	// Component name for metrics of this package
+	metricsComponent = "deployment_replication"
+
+	metricsEndpointSource      = "source"
+	metricsEndpointDestination = "destination"
+)
+
+var (
+	endpointInSync       = metrics.MustRegisterGaugeVec(metricsComponent, "in_sync", "Set to 1 when all shards of the endpoint are synchronized incrementally", metrics.DeploymentReplicationName, metrics.Endpoint)
+	endpointShards       = metrics.MustRegisterGaugeVec(metricsComponent, "shards", "Number of replicated shards", metrics.DeploymentReplicationName, metrics.Endpoint)
+	endpointShardsInSync = metrics.MustRegisterGaugeVec(metricsComponent, "shards_in_sync", "Number of shards which are synchronized incrementally", metrics.DeploymentReplicationName, metrics.Endpoint)
+	endpointLag          = metrics.MustRegisterGaugeVec(metricsComponent, "lag_seconds", "Highest delay of all replicated shards (in sec)", metrics.DeploymentReplicationName, metrics.Endpoint)
+	databaseLag          = metrics.MustRegisterGaugeVec(metricsComponent, "database_lag_seconds", "Highest delay of all replicated shards of the database (in sec)", metrics.DeploymentReplicationName, metrics.Endpoint, metrics.Database)
+)
+
+// metricsDatabase identifies a database with exported metrics
+type metricsDatabase struct {
+	endpoint, database string
+}
+
+// updateSyncMetrics exports the replication progress of the source & destination endpoints
+// and removes metrics of databases which are no longer reported.
+func (dr *DeploymentReplication) updateSyncMetrics() {
+	name := dr.apiObject.GetName()
+	current := map[metricsDatabase]bool{}
+
+	for endpoint, status := range map[string]api.EndpointStatus{
+		metricsEndpointSource:      dr.status.Source,
+		metricsEndpointDestination: dr.status.Destination,
+	} {
+		inSync := 0.0
+		if status.IsInSync() {
+			inSync = 1
+		}
+		endpointInSync.WithLabelValues(name, endpoint).Set(inSync)
+		endpointShards.WithLabelValues(name, endpoint).Set(float64(status.ShardsTotal))
+		endpointShardsInSync.WithLabelValues(name, endpoint).Set(float64(status.ShardsInSync))
+		endpointLag.WithLabelValues(name, endpoint).Set(status.GetLag().Seconds())
+
+		for _, db := range status.Databases {
+			current[metricsDatabase{endpoint: endpoint, database: db.Name}] = true
+			databaseLag.WithLabelValues(name, endpoint, db.Name).Set(db.GetLag().Seconds())
+		}
+	}
+
+	for db := range dr.metricDatabases {
+		if !current[db] {
+			databaseLag.DeleteLabelValues(name, db.endpoint, db.database)
+		}
+	}
+	dr.metricDatabases = current
+}
+
+// removeSyncMetrics removes all metrics of the deployment replication.
+func (dr *DeploymentReplication) removeSyncMetrics() {
+	name := dr.apiObject.GetName()
+	for _, endpoint := range []string{metricsEndpointSource, metricsEndpointDestination} {
+		endpointInSync.DeleteLabelValues(name, endpoint)
+		endpointShards.DeleteLabelValues(name, endpoint)
+		endpointShardsInSync.DeleteLabelValues(name, endpoint)
+		endpointLag.DeleteLabelValues(name, endpoint)
+	}
+	for db := range dr.metricDatabases {
+		databaseLag.DeleteLabelValues(name, db.endpoint, db.database)
+	}
+	dr.metricDatabases = nil
+}
@@ -0,0 +1,151 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	"context"
+	"time"
+
+	"github.com/arangodb/arangosync-client/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// inspectSwitchover progresses the switchover of the replication when it is requested in the spec.
+// Returns true when the switchover is in progress or done, so the synchronization must not be configured.
+func (dr *DeploymentReplication) inspectSwitchover(ctx context.Context) (bool, error) {
+	log := dr.deps.Log
+	spec := dr.apiObject.Spec
+	status := dr.status.Switchover
+
+	if spec.Switchover == nil {
+		if status != nil && status.Phase != api.DeploymentReplicationSwitchoverPhaseReversed {
+			// Switchover request has been removed before it was reversed, resume replication
+			log.Info().Msg("Switchover request removed, resuming synchronization")
+			dr.status.Switchover = nil
+			if err := dr.updateCRStatus(); err != nil {
+				return false, errors.WithStack(err)
+			}
+		}
+		return false, nil
+	}
+
+	if status == nil || status.Phase == api.DeploymentReplicationSwitchoverPhaseReversed {
+		// New switchover request
+		log.Info().Msg("Starting switchover")
+		dr.status.Switchover = &api.DeploymentReplicationSwitchoverStatus{
+			Phase:     api.DeploymentReplicationSwitchoverPhaseStopping,
+			StartTime: metav1.Now(),
+		}
+		dr.createEvent(k8sutil.NewReplicationSwitchoverEvent(dr.apiObject, string(api.DeploymentReplicationSwitchoverPhaseStopping)))
+		if err := dr.updateCRStatus(); err != nil {
+			return true, errors.WithStack(err)
+		}
+		return true, nil
+	}
+
+	switch status.Phase {
+	case api.DeploymentReplicationSwitchoverPhaseStopping:
+		return true, dr.inspectSwitchoverStopping(ctx)
+	case api.DeploymentReplicationSwitchoverPhaseStopped:
+		if !spec.Switchover.IsReverse() {
+			// Destination is writable, nothing more to do
+			return true, nil
+		}
+
+		// Reverse direction of the replication
+		log.Info().Msg("Reversing direction of the synchronization")
+		status.Phase = api.DeploymentReplicationSwitchoverPhaseReversed
+		status.Message = ""
+		if err := dr.updateCRSpec(spec.Reverse()); err != nil {
+			status.Phase = api.DeploymentReplicationSwitchoverPhaseStopped
+			return true, errors.WithStack(err)
+		}
+		if err := dr.updateCRStatus(); err != nil {
+			return true, errors.WithStack(err)
+		}
+		dr.createEvent(k8sutil.NewReplicationSwitchoverEvent(dr.apiObject, string(api.DeploymentReplicationSwitchoverPhaseReversed)))
+		return true, nil
+	}
+
+	return true, nil
+}
+
+// inspectSwitchoverStopping stops the synchronization without aborting it, so all data is transferred
+// to the destination before it is made writable.
+func (dr *DeploymentReplication) inspectSwitchoverStopping(ctx context.Context) error {
+	log := dr.deps.Log
+	status := dr.status.Switchover
+
+	destClient, err := dr.createSyncMasterClient(dr.apiObject.Spec.Destination)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	destStatus, err := destClient.Master().Status(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !destStatus.Status.IsInactiveOrEmpty() {
+		req := client.CancelSynchronizationRequest{
+			WaitTimeout: time.Minute * 3,
+		}
+		if dr.apiObject.Spec.Switchover.IsForced(status.StartTime, time.Now()) {
+			// Source datacenter may be unreachable, do not wait for it
+			req.Force = true
+			req.ForceTimeout = time.Minute
+			log.Warn().Msg("Stopping synchronization for switchover with force")
+		} else {
+			log.Info().Msg("Stopping synchronization for switchover")
+		}
+		if _, err := destClient.Master().CancelSynchronization(ctx, req); err != nil && !client.IsPreconditionFailed(err) {
+			status.Message = err.Error()
+			if err := dr.updateCRStatus(); err != nil {
+				log.Warn().Err(err).Msg("Failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+		status.Forced = status.Forced || req.Force
+
+		if destStatus, err = destClient.Master().Status(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if !destStatus.Status.IsInactiveOrEmpty() {
+			// Not yet stopped
+			return nil
+		}
+	}
+
+	log.Info().Bool("forced", status.Forced).Msg("Synchronization stopped, destination is writable")
+	now := metav1.Now()
+	status.Phase = api.DeploymentReplicationSwitchoverPhaseStopped
+	status.Message = ""
+	status.FinishTime = &now
+	dr.status.Conditions.Update(api.ConditionTypeConfigured, false, "Switchover", "Synchronization stopped by switchover")
+	dr.status.Conditions.Update(api.ConditionTypeInSync, false, "Switchover", "Synchronization stopped by switchover")
+	dr.createEvent(k8sutil.NewReplicationSwitchoverEvent(dr.apiObject, string(api.DeploymentReplicationSwitchoverPhaseStopped)))
+
+	return errors.WithStack(dr.updateCRStatus())
+}
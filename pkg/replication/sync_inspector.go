@@ -22,6 +22,7 @@ package replication
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -29,6 +30,9 @@ import (
 
 	"github.com/arangodb/arangosync-client/client"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // inspectDeploymentReplication inspects the entire deployment replication
@@ -64,6 +68,12 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 			log.Warn().Err(err).Msg("Failed to run finalizers")
 			hasError = true
 		}
+	} else if inProgress, err := dr.inspectSwitchover(ctx); err != nil || inProgress {
+		// Switchover is in progress or done, synchronization must not be configured
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to inspect switchover")
+			hasError = true
+		}
 	} else {
 		// Inspect configuration status
 		destClient, err := dr.createSyncMasterClient(spec.Destination)
@@ -93,7 +103,13 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 							dr.status.Conditions.Update(api.ConditionTypeConfigured, true, "Active", "Destination syncmaster is configured correctly and active")
 							// Fetch shard status
 							dr.status.Destination = createEndpointStatus(destStatus, "")
+							dr.updateInSyncCondition()
 							updateStatusNeeded = true
+							// Propagate renewed authentication of the source
+							if err := dr.updateSourceAuthentication(ctx, destClient); err != nil {
+								log.Warn().Err(err).Msg("Failed to update authentication of the source")
+								hasError = true
+							}
 						} else {
 							// Sync is active, but from different source
 							log.Warn().Msg("Destination syncmaster is configured for different source")
@@ -101,6 +117,9 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 							if dr.status.Conditions.Update(api.ConditionTypeConfigured, false, "Invalid", "Destination syncmaster is configured for different source") {
 								updateStatusNeeded = true
 							}
+							if dr.status.Conditions.Update(api.ConditionTypeInSync, false, "Not Configured", "Destination syncmaster is configured for different source") {
+								updateStatusNeeded = true
+							}
 						}
 					}
 				} else {
@@ -109,6 +128,9 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 					if dr.status.Conditions.Update(api.ConditionTypeConfigured, false, "Inactive", "Destination syncmaster is configured correctly but in-active") {
 						updateStatusNeeded = true
 					}
+					if dr.status.Conditions.Update(api.ConditionTypeInSync, false, "Not Configured", "Destination syncmaster is configured correctly but in-active") {
+						updateStatusNeeded = true
+					}
 				}
 			}
 
@@ -137,6 +159,8 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 				}
 			}
 
+			dr.updateSyncMetrics()
+
 			// Update status if needed
 			if updateStatusNeeded {
 				if err := dr.updateCRStatus(); err != nil {
@@ -180,6 +204,11 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 							hasError = true
 						} else {
 							log.Info().Msg("Configured synchronization")
+							dr.status.SourceAuthenticationHash = getSourceAuthenticationHash(auth)
+							if err := dr.updateCRStatus(); err != nil {
+								log.Warn().Err(err).Msg("Failed to update status")
+								hasError = true
+							}
 							nextInterval = time.Second * 10
 						}
 					}
@@ -203,6 +232,57 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 	return nextInterval
 }
 
+// updateSourceAuthentication reconfigures the active synchronization when the client authentication
+// keyfile or TLS CA of the source has been changed (e.g. after renewal of the access package).
+func (dr *DeploymentReplication) updateSourceAuthentication(ctx context.Context, destClient client.API) error {
+	auth, err := dr.createArangoSyncTLSAuthentication(dr.apiObject.Spec)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	hash := getSourceAuthenticationHash(auth)
+	if dr.status.SourceAuthenticationHash == hash {
+		return nil
+	}
+
+	if dr.status.SourceAuthenticationHash != "" {
+		source, err := dr.createArangoSyncEndpoint(dr.apiObject.Spec.Source)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		req := client.SynchronizationRequest{
+			Source:         source,
+			Authentication: auth,
+		}
+		dr.deps.Log.Info().Msg("Reconfiguring synchronization with renewed authentication")
+		if err := destClient.Master().Synchronize(ctx, req); err != nil {
+			return errors.WithStack(err)
+		}
+		dr.createEvent(k8sutil.NewReplicationAuthenticationUpdatedEvent(dr.apiObject))
+	}
+
+	dr.status.SourceAuthenticationHash = hash
+	return nil
+}
+
+// getSourceAuthenticationHash returns the checksum of the given authentication.
+func getSourceAuthenticationHash(auth client.TLSAuthentication) string {
+	return util.SHA256FromString(auth.ClientCertificate + auth.ClientKey + auth.CACertificate)
+}
+
+// updateInSyncCondition updates the InSync condition from the status of the destination.
+func (dr *DeploymentReplication) updateInSyncCondition() {
+	dest := dr.status.Destination
+	if dest.IsInSync() {
+		dr.status.Conditions.Update(api.ConditionTypeInSync, true, "In Sync",
+			fmt.Sprintf("All %d shards are synchronized incrementally", dest.ShardsTotal))
+	} else {
+		dr.status.Conditions.Update(api.ConditionTypeInSync, false, "Not In Sync",
+			fmt.Sprintf("%d of %d shards are synchronized incrementally, synchronization status is %s", dest.ShardsInSync, dest.ShardsTotal, dest.Phase))
+	}
+}
+
 // isIncomingEndpoint returns true when given sync status's endpoint
 // intersects with the given endpoint spec.
 func (dr *DeploymentReplication) isIncomingEndpoint(status client.SyncInfo, epSpec api.EndpointSpec) (bool, error) {
@@ -234,13 +314,17 @@ func (dr *DeploymentReplication) hasOutgoingEndpoint(status client.SyncInfo, epS
 func createEndpointStatus(status client.SyncInfo, outgoingID string) api.EndpointStatus {
 	result := api.EndpointStatus{}
 	if outgoingID == "" {
-		return createEndpointStatusFromShards(status.Shards)
+		result = createEndpointStatusFromShards(status.Shards)
+		result.Phase = string(status.Status.Normalize())
+		return result
 	}
 	for _, o := range status.Outgoing {
 		if o.ID != outgoingID {
 			continue
 		}
-		return createEndpointStatusFromShards(o.Shards)
+		result = createEndpointStatusFromShards(o.Shards)
+		result.Phase = string(o.Status.Normalize())
+		return result
 	}
 
 	return result
@@ -286,7 +370,28 @@ func createEndpointStatusFromShards(shards []client.ShardSyncInfo) api.EndpointS
 		}
 
 		// Add current shard
-		col.Shards = append(col.Shards, api.ShardStatus{Status: string(s.Status)})
+		shard := api.ShardStatus{
+			Status:        string(s.Status),
+			StatusMessage: s.StatusMessage,
+		}
+		if s.Delay > 0 {
+			shard.Delay = &meta.Duration{Duration: s.Delay}
+		}
+		col.Shards = append(col.Shards, shard)
+
+		// Update progress
+		db.ShardsTotal++
+		result.ShardsTotal++
+		if shard.IsInSync() {
+			db.ShardsInSync++
+			result.ShardsInSync++
+		}
+		if s.Delay > db.GetLag() {
+			db.Lag = &meta.Duration{Duration: s.Delay}
+		}
+		if s.Delay > result.GetLag() {
+			result.Lag = &meta.Duration{Duration: s.Delay}
+		}
 	}
 
 	// Sort result
@@ -0,0 +1,74 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arangodb/arangosync-client/client"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreateEndpointStatus(t *testing.T) {
+	status := client.SyncInfo{
+		Status: client.SyncStatusRunning,
+		Shards: []client.ShardSyncInfo{
+			{Database: "db1", Collection: "c1", ShardIndex: 1, Status: client.SyncStatusRunning, Delay: 2 * time.Second},
+			{Database: "db1", Collection: "c1", ShardIndex: 0, Status: client.SyncStatusRunning, Delay: time.Second},
+			{Database: "db2", Collection: "c2", ShardIndex: 0, Status: client.SyncStatusInitialSync, StatusMessage: "copying"},
+		},
+		Outgoing: []client.OutgoingSyncInfo{
+			{ID: "out", Status: client.SyncStatusRunning, Shards: []client.ShardSyncInfo{
+				{Database: "db1", Collection: "c1", ShardIndex: 0, Status: client.SyncStatusRunning},
+			}},
+		},
+	}
+
+	t.Run("Incoming", func(t *testing.T) {
+		s := createEndpointStatus(status, "")
+
+		require.Equal(t, "running", s.Phase)
+		require.Equal(t, 3, s.ShardsTotal)
+		require.Equal(t, 2, s.ShardsInSync)
+		require.Equal(t, 2*time.Second, s.GetLag())
+		require.False(t, s.IsInSync())
+
+		require.Len(t, s.Databases, 2)
+		require.Equal(t, "db1", s.Databases[0].Name)
+		require.Equal(t, 2, s.Databases[0].ShardsInSync)
+		require.Equal(t, 2*time.Second, s.Databases[0].GetLag())
+		require.Equal(t, time.Second, s.Databases[0].Collections[0].Shards[0].GetDelay())
+
+		require.Equal(t, "db2", s.Databases[1].Name)
+		require.Equal(t, 0, s.Databases[1].ShardsInSync)
+		require.Nil(t, s.Databases[1].Lag)
+		require.Equal(t, "copying", s.Databases[1].Collections[0].Shards[0].StatusMessage)
+	})
+
+	t.Run("Outgoing", func(t *testing.T) {
+		s := createEndpointStatus(status, "out")
+
+		require.Equal(t, "running", s.Phase)
+		require.Equal(t, 1, s.ShardsTotal)
+		require.True(t, s.IsInSync())
+	})
+}
@@ -21,6 +21,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"sort"
 	"strings"
@@ -45,6 +46,8 @@ type Deployment interface {
 	DatabaseURL() string
 	DatabaseVersion() (string, string)
 	Members() map[api.ServerGroup][]Member
+	// AgencyDump returns the sanitized agency dump of the deployment
+	AgencyDump(ctx context.Context) (interface{}, error)
 }
 
 // Member is the API implemented by a member of an ArangoDeployment.
@@ -207,3 +210,22 @@ func (s *Server) handleGetDeploymentDetails(c *gin.Context) {
 		}
 	}
 }
+
+// Handle a GET /api/deployment/:name/agency-dump request
+func (s *Server) handleGetDeploymentAgencyDump(c *gin.Context) {
+	if do := s.deps.Operators.DeploymentOperator(); do != nil {
+		// Fetch deployment
+		depl, err := do.GetDeployment(c.Params.ByName("name"))
+		if err != nil {
+			sendError(c, err)
+			return
+		}
+
+		dump, err := depl.AgencyDump(c.Request.Context())
+		if err != nil {
+			sendError(c, err)
+		} else {
+			c.JSON(http.StatusOK, dump)
+		}
+	}
+}
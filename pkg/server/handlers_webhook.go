@@ -0,0 +1,190 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	admission "k8s.io/api/admission/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/apis/replication"
+	replicationApi "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	storageApi "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// MutatingWebhookPath is the path of the defaulting admission webhook
+const MutatingWebhookPath = "/webhook/mutate"
+
+// jsonPatchOperation is a single operation of the JSON patch (RFC 6902) returned by the webhook
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// handleMutate handles admission reviews of Arango resources and fills the spec with the defaults,
+// so the stored object matches the spec the operator acts upon.
+func (s *Server) handleMutate(c *gin.Context) {
+	var review admission.AdmissionReview
+	if err := c.BindJSON(&review); err != nil {
+		return
+	}
+
+	if review.Request == nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "admission review without request",
+		})
+		return
+	}
+
+	review.Response = mutate(review.Request)
+	if len(review.Response.Warnings) > 0 {
+		s.deps.Log.Warn().Strs("warnings", review.Response.Warnings).Str("kind", review.Request.Kind.Kind).
+			Str("name", review.Request.Name).Msg("Unable to apply defaults")
+	}
+	review.Request = nil
+
+	c.JSON(http.StatusOK, review)
+}
+
+// mutate returns the admission response for the given request.
+// Objects are always admitted, the API server validates them against the CRD schema.
+func mutate(req *admission.AdmissionRequest) *admission.AdmissionResponse {
+	resp := &admission.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+
+	patch, err := defaultingPatch(req)
+	if err != nil {
+		resp.Warnings = []string{err.Error()}
+		return resp
+	}
+
+	if patch != nil {
+		patchType := admission.PatchTypeJSONPatch
+		resp.Patch = patch
+		resp.PatchType = &patchType
+	}
+
+	return resp
+}
+
+// defaultingPatch returns the JSON patch which replaces the spec of the requested object with the defaulted one,
+// or nil when the spec does not change. Defaults are applied in the same way as by the operators:
+// unspecified fields are taken from the old object first (on update), then filled with default values.
+// All served versions of a resource share the spec structure, so the objects are decoded as the storage version.
+func defaultingPatch(req *admission.AdmissionRequest) ([]byte, error) {
+	switch req.Kind.Kind {
+	case deployment.ArangoDeploymentResourceKind:
+		var obj, old deploymentApi.ArangoDeployment
+		if err := decodeAdmissionObjects(req, &obj, &old); err != nil {
+			return nil, err
+		}
+		if obj.GetName() == "" {
+			return nil, nil
+		}
+
+		before := obj.Spec.DeepCopy()
+		if len(req.OldObject.Raw) > 0 {
+			obj.Spec.SetDefaultsFrom(old.Spec)
+		}
+		obj.Spec.SetDefaults(obj.GetName())
+
+		return specPatch(before, obj.Spec)
+	case replication.ArangoDeploymentReplicationResourceKind:
+		var obj, old replicationApi.ArangoDeploymentReplication
+		if err := decodeAdmissionObjects(req, &obj, &old); err != nil {
+			return nil, err
+		}
+
+		before := obj.Spec.DeepCopy()
+		if len(req.OldObject.Raw) > 0 {
+			obj.Spec.SetDefaultsFrom(old.Spec)
+		}
+		obj.Spec.SetDefaults()
+
+		return specPatch(before, obj.Spec)
+	case storageApi.ArangoLocalStorageResourceKind:
+		var obj, old storageApi.ArangoLocalStorage
+		if err := decodeAdmissionObjects(req, &obj, &old); err != nil {
+			return nil, err
+		}
+		if obj.GetName() == "" {
+			return nil, nil
+		}
+
+		before := obj.Spec.DeepCopy()
+		obj.Spec.SetDefaults(obj.GetName())
+
+		return specPatch(before, obj.Spec)
+	default:
+		return nil, nil
+	}
+}
+
+// decodeAdmissionObjects decodes the object and (if present) the old object of the request.
+func decodeAdmissionObjects(req *admission.AdmissionRequest, obj, old interface{}) error {
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return errors.Wrapf(err, "Unable to decode %s", req.Kind.Kind)
+	}
+	if len(req.OldObject.Raw) > 0 {
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return errors.Wrapf(err, "Unable to decode old %s", req.Kind.Kind)
+		}
+	}
+	return nil
+}
+
+// specPatch returns the JSON patch which sets the spec to the given value, or nil when both specs are equal.
+func specPatch(before, after interface{}) ([]byte, error) {
+	beforeData, err := json.Marshal(before)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	afterData, err := json.Marshal(after)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if bytes.Equal(beforeData, afterData) {
+		return nil, nil
+	}
+
+	patch, err := json.Marshal([]jsonPatchOperation{
+		{
+			Op:    "add",
+			Path:  "/spec",
+			Value: json.RawMessage(afterData),
+		},
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return patch, nil
+}
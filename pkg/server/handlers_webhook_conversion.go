@@ -0,0 +1,263 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	deploymentApiV2 "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v2alpha1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// ConversionWebhookPath is the path of the CRD conversion webhook
+const ConversionWebhookPath = "/webhook/convert"
+
+// conversionTypes contains the typed objects of the served versions of the resources supported by the conversion webhook.
+// ArangoBackup is served as v1alpha and v1, which both use the v1 types.
+var conversionTypes = map[schema.GroupKind]map[string]func() runtime.Object{
+	{Group: deployment.ArangoDeploymentGroupName, Kind: deployment.ArangoDeploymentResourceKind}: {
+		"v1alpha":  func() runtime.Object { return &deploymentApi.ArangoDeployment{} },
+		"v1":       func() runtime.Object { return &deploymentApi.ArangoDeployment{} },
+		"v2alpha1": func() runtime.Object { return &deploymentApiV2.ArangoDeployment{} },
+	},
+	{Group: backup.ArangoBackupGroupName, Kind: backup.ArangoBackupResourceKind}: {
+		"v1alpha": func() runtime.Object { return &backupApi.ArangoBackup{} },
+		"v1":      func() runtime.Object { return &backupApi.ArangoBackup{} },
+	},
+}
+
+// handleConvert handles conversion reviews of Arango resources between the served versions.
+func (s *Server) handleConvert(c *gin.Context) {
+	var review apiextensions.ConversionReview
+	if err := c.BindJSON(&review); err != nil {
+		return
+	}
+
+	if review.Request == nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "conversion review without request",
+		})
+		return
+	}
+
+	review.Response = convert(review.Request)
+	if review.Response.Result.Status != metav1.StatusSuccess {
+		s.deps.Log.Warn().Str("version", review.Request.DesiredAPIVersion).
+			Str("message", review.Response.Result.Message).Msg("Unable to convert objects")
+	}
+	review.Request = nil
+
+	c.JSON(http.StatusOK, review)
+}
+
+// convert returns the conversion response for the given request.
+func convert(req *apiextensions.ConversionRequest) *apiextensions.ConversionResponse {
+	resp := &apiextensions.ConversionResponse{
+		UID: req.UID,
+	}
+
+	objects, err := convertObjects(req.Objects, req.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: err.Error(),
+		}
+		return resp
+	}
+
+	resp.ConvertedObjects = objects
+	resp.Result = metav1.Status{
+		Status: metav1.StatusSuccess,
+	}
+	return resp
+}
+
+// convertObjects converts all objects to the desired version. Objects are kept in the same order.
+func convertObjects(objects []runtime.RawExtension, desiredAPIVersion string) ([]runtime.RawExtension, error) {
+	desired, err := schema.ParseGroupVersion(desiredAPIVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid desired version %s", desiredAPIVersion)
+	}
+
+	converted := make([]runtime.RawExtension, len(objects))
+	for id, raw := range objects {
+		data, err := convertObject(raw.Raw, desired)
+		if err != nil {
+			return nil, err
+		}
+		converted[id] = runtime.RawExtension{Raw: data}
+	}
+
+	return converted, nil
+}
+
+// convertObject converts the object to the desired version through the typed objects of both versions.
+// The typed result is merged into the original object, so fields unknown to this operator version
+// (the schemas preserve unknown fields) are kept and the conversion back returns the original object.
+func convertObject(raw []byte, desired schema.GroupVersion) ([]byte, error) {
+	original, err := decodeConversionObject(raw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to decode object")
+	}
+	originalMap, ok := original.(map[string]interface{})
+	if !ok {
+		return nil, errors.Newf("Object is not a JSON object")
+	}
+
+	var meta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, errors.Wrapf(err, "Unable to decode object")
+	}
+	gvk := meta.GroupVersionKind()
+
+	newSource, ok := conversionTypes[gvk.GroupKind()][gvk.Version]
+	if !ok {
+		return nil, errors.Newf("Conversion of %s is not supported", gvk.String())
+	}
+	newTarget, ok := conversionTypes[gvk.GroupKind()][desired.Version]
+	if gvk.Group != desired.Group || !ok {
+		return nil, errors.Newf("Conversion of %s to %s is not supported", gvk.String(), desired.String())
+	}
+
+	source := newSource()
+	if err := json.Unmarshal(raw, source); err != nil {
+		return nil, errors.Wrapf(err, "Unable to decode %s", gvk.String())
+	}
+
+	target := newTarget()
+	if err := convertTyped(source, target); err != nil {
+		return nil, errors.Wrapf(err, "Unable to convert %s to %s", gvk.String(), desired.String())
+	}
+	target.GetObjectKind().SetGroupVersionKind(desired.WithKind(gvk.Kind))
+
+	data, err := json.Marshal(target)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	typed, err := decodeConversionObject(data)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	data, err = json.Marshal(mergeConverted(originalMap, typed))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+// convertTyped converts the typed source object into the typed target object.
+// All served versions share the same schema, so the fields are copied by their JSON representation.
+func convertTyped(source, target runtime.Object) error {
+	data, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// decodeConversionObject decodes the JSON data and keeps numbers as they are.
+func decodeConversionObject(data []byte) (interface{}, error) {
+	var obj interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// mergeConverted merges the typed object into the original one.
+// Values of the typed object win, fields missing in the typed object (unknown or omitted) are kept,
+// and empty fields added by the typed encoding (e.g. `creationTimestamp: null`) are skipped.
+func mergeConverted(original, typed interface{}) interface{} {
+	switch t := typed.(type) {
+	case map[string]interface{}:
+		o, ok := original.(map[string]interface{})
+		if !ok {
+			return typed
+		}
+
+		result := make(map[string]interface{}, len(o))
+		for k, v := range o {
+			result[k] = v
+		}
+		for k, v := range t {
+			if ov, ok := o[k]; ok {
+				result[k] = mergeConverted(ov, v)
+			} else if !isEmptyConverted(v) {
+				result[k] = v
+			}
+		}
+		return result
+	case []interface{}:
+		o, ok := original.([]interface{})
+		if !ok || len(o) != len(t) {
+			return typed
+		}
+
+		result := make([]interface{}, len(t))
+		for id := range t {
+			result[id] = mergeConverted(o[id], t[id])
+		}
+		return result
+	default:
+		return typed
+	}
+}
+
+// isEmptyConverted returns true if the value is a zero value or contains only zero values.
+func isEmptyConverted(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, e := range v {
+			if !isEmptyConverted(e) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
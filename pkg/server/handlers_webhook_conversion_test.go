@@ -0,0 +1,141 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	deploymentApiV2 "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v2alpha1"
+)
+
+const conversionTestDeployment = `{
+	"apiVersion": "database.arangodb.com/v1",
+	"kind": "ArangoDeployment",
+	"metadata": {"name": "example", "namespace": "default", "uid": "1234", "labels": {"app": "arangodb"}},
+	"spec": {
+		"mode": "Cluster",
+		"image": "arangodb/arangodb:3.9.0",
+		"agents": {"count": 3, "args": ["--log.level=debug"], "unknownGroupField": true},
+		"dbservers": {"count": 5, "resources": {"requests": {"memory": "1Gi"}}},
+		"externalAccess": {"type": "None"},
+		"unknownField": {"nested": [1, 2, 3], "large": 9007199254740993}
+	},
+	"status": {"phase": "Running", "unknownStatusField": "value"}
+}`
+
+func convertTestObject(t *testing.T, obj []byte, version string) *apiextensions.ConversionResponse {
+	return convert(&apiextensions.ConversionRequest{
+		UID:               "uid",
+		DesiredAPIVersion: version,
+		Objects:           []runtime.RawExtension{{Raw: obj}},
+	})
+}
+
+func Test_Convert(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		resp := convertTestObject(t, []byte(conversionTestDeployment), "database.arangodb.com/v2alpha1")
+		require.Equal(t, metav1.StatusSuccess, resp.Result.Status)
+		require.Equal(t, "uid", string(resp.UID))
+		require.Len(t, resp.ConvertedObjects, 1)
+
+		var converted deploymentApiV2.ArangoDeployment
+		require.NoError(t, json.Unmarshal(resp.ConvertedObjects[0].Raw, &converted))
+		require.Equal(t, "database.arangodb.com/v2alpha1", converted.APIVersion)
+		require.Equal(t, deploymentApiV2.DeploymentModeCluster, converted.Spec.GetMode())
+		require.Equal(t, 3, converted.Spec.Agents.GetCount())
+		require.Equal(t, []string{"--log.level=debug"}, converted.Spec.Agents.Args)
+		require.Equal(t, 5, converted.Spec.DBServers.GetCount())
+		require.Equal(t, "1Gi", converted.Spec.DBServers.Resources.Requests.Memory().String())
+		require.Equal(t, deploymentApiV2.DeploymentPhaseRunning, converted.Status.Phase)
+
+		var convertedMap map[string]interface{}
+		require.NoError(t, json.Unmarshal(resp.ConvertedObjects[0].Raw, &convertedMap))
+		require.Contains(t, convertedMap["spec"], "unknownField")
+
+		resp = convertTestObject(t, resp.ConvertedObjects[0].Raw, "database.arangodb.com/v1")
+		require.Equal(t, metav1.StatusSuccess, resp.Result.Status)
+		require.JSONEq(t, conversionTestDeployment, string(resp.ConvertedObjects[0].Raw))
+	})
+
+	t.Run("Typed fields", func(t *testing.T) {
+		var source deploymentApi.ArangoDeployment
+		require.NoError(t, json.Unmarshal([]byte(conversionTestDeployment), &source))
+
+		var target deploymentApiV2.ArangoDeployment
+		require.NoError(t, convertTyped(&source, &target))
+
+		sourceData, err := json.Marshal(source.Spec)
+		require.NoError(t, err)
+		targetData, err := json.Marshal(target.Spec)
+		require.NoError(t, err)
+		require.JSONEq(t, string(sourceData), string(targetData))
+	})
+
+	t.Run("Invalid typed field", func(t *testing.T) {
+		resp := convertTestObject(t, []byte(`{"apiVersion": "database.arangodb.com/v1", "kind": "ArangoDeployment", "spec": {"agents": {"count": "three"}}}`), "database.arangodb.com/v2alpha1")
+		require.Equal(t, metav1.StatusFailure, resp.Result.Status)
+		require.Empty(t, resp.ConvertedObjects)
+	})
+
+	t.Run("Backup", func(t *testing.T) {
+		backup := `{
+			"apiVersion": "backup.arangodb.com/v1",
+			"kind": "ArangoBackup",
+			"metadata": {"name": "backup", "namespace": "default"},
+			"spec": {"deployment": {"name": "example"}, "upload": {"repositoryURL": "s3://bucket"}, "unknownField": 1},
+			"status": {"state": "Ready", "backup": {"id": "id", "version": "3.9.0", "createdAt": "2022-03-01T10:00:00Z"}}
+		}`
+
+		resp := convertTestObject(t, []byte(backup), "backup.arangodb.com/v1alpha")
+		require.Equal(t, metav1.StatusSuccess, resp.Result.Status)
+
+		var converted map[string]interface{}
+		require.NoError(t, json.Unmarshal(resp.ConvertedObjects[0].Raw, &converted))
+		require.Equal(t, "backup.arangodb.com/v1alpha", converted["apiVersion"])
+
+		resp = convertTestObject(t, resp.ConvertedObjects[0].Raw, "backup.arangodb.com/v1")
+		require.Equal(t, metav1.StatusSuccess, resp.Result.Status)
+		require.JSONEq(t, backup, string(resp.ConvertedObjects[0].Raw))
+	})
+
+	t.Run("Version not served", func(t *testing.T) {
+		resp := convertTestObject(t, []byte(`{"apiVersion": "backup.arangodb.com/v1", "kind": "ArangoBackup"}`), "backup.arangodb.com/v2alpha1")
+		require.Equal(t, metav1.StatusFailure, resp.Result.Status)
+		require.Empty(t, resp.ConvertedObjects)
+	})
+
+	t.Run("Different group", func(t *testing.T) {
+		resp := convertTestObject(t, []byte(conversionTestDeployment), "backup.arangodb.com/v1")
+		require.Equal(t, metav1.StatusFailure, resp.Result.Status)
+	})
+
+	t.Run("Unknown kind", func(t *testing.T) {
+		resp := convertTestObject(t, []byte(`{"apiVersion": "database.arangodb.com/v1", "kind": "Unknown"}`), "database.arangodb.com/v2alpha1")
+		require.Equal(t, metav1.StatusFailure, resp.Result.Status)
+	})
+}
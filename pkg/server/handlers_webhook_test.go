@@ -0,0 +1,129 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	admission "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func newDeploymentAdmissionRequest(t *testing.T, obj, old *deploymentApi.ArangoDeployment) *admission.AdmissionRequest {
+	req := &admission.AdmissionRequest{
+		UID:  "uid",
+		Kind: metav1.GroupVersionKind{Kind: deployment.ArangoDeploymentResourceKind},
+	}
+
+	data, err := json.Marshal(obj)
+	require.NoError(t, err)
+	req.Object = runtime.RawExtension{Raw: data}
+
+	if old != nil {
+		data, err := json.Marshal(old)
+		require.NoError(t, err)
+		req.OldObject = runtime.RawExtension{Raw: data}
+	}
+
+	return req
+}
+
+func applyAdmissionPatch(t *testing.T, resp *admission.AdmissionResponse) deploymentApi.DeploymentSpec {
+	require.NotNil(t, resp.PatchType)
+	require.Equal(t, admission.PatchTypeJSONPatch, *resp.PatchType)
+
+	var patch []struct {
+		Op    string                      `json:"op"`
+		Path  string                      `json:"path"`
+		Value deploymentApi.DeploymentSpec `json:"value"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Patch, &patch))
+	require.Len(t, patch, 1)
+	require.Equal(t, "add", patch[0].Op)
+	require.Equal(t, "/spec", patch[0].Path)
+
+	return patch[0].Value
+}
+
+func Test_Mutate(t *testing.T) {
+	t.Run("Defaults on create", func(t *testing.T) {
+		obj := &deploymentApi.ArangoDeployment{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+
+		resp := mutate(newDeploymentAdmissionRequest(t, obj, nil))
+		require.True(t, resp.Allowed)
+		require.Equal(t, "uid", string(resp.UID))
+
+		spec := applyAdmissionPatch(t, resp)
+
+		expected := obj.Spec.DeepCopy()
+		expected.SetDefaults("example")
+		require.Equal(t, deploymentApi.DeploymentModeCluster, spec.GetMode())
+		require.Equal(t, "example-jwt", spec.Authentication.GetJWTSecretName())
+		require.Equal(t, expected.GetImage(), spec.GetImage())
+	})
+
+	t.Run("Defaults from old object on update", func(t *testing.T) {
+		old := &deploymentApi.ArangoDeployment{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+		old.Spec.Image = util.NewString("arangodb/enterprise:3.8.5")
+		old.Spec.SetDefaults("example")
+
+		obj := &deploymentApi.ArangoDeployment{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+
+		spec := applyAdmissionPatch(t, mutate(newDeploymentAdmissionRequest(t, obj, old)))
+		require.Equal(t, "arangodb/enterprise:3.8.5", spec.GetImage())
+	})
+
+	t.Run("No patch when defaulted", func(t *testing.T) {
+		obj := &deploymentApi.ArangoDeployment{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+		obj.Spec.SetDefaults("example")
+
+		resp := mutate(newDeploymentAdmissionRequest(t, obj, nil))
+		require.True(t, resp.Allowed)
+		require.Nil(t, resp.Patch)
+		require.Nil(t, resp.PatchType)
+	})
+
+	t.Run("Invalid object", func(t *testing.T) {
+		resp := mutate(&admission.AdmissionRequest{
+			Kind:   metav1.GroupVersionKind{Kind: deployment.ArangoDeploymentResourceKind},
+			Object: runtime.RawExtension{Raw: []byte(`{"spec": []}`)},
+		})
+		require.True(t, resp.Allowed)
+		require.Nil(t, resp.Patch)
+		require.Len(t, resp.Warnings, 1)
+	})
+
+	t.Run("Unknown kind", func(t *testing.T) {
+		resp := mutate(&admission.AdmissionRequest{
+			Kind:   metav1.GroupVersionKind{Kind: "Pod"},
+			Object: runtime.RawExtension{Raw: []byte(`{}`)},
+		})
+		require.True(t, resp.Allowed)
+		require.Nil(t, resp.Patch)
+	})
+}
@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	admission "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+)
+
+// ValidatingWebhookPath is the path of the validating admission webhook
+const ValidatingWebhookPath = "/webhook/validate"
+
+// handleValidate handles admission reviews of Arango resources and rejects invalid changes,
+// so they are reported to the user instead of failing the resource at runtime.
+func (s *Server) handleValidate(c *gin.Context) {
+	var review admission.AdmissionReview
+	if err := c.BindJSON(&review); err != nil {
+		return
+	}
+
+	if review.Request == nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "admission review without request",
+		})
+		return
+	}
+
+	review.Response = validate(review.Request)
+	review.Request = nil
+
+	c.JSON(http.StatusOK, review)
+}
+
+// validate returns the admission response for the given request.
+func validate(req *admission.AdmissionRequest) *admission.AdmissionResponse {
+	resp := &admission.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+
+	if err := validateAdmissionRequest(req); err != nil {
+		resp.Allowed = false
+		resp.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusUnprocessableEntity,
+			Reason:  metav1.StatusReasonInvalid,
+			Message: err.Error(),
+		}
+	}
+
+	return resp
+}
+
+// validateAdmissionRequest returns an error when the requested object is invalid or changes immutable fields.
+// Updates which do not change the spec (e.g. removal of finalizers) are always allowed.
+// All requests are allowed when the webhook-validation feature is disabled.
+func validateAdmissionRequest(req *admission.AdmissionRequest) error {
+	if !features.WebhookValidation().Enabled() {
+		return nil
+	}
+
+	if req.Operation != admission.Create && req.Operation != admission.Update {
+		return nil
+	}
+
+	switch req.Kind.Kind {
+	case backup.ArangoBackupResourceKind:
+		var obj, old backupApi.ArangoBackup
+		if err := decodeAdmissionObjects(req, &obj, &old); err != nil {
+			return err
+		}
+
+		if req.Operation != admission.Update {
+			return obj.Spec.Validate()
+		}
+
+		if equality.Semantic.DeepEqual(obj.Spec, old.Spec) {
+			return nil
+		}
+
+		if err := obj.Spec.ValidateUpdate(old.Spec); err != nil {
+			return err
+		}
+
+		return obj.Spec.Validate()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,117 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	admission "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+)
+
+func newBackupAdmissionRequest(t *testing.T, operation admission.Operation, obj, old *backupApi.ArangoBackup) *admission.AdmissionRequest {
+	req := &admission.AdmissionRequest{
+		UID:       "uid",
+		Kind:      metav1.GroupVersionKind{Kind: backup.ArangoBackupResourceKind},
+		Operation: operation,
+	}
+
+	data, err := json.Marshal(obj)
+	require.NoError(t, err)
+	req.Object = runtime.RawExtension{Raw: data}
+
+	if old != nil {
+		data, err := json.Marshal(old)
+		require.NoError(t, err)
+		req.OldObject = runtime.RawExtension{Raw: data}
+	}
+
+	return req
+}
+
+func Test_Validate(t *testing.T) {
+	newBackup := func(deployment string) *backupApi.ArangoBackup {
+		return &backupApi.ArangoBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup"},
+			Spec: backupApi.ArangoBackupSpec{
+				Deployment: backupApi.ArangoBackupSpecDeployment{Name: deployment},
+			},
+		}
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		resp := validate(newBackupAdmissionRequest(t, admission.Create, newBackup("example"), nil))
+		require.True(t, resp.Allowed)
+		require.Equal(t, "uid", string(resp.UID))
+
+		resp = validate(newBackupAdmissionRequest(t, admission.Create, newBackup(""), nil))
+		require.False(t, resp.Allowed)
+		require.Equal(t, "deployment name can not be empty", resp.Result.Message)
+	})
+
+	t.Run("Update of immutable field", func(t *testing.T) {
+		resp := validate(newBackupAdmissionRequest(t, admission.Update, newBackup("other"), newBackup("example")))
+		require.False(t, resp.Allowed)
+		require.Equal(t, metav1.StatusReasonInvalid, resp.Result.Reason)
+		require.Equal(t, "deployment name can not be changed", resp.Result.Message)
+	})
+
+	t.Run("Update of mutable field", func(t *testing.T) {
+		obj := newBackup("example")
+		obj.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3://backups"}
+
+		resp := validate(newBackupAdmissionRequest(t, admission.Update, obj, newBackup("example")))
+		require.True(t, resp.Allowed)
+	})
+
+	t.Run("Update without spec change", func(t *testing.T) {
+		obj := newBackup("")
+		obj.Finalizers = nil
+
+		resp := validate(newBackupAdmissionRequest(t, admission.Update, obj, newBackup("")))
+		require.True(t, resp.Allowed)
+	})
+
+	t.Run("Feature disabled", func(t *testing.T) {
+		require.NoError(t, features.ApplyOverrides(map[string]string{features.WebhookValidation().Name(): "false"}))
+		defer func() {
+			require.NoError(t, features.ApplyOverrides(nil))
+		}()
+
+		resp := validate(newBackupAdmissionRequest(t, admission.Update, newBackup("other"), newBackup("example")))
+		require.True(t, resp.Allowed)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		resp := validate(&admission.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Kind: backup.ArangoBackupResourceKind},
+			Operation: admission.Delete,
+		})
+		require.True(t, resp.Allowed)
+	})
+}
@@ -183,6 +183,9 @@ func NewServer(cli corev1.CoreV1Interface, cfg Config, deps Dependencies) (*Serv
 	r.GET("/ready", gin.WrapF(ready(readyProbes...)))
 	r.GET("/metrics", gin.WrapH(prometheus.Handler()))
 	r.POST("/login", s.auth.handleLogin)
+	r.POST(MutatingWebhookPath, s.handleMutate)
+	r.POST(ValidatingWebhookPath, s.handleValidate)
+	r.POST(ConversionWebhookPath, s.handleConvert)
 	api := r.Group("/api", s.auth.checkAuthentication)
 	{
 		api.GET("/operators", s.handleGetOperators)
@@ -190,6 +193,7 @@ func NewServer(cli corev1.CoreV1Interface, cfg Config, deps Dependencies) (*Serv
 		// Deployment operator
 		api.GET("/deployment", s.handleGetDeployments)
 		api.GET("/deployment/:name", s.handleGetDeploymentDetails)
+		api.GET("/deployment/:name/agency-dump", s.handleGetDeploymentAgencyDump)
 
 		// Deployment replication operator
 		api.GET("/deployment-replication", s.handleGetDeploymentReplications)
@@ -118,6 +118,29 @@ func (ls *LocalStorage) ensureDaemonSet(apiObject *api.ArangoLocalStorage) error
 			},
 		})
 	}
+	for i, device := range apiObject.Spec.Devices {
+		volName := fmt.Sprintf("device-%d", i)
+		c := &dsSpec.Template.Spec.Containers[0]
+		// Devices mounted on the node after the provisioner started are propagated to the container
+		mountPropagation := core.MountPropagationHostToContainer
+		c.VolumeMounts = append(c.VolumeMounts,
+			core.VolumeMount{
+				Name:             volName,
+				MountPath:        device,
+				MountPropagation: &mountPropagation,
+			})
+		// Provisioner starts also on nodes without the device, such directories are not used as devices
+		hostPathType := core.HostPathDirectoryOrCreate
+		dsSpec.Template.Spec.Volumes = append(dsSpec.Template.Spec.Volumes, core.Volume{
+			Name: volName,
+			VolumeSource: core.VolumeSource{
+				HostPath: &core.HostPathVolumeSource{
+					Path: device,
+					Type: &hostPathType,
+				},
+			},
+		})
+	}
 	ds := &apps.DaemonSet{
 		ObjectMeta: meta.ObjectMeta{
 			Name:   apiObject.GetName(),
@@ -96,6 +96,8 @@ type LocalStorage struct {
 
 	inspectTrigger trigger.Trigger
 	pvCleaner      *pvCleaner
+
+	metricNodes map[string]bool // Nodes with exported metrics
 }
 
 // New creates a new LocalStorage from the given API object.
@@ -171,6 +173,8 @@ func (ls *LocalStorage) run() {
 	ls.imagePullPolicy = pullPolicy
 	ls.imagePullSecrets = pullSecrets
 
+	defer ls.removeNodeMetrics()
+
 	// Set state
 	if ls.status.State == api.LocalStorageStateNone {
 		ls.status.State = api.LocalStorageStateCreating
@@ -233,6 +237,10 @@ func (ls *LocalStorage) run() {
 				hasError = true
 				ls.createEvent(k8sutil.NewErrorEvent("PV inspection failed", err, ls.apiObject))
 			}
+			if err := ls.inspectNodes(context.Background()); err != nil {
+				hasError = true
+				ls.createEvent(k8sutil.NewErrorEvent("Node inspection failed", err, ls.apiObject))
+			}
 			if len(unboundPVCs) == 0 {
 				pvsNeededSince = nil
 			} else if len(unboundPVCs) > 0 {
@@ -349,66 +357,67 @@ func (ls *LocalStorage) updateCRStatus() error {
 	}
 
 	// Send update to API server
+	storages := ls.deps.Client.Arango().StorageV1alpha().ArangoLocalStorages()
 	update := ls.apiObject.DeepCopy()
-	attempt := 0
-	for {
-		attempt++
+	err := k8sutil.RetryOnConflict(func() error {
 		update.Status = ls.status
-		newAPIObject, err := ls.deps.Client.Arango().StorageV1alpha().ArangoLocalStorages().Update(context.Background(), update, metav1.UpdateOptions{})
-		if err == nil {
-			// Update internal object
-			ls.apiObject = newAPIObject
-			return nil
+		newAPIObject, err := storages.UpdateStatus(context.Background(), update, metav1.UpdateOptions{})
+		if k8sutil.IsNotFound(err) {
+			// CRD is installed without the status subresource
+			newAPIObject, err = storages.Update(context.Background(), update, metav1.UpdateOptions{})
 		}
-		if attempt < 10 && k8sutil.IsConflict(err) {
-			// API object may have been changed already,
-			// Reload api object and try again
-			var current *api.ArangoLocalStorage
-			current, err = ls.deps.Client.Arango().StorageV1alpha().ArangoLocalStorages().Get(context.Background(), update.GetName(), metav1.GetOptions{})
-			if err == nil {
-				update = current.DeepCopy()
-				continue
-			}
+		if err != nil {
+			return err
 		}
+		// Update internal object
+		ls.apiObject = newAPIObject
+		return nil
+	}, func() error {
+		// API object may have been changed already, reload api object and try again
+		current, err := storages.Get(context.Background(), update.GetName(), metav1.GetOptions{})
 		if err != nil {
-			ls.deps.Log.Debug().Err(err).Msg("failed to patch ArangoLocalStorage status")
-			return errors.WithStack(errors.Newf("failed to patch ArangoLocalStorage status: %v", err))
+			return err
 		}
+		update = current.DeepCopy()
+		return nil
+	})
+	if err != nil {
+		ls.deps.Log.Debug().Err(err).Msg("failed to update ArangoLocalStorage status")
+		return errors.WithStack(errors.Newf("failed to update ArangoLocalStorage status: %v", err))
 	}
+	return nil
 }
 
 // Update the spec part of the API object (d.apiObject)
-// to the given object, while preserving the status.
+// to the given object. The status is written by updateCRStatus.
 // On success, d.apiObject is updated.
 func (ls *LocalStorage) updateCRSpec(newSpec api.LocalStorageSpec) error {
 	// Send update to API server
+	storages := ls.deps.Client.Arango().StorageV1alpha().ArangoLocalStorages()
 	update := ls.apiObject.DeepCopy()
-	attempt := 0
-	for {
-		attempt++
+	err := k8sutil.RetryOnConflict(func() error {
 		update.Spec = newSpec
-		update.Status = ls.status
-		newAPIObject, err := ls.deps.Client.Arango().StorageV1alpha().ArangoLocalStorages().Update(context.Background(), update, metav1.UpdateOptions{})
-		if err == nil {
-			// Update internal object
-			ls.apiObject = newAPIObject
-			return nil
-		}
-		if attempt < 10 && k8sutil.IsConflict(err) {
-			// API object may have been changed already,
-			// Reload api object and try again
-			var current *api.ArangoLocalStorage
-			current, err = ls.deps.Client.Arango().StorageV1alpha().ArangoLocalStorages().Get(context.Background(), update.GetName(), metav1.GetOptions{})
-			if err == nil {
-				update = current.DeepCopy()
-				continue
-			}
+		newAPIObject, err := storages.Update(context.Background(), update, metav1.UpdateOptions{})
+		if err != nil {
+			return err
 		}
+		// Update internal object
+		ls.apiObject = newAPIObject
+		return nil
+	}, func() error {
+		// API object may have been changed already, reload api object and try again
+		current, err := storages.Get(context.Background(), update.GetName(), metav1.GetOptions{})
 		if err != nil {
-			ls.deps.Log.Debug().Err(err).Msg("failed to patch ArangoLocalStorage spec")
-			return errors.WithStack(errors.Newf("failed to patch ArangoLocalStorage spec: %v", err))
+			return err
 		}
+		update = current.DeepCopy()
+		return nil
+	})
+	if err != nil {
+		ls.deps.Log.Debug().Err(err).Msg("failed to update ArangoLocalStorage spec")
+		return errors.WithStack(errors.Newf("failed to update ArangoLocalStorage spec: %v", err))
 	}
+	return nil
 }
 
 // failOnError reports the given error and sets the local storage status to failed.
@@ -0,0 +1,73 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+)
+
+const (
+	// Component name for metrics of this package
+	metricsComponent = "local_storage"
+)
+
+var (
+	nodeCapacityBytes    = metrics.MustRegisterGaugeVec(metricsComponent, "node_capacity_bytes", "Total size of local paths and devices on the node", metrics.LocalStorageName, metrics.NodeName)
+	nodeAvailableBytes   = metrics.MustRegisterGaugeVec(metricsComponent, "node_available_bytes", "Size which can still be provisioned on the node", metrics.LocalStorageName, metrics.NodeName)
+	nodeProvisionedBytes = metrics.MustRegisterGaugeVec(metricsComponent, "node_provisioned_bytes", "Total capacity of PersistentVolumes created on the node", metrics.LocalStorageName, metrics.NodeName)
+	nodeVolumes          = metrics.MustRegisterGaugeVec(metricsComponent, "node_volumes", "Number of PersistentVolumes created on the node", metrics.LocalStorageName, metrics.NodeName)
+)
+
+// updateNodeMetrics exports the node statuses of the local storage
+// and removes metrics of nodes which are no longer reported.
+func (ls *LocalStorage) updateNodeMetrics() {
+	name := ls.apiObject.GetName()
+	current := make(map[string]bool, len(ls.status.Nodes))
+	for _, node := range ls.status.Nodes {
+		current[node.NodeName] = true
+		nodeCapacityBytes.WithLabelValues(name, node.NodeName).Set(float64(node.Capacity))
+		nodeAvailableBytes.WithLabelValues(name, node.NodeName).Set(float64(node.Available))
+		nodeProvisionedBytes.WithLabelValues(name, node.NodeName).Set(float64(node.Provisioned))
+		nodeVolumes.WithLabelValues(name, node.NodeName).Set(float64(node.Volumes))
+	}
+	for nodeName := range ls.metricNodes {
+		if !current[nodeName] {
+			deleteNodeMetrics(name, nodeName)
+		}
+	}
+	ls.metricNodes = current
+}
+
+// removeNodeMetrics removes all node metrics of the local storage.
+func (ls *LocalStorage) removeNodeMetrics() {
+	for nodeName := range ls.metricNodes {
+		deleteNodeMetrics(ls.apiObject.GetName(), nodeName)
+	}
+	ls.metricNodes = nil
+}
+
+// deleteNodeMetrics removes metrics of the given node.
+func deleteNodeMetrics(name, nodeName string) {
+	nodeCapacityBytes.DeleteLabelValues(name, nodeName)
+	nodeAvailableBytes.DeleteLabelValues(name, nodeName)
+	nodeProvisionedBytes.DeleteLabelValues(name, nodeName)
+	nodeVolumes.DeleteLabelValues(name, nodeName)
+}
@@ -0,0 +1,130 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"context"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// inspectNodes collects the capacity and usage of local paths and devices on all nodes
+// served by provisioners and stores it in the status and metrics.
+func (ls *LocalStorage) inspectNodes(ctx context.Context) error {
+	log := ls.deps.Log
+	clients, err := ls.createProvisionerClients()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	list, err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	var pvs []v1.PersistentVolume
+	for _, pv := range list.Items {
+		if ls.isOwnerOf(&pv) {
+			pvs = append(pvs, pv)
+		}
+	}
+	used := usedDevices(ls.apiObject, pvs)
+
+	nodes := map[string]*api.LocalStorageNodeStatus{}
+	for _, c := range clients {
+		nodeInfo, err := c.GetNodeInfo(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get node info")
+			continue
+		}
+		node := getNodeStatus(nodes, nodeInfo.NodeName)
+		for _, localPath := range ls.apiObject.Spec.LocalPath {
+			info, err := c.GetInfo(ctx, localPath)
+			if err != nil {
+				log.Error().Err(err).Str("local-path", localPath).Msg("Failed to get client info")
+				continue
+			}
+			node.Capacity += info.Capacity
+			node.Available += info.Available
+		}
+		for _, device := range ls.apiObject.Spec.Devices {
+			info, err := c.GetInfo(ctx, device)
+			if err != nil {
+				log.Error().Err(err).Str("device", device).Msg("Failed to get client info")
+				continue
+			}
+			node.Capacity += info.Capacity
+			if !used[deviceKey(nodeInfo.NodeName, device)] {
+				// Devices are provisioned as a whole
+				node.Available += info.Capacity
+			}
+		}
+	}
+	addProvisionedVolumes(nodes, pvs)
+
+	ls.status.Nodes = sortNodeStatuses(nodes)
+	ls.updateNodeMetrics()
+
+	return ls.updateCRStatus()
+}
+
+// getNodeStatus returns the status of the given node, creating it when needed.
+func getNodeStatus(nodes map[string]*api.LocalStorageNodeStatus, nodeName string) *api.LocalStorageNodeStatus {
+	node, ok := nodes[nodeName]
+	if !ok {
+		node = &api.LocalStorageNodeStatus{NodeName: nodeName}
+		nodes[nodeName] = node
+	}
+	return node
+}
+
+// addProvisionedVolumes adds the capacity of the given PersistentVolumes to the nodes they are created on.
+func addProvisionedVolumes(nodes map[string]*api.LocalStorageNodeStatus, pvs []v1.PersistentVolume) {
+	for _, pv := range pvs {
+		nodeName, ok := pv.GetAnnotations()[nodeNameAnnotation]
+		if !ok {
+			continue
+		}
+		node := getNodeStatus(nodes, nodeName)
+		node.Volumes++
+		if c, found := pv.Spec.Capacity[v1.ResourceStorage]; found {
+			node.Provisioned += c.Value()
+		}
+	}
+}
+
+// sortNodeStatuses returns the statuses of the given nodes sorted by node name.
+func sortNodeStatuses(nodes map[string]*api.LocalStorageNodeStatus) []api.LocalStorageNodeStatus {
+	if len(nodes) == 0 {
+		return nil
+	}
+	result := make([]api.LocalStorageNodeStatus, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, *node)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].NodeName < result[j].NodeName
+	})
+	return result
+}
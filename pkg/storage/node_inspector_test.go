@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+)
+
+// TestAddProvisionedVolumes tests addProvisionedVolumes and sortNodeStatuses.
+func TestAddProvisionedVolumes(t *testing.T) {
+	pv := func(node string, size string) v1.PersistentVolume {
+		return v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{nodeNameAnnotation: node},
+			},
+			Spec: v1.PersistentVolumeSpec{
+				Capacity: v1.ResourceList{
+					v1.ResourceStorage: resource.MustParse(size),
+				},
+			},
+		}
+	}
+
+	nodes := map[string]*api.LocalStorageNodeStatus{
+		"node2": {NodeName: "node2", Capacity: 100 << 30, Available: 60 << 30},
+	}
+	addProvisionedVolumes(nodes, []v1.PersistentVolume{
+		pv("node2", "10Gi"),
+		pv("node2", "20Gi"),
+		pv("node1", "1Gi"),
+		{},
+	})
+
+	assert.Equal(t, []api.LocalStorageNodeStatus{
+		{NodeName: "node1", Provisioned: 1 << 30, Volumes: 1},
+		{NodeName: "node2", Capacity: 100 << 30, Available: 60 << 30, Provisioned: 30 << 30, Volumes: 2},
+	}, sortNodeStatuses(nodes))
+
+	assert.Nil(t, sortNodeStatuses(nil))
+}
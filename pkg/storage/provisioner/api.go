@@ -49,6 +49,8 @@ type Info struct {
 	NodeInfo
 	Available int64 `json:"available"`
 	Capacity  int64 `json:"capacity"`
+	// MountPoint is set when the local path is a mount point of a dedicated filesystem
+	MountPoint bool `json:"mountPoint,omitempty"`
 }
 
 // Request body for API HTTP requests.
@@ -32,6 +32,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/arangodb/kube-arangodb/pkg/util/dryrun"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 
 	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
@@ -46,11 +47,16 @@ func New(endpoint string) (provisioner.API, error) {
 	u.Path = ""
 	return &client{
 		endpoint: *u,
+		httpClient: &http.Client{
+			Timeout:   defaultHTTPTimeout,
+			Transport: dryrun.WrapProvisionerTransport(httpTransport),
+		},
 	}, nil
 }
 
 type client struct {
-	endpoint url.URL
+	endpoint   url.URL
+	httpClient *http.Client
 }
 
 const (
@@ -58,24 +64,21 @@ const (
 )
 
 var (
-	httpClient = &http.Client{
-		Timeout: defaultHTTPTimeout,
-		Transport: &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        100,
-			MaxIdleConnsPerHost: 10,
-			IdleConnTimeout:     90 * time.Second,
-			TLSHandshakeTimeout: 90 * time.Second,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-			ExpectContinueTimeout: 1 * time.Second,
+	httpTransport = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			DualStack: true,
+		}).DialContext,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 90 * time.Second,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
 		},
+		ExpectContinueTimeout: 1 * time.Second,
 	}
 )
 
@@ -167,7 +170,7 @@ func (c *client) newRequest(method string, localPath string, body interface{}) (
 // do performs the given request and parses the result.
 func (c *client) do(ctx context.Context, req *http.Request, result interface{}) error {
 	req = req.WithContext(ctx)
-	resp, err := httpClient.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		// Request failed
 		return errors.WithStack(err)
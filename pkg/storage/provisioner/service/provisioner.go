@@ -23,6 +23,7 @@ package service
 import (
 	"context"
 	"os"
+	"path/filepath"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 
@@ -87,17 +88,25 @@ func (p *Provisioner) GetInfo(ctx context.Context, localPath string) (provisione
 	// Capacity is total block count * fragment size
 	capacity := int64(statfs.Blocks) * statfs.Bsize // nolint:typecheck
 
+	mountPoint, err := isMountPoint(localPath)
+	if err != nil {
+		log.Error().Err(err).Msg("Mount point check failed")
+		return provisioner.Info{}, errors.WithStack(err)
+	}
+
 	log.Debug().
 		Str("node-name", p.NodeName).
 		Int64("capacity", capacity).
 		Int64("available", available).
+		Bool("mount-point", mountPoint).
 		Msg("Returning info for local path")
 	return provisioner.Info{
 		NodeInfo: provisioner.NodeInfo{
 			NodeName: p.NodeName,
 		},
-		Available: available,
-		Capacity:  capacity,
+		Available:  available,
+		Capacity:   capacity,
+		MountPoint: mountPoint,
 	}, nil
 }
 
@@ -107,7 +116,7 @@ func (p *Provisioner) Prepare(ctx context.Context, localPath string) error {
 	log.Debug().Msg("preparing local path")
 
 	// Make sure directory is empty
-	if err := os.RemoveAll(localPath); err != nil && !os.IsNotExist(err) {
+	if err := cleanLocalPath(localPath); err != nil {
 		log.Error().Err(err).Msg("Failed to clean existing directory")
 		return errors.WithStack(err)
 	}
@@ -130,9 +139,55 @@ func (p *Provisioner) Remove(ctx context.Context, localPath string) error {
 	log.Debug().Msg("cleanup local path")
 
 	// Make sure directory is empty
-	if err := os.RemoveAll(localPath); err != nil && !os.IsNotExist(err) {
+	if err := cleanLocalPath(localPath); err != nil {
 		log.Error().Err(err).Msg("Failed to clean directory")
 		return errors.WithStack(err)
 	}
 	return nil
 }
+
+// cleanLocalPath removes the given local path.
+// Mount points (dedicated devices) cannot be removed, so only their content is removed.
+func cleanLocalPath(localPath string) error {
+	mountPoint, err := isMountPoint(localPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+
+	if !mountPoint {
+		if err := os.RemoveAll(localPath); err != nil && !os.IsNotExist(err) {
+			return errors.WithStack(err)
+		}
+		return nil
+	}
+
+	entries, err := os.ReadDir(localPath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(localPath, entry.Name())); err != nil && !os.IsNotExist(err) {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// isMountPoint returns true when the given path is located on a different device than its parent.
+func isMountPoint(localPath string) (bool, error) {
+	var stat, parentStat unix.Stat_t
+	if err := unix.Lstat(localPath, &stat); err != nil {
+		return false, err
+	}
+	if err := unix.Lstat(filepath.Dir(filepath.Clean(localPath)), &parentStat); err != nil {
+		return false, err
+	}
+	if stat.Dev != parentStat.Dev {
+		return true, nil
+	}
+	// The root of the filesystem is its own parent
+	return stat.Ino == parentStat.Ino, nil
+}
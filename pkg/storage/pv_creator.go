@@ -75,6 +75,11 @@ func (ls *LocalStorage) createPVs(ctx context.Context, apiObject *api.ArangoLoca
 		clients[i], clients[j] = clients[j], clients[i]
 	})
 
+	usedDevices, err := ls.getUsedDevices(ctx, apiObject)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
 	var nodeClientMap map[string]provisioner.API
 	for i, claim := range unboundClaims {
 		// Find deployment name & role in the claim (if any)
@@ -106,7 +111,7 @@ func (ls *LocalStorage) createPVs(ctx context.Context, apiObject *api.ArangoLoca
 			}
 		}
 		// Create PV
-		if err := ls.createPV(ctx, apiObject, allowedClients, i, volSize, claim, deplName, role); err != nil {
+		if err := ls.createPV(ctx, apiObject, allowedClients, i, volSize, claim, deplName, role, usedDevices); err != nil {
 			log.Error().Err(err).Msg("Failed to create PersistentVolume")
 		}
 	}
@@ -115,12 +120,49 @@ func (ls *LocalStorage) createPVs(ctx context.Context, apiObject *api.ArangoLoca
 }
 
 // createPV creates a PersistentVolume.
-func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocalStorage, clients []provisioner.API, clientsOffset int, volSize int64, claim v1.PersistentVolumeClaim, deploymentName, role string) error {
+// Dedicated devices are preferred over directories created in local paths.
+func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocalStorage, clients []provisioner.API, clientsOffset int, volSize int64, claim v1.PersistentVolumeClaim, deploymentName, role string, usedDevices map[string]bool) error {
 	log := ls.deps.Log
 	// Try clients
 	for clientIdx := 0; clientIdx < len(clients); clientIdx++ {
 		client := clients[(clientsOffset+clientIdx)%len(clients)]
 
+		// Try devices within client
+		for _, device := range apiObject.Spec.Devices {
+			log := log.With().Str("device", device).Logger()
+			info, err := client.GetInfo(ctx, device)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to get client info")
+				continue
+			}
+			if !info.MountPoint {
+				// Directory is created on nodes where the device is not mounted
+				log.Debug().Msg("Device is not mounted")
+				continue
+			}
+			if usedDevices[deviceKey(info.NodeName, device)] {
+				log.Debug().Msg("Device is already used")
+				continue
+			}
+			if info.Capacity < volSize {
+				log.Debug().Msg("Not enough device capacity")
+				continue
+			}
+			// Ok, prepare the device
+			if err := client.Prepare(ctx, device); err != nil {
+				log.Error().Err(err).Msg("Failed to prepare device")
+				continue
+			}
+			// Create a volume with the capacity of the whole device
+			name := strings.ToLower(uniuri.New())
+			if created, err := ls.createAndBindPV(ctx, apiObject, name, info.NodeName, device, info.Capacity, claim, deploymentName, role); err != nil {
+				return errors.WithStack(err)
+			} else if created {
+				usedDevices[deviceKey(info.NodeName, device)] = true
+				return nil
+			}
+		}
+
 		// Try local path within client
 		for _, localPathRoot := range apiObject.Spec.LocalPath {
 			log := log.With().Str("local-path-root", localPathRoot).Logger()
@@ -142,74 +184,125 @@ func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocal
 				continue
 			}
 			// Create a volume
-			pvName := strings.ToLower(apiObject.GetName() + "-" + shortHash(info.NodeName) + "-" + name)
-			volumeMode := v1.PersistentVolumeFilesystem
-			nodeSel := createNodeSelector(info.NodeName)
-			pv := &v1.PersistentVolume{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: pvName,
-					Annotations: map[string]string{
-						AnnProvisionedBy:   storageClassProvisioner,
-						nodeNameAnnotation: info.NodeName,
-					},
-					Labels: map[string]string{
-						k8sutil.LabelKeyArangoDeployment: deploymentName,
-						k8sutil.LabelKeyRole:             role,
-					},
-				},
-				Spec: v1.PersistentVolumeSpec{
-					Capacity: v1.ResourceList{
-						v1.ResourceStorage: *resource.NewQuantity(volSize, resource.BinarySI),
-					},
-					PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimRetain,
-					PersistentVolumeSource: v1.PersistentVolumeSource{
-						Local: &v1.LocalVolumeSource{
-							Path: localPath,
-						},
-					},
-					AccessModes: []v1.PersistentVolumeAccessMode{
-						v1.ReadWriteOnce,
-					},
-					StorageClassName: apiObject.Spec.StorageClass.Name,
-					VolumeMode:       &volumeMode,
-					ClaimRef: &v1.ObjectReference{
-						Kind:       "PersistentVolumeClaim",
-						APIVersion: "",
-						Name:       claim.GetName(),
-						Namespace:  claim.GetNamespace(),
-						UID:        claim.GetUID(),
-					},
-					NodeAffinity: &v1.VolumeNodeAffinity{
-						Required: nodeSel,
-					},
-				},
-			}
-			// Attach PV to ArangoLocalStorage
-			pv.SetOwnerReferences(append(pv.GetOwnerReferences(), apiObject.AsOwner()))
-			if _, err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes().Create(context.Background(), pv, metav1.CreateOptions{}); err != nil {
-				log.Error().Err(err).Msg("Failed to create PersistentVolume")
-				continue
-			}
-			log.Debug().
-				Str("name", pvName).
-				Str("node-name", info.NodeName).
-				Msg("Created PersistentVolume")
-
-			// Bind claim to volume
-			if err := ls.bindClaimToVolume(claim, pv.GetName()); err != nil {
-				// Try to delete the PV now
-				if err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes().Delete(context.Background(), pv.GetName(), metav1.DeleteOptions{}); err != nil {
-					log.Error().Err(err).Msg("Failed to delete PV after binding PVC failed")
-				}
+			if created, err := ls.createAndBindPV(ctx, apiObject, name, info.NodeName, localPath, volSize, claim, deploymentName, role); err != nil {
 				return errors.WithStack(err)
+			} else if created {
+				return nil
 			}
-
-			return nil
 		}
 	}
 	return errors.WithStack(errors.Newf("No more nodes available"))
 }
 
+// createAndBindPV creates a PersistentVolume for the given local path and binds the claim to it.
+// Returns false when the PersistentVolume could not be created, so another location can be tried.
+func (ls *LocalStorage) createAndBindPV(ctx context.Context, apiObject *api.ArangoLocalStorage, name, nodeName, localPath string, volSize int64, claim v1.PersistentVolumeClaim, deploymentName, role string) (bool, error) {
+	log := ls.deps.Log.With().Str("local-path", localPath).Logger()
+	pvName := strings.ToLower(apiObject.GetName() + "-" + shortHash(nodeName) + "-" + name)
+	volumeMode := v1.PersistentVolumeFilesystem
+	nodeSel := createNodeSelector(nodeName)
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: pvName,
+			Annotations: map[string]string{
+				AnnProvisionedBy:   storageClassProvisioner,
+				nodeNameAnnotation: nodeName,
+			},
+			Labels: map[string]string{
+				k8sutil.LabelKeyArangoDeployment: deploymentName,
+				k8sutil.LabelKeyRole:             role,
+			},
+		},
+		Spec: v1.PersistentVolumeSpec{
+			Capacity: v1.ResourceList{
+				v1.ResourceStorage: *resource.NewQuantity(volSize, resource.BinarySI),
+			},
+			PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimRetain,
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				Local: &v1.LocalVolumeSource{
+					Path: localPath,
+				},
+			},
+			AccessModes: []v1.PersistentVolumeAccessMode{
+				v1.ReadWriteOnce,
+			},
+			StorageClassName: apiObject.Spec.StorageClass.Name,
+			VolumeMode:       &volumeMode,
+			ClaimRef: &v1.ObjectReference{
+				Kind:       "PersistentVolumeClaim",
+				APIVersion: "",
+				Name:       claim.GetName(),
+				Namespace:  claim.GetNamespace(),
+				UID:        claim.GetUID(),
+			},
+			NodeAffinity: &v1.VolumeNodeAffinity{
+				Required: nodeSel,
+			},
+		},
+	}
+	// Attach PV to ArangoLocalStorage
+	pv.SetOwnerReferences(append(pv.GetOwnerReferences(), apiObject.AsOwner()))
+	if _, err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes().Create(context.Background(), pv, metav1.CreateOptions{}); err != nil {
+		log.Error().Err(err).Msg("Failed to create PersistentVolume")
+		return false, nil
+	}
+	log.Debug().
+		Str("name", pvName).
+		Str("node-name", nodeName).
+		Msg("Created PersistentVolume")
+
+	// Bind claim to volume
+	if err := ls.bindClaimToVolume(claim, pv.GetName()); err != nil {
+		// Try to delete the PV now
+		if err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes().Delete(context.Background(), pv.GetName(), metav1.DeleteOptions{}); err != nil {
+			log.Error().Err(err).Msg("Failed to delete PV after binding PVC failed")
+		}
+		return true, errors.WithStack(err)
+	}
+
+	return true, nil
+}
+
+// deviceKey returns the key of the device on the given node.
+func deviceKey(nodeName, device string) string {
+	return nodeName + ":" + device
+}
+
+// getUsedDevices returns keys of devices used by existing PersistentVolumes of the given ArangoLocalStorage.
+func (ls *LocalStorage) getUsedDevices(ctx context.Context, apiObject *api.ArangoLocalStorage) (map[string]bool, error) {
+	used := map[string]bool{}
+	if len(apiObject.Spec.Devices) == 0 {
+		return used, nil
+	}
+
+	list, err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return usedDevices(apiObject, list.Items), nil
+}
+
+// usedDevices returns keys of devices used by the given PersistentVolumes.
+func usedDevices(apiObject *api.ArangoLocalStorage, pvs []v1.PersistentVolume) map[string]bool {
+	devices := make(map[string]bool, len(apiObject.Spec.Devices))
+	for _, d := range apiObject.Spec.Devices {
+		devices[d] = true
+	}
+
+	used := map[string]bool{}
+	for _, pv := range pvs {
+		local := pv.Spec.PersistentVolumeSource.Local
+		if local == nil || !devices[local.Path] {
+			continue
+		}
+
+		used[deviceKey(pv.GetAnnotations()[nodeNameAnnotation], local.Path)] = true
+	}
+
+	return used
+}
+
 // createValidEndpointList convers the given endpoints list into
 // valid addresses.
 func createValidEndpointList(list *v1.EndpointsList) []string {
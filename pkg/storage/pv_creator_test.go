@@ -29,6 +29,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
 	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
 	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner/mocks"
 )
@@ -207,3 +208,36 @@ func TestShortHash(t *testing.T) {
 		assert.Equal(t, expected, output, "Input: '%s'", input)
 	}
 }
+
+// TestUsedDevices tests usedDevices.
+func TestUsedDevices(t *testing.T) {
+	apiObject := &api.ArangoLocalStorage{
+		Spec: api.LocalStorageSpec{
+			LocalPath: []string{"/data"},
+			Devices:   []string{"/mnt/disk1", "/mnt/disk2"},
+		},
+	}
+	pv := func(node, path string) v1.PersistentVolume {
+		return v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{nodeNameAnnotation: node},
+			},
+			Spec: v1.PersistentVolumeSpec{
+				PersistentVolumeSource: v1.PersistentVolumeSource{
+					Local: &v1.LocalVolumeSource{Path: path},
+				},
+			},
+		}
+	}
+
+	used := usedDevices(apiObject, []v1.PersistentVolume{
+		pv("node1", "/mnt/disk1"),
+		pv("node2", "/mnt/disk2"),
+		pv("node1", "/data/abc"),
+		{},
+	})
+	assert.Equal(t, map[string]bool{
+		deviceKey("node1", "/mnt/disk1"): true,
+		deviceKey("node2", "/mnt/disk2"): true,
+	}, used)
+}
@@ -28,7 +28,9 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/arangodb/kube-arangodb/pkg/util/dryrun"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 
@@ -117,7 +119,7 @@ var (
 func CreateArangodClient(ctx context.Context, cli corev1.CoreV1Interface, apiObject *api.ArangoDeployment, group api.ServerGroup, id string) (driver.Client, error) {
 	// Create connection
 	dnsName := k8sutil.CreatePodDNSNameWithDomain(apiObject, apiObject.Spec.ClusterDomain, group.AsRole(), id)
-	c, err := createArangodClientForDNSName(ctx, cli, apiObject, dnsName, false)
+	c, err := createArangodClientForDNSName(ctx, cli, apiObject, dnsName, apiObject.Spec.GetServerGroupPort(group), false)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -128,7 +130,7 @@ func CreateArangodClient(ctx context.Context, cli corev1.CoreV1Interface, apiObj
 func CreateArangodDatabaseClient(ctx context.Context, cli corev1.CoreV1Interface, apiObject *api.ArangoDeployment, shortTimeout bool) (driver.Client, error) {
 	// Create connection
 	dnsName := k8sutil.CreateDatabaseClientServiceDNSNameWithDomain(apiObject, apiObject.Spec.ClusterDomain)
-	c, err := createArangodClientForDNSName(ctx, cli, apiObject, dnsName, shortTimeout)
+	c, err := createArangodClientForDNSName(ctx, cli, apiObject, dnsName, apiObject.Spec.GetDatabasePort(), shortTimeout)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -137,9 +139,9 @@ func CreateArangodDatabaseClient(ctx context.Context, cli corev1.CoreV1Interface
 
 // CreateArangodImageIDClient creates a go-driver client for an ArangoDB instance
 // running in an Image-ID pod.
-func CreateArangodImageIDClient(ctx context.Context, deployment k8sutil.APIObject, ip string) (driver.Client, error) {
+func CreateArangodImageIDClient(ctx context.Context, deployment k8sutil.APIObject, ip string, port int) (driver.Client, error) {
 	// Create connection
-	c, err := createArangodClientForDNSName(ctx, nil, nil, ip, false)
+	c, err := createArangodClientForDNSName(ctx, nil, nil, ip, port, false)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -147,8 +149,8 @@ func CreateArangodImageIDClient(ctx context.Context, deployment k8sutil.APIObjec
 }
 
 // CreateArangodClientForDNSName creates a go-driver client for a given DNS name.
-func createArangodClientForDNSName(ctx context.Context, cli corev1.CoreV1Interface, apiObject *api.ArangoDeployment, dnsName string, shortTimeout bool) (driver.Client, error) {
-	connConfig := createArangodHTTPConfigForDNSNames(apiObject, []string{dnsName}, shortTimeout)
+func createArangodClientForDNSName(ctx context.Context, cli corev1.CoreV1Interface, apiObject *api.ArangoDeployment, dnsName string, port int, shortTimeout bool) (driver.Client, error) {
+	connConfig := createArangodHTTPConfigForDNSNames(apiObject, []string{dnsName}, port, shortTimeout)
 	// TODO deal with TLS with proper CA checking
 	conn, err := http.NewConnection(connConfig)
 	if err != nil {
@@ -172,7 +174,7 @@ func createArangodClientForDNSName(ctx context.Context, cli corev1.CoreV1Interfa
 }
 
 // createArangodHTTPConfigForDNSNames creates a go-driver HTTP connection config for a given DNS names.
-func createArangodHTTPConfigForDNSNames(apiObject *api.ArangoDeployment, dnsNames []string, shortTimeout bool) http.ConnectionConfig {
+func createArangodHTTPConfigForDNSNames(apiObject *api.ArangoDeployment, dnsNames []string, port int, shortTimeout bool) http.ConnectionConfig {
 	scheme := "http"
 	transport := sharedHTTPTransport
 	if shortTimeout {
@@ -186,11 +188,11 @@ func createArangodHTTPConfigForDNSNames(apiObject *api.ArangoDeployment, dnsName
 		}
 	}
 	connConfig := http.ConnectionConfig{
-		Transport:          transport,
+		Transport:          tracing.WrapTransport(dryrun.WrapArangoDTransport(transport)),
 		DontFollowRedirect: true,
 	}
 	for _, dnsName := range dnsNames {
-		connConfig.Endpoints = append(connConfig.Endpoints, scheme+"://"+net.JoinHostPort(dnsName, strconv.Itoa(k8sutil.ArangoPort)))
+		connConfig.Endpoints = append(connConfig.Endpoints, scheme+"://"+net.JoinHostPort(dnsName, strconv.Itoa(port)))
 	}
 	return connConfig
 }
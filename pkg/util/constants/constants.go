@@ -55,6 +55,8 @@ const (
 	FinalizerDelayPodTermination       = "pod.database.arangodb.com/delay"               // Finalizer added to Pod, delays termination
 
 	AnnotationEnforceAntiAffinity = "database.arangodb.com/enforce-anti-affinity" // Key of annotation added to PVC. Value is a boolean "true" or "false"
+	AnnotationPVCRetainedMember   = "database.arangodb.com/retained-member"       // Key of annotation added to retained PVC. Value is the ID of the removed member
+	AnnotationPVCRetainedAt       = "database.arangodb.com/retained-at"           // Key of annotation added to retained PVC. Value is the time of member removal (RFC3339)
 
 	BackupLabelRole      = "backup/role"
 	AppsLabelRole        = "apps/role"
@@ -0,0 +1,199 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DryRunError is returned for ArangoDB and provisioner requests which are not sent in dry-run mode
+var DryRunError = errors.New("dry-run: mutating request was not sent")
+
+var enabled int32
+
+// SetEnabled enables or disables dry-run mode of the operator.
+// In dry-run mode Kubernetes mutations are sent as server side dry-run requests and mutating ArangoDB requests are not sent.
+func SetEnabled(value bool) {
+	if value {
+		atomic.StoreInt32(&enabled, 1)
+	} else {
+		atomic.StoreInt32(&enabled, 0)
+	}
+}
+
+// IsEnabled returns true if dry-run mode is enabled
+func IsEnabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// IsDryRunError returns true if the request was not sent because of the dry-run mode
+func IsDryRunError(err error) bool {
+	return errors.Cause(err) == DryRunError
+}
+
+// readOnlyArangoDPaths are ArangoDB endpoints which use POST method, but do not modify any data
+var readOnlyArangoDPaths = []string{
+	"/_api/agency/read",
+	"/_admin/backup/list",
+}
+
+// arangoDCursorPath is the ArangoDB endpoint of AQL queries, which are read-only if they do not contain modification operations
+const arangoDCursorPath = "/_api/cursor"
+
+// aqlModificationRE matches AQL modification operations
+var aqlModificationRE = regexp.MustCompile(`(?i)\b(INSERT|UPDATE|REPLACE|REMOVE|UPSERT)\b`)
+
+// readOnlyProvisionerPaths are storage provisioner endpoints which use POST method, but do not modify any data
+var readOnlyProvisionerPaths = []string{
+	"/info",
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// NewKubernetesRoundTripper returns the round tripper which sends mutating Kubernetes requests as server side dry-run
+// requests when dry-run mode is enabled. Mode is checked on each request.
+func NewKubernetesRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return &kubernetesRoundTripper{rt: rt}
+}
+
+type kubernetesRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func (k *kubernetesRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !IsEnabled() || !isMutating(req.Method) {
+		return k.rt.RoundTrip(req)
+	}
+
+	log.Info().Str("method", req.Method).Str("path", req.URL.Path).Msg("Dry-run: Kubernetes mutation")
+
+	req = req.Clone(req.Context())
+	q := req.URL.Query()
+	q.Set("dryRun", "All")
+	req.URL.RawQuery = q.Encode()
+
+	return k.rt.RoundTrip(req)
+}
+
+// WrapArangoDTransport returns the round tripper which does not send mutating ArangoDB requests
+// when dry-run mode is enabled. Mode is checked on each request.
+func WrapArangoDTransport(rt http.RoundTripper) http.RoundTripper {
+	return wrapTransport(rt, "ArangoDB", isReadOnlyArangoDRequest)
+}
+
+// WrapProvisionerTransport returns the round tripper which does not send mutating storage provisioner requests
+// (volume preparation and removal) when dry-run mode is enabled. Mode is checked on each request.
+func WrapProvisionerTransport(rt http.RoundTripper) http.RoundTripper {
+	return wrapTransport(rt, "Provisioner", func(req *http.Request) bool {
+		return hasPathSuffix(req, readOnlyProvisionerPaths)
+	})
+}
+
+// isReadOnlyArangoDRequest returns true if the mutating ArangoDB request does not modify any data
+func isReadOnlyArangoDRequest(req *http.Request) bool {
+	if hasPathSuffix(req, readOnlyArangoDPaths) {
+		return true
+	}
+
+	if req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, arangoDCursorPath) {
+		return isReadOnlyQuery(req)
+	}
+
+	// Next batch of the cursor, cursors of modification queries are never created in dry-run mode
+	if req.Method == http.MethodPut && strings.Contains(req.URL.Path, arangoDCursorPath+"/") {
+		return true
+	}
+
+	return false
+}
+
+// isReadOnlyQuery returns true if the AQL query of the cursor request does not contain modification operations.
+// Body of the request is kept, so it can be sent.
+func isReadOnlyQuery(req *http.Request) bool {
+	if req.Body == nil {
+		return false
+	}
+
+	data, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+
+	var query struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(data, &query); err != nil || query.Query == "" {
+		return false
+	}
+
+	return !aqlModificationRE.MatchString(query.Query)
+}
+
+func hasPathSuffix(req *http.Request, paths []string) bool {
+	for _, path := range paths {
+		if strings.HasSuffix(req.URL.Path, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wrapTransport returns the round tripper which does not send mutating requests when dry-run mode is enabled.
+// Mode is checked on each request.
+func wrapTransport(rt http.RoundTripper, target string, isReadOnly func(req *http.Request) bool) http.RoundTripper {
+	return &mutationRoundTripper{rt: rt, target: target, isReadOnly: isReadOnly}
+}
+
+type mutationRoundTripper struct {
+	rt         http.RoundTripper
+	target     string
+	isReadOnly func(req *http.Request) bool
+}
+
+func (a *mutationRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !IsEnabled() || !isMutating(req.Method) || a.isReadOnly(req) {
+		return a.rt.RoundTrip(req)
+	}
+
+	log.Info().Str("method", req.Method).Str("host", req.URL.Host).Str("path", req.URL.Path).Msgf("Dry-run: %s mutation", a.target)
+
+	return nil, DryRunError
+}
@@ -0,0 +1,148 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package dryrun
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingRoundTripper struct {
+	requests []*http.Request
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req)
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func newRequest(t *testing.T, method, url string) *http.Request {
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	return req
+}
+
+func newQueryRequest(t *testing.T, query string) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "http://arangod/_db/test/_api/cursor", strings.NewReader(`{"query": "`+query+`"}`))
+	require.NoError(t, err)
+	return req
+}
+
+func Test_KubernetesRoundTripper(t *testing.T) {
+	defer SetEnabled(false)
+
+	rt := &recordingRoundTripper{}
+	k := NewKubernetesRoundTripper(rt)
+
+	_, err := k.RoundTrip(newRequest(t, http.MethodPost, "https://k8s/api/v1/namespaces/test/pods"))
+	require.NoError(t, err)
+	require.Empty(t, rt.requests[0].URL.Query().Get("dryRun"))
+
+	SetEnabled(true)
+
+	_, err = k.RoundTrip(newRequest(t, http.MethodGet, "https://k8s/api/v1/namespaces/test/pods"))
+	require.NoError(t, err)
+	require.Empty(t, rt.requests[1].URL.Query().Get("dryRun"))
+
+	_, err = k.RoundTrip(newRequest(t, http.MethodDelete, "https://k8s/api/v1/namespaces/test/pods/pod?gracePeriodSeconds=0"))
+	require.NoError(t, err)
+	require.Equal(t, "All", rt.requests[2].URL.Query().Get("dryRun"))
+	require.Equal(t, "0", rt.requests[2].URL.Query().Get("gracePeriodSeconds"))
+}
+
+func Test_ArangoDRoundTripper(t *testing.T) {
+	defer SetEnabled(false)
+
+	rt := &recordingRoundTripper{}
+	a := WrapArangoDTransport(rt)
+
+	_, err := a.RoundTrip(newRequest(t, http.MethodPut, "http://arangod/_admin/cluster/maintenance"))
+	require.NoError(t, err)
+
+	// Mode is checked on each request
+	SetEnabled(true)
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodGet, "http://arangod/_api/version"))
+	require.NoError(t, err)
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodPost, "http://arangod/_api/agency/read"))
+	require.NoError(t, err)
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodPost, "http://arangod/_admin/backup/list"))
+	require.NoError(t, err)
+
+	_, err = a.RoundTrip(newQueryRequest(t, "FOR u IN users RETURN u"))
+	require.NoError(t, err)
+	require.Len(t, rt.requests, 5)
+	body, err := ioutil.ReadAll(rt.requests[4].Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"query": "FOR u IN users RETURN u"}`, string(body))
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodPut, "http://arangod/_db/test/_api/cursor/1234"))
+	require.NoError(t, err)
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodPut, "http://arangod/_admin/cluster/maintenance"))
+	require.True(t, IsDryRunError(err))
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodPost, "http://arangod/_admin/backup/create"))
+	require.True(t, IsDryRunError(err))
+
+	_, err = a.RoundTrip(newQueryRequest(t, "FOR u IN users UPDATE u WITH {active: false} IN users"))
+	require.True(t, IsDryRunError(err))
+
+	_, err = a.RoundTrip(newQueryRequest(t, "for u in users remove u in users"))
+	require.True(t, IsDryRunError(err))
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodDelete, "http://arangod/_db/test/_api/cursor/1234"))
+	require.True(t, IsDryRunError(err))
+
+	require.Len(t, rt.requests, 6)
+}
+
+func Test_ProvisionerRoundTripper(t *testing.T) {
+	defer SetEnabled(false)
+
+	rt := &recordingRoundTripper{}
+	a := WrapProvisionerTransport(rt)
+
+	_, err := a.RoundTrip(newRequest(t, http.MethodPost, "http://provisioner/prepare"))
+	require.NoError(t, err)
+
+	SetEnabled(true)
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodGet, "http://provisioner/nodeinfo"))
+	require.NoError(t, err)
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodPost, "http://provisioner/info"))
+	require.NoError(t, err)
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodPost, "http://provisioner/prepare"))
+	require.True(t, IsDryRunError(err))
+
+	_, err = a.RoundTrip(newRequest(t, http.MethodPost, "http://provisioner/remove"))
+	require.True(t, IsDryRunError(err))
+
+	require.Len(t, rt.requests, 3)
+}
@@ -28,7 +28,10 @@ const (
 	DefaultArangoDCheckTimeout   = time.Second * 2
 	DefaultReconciliationTimeout = time.Minute
 
-	DefaultKubernetesRequestBatchSize = 256
+	DefaultKubernetesRequestBatchSize     = 256
+	DefaultKubernetesInspectorParallelism = 15
+	DefaultKubernetesClusterDomain        = ""
+	DefaultKubernetesInspectorSelector    = ""
 
 	DefaultBackupConcurrentUploads = 4
 )
@@ -41,7 +44,10 @@ var globalObj = &globals{
 		reconciliation: NewTimeout(DefaultReconciliationTimeout),
 	},
 	kubernetes: &globalKubernetes{
-		requestBatchSize: NewInt64(DefaultKubernetesRequestBatchSize),
+		requestBatchSize:     NewInt64(DefaultKubernetesRequestBatchSize),
+		clusterDomain:        NewString(DefaultKubernetesClusterDomain),
+		inspectorParallelism: NewInt(DefaultKubernetesInspectorParallelism),
+		inspectorSelector:    NewString(DefaultKubernetesInspectorSelector),
 	},
 	backup: &globalBackup{
 		concurrentUploads: NewInt(DefaultBackupConcurrentUploads),
@@ -82,16 +88,37 @@ func (g globals) Timeouts() GlobalTimeouts {
 
 type GlobalKubernetes interface {
 	RequestBatchSize() Int64
+	ClusterDomain() String
+	InspectorParallelism() Int
+	InspectorSelector() String
 }
 
 type globalKubernetes struct {
-	requestBatchSize Int64
+	requestBatchSize     Int64
+	clusterDomain        String
+	inspectorParallelism Int
+	inspectorSelector    String
+}
+
+// InspectorSelector returns the label selector used to scope inspector lists of secrets, services,
+// service accounts and pod disruption budgets. Empty selector means all resources in the namespace.
+func (g *globalKubernetes) InspectorSelector() String {
+	return g.inspectorSelector
+}
+
+// InspectorParallelism returns the number of resource kinds loaded in parallel by the inspector.
+func (g *globalKubernetes) InspectorParallelism() Int {
+	return g.inspectorParallelism
 }
 
 func (g *globalKubernetes) RequestBatchSize() Int64 {
 	return g.requestBatchSize
 }
 
+func (g *globalKubernetes) ClusterDomain() String {
+	return g.clusterDomain
+}
+
 type GlobalBackup interface {
 	ConcurrentUploads() Int
 }
@@ -33,6 +33,9 @@ func Test_Globals(t *testing.T) {
 		require.EqualValues(t, DefaultArangoDTimeout, GetGlobals().Timeouts().ArangoD().Get())
 		require.EqualValues(t, DefaultReconciliationTimeout, GetGlobals().Timeouts().Reconciliation().Get())
 		require.EqualValues(t, DefaultBackupConcurrentUploads, GetGlobals().Backup().ConcurrentUploads().Get())
+		require.EqualValues(t, DefaultKubernetesClusterDomain, GetGlobals().Kubernetes().ClusterDomain().Get())
+		require.EqualValues(t, DefaultKubernetesInspectorParallelism, GetGlobals().Kubernetes().InspectorParallelism().Get())
+		require.EqualValues(t, DefaultKubernetesInspectorSelector, GetGlobals().Kubernetes().InspectorSelector().Get())
 	})
 
 	t.Run("Override", func(t *testing.T) {
@@ -41,6 +44,9 @@ func Test_Globals(t *testing.T) {
 		GetGlobals().Timeouts().ArangoD().Set(0)
 		GetGlobals().Timeouts().Reconciliation().Set(0)
 		GetGlobals().Backup().ConcurrentUploads().Set(0)
+		GetGlobals().Kubernetes().ClusterDomain().Set("cluster.local")
+		GetGlobals().Kubernetes().InspectorParallelism().Set(1)
+		GetGlobals().Kubernetes().InspectorSelector().Set("arango_deployment")
 	})
 
 	t.Run("Check", func(t *testing.T) {
@@ -49,5 +55,8 @@ func Test_Globals(t *testing.T) {
 		require.EqualValues(t, 0, GetGlobals().Timeouts().ArangoD().Get())
 		require.EqualValues(t, 0, GetGlobals().Timeouts().Reconciliation().Get())
 		require.EqualValues(t, 0, GetGlobals().Backup().ConcurrentUploads().Get())
+		require.EqualValues(t, "cluster.local", GetGlobals().Kubernetes().ClusterDomain().Get())
+		require.EqualValues(t, 1, GetGlobals().Kubernetes().InspectorParallelism().Get())
+		require.EqualValues(t, "arango_deployment", GetGlobals().Kubernetes().InspectorSelector().Get())
 	})
 }
@@ -0,0 +1,42 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package globals
+
+type String interface {
+	Set(in string)
+	Get() string
+}
+
+func NewString(def string) String {
+	return &stringObj{s: def}
+}
+
+type stringObj struct {
+	s string
+}
+
+func (s *stringObj) Set(in string) {
+	s.s = in
+}
+
+func (s *stringObj) Get() string {
+	return s.s
+}
@@ -32,6 +32,7 @@ const (
 	ArangoExporterInternalEndpoint      = "/_admin/metrics"
 	ArangoExporterInternalEndpointV2    = "/_admin/metrics/v2"
 	ArangoExporterDefaultEndpoint       = "/metrics"
+	ArangoSyncMetricsEndpoint           = "/metrics"
 
 	// K8s constants
 	ClusterIPNone       = "None"
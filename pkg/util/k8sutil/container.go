@@ -22,6 +22,9 @@ package k8sutil
 
 import core "k8s.io/api/core/v1"
 
+// DebugEntrypointCommand keeps the container running without starting the server, until it gets terminated.
+var DebugEntrypointCommand = []string{"/bin/sh", "-c", "trap 'exit 0' TERM INT; while true; do sleep 1; done"}
+
 // GetContainerByName returns the container in the given pod with the given name.
 // Returns false if not found.
 func GetContainerByName(p *core.Pod, name string) (core.Container, bool) {
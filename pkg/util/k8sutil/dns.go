@@ -23,17 +23,34 @@ package k8sutil
 import (
 	"fmt"
 
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	core "k8s.io/api/core/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// GetClusterDomain returns the cluster domain of the deployment. When it is not set on the deployment,
+// the default cluster domain of the Operator is returned.
+func GetClusterDomain(domain *string) string {
+	if domain != nil {
+		return *domain
+	}
+
+	return globals.GetGlobals().Kubernetes().ClusterDomain().Get()
+}
+
+// HasClusterDomain returns true if DNS names of the deployment are extended with the cluster domain.
+func HasClusterDomain(domain *string) bool {
+	return GetClusterDomain(domain) != ""
+}
+
 func appendDeploymentClusterDomain(dns string, domain *string) string {
-	if domain == nil || *domain == "" {
+	d := GetClusterDomain(domain)
+	if d == "" {
 		return dns
 	}
 
-	return fmt.Sprintf("%s.%s", dns, *domain)
+	return fmt.Sprintf("%s.%s", dns, d)
 }
 
 // CreatePodDNSName returns the DNS of a pod with a given role & id in
@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 
 	"github.com/stretchr/testify/assert"
 
@@ -59,3 +60,17 @@ func TestCreatePodDNSNameWithDomain(t *testing.T) {
 	assert.Equal(t, "test-agent-id1.test-int.ns.svc", CreatePodDNSNameWithDomain(depl, nil, "agent", "id1"))
 	assert.Equal(t, "test-agent-id1.test-int.ns.svc.cluster.local", CreatePodDNSNameWithDomain(depl, util.NewString("cluster.local"), "agent", "id1"))
 }
+
+func TestCreatePodDNSNameWithOperatorDomain(t *testing.T) {
+	depl := &metav1.ObjectMeta{
+		Name:      "test",
+		Namespace: "ns",
+	}
+
+	globals.GetGlobals().Kubernetes().ClusterDomain().Set("example.org")
+	defer globals.GetGlobals().Kubernetes().ClusterDomain().Set(globals.DefaultKubernetesClusterDomain)
+
+	assert.Equal(t, "test-agent-id1.test-int.ns.svc.example.org", CreatePodDNSNameWithDomain(depl, nil, "agent", "id1"))
+	assert.Equal(t, "test-agent-id1.test-int.ns.svc.cluster.local", CreatePodDNSNameWithDomain(depl, util.NewString("cluster.local"), "agent", "id1"))
+	assert.Equal(t, "test-agent-id1.test-int.ns.svc", CreatePodDNSNameWithDomain(depl, util.NewString(""), "agent", "id1"))
+}
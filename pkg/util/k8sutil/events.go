@@ -112,6 +112,15 @@ func NewPodsSchedulingResolvedEvent(apiObject APIObject) *Event {
 	return event
 }
 
+// NewVolumeAlmostFullEvent creates an event indicating that data volumes of members are almost full.
+func NewVolumeAlmostFullEvent(memberIDs []string, apiObject APIObject) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = "Volume Almost Full"
+	event.Message = fmt.Sprintf("Data volumes of one or more members are almost full. Members: %v", memberIDs)
+	return event
+}
+
 // NewSecretsChangedEvent creates an event indicating that one of more secrets have changed.
 func NewSecretsChangedEvent(changedSecretNames []string, apiObject APIObject) *Event {
 	event := newDeploymentEvent(apiObject)
@@ -141,6 +150,35 @@ func NewAccessPackageCreatedEvent(apiObject APIObject, apSecretName string) *Eve
 	return event
 }
 
+// NewAccessPackageRenewedEvent creates an event indicating that a secret containing an access package
+// has been renewed.
+func NewAccessPackageRenewedEvent(apiObject APIObject, apSecretName, reason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	event.Reason = "Access package renewed"
+	event.Message = fmt.Sprintf("The access package named %s has been renewed: %s", apSecretName, reason)
+	return event
+}
+
+// NewReplicationAuthenticationUpdatedEvent creates an event indicating that the synchronization
+// has been reconfigured with renewed authentication of the source.
+func NewReplicationAuthenticationUpdatedEvent(apiObject APIObject) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	event.Reason = "Authentication updated"
+	event.Message = "Synchronization has been reconfigured with renewed authentication of the source"
+	return event
+}
+
+// NewReplicationSwitchoverEvent creates an event indicating that the switchover of the replication reached the given phase.
+func NewReplicationSwitchoverEvent(apiObject APIObject, phase string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	event.Reason = "Switchover"
+	event.Message = fmt.Sprintf("Switchover of the replication reached phase %s", phase)
+	return event
+}
+
 // NewAccessPackageDeletedEvent creates an event indicating that a secret containing an access package
 // has been deleted.
 func NewAccessPackageDeletedEvent(apiObject APIObject, apSecretName string) *Event {
@@ -256,6 +294,53 @@ func NewUpgradeNotAllowedEvent(apiObject APIObject,
 	return event
 }
 
+// NewUpgradeChannelImageEvent creates an event indicating that the deployment image has been updated
+// to the newest image of the upgrade channel.
+func NewUpgradeChannelImageEvent(apiObject APIObject, channel, fromImage, toImage string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	event.Reason = "Upgrade Channel Image Updated"
+	event.Message = fmt.Sprintf("Image updated from %s to %s according to the upgrade channel %s", fromImage, toImage, channel)
+	return event
+}
+
+// NewUpgradeRollbackEvent creates an event indicating that the deployment image has been rolled back
+// to the previous image after failed upgrade.
+func NewUpgradeRollbackEvent(apiObject APIObject, fromImage, toImage string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = "Upgrade Rolled Back"
+	event.Message = fmt.Sprintf("Upgrade to image %s failed, image rolled back to %s", fromImage, toImage)
+	return event
+}
+
+// NewLicenseExpiringEvent creates an event indicating that the license of the deployment expires soon or is expired.
+func NewLicenseExpiringEvent(apiObject APIObject, reason, message string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = reason
+	event.Message = message
+	return event
+}
+
+// NewUpgradeBlockedEvent creates an event indicating that an upgrade is blocked due to an unsupported version skew.
+func NewUpgradeBlockedEvent(apiObject APIObject, reason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = "Upgrade Blocked"
+	event.Message = fmt.Sprintf("Upgrade is blocked: %s", reason)
+	return event
+}
+
+// NewImageArchitectureNotSupportedEvent creates an event indicating that the image does not provide requested architecture.
+func NewImageArchitectureNotSupportedEvent(apiObject APIObject, image, arch string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = "Image Architecture Not Supported"
+	event.Message = fmt.Sprintf("The image %s does not provide the %s architecture", image, arch)
+	return event
+}
+
 // NewErrorEvent creates an even of type error.
 func NewErrorEvent(reason string, err error, apiObject APIObject) *Event {
 	event := newDeploymentEvent(apiObject)
@@ -271,3 +356,13 @@ func newDeploymentEvent(apiObject runtime.Object) *Event {
 		InvolvedObject: apiObject,
 	}
 }
+
+// NewCannotExpandVolumeEvent creates an event indicating that the volume of a member should be expanded,
+// but this is not possible for the given reason.
+func NewCannotExpandVolumeEvent(apiObject APIObject, memberID, role, subReason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	event.Reason = fmt.Sprintf("%s Member Volume Cannot Expand", strings.Title(role))
+	event.Message = fmt.Sprintf("Member %s with role %s should have a bigger volume, but it cannot be expanded because: %s", memberID, role, subReason)
+	return event
+}
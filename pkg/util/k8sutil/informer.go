@@ -86,3 +86,8 @@ func NewResourceWatcher(log zerolog.Logger, getter cache.Getter, resource, names
 func (rw *ResourceWatcher) Run(stopCh <-chan struct{}) {
 	rw.informer.Run(stopCh)
 }
+
+// HasSynced returns true once the initial list of resources has been delivered to the handlers.
+func (rw *ResourceWatcher) HasSynced() bool {
+	return rw.informer.HasSynced()
+}
@@ -21,6 +21,8 @@
 package arangomember
 
 import (
+	"context"
+
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -29,6 +31,9 @@ type Inspector interface {
 	ArangoMember(name string) (*api.ArangoMember, bool)
 	IterateArangoMembers(action Action, filters ...Filter) error
 	ArangoMemberReadInterface() ReadInterface
+
+	// RefreshArangoMembers reloads only ArangoMembers from the API server
+	RefreshArangoMembers(ctx context.Context) error
 }
 
 type Filter func(pod *api.ArangoMember) bool
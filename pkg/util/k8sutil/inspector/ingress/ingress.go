@@ -0,0 +1,39 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package ingress
+
+import (
+	"context"
+
+	networking "k8s.io/api/networking/v1"
+)
+
+type Inspector interface {
+	Ingress(name string) (*networking.Ingress, bool)
+	IterateIngresses(action Action, filters ...Filter) error
+	IngressReadInterface() ReadInterface
+
+	// RefreshIngresses reloads only Ingresses from the API server
+	RefreshIngresses(ctx context.Context) error
+}
+
+type Filter func(ingress *networking.Ingress) bool
+type Action func(ingress *networking.Ingress) error
@@ -21,6 +21,7 @@
 package inspector
 
 import (
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/ingress"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/node"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/refresh"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/server"
@@ -28,13 +29,16 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangoclustersynchronization"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangomember"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangotask"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/persistentvolume"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/persistentvolumeclaim"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/pod"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/poddisruptionbudget"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/prometheusrule"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/secret"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/service"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/serviceaccount"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/servicemonitor"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/storageclass"
 )
 
 type Inspector interface {
@@ -45,11 +49,15 @@ type Inspector interface {
 	service.Inspector
 	poddisruptionbudget.Inspector
 	servicemonitor.Inspector
+	prometheusrule.Inspector
+	ingress.Inspector
 	serviceaccount.Inspector
 	arangomember.Inspector
 	server.Inspector
 
 	node.Loader
+	persistentvolume.Loader
+	storageclass.Loader
 	arangoclustersynchronization.Loader
 	arangotask.Loader
 }
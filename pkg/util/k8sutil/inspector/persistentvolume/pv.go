@@ -0,0 +1,39 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package persistentvolume
+
+import (
+	core "k8s.io/api/core/v1"
+)
+
+type Loader interface {
+	GetPersistentVolumes() (Inspector, bool)
+}
+
+type Inspector interface {
+	PersistentVolumes() []*core.PersistentVolume
+	PersistentVolume(name string) (*core.PersistentVolume, bool)
+	IteratePersistentVolumes(action Action, filters ...Filter) error
+	PersistentVolumeReadInterface() ReadInterface
+}
+
+type Filter func(persistentVolume *core.PersistentVolume) bool
+type Action func(persistentVolume *core.PersistentVolume) error
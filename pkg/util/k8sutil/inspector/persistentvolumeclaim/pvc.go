@@ -21,6 +21,8 @@
 package persistentvolumeclaim
 
 import (
+	"context"
+
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/refresh"
 	core "k8s.io/api/core/v1"
 )
@@ -32,6 +34,9 @@ type Inspector interface {
 	PersistentVolumeClaim(name string) (*core.PersistentVolumeClaim, bool)
 	IteratePersistentVolumeClaims(action Action, filters ...Filter) error
 	PersistentVolumeClaimReadInterface() ReadInterface
+
+	// RefreshPersistentVolumeClaims reloads only PersistentVolumeClaims from the API server
+	RefreshPersistentVolumeClaims(ctx context.Context) error
 }
 
 type Filter func(pvc *core.PersistentVolumeClaim) bool
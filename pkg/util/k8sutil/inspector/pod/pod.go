@@ -21,6 +21,8 @@
 package pod
 
 import (
+	"context"
+
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/refresh"
 	core "k8s.io/api/core/v1"
 )
@@ -32,6 +34,9 @@ type Inspector interface {
 	Pod(name string) (*core.Pod, bool)
 	IteratePods(action Action, filters ...Filter) error
 	PodReadInterface() ReadInterface
+
+	// RefreshPods reloads only Pods from the API server
+	RefreshPods(ctx context.Context) error
 }
 
 type Filter func(pod *core.Pod) bool
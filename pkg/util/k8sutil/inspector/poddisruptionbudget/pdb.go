@@ -20,12 +20,19 @@
 
 package poddisruptionbudget
 
-import policy "k8s.io/api/policy/v1beta1"
+import (
+	"context"
+
+	policy "k8s.io/api/policy/v1beta1"
+)
 
 type Inspector interface {
 	PodDisruptionBudget(name string) (*policy.PodDisruptionBudget, bool)
 	IteratePodDisruptionBudgets(action Action, filters ...Filter) error
 	PodDisruptionBudgetReadInterface() ReadInterface
+
+	// RefreshPodDisruptionBudgets reloads only PodDisruptionBudgets from the API server
+	RefreshPodDisruptionBudgets(ctx context.Context) error
 }
 
 type Filter func(podDisruptionBudget *policy.PodDisruptionBudget) bool
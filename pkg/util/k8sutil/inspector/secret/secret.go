@@ -1,7 +1,6 @@
-//
 // DISCLAIMER
 //
-// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+// # Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -16,10 +15,11 @@
 // limitations under the License.
 //
 // Copyright holder is ArangoDB GmbH, Cologne, Germany
-//
 package secret
 
 import (
+	"context"
+
 	core "k8s.io/api/core/v1"
 )
 
@@ -28,6 +28,9 @@ type Inspector interface {
 	Secret(name string) (*core.Secret, bool)
 	IterateSecrets(action Action, filters ...Filter) error
 	SecretReadInterface() ReadInterface
+
+	// RefreshSecrets reloads only Secrets from the API server
+	RefreshSecrets(ctx context.Context) error
 }
 
 type Filter func(pod *core.Secret) bool
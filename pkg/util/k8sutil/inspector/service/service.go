@@ -21,6 +21,8 @@
 package service
 
 import (
+	"context"
+
 	core "k8s.io/api/core/v1"
 )
 
@@ -28,6 +30,9 @@ type Inspector interface {
 	Service(name string) (*core.Service, bool)
 	IterateServices(action Action, filters ...Filter) error
 	ServiceReadInterface() ReadInterface
+
+	// RefreshServices reloads only Services from the API server
+	RefreshServices(ctx context.Context) error
 }
 
 type Filter func(pod *core.Service) bool
@@ -20,12 +20,19 @@
 
 package serviceaccount
 
-import core "k8s.io/api/core/v1"
+import (
+	"context"
+
+	core "k8s.io/api/core/v1"
+)
 
 type Inspector interface {
 	ServiceAccount(name string) (*core.ServiceAccount, bool)
 	IterateServiceAccounts(action Action, filters ...Filter) error
 	ServiceAccountReadInterface() ReadInterface
+
+	// RefreshServiceAccounts reloads only ServiceAccounts from the API server
+	RefreshServiceAccounts(ctx context.Context) error
 }
 
 type Filter func(pod *core.ServiceAccount) bool
@@ -20,12 +20,19 @@
 
 package servicemonitor
 
-import monitoring "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+import (
+	"context"
+
+	monitoring "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
 
 type Inspector interface {
 	ServiceMonitor(name string) (*monitoring.ServiceMonitor, bool)
 	IterateServiceMonitors(action Action, filters ...Filter) error
 	ServiceMonitorReadInterface() ReadInterface
+
+	// RefreshServiceMonitors reloads only ServiceMonitors from the API server
+	RefreshServiceMonitors(ctx context.Context) error
 }
 
 type Filter func(serviceMonitor *monitoring.ServiceMonitor) bool
@@ -21,6 +21,7 @@
 package k8sutil
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -66,12 +67,16 @@ func NewLifecycleFinalizers() (*core.Lifecycle, error) {
 }
 
 // NewLifecyclePort creates a lifecycle structure with preStop handler which wait for port to be closed.
-func NewLifecyclePort() (*core.Lifecycle, error) {
+func NewLifecyclePort(port int) (*core.Lifecycle, error) {
+	if port != ArangoPort {
+		return NewLifecycle("port", fmt.Sprintf("--port=%d", port))
+	}
+
 	return NewLifecycle("port")
 }
 
 // NewLifecycle creates a lifecycle structure with preStop handler.
-func NewLifecycle(t string) (*core.Lifecycle, error) {
+func NewLifecycle(t string, args ...string) (*core.Lifecycle, error) {
 	binaryPath, err := os.Executable()
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -80,7 +85,7 @@ func NewLifecycle(t string) (*core.Lifecycle, error) {
 	lifecycle := &core.Lifecycle{
 		PreStop: &core.Handler{
 			Exec: &core.ExecAction{
-				Command: append([]string{exePath}, "lifecycle", "preStop", t),
+				Command: append([]string{exePath, "lifecycle", "preStop", t}, args...),
 			},
 		},
 	}
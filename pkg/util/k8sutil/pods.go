@@ -55,6 +55,9 @@ const (
 	TMPEphemeralVolumeName          = "ephemeral-tmp"
 	RocksdbEncryptionVolumeName     = "rocksdb-encryption"
 	ExporterJWTVolumeName           = "exporter-jwt"
+	AuditLogVolumeName              = "audit-log"
+	ArangodLogsVolumeName           = "arangod-logs"
+	ArangodAppsVolumeName           = "arangod-apps"
 	ArangodVolumeMountDir           = "/data"
 	RocksDBEncryptionVolumeMountDir = "/secrets/rocksdb/encryption"
 	TLSKeyfileVolumeMountDir        = "/secrets/tls"
@@ -63,6 +66,11 @@ const (
 	ClusterJWTSecretVolumeMountDir  = "/secrets/cluster/jwt"
 	ExporterJWTVolumeMountDir       = "/secrets/exporter/jwt"
 	MasterJWTSecretVolumeMountDir   = "/secrets/master/jwt"
+	AuditLogVolumeMountDir          = "/var/log/arangodb/audit"
+	ArangodLogsVolumeMountDir       = "/var/log/arangodb/server"
+	ArangodAppsVolumeMountDir       = "/apps"
+
+	SecretsStoreCSIDriver = "secrets-store.csi.k8s.io"
 
 	ServerContainerConditionContainersNotReady = "ContainersNotReady"
 	ServerContainerConditionPrefix             = "containers with unready status: "
@@ -266,6 +274,28 @@ func IsPodFailed(pod *core.Pod, coreContainers utils.StringList) bool {
 	return true
 }
 
+// IsPodImagePlatformNotAvailable returns true when one of the core containers can not be started
+// because its image does not provide a manifest for the node platform (architecture).
+func IsPodImagePlatformNotAvailable(pod *core.Pod, coreContainers utils.StringList) bool {
+	for _, c := range pod.Status.ContainerStatuses {
+		if !coreContainers.Has(c.Name) {
+			continue
+		}
+
+		if w := c.State.Waiting; w != nil {
+			if w.Reason != "ErrImagePull" && w.Reason != "ImagePullBackOff" {
+				continue
+			}
+
+			if strings.Contains(w.Message, "no matching manifest") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // IsContainerFailed returns true if the arangodb container
 // has terminated wih a non-zero exit code.
 func IsContainerFailed(container *core.ContainerStatus) bool {
@@ -622,6 +652,22 @@ func CreateVolumeWithSecret(name, secretName string) core.Volume {
 	}
 }
 
+// CreateVolumeWithSecretProviderClass returns a volume with files provided by the Secrets Store CSI driver.
+func CreateVolumeWithSecretProviderClass(name, secretProviderClass string) core.Volume {
+	return core.Volume{
+		Name: name,
+		VolumeSource: core.VolumeSource{
+			CSI: &core.CSIVolumeSource{
+				Driver:   SecretsStoreCSIDriver,
+				ReadOnly: util.NewBool(true),
+				VolumeAttributes: map[string]string{
+					"secretProviderClass": secretProviderClass,
+				},
+			},
+		},
+	}
+}
+
 func CreateVolumeWithPersitantVolumeClaim(name, claimName string) core.Volume {
 	return core.Volume{
 		Name: name,
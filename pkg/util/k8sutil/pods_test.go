@@ -320,6 +320,92 @@ func TestIsPodSucceeded(t *testing.T) {
 	}
 }
 
+// TestIsPodImagePlatformNotAvailable tests IsPodImagePlatformNotAvailable.
+func TestIsPodImagePlatformNotAvailable(t *testing.T) {
+	type args struct {
+		pod            *v1.Pod
+		coreContainers utils.StringList
+	}
+	tests := map[string]struct {
+		args args
+		want bool
+	}{
+		"empty pod": {
+			args: args{
+				pod: &v1.Pod{},
+			},
+		},
+		"core container without platform manifest": {
+			args: args{
+				pod: &v1.Pod{
+					Status: v1.PodStatus{
+						ContainerStatuses: []v1.ContainerStatus{
+							{
+								Name: "core_container",
+								State: v1.ContainerState{
+									Waiting: &v1.ContainerStateWaiting{
+										Reason:  "ErrImagePull",
+										Message: "no matching manifest for linux/arm64/v8 in the manifest list entries",
+									},
+								},
+							},
+						},
+					},
+				},
+				coreContainers: utils.StringList{"core_container"},
+			},
+			want: true,
+		},
+		"core container with different pull error": {
+			args: args{
+				pod: &v1.Pod{
+					Status: v1.PodStatus{
+						ContainerStatuses: []v1.ContainerStatus{
+							{
+								Name: "core_container",
+								State: v1.ContainerState{
+									Waiting: &v1.ContainerStateWaiting{
+										Reason:  "ImagePullBackOff",
+										Message: "pull access denied",
+									},
+								},
+							},
+						},
+					},
+				},
+				coreContainers: utils.StringList{"core_container"},
+			},
+		},
+		"non-core container without platform manifest": {
+			args: args{
+				pod: &v1.Pod{
+					Status: v1.PodStatus{
+						ContainerStatuses: []v1.ContainerStatus{
+							{
+								Name: "non_core_container",
+								State: v1.ContainerState{
+									Waiting: &v1.ContainerStateWaiting{
+										Reason:  "ErrImagePull",
+										Message: "no matching manifest for linux/arm64/v8 in the manifest list entries",
+									},
+								},
+							},
+						},
+					},
+				},
+				coreContainers: utils.StringList{"core_container"},
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			got := IsPodImagePlatformNotAvailable(test.args.pod, test.args.coreContainers)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
 func Test_extractContainerNamesFromConditionMessage(t *testing.T) {
 	t.Run("Valid name", func(t *testing.T) {
 		c, ok := extractContainerNamesFromConditionMessage("containers with unready status: [sidecar2 sidecar3]")
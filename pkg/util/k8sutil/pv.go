@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package k8sutil
+
+import (
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// IsPersistentVolumeNodeBound returns true if the persistent volume can be used only on nodes
+// selected by its node affinity (e.g. local volumes).
+func IsPersistentVolumeNodeBound(pv *core.PersistentVolume) bool {
+	a := pv.Spec.NodeAffinity
+	return a != nil && a.Required != nil && len(a.Required.NodeSelectorTerms) > 0
+}
+
+// IsPersistentVolumeAccessibleFromNode returns true if the node matches the node affinity of the persistent volume.
+// Terms of the node selector are ORed, requirements within a term are ANDed.
+func IsPersistentVolumeAccessibleFromNode(pv *core.PersistentVolume, node *core.Node) bool {
+	if !IsPersistentVolumeNodeBound(pv) {
+		return true
+	}
+
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		if isNodeSelectorTermMatching(term, node) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPersistentVolumeNodeGone returns true if the persistent volume can be used only on nodes
+// which are gone or unschedulable, so data stored on it is not accessible.
+func IsPersistentVolumeNodeGone(pv *core.PersistentVolume, nodes []*core.Node) bool {
+	if !IsPersistentVolumeNodeBound(pv) {
+		return false
+	}
+
+	for _, node := range nodes {
+		if !node.Spec.Unschedulable && IsPersistentVolumeAccessibleFromNode(pv, node) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isNodeSelectorTermMatching(term core.NodeSelectorTerm, node *core.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+
+	for _, r := range term.MatchExpressions {
+		if !isNodeSelectorRequirementMatching(r, labels.Set(node.GetLabels())) {
+			return false
+		}
+	}
+
+	for _, r := range term.MatchFields {
+		if !isNodeSelectorRequirementMatching(r, fields.Set{"metadata.name": node.GetName()}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isNodeSelectorRequirementMatching(r core.NodeSelectorRequirement, values labels.Labels) bool {
+	var op selection.Operator
+	switch r.Operator {
+	case core.NodeSelectorOpIn:
+		op = selection.In
+	case core.NodeSelectorOpNotIn:
+		op = selection.NotIn
+	case core.NodeSelectorOpExists:
+		op = selection.Exists
+	case core.NodeSelectorOpDoesNotExist:
+		op = selection.DoesNotExist
+	case core.NodeSelectorOpGt:
+		op = selection.GreaterThan
+	case core.NodeSelectorOpLt:
+		op = selection.LessThan
+	default:
+		return false
+	}
+
+	req, err := labels.NewRequirement(r.Key, op, r.Values)
+	if err != nil {
+		return false
+	}
+
+	return req.Matches(values)
+}
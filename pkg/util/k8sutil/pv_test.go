@@ -0,0 +1,102 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package k8sutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsPersistentVolumeAccessibleFromNode(t *testing.T) {
+	newPV := func(terms ...core.NodeSelectorTerm) *core.PersistentVolume {
+		pv := &core.PersistentVolume{}
+		if len(terms) > 0 {
+			pv.Spec.NodeAffinity = &core.VolumeNodeAffinity{
+				Required: &core.NodeSelector{NodeSelectorTerms: terms},
+			}
+		}
+		return pv
+	}
+	node := &core.Node{
+		ObjectMeta: meta.ObjectMeta{
+			Name:   "node1",
+			Labels: map[string]string{"kubernetes.io/hostname": "node1", "zone": "a"},
+		},
+	}
+
+	hostname := func(op core.NodeSelectorOperator, values ...string) core.NodeSelectorTerm {
+		return core.NodeSelectorTerm{MatchExpressions: []core.NodeSelectorRequirement{
+			{Key: "kubernetes.io/hostname", Operator: op, Values: values},
+		}}
+	}
+
+	// Network attached volume
+	assert.False(t, IsPersistentVolumeNodeBound(newPV()))
+	assert.True(t, IsPersistentVolumeAccessibleFromNode(newPV(), node))
+
+	assert.True(t, IsPersistentVolumeNodeBound(newPV(hostname(core.NodeSelectorOpIn, "node1"))))
+	assert.True(t, IsPersistentVolumeAccessibleFromNode(newPV(hostname(core.NodeSelectorOpIn, "node1")), node))
+	assert.False(t, IsPersistentVolumeAccessibleFromNode(newPV(hostname(core.NodeSelectorOpIn, "node2")), node))
+	assert.True(t, IsPersistentVolumeAccessibleFromNode(newPV(hostname(core.NodeSelectorOpNotIn, "node2")), node))
+	assert.True(t, IsPersistentVolumeAccessibleFromNode(newPV(hostname(core.NodeSelectorOpExists)), node))
+
+	// Terms are ORed
+	assert.True(t, IsPersistentVolumeAccessibleFromNode(newPV(hostname(core.NodeSelectorOpIn, "node2"), hostname(core.NodeSelectorOpIn, "node1")), node))
+
+	// Requirements are ANDed
+	assert.False(t, IsPersistentVolumeAccessibleFromNode(newPV(core.NodeSelectorTerm{MatchExpressions: []core.NodeSelectorRequirement{
+		{Key: "kubernetes.io/hostname", Operator: core.NodeSelectorOpIn, Values: []string{"node1"}},
+		{Key: "zone", Operator: core.NodeSelectorOpIn, Values: []string{"b"}},
+	}}), node))
+
+	// Fields
+	assert.True(t, IsPersistentVolumeAccessibleFromNode(newPV(core.NodeSelectorTerm{MatchFields: []core.NodeSelectorRequirement{
+		{Key: "metadata.name", Operator: core.NodeSelectorOpIn, Values: []string{"node1"}},
+	}}), node))
+	assert.False(t, IsPersistentVolumeAccessibleFromNode(newPV(core.NodeSelectorTerm{MatchFields: []core.NodeSelectorRequirement{
+		{Key: "metadata.name", Operator: core.NodeSelectorOpIn, Values: []string{"node2"}},
+	}}), node))
+}
+
+func TestIsPersistentVolumeNodeGone(t *testing.T) {
+	local := &core.PersistentVolume{}
+	local.Spec.NodeAffinity = &core.VolumeNodeAffinity{
+		Required: &core.NodeSelector{NodeSelectorTerms: []core.NodeSelectorTerm{
+			{MatchFields: []core.NodeSelectorRequirement{
+				{Key: "metadata.name", Operator: core.NodeSelectorOpIn, Values: []string{"node1"}},
+			}},
+		}},
+	}
+	node1 := &core.Node{ObjectMeta: meta.ObjectMeta{Name: "node1"}}
+	node2 := &core.Node{ObjectMeta: meta.ObjectMeta{Name: "node2"}}
+	cordoned := node1.DeepCopy()
+	cordoned.Spec.Unschedulable = true
+
+	// Network attached volume
+	assert.False(t, IsPersistentVolumeNodeGone(&core.PersistentVolume{}, nil))
+
+	assert.False(t, IsPersistentVolumeNodeGone(local, []*core.Node{node1, node2}))
+	assert.True(t, IsPersistentVolumeNodeGone(local, []*core.Node{node2}))
+	assert.True(t, IsPersistentVolumeNodeGone(local, []*core.Node{cordoned, node2}))
+}
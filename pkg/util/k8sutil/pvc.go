@@ -22,7 +22,9 @@ package k8sutil
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
+	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/persistentvolumeclaim"
 
@@ -30,6 +32,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 )
@@ -114,3 +117,54 @@ func CreatePersistentVolumeClaim(ctx context.Context, pvcs persistentvolumeclaim
 	}
 	return nil
 }
+
+// GetPersistentVolumeClaimRetention returns the ID of the member the pvc has been retained for
+// and the time of the member removal. Returns false if the pvc is not retained.
+func GetPersistentVolumeClaimRetention(pvc *v1.PersistentVolumeClaim) (string, time.Time, bool) {
+	memberID, ok := pvc.GetAnnotations()[constants.AnnotationPVCRetainedMember]
+	if !ok || memberID == "" {
+		return "", time.Time{}, false
+	}
+
+	// Unparsable time is treated as just retained, so the pvc is not removed too early
+	retainedAt, err := time.Parse(time.RFC3339, pvc.GetAnnotations()[constants.AnnotationPVCRetainedAt])
+	if err != nil {
+		retainedAt = time.Now()
+	}
+
+	return memberID, retainedAt, true
+}
+
+// RetainPersistentVolumeClaim marks the pvc with given name as retained after removal of the member with given id.
+// If the pvc does not exist, nil is returned.
+func RetainPersistentVolumeClaim(ctx context.Context, pvcs persistentvolumeclaim.ModInterface, pvcName, memberID string, retainedAt time.Time) error {
+	return patchPersistentVolumeClaimAnnotations(ctx, pvcs, pvcName, map[string]interface{}{
+		constants.AnnotationPVCRetainedMember: memberID,
+		constants.AnnotationPVCRetainedAt:     retainedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// ClearPersistentVolumeClaimRetention removes the retention marks from the pvc with given name,
+// once it is used by a member again. If the pvc does not exist, nil is returned.
+func ClearPersistentVolumeClaimRetention(ctx context.Context, pvcs persistentvolumeclaim.ModInterface, pvcName string) error {
+	return patchPersistentVolumeClaimAnnotations(ctx, pvcs, pvcName, map[string]interface{}{
+		constants.AnnotationPVCRetainedMember: nil,
+		constants.AnnotationPVCRetainedAt:     nil,
+	})
+}
+
+func patchPersistentVolumeClaimAnnotations(ctx context.Context, pvcs persistentvolumeclaim.ModInterface, pvcName string, annotations map[string]interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := pvcs.Patch(ctx, pvcName, types.MergePatchType, data, metav1.PatchOptions{}); err != nil && !IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
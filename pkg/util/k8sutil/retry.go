@@ -0,0 +1,53 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package k8sutil
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+// conflictBackoff is the backoff between attempts of an update which failed with a conflict
+var conflictBackoff = wait.Backoff{
+	Steps:    10,
+	Duration: 10 * time.Millisecond,
+	Factor:   1.5,
+	Jitter:   0.1,
+}
+
+// RetryOnConflict calls update until it succeeds, fails with an error other than a conflict
+// or the attempts are exhausted. Before every retry, reload is called to fetch the current version
+// of the object, so the update is never applied over changes made by other writers.
+func RetryOnConflict(update, reload func() error) error {
+	first := true
+	return retry.OnError(conflictBackoff, IsConflict, func() error {
+		if !first {
+			if err := reload(); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		return update()
+	})
+}
@@ -0,0 +1,87 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package k8sutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+func Test_RetryOnConflict(t *testing.T) {
+	conflict := apierrors.NewConflict(schema.GroupResource{Resource: "arangodeployments"}, "example", errors.Newf("conflict"))
+
+	t.Run("Reload after conflict", func(t *testing.T) {
+		updates, reloads := 0, 0
+		err := RetryOnConflict(func() error {
+			updates++
+			if updates < 3 {
+				return errors.WithStack(conflict)
+			}
+			return nil
+		}, func() error {
+			reloads++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, updates)
+		require.Equal(t, 2, reloads)
+	})
+
+	t.Run("Other error", func(t *testing.T) {
+		updates, reloads := 0, 0
+		err := RetryOnConflict(func() error {
+			updates++
+			return errors.Newf("failed")
+		}, func() error {
+			reloads++
+			return nil
+		})
+		require.EqualError(t, err, "failed")
+		require.Equal(t, 1, updates)
+		require.Equal(t, 0, reloads)
+	})
+
+	t.Run("Reload error", func(t *testing.T) {
+		err := RetryOnConflict(func() error {
+			return conflict
+		}, func() error {
+			return errors.Newf("reload failed")
+		})
+		require.EqualError(t, err, "reload failed")
+	})
+
+	t.Run("Attempts exhausted", func(t *testing.T) {
+		updates := 0
+		err := RetryOnConflict(func() error {
+			updates++
+			return conflict
+		}, func() error {
+			return nil
+		})
+		require.True(t, IsConflict(err))
+		require.Equal(t, conflictBackoff.Steps, updates)
+	})
+}
@@ -0,0 +1,80 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package k8sutil
+
+import (
+	"context"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/secret"
+)
+
+// NewLabeledSecretsModInterface returns a secrets interface which adds the given labels to created and updated secrets.
+// Existing labels are not overridden.
+func NewLabeledSecretsModInterface(secrets secret.ModInterface, labels map[string]string) secret.ModInterface {
+	return labeledSecretsModInterface{
+		ModInterface: secrets,
+		labels:       labels,
+	}
+}
+
+type labeledSecretsModInterface struct {
+	secret.ModInterface
+
+	labels map[string]string
+}
+
+func (l labeledSecretsModInterface) Create(ctx context.Context, secret *core.Secret, opts meta.CreateOptions) (*core.Secret, error) {
+	return l.ModInterface.Create(ctx, l.withLabels(secret), opts)
+}
+
+func (l labeledSecretsModInterface) Update(ctx context.Context, secret *core.Secret, opts meta.UpdateOptions) (*core.Secret, error) {
+	return l.ModInterface.Update(ctx, l.withLabels(secret), opts)
+}
+
+func (l labeledSecretsModInterface) withLabels(secret *core.Secret) *core.Secret {
+	missing := false
+	for k := range l.labels {
+		if _, ok := secret.GetLabels()[k]; !ok {
+			missing = true
+			break
+		}
+	}
+
+	if !missing {
+		return secret
+	}
+
+	s := secret.DeepCopy()
+	if s.Labels == nil {
+		s.Labels = map[string]string{}
+	}
+
+	for k, v := range l.labels {
+		if _, ok := s.Labels[k]; !ok {
+			s.Labels[k] = v
+		}
+	}
+
+	return s
+}
@@ -33,6 +33,7 @@ import (
 
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // CreateHeadlessServiceName returns the name of the headless service for the given
@@ -53,6 +54,12 @@ func CreateDatabaseExternalAccessServiceName(deploymentName string) string {
 	return deploymentName + "-ea"
 }
 
+// CreateMemberExternalAccessServiceName returns the name of the service used to access a single member from
+// outside the kubernetes cluster.
+func CreateMemberExternalAccessServiceName(memberName string) string {
+	return memberName + "-ea"
+}
+
 // CreateSyncMasterClientServiceName returns the name of the service used by syncmaster clients for the given
 // deployment name.
 func CreateSyncMasterClientServiceName(deploymentName string) string {
@@ -65,6 +72,9 @@ func CreateExporterClientServiceName(deploymentName string) string {
 	return deploymentName + "-exporter"
 }
 
+// exporterTargetPort is the name of the pod port with metrics
+var exporterTargetPort = intstr.FromString("exporter")
+
 // CreateExporterService
 func CreateExporterService(ctx context.Context, cachedStatus service.Inspector, svcs service.ModInterface,
 	deployment metav1.Object, owner metav1.OwnerReference) (string, bool, error) {
@@ -73,7 +83,15 @@ func CreateExporterService(ctx context.Context, cachedStatus service.Inspector,
 
 	selectorLabels := LabelsForExporterServiceSelector(deploymentName)
 
-	if _, exists := cachedStatus.Service(svcName); exists {
+	if existing, exists := cachedStatus.Service(svcName); exists {
+		// Ensure the service targets the named port, which is exposed by the exporter sidecar or by the arangod
+		if len(existing.Spec.Ports) == 1 && existing.Spec.Ports[0].TargetPort != exporterTargetPort {
+			svc := existing.DeepCopy()
+			svc.Spec.Ports[0].TargetPort = exporterTargetPort
+			if _, err := svcs.Update(ctx, svc, metav1.UpdateOptions{}); err != nil {
+				return svcName, false, errors.WithStack(err)
+			}
+		}
 		return svcName, false, nil
 	}
 
@@ -86,9 +104,10 @@ func CreateExporterService(ctx context.Context, cachedStatus service.Inspector,
 			ClusterIP: core.ClusterIPNone,
 			Ports: []core.ServicePort{
 				core.ServicePort{
-					Name:     "exporter",
-					Protocol: core.ProtocolTCP,
-					Port:     ArangoExporterPort,
+					Name:       "exporter",
+					Protocol:   core.ProtocolTCP,
+					Port:       ArangoExporterPort,
+					TargetPort: exporterTargetPort,
 				},
 			},
 			Selector: selectorLabels,
@@ -103,12 +122,60 @@ func CreateExporterService(ctx context.Context, cachedStatus service.Inspector,
 	return svcName, true, nil
 }
 
+// CreateSyncExporterServiceName returns the name of the service used to scrape the metrics of the sync
+// members with the given role.
+func CreateSyncExporterServiceName(deploymentName, role string) string {
+	return deploymentName + "-" + role + "-exporter"
+}
+
+// SyncExporterPortName is the name of the service port which exposes the metrics of the sync members
+const SyncExporterPortName = "sync-exporter"
+
+// CreateSyncExporterService prepares and creates a headless service which exposes the metrics endpoint
+// of the sync members with the given role. Sync members serve metrics on their server port, so the
+// service targets the port number instead of the named port of the exporter.
+func CreateSyncExporterService(ctx context.Context, cachedStatus service.Inspector, svcs service.ModInterface,
+	deployment metav1.Object, role string, port int, owner metav1.OwnerReference) (string, bool, error) {
+	deploymentName := deployment.GetName()
+	svcName := CreateSyncExporterServiceName(deploymentName, role)
+
+	if _, exists := cachedStatus.Service(svcName); exists {
+		return svcName, false, nil
+	}
+
+	svc := &core.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   svcName,
+			Labels: LabelsForExporterService(deploymentName),
+		},
+		Spec: core.ServiceSpec{
+			ClusterIP: core.ClusterIPNone,
+			Ports: []core.ServicePort{
+				{
+					Name:       SyncExporterPortName,
+					Protocol:   core.ProtocolTCP,
+					Port:       int32(port),
+					TargetPort: intstr.FromInt(port),
+				},
+			},
+			Selector: LabelsForDeployment(deploymentName, role),
+		},
+	}
+	AddOwnerRefToObject(svc.GetObjectMeta(), &owner)
+	if _, err := svcs.Create(ctx, svc, metav1.CreateOptions{}); IsAlreadyExists(err) {
+		return svcName, false, nil
+	} else if err != nil {
+		return svcName, false, errors.WithStack(err)
+	}
+	return svcName, true, nil
+}
+
 // CreateHeadlessService prepares and creates a headless service in k8s, used to provide a stable
 // DNS name for all pods.
 // If the service already exists, nil is returned.
 // If another error occurs, that error is returned.
 // The returned bool is true if the service is created, or false when the service already existed.
-func CreateHeadlessService(ctx context.Context, svcs service.ModInterface, deployment metav1.Object,
+func CreateHeadlessService(ctx context.Context, svcs service.ModInterface, deployment metav1.Object, port int,
 	owner metav1.OwnerReference) (string, bool, error) {
 	deploymentName := deployment.GetName()
 	svcName := CreateHeadlessServiceName(deploymentName)
@@ -116,7 +183,7 @@ func CreateHeadlessService(ctx context.Context, svcs service.ModInterface, deplo
 		core.ServicePort{
 			Name:     "server",
 			Protocol: core.ProtocolTCP,
-			Port:     ArangoPort,
+			Port:     int32(port),
 		},
 	}
 	publishNotReadyAddresses := true
@@ -132,7 +199,7 @@ func CreateHeadlessService(ctx context.Context, svcs service.ModInterface, deplo
 // If the service already exists, nil is returned.
 // If another error occurs, that error is returned.
 // The returned bool is true if the service is created, or false when the service already existed.
-func CreateDatabaseClientService(ctx context.Context, svcs service.ModInterface, deployment metav1.Object, single bool,
+func CreateDatabaseClientService(ctx context.Context, svcs service.ModInterface, deployment metav1.Object, single bool, port int,
 	owner metav1.OwnerReference) (string, bool, error) {
 	deploymentName := deployment.GetName()
 	svcName := CreateDatabaseClientServiceName(deploymentName)
@@ -140,7 +207,7 @@ func CreateDatabaseClientService(ctx context.Context, svcs service.ModInterface,
 		core.ServicePort{
 			Name:     "server",
 			Protocol: core.ProtocolTCP,
-			Port:     ArangoPort,
+			Port:     int32(port),
 		},
 	}
 	var role string
@@ -164,7 +231,7 @@ func CreateDatabaseClientService(ctx context.Context, svcs service.ModInterface,
 // The returned bool is true if the service is created, or false when the service already existed.
 func CreateExternalAccessService(ctx context.Context, svcs service.ModInterface, svcName, role string,
 	deployment metav1.Object, serviceType core.ServiceType, port, nodePort int, loadBalancerIP string,
-	loadBalancerSourceRanges []string, owner metav1.OwnerReference) (string, bool, error) {
+	loadBalancerSourceRanges []string, owner metav1.OwnerReference, mods ...ServiceModifier) (string, bool, error) {
 	deploymentName := deployment.GetName()
 	ports := []core.ServicePort{
 		core.ServicePort{
@@ -175,20 +242,23 @@ func CreateExternalAccessService(ctx context.Context, svcs service.ModInterface,
 		},
 	}
 	publishNotReadyAddresses := false
-	newlyCreated, err := createService(ctx, svcs, svcName, deploymentName, "", role, serviceType, ports, loadBalancerIP, loadBalancerSourceRanges, publishNotReadyAddresses, owner)
+	newlyCreated, err := createService(ctx, svcs, svcName, deploymentName, "", role, serviceType, ports, loadBalancerIP, loadBalancerSourceRanges, publishNotReadyAddresses, owner, mods...)
 	if err != nil {
 		return "", false, errors.WithStack(err)
 	}
 	return svcName, newlyCreated, nil
 }
 
+// ServiceModifier applies additional settings to a service before it is created.
+type ServiceModifier func(svc *core.Service)
+
 // createService prepares and creates a service in k8s.
 // If the service already exists, nil is returned.
 // If another error occurs, that error is returned.
 // The returned bool is true if the service is created, or false when the service already existed.
 func createService(ctx context.Context, svcs service.ModInterface, svcName, deploymentName, clusterIP, role string,
 	serviceType core.ServiceType, ports []core.ServicePort, loadBalancerIP string, loadBalancerSourceRanges []string,
-	publishNotReadyAddresses bool, owner metav1.OwnerReference) (bool, error) {
+	publishNotReadyAddresses bool, owner metav1.OwnerReference, mods ...ServiceModifier) (bool, error) {
 	labels := LabelsForDeployment(deploymentName, role)
 	svc := &core.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -206,6 +276,9 @@ func createService(ctx context.Context, svcs service.ModInterface, svcName, depl
 			LoadBalancerSourceRanges: loadBalancerSourceRanges,
 		},
 	}
+	for _, mod := range mods {
+		mod(svc)
+	}
 	AddOwnerRefToObject(svc.GetObjectMeta(), &owner)
 	if _, err := svcs.Create(ctx, svc, metav1.CreateOptions{}); IsAlreadyExists(err) {
 		return false, nil
@@ -39,11 +39,12 @@ func (k KeyfileInput) Append(b KeyfileInput) KeyfileInput {
 	return k
 }
 
-func GetAltNames(tls api.TLSSpec) (KeyfileInput, error) {
+// GetMemberAltNames returns alt names from the TLS spec rendered for the given member.
+func GetMemberAltNames(deployment meta.Object, tls api.TLSSpec, group api.ServerGroup, id string) (KeyfileInput, error) {
 	var k KeyfileInput
 
 	// Load alt names
-	dnsNames, ipAddresses, emailAddress, err := tls.GetParsedAltNames()
+	dnsNames, ipAddresses, emailAddress, err := tls.GetParsedMemberAltNames(deployment.GetName(), deployment.GetNamespace(), group.AsRole(), id)
 	if err != nil {
 		return k, errors.WithStack(err)
 	}
@@ -67,7 +68,7 @@ func GetServerAltNames(deployment meta.Object, spec api.DeploymentSpec, tls api.
 		service.GetName(),
 	)
 
-	if spec.ClusterDomain != nil {
+	if k8sutil.HasClusterDomain(spec.ClusterDomain) {
 		k.AltNames = append(k.AltNames,
 			k8sutil.CreateDatabaseClientServiceDNSNameWithDomain(deployment, spec.ClusterDomain),
 			k8sutil.CreatePodDNSNameWithDomain(deployment, spec.ClusterDomain, group.AsRole(), member.ID),
@@ -78,7 +79,12 @@ func GetServerAltNames(deployment meta.Object, spec api.DeploymentSpec, tls api.
 		k.AltNames = append(k.AltNames, ip)
 	}
 
-	if names, err := GetAltNames(tls); err != nil {
+	if group == api.ServerGroupCoordinators || group == api.ServerGroupSingle {
+		// Members exposed via external access
+		k.AltNames = append(k.AltNames, spec.ExternalAccess.GetAltNames()...)
+	}
+
+	if names, err := GetMemberAltNames(deployment, tls, group, member.ID); err != nil {
 		return k, errors.WithStack(err)
 	} else {
 		k = k.Append(names)
@@ -44,6 +44,8 @@ const (
 	LabelKeyArangoScheduled = "deployment.arangodb.com/scheduled"
 	// LabelKeyArangoTopology is the key of the label used to store the ArangoDeployment topology ID in
 	LabelKeyArangoTopology = "deployment.arangodb.com/topology"
+	// LabelKeyArangoClientCertificate is the key of the label used to mark secrets with client certificates issued by the operator
+	LabelKeyArangoClientCertificate = "deployment.arangodb.com/client-certificate"
 
 	// AppName is the fixed value for the "app" label
 	AppName = "arangodb"
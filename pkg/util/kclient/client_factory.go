@@ -24,6 +24,8 @@ import (
 	"sync"
 
 	"github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/util/dryrun"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 	"github.com/dchest/uniuri"
 	"github.com/pkg/errors"
 	monitoring "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
@@ -51,6 +53,23 @@ func GetDefaultFactory() Factory {
 	return GetFactory("")
 }
 
+// GetNamedClient returns the client of the factory with given name.
+// Client uses the default kube config and the rate limiter with the same name.
+func GetNamedClient(name string) (Client, bool) {
+	f := GetFactory(name)
+
+	if c, ok := f.Client(); ok {
+		return c, true
+	}
+
+	f.SetKubeConfigGetter(NewStaticConfigGetter(newKubeConfig))
+	if err := f.Refresh(); err != nil {
+		return nil, false
+	}
+
+	return f.Client()
+}
+
 func GetFactory(name string) Factory {
 	factoriesLock.Lock()
 	defer factoriesLock.Unlock()
@@ -136,6 +155,8 @@ func (f *factory) refresh() error {
 	}
 
 	cfg.RateLimiter = GetRateLimiter(f.name)
+	cfg.Wrap(dryrun.NewKubernetesRoundTripper)
+	cfg.Wrap(tracing.WrapTransport)
 
 	client, err := newClient(cfg)
 	if err != nil {
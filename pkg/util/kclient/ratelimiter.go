@@ -85,3 +85,30 @@ func SetDefaultQPS(q float32) {
 		v.setQPS(q)
 	}
 }
+
+// GetDefaultRateLimit returns default QPS and burst of rate limiters.
+func GetDefaultRateLimit() (float32, int) {
+	rateLimitersLock.Lock()
+	defer rateLimitersLock.Unlock()
+
+	return defaultQPS, defaultBurst
+}
+
+// SetRateLimit sets QPS and burst of the rate limiter with given name.
+func SetRateLimit(name string, qps float32, burst int) {
+	l := GetRateLimiter(name).(*rateLimiter)
+
+	l.setQPS(qps)
+	l.setBurst(burst)
+}
+
+// ResetRateLimit sets default QPS and burst on the rate limiter with given name, if it exists.
+func ResetRateLimit(name string) {
+	rateLimitersLock.Lock()
+	defer rateLimitersLock.Unlock()
+
+	if v, ok := rateLimiters[name]; ok {
+		v.setQPS(defaultQPS)
+		v.setBurst(defaultBurst)
+	}
+}
@@ -0,0 +1,134 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package registry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// Credentials used to authenticate in the registry
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Keychain keeps credentials of the registries, indexed by the registry host
+type Keychain map[string]Credentials
+
+// Get returns credentials of the registry
+func (k Keychain) Get(registry string) (Credentials, bool) {
+	c, ok := k[normalizeRegistry(registry)]
+	return c, ok
+}
+
+// Merge adds credentials of the given keychain, existing entries are not overridden
+func (k Keychain) Merge(other Keychain) Keychain {
+	if k == nil {
+		k = Keychain{}
+	}
+
+	for registry, c := range other {
+		if _, ok := k[registry]; !ok {
+			k[registry] = c
+		}
+	}
+
+	return k
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type dockerConfigAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// KeychainFromSecret reads credentials from the image pull secret (kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg)
+func KeychainFromSecret(secret *core.Secret) (Keychain, error) {
+	var auths map[string]dockerConfigAuth
+
+	switch secret.Type {
+	case core.SecretTypeDockerConfigJson:
+		var c dockerConfig
+		if err := json.Unmarshal(secret.Data[core.DockerConfigJsonKey], &c); err != nil {
+			return nil, errors.Wrapf(err, "Invalid docker config in secret %s", secret.GetName())
+		}
+		auths = c.Auths
+	case core.SecretTypeDockercfg:
+		if err := json.Unmarshal(secret.Data[core.DockerConfigKey], &auths); err != nil {
+			return nil, errors.Wrapf(err, "Invalid docker config in secret %s", secret.GetName())
+		}
+	default:
+		return nil, errors.Newf("Secret %s is not an image pull secret", secret.GetName())
+	}
+
+	k := Keychain{}
+
+	for registry, a := range auths {
+		c := Credentials{Username: a.Username, Password: a.Password}
+
+		if a.Auth != "" {
+			data, err := base64.StdEncoding.DecodeString(a.Auth)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Invalid auth of registry %s in secret %s", registry, secret.GetName())
+			}
+
+			parts := strings.SplitN(string(data), ":", 2)
+			if len(parts) != 2 {
+				return nil, errors.Newf("Invalid auth of registry %s in secret %s", registry, secret.GetName())
+			}
+
+			c = Credentials{Username: parts[0], Password: parts[1]}
+		}
+
+		k[normalizeRegistry(registry)] = c
+	}
+
+	return k, nil
+}
+
+// normalizeRegistry returns registry host from the docker config key (e.g. https://index.docker.io/v1/)
+func normalizeRegistry(registry string) string {
+	if strings.Contains(registry, "://") {
+		if u, err := url.Parse(registry); err == nil {
+			registry = u.Host
+		}
+	}
+
+	registry = strings.SplitN(registry, "/", 2)[0]
+
+	switch registry {
+	case dockerHubDomain, "index." + dockerHubDomain:
+		return DockerHubRegistry
+	}
+
+	return registry
+}
@@ -0,0 +1,79 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_KeychainFromSecret(t *testing.T) {
+	t.Run("DockerConfigJson", func(t *testing.T) {
+		k, err := KeychainFromSecret(&core.Secret{
+			Type: core.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				core.DockerConfigJsonKey: []byte(`{"auths":{"https://index.docker.io/v1/":{"auth":"dXNlcjpwYXNz"},"quay.io":{"username":"robot","password":"secret"}}}`),
+			},
+		})
+		require.NoError(t, err)
+
+		c, ok := k.Get(DockerHubRegistry)
+		require.True(t, ok)
+		require.Equal(t, Credentials{Username: "user", Password: "pass"}, c)
+
+		c, ok = k.Get("quay.io")
+		require.True(t, ok)
+		require.Equal(t, Credentials{Username: "robot", Password: "secret"}, c)
+
+		_, ok = k.Get("gcr.io")
+		require.False(t, ok)
+	})
+
+	t.Run("Dockercfg", func(t *testing.T) {
+		k, err := KeychainFromSecret(&core.Secret{
+			Type: core.SecretTypeDockercfg,
+			Data: map[string][]byte{
+				core.DockerConfigKey: []byte(`{"registry.example.com:5000":{"username":"user","password":"pass"}}`),
+			},
+		})
+		require.NoError(t, err)
+
+		c, ok := k.Get("registry.example.com:5000")
+		require.True(t, ok)
+		require.Equal(t, Credentials{Username: "user", Password: "pass"}, c)
+	})
+
+	t.Run("Invalid type", func(t *testing.T) {
+		_, err := KeychainFromSecret(&core.Secret{ObjectMeta: meta.ObjectMeta{Name: "opaque"}, Type: core.SecretTypeOpaque})
+		require.Error(t, err)
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		var k Keychain
+		k = k.Merge(Keychain{"quay.io": {Username: "first"}})
+		k = k.Merge(Keychain{"quay.io": {Username: "second"}, "gcr.io": {Username: "other"}})
+
+		require.Equal(t, Keychain{"quay.io": {Username: "first"}, "gcr.io": {Username: "other"}}, k)
+	})
+}
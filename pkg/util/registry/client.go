@@ -0,0 +1,246 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+var (
+	linkNextRegex       = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+	challengeParamRegex = regexp.MustCompile(`([a-zA-Z]+)="([^"]*)"`)
+)
+
+// Client lists tags of the images from Docker Registry HTTP API V2 compatible registries
+type Client interface {
+	// ListTags returns all tags of the image repository. Credentials of the image registry are taken from the keychain,
+	// anonymous access is used when keychain does not contain the registry.
+	ListTags(ctx context.Context, image Image, keychain Keychain) ([]string, error)
+}
+
+// NewClient returns registry client which supports basic and bearer token authentication
+func NewClient(client *http.Client) Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &registryClient{
+		client: client,
+	}
+}
+
+type registryClient struct {
+	client *http.Client
+}
+
+type tagsResponse struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+type tokenResponse struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+func (r *registryClient) ListTags(ctx context.Context, image Image, keychain Keychain) ([]string, error) {
+	var tags []string
+
+	next := fmt.Sprintf("https://%s/v2/%s/tags/list", image.Registry, image.Repository)
+	authorization := ""
+
+	credentials, hasCredentials := keychain.Get(image.Registry)
+
+	for next != "" {
+		resp, err := r.get(ctx, next, authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && authorization == "" {
+			challenge := resp.Header.Get("WWW-Authenticate")
+			resp.Body.Close()
+
+			if authorization, err = r.authorize(ctx, challenge, credentials, hasCredentials); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		var t tagsResponse
+		if err := decodeResponse(resp, &t); err != nil {
+			return nil, errors.Wrapf(err, "Unable to list tags of %s", image.String())
+		}
+
+		tags = append(tags, t.Tags...)
+
+		next = ""
+		if link := linkNextRegex.FindStringSubmatch(resp.Header.Get("Link")); len(link) == 2 {
+			u, err := resp.Request.URL.Parse(link[1])
+			if err != nil {
+				return nil, errors.Wrapf(err, "Invalid next page link")
+			}
+			next = u.String()
+		}
+	}
+
+	return tags, nil
+}
+
+// authorize returns value of the Authorization header which satisfies the authentication challenge
+func (r *registryClient) authorize(ctx context.Context, challenge string, credentials Credentials, hasCredentials bool) (string, error) {
+	if params, ok := parseBearerChallenge(challenge); ok {
+		basic := ""
+		if hasCredentials {
+			basic = credentials.basic()
+		}
+
+		token, err := r.token(ctx, params, basic)
+		if err != nil {
+			return "", err
+		}
+
+		return "Bearer " + token, nil
+	}
+
+	if isBasicChallenge(challenge) {
+		if !hasCredentials {
+			return "", errors.Newf("Registry requires credentials, none found in image pull secrets")
+		}
+
+		return credentials.basic(), nil
+	}
+
+	return "", errors.Newf("Unsupported authentication challenge: '%s'", challenge)
+}
+
+func (r *registryClient) token(ctx context.Context, params map[string]string, authorization string) (string, error) {
+	realm, ok := params["realm"]
+	if !ok {
+		return "", errors.Newf("Missing realm in authentication challenge")
+	}
+
+	u, err := url.Parse(realm)
+	if err != nil {
+		return "", errors.Wrapf(err, "Invalid realm '%s'", realm)
+	}
+
+	q := u.Query()
+	for _, k := range []string{"service", "scope"} {
+		if v, ok := params[k]; ok {
+			q.Set(k, v)
+		}
+	}
+	u.RawQuery = q.Encode()
+
+	resp, err := r.get(ctx, u.String(), authorization)
+	if err != nil {
+		return "", err
+	}
+
+	var t tokenResponse
+	if err := decodeResponse(resp, &t); err != nil {
+		return "", errors.Wrapf(err, "Unable to fetch registry token")
+	}
+
+	if t.Token != "" {
+		return t.Token, nil
+	}
+
+	if t.AccessToken != "" {
+		return t.AccessToken, nil
+	}
+
+	return "", errors.Newf("Registry token is empty")
+}
+
+func (r *registryClient) get(ctx context.Context, url, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return resp, nil
+}
+
+func decodeResponse(resp *http.Response, obj interface{}) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return errors.Newf("Unexpected response code %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(obj); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// basic returns value of the Authorization header for the basic authentication
+func (c Credentials) basic() string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(c.Username+":"+c.Password))
+}
+
+// isBasicChallenge returns true for WWW-Authenticate header in format: Basic realm="..."
+func isBasicChallenge(challenge string) bool {
+	const prefix = "basic"
+
+	return len(challenge) >= len(prefix) && strings.EqualFold(challenge[:len(prefix)], prefix)
+}
+
+// parseBearerChallenge parses WWW-Authenticate header in format: Bearer realm="...",service="...",scope="..."
+func parseBearerChallenge(challenge string) (map[string]string, bool) {
+	const prefix = "bearer "
+
+	if len(challenge) < len(prefix) || !strings.EqualFold(challenge[:len(prefix)], prefix) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+
+	for _, kv := range challengeParamRegex.FindAllStringSubmatch(challenge[len(prefix):], -1) {
+		params[strings.ToLower(kv[1])] = kv[2]
+	}
+
+	return params, true
+}
@@ -0,0 +1,132 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_ListTags(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			require.Equal(t, "registry", r.URL.Query().Get("service"))
+			require.Equal(t, "repository:arangodb/arangodb:pull", r.URL.Query().Get("scope"))
+			fmt.Fprint(w, `{"token":"secret"}`)
+		case "/v2/arangodb/arangodb/tags/list":
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:arangodb/arangodb:pull"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			if r.URL.Query().Get("last") == "" {
+				w.Header().Set("Link", `</v2/arangodb/arangodb/tags/list?n=2&last=3.9.1>; rel="next"`)
+				fmt.Fprint(w, `{"name":"arangodb/arangodb","tags":["3.9.0","3.9.1"]}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"name":"arangodb/arangodb","tags":["3.9.2"]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.Client())
+
+	image := Image{Registry: strings.TrimPrefix(server.URL, "https://"), Repository: "arangodb/arangodb"}
+
+	tags, err := c.ListTags(context.Background(), image, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"3.9.0", "3.9.1", "3.9.2"}, tags)
+
+	_, err = c.ListTags(context.Background(), Image{Registry: image.Registry, Repository: "arangodb/missing"}, nil)
+	require.Error(t, err)
+}
+
+func Test_Client_ListTags_Credentials(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"access_token":"private"}`)
+		case "/v2/arangodb/enterprise/tags/list":
+			if r.Header.Get("Authorization") != "Bearer private" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			fmt.Fprint(w, `{"name":"arangodb/enterprise","tags":["3.9.0"]}`)
+		case "/v2/arangodb/basic/tags/list":
+			if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+				w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			fmt.Fprint(w, `{"name":"arangodb/basic","tags":["3.9.1"]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.Client())
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	keychain := Keychain{host: {Username: "user", Password: "pass"}}
+
+	t.Run("Bearer without credentials", func(t *testing.T) {
+		_, err := c.ListTags(context.Background(), Image{Registry: host, Repository: "arangodb/enterprise"}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("Bearer with credentials", func(t *testing.T) {
+		tags, err := c.ListTags(context.Background(), Image{Registry: host, Repository: "arangodb/enterprise"}, keychain)
+		require.NoError(t, err)
+		require.Equal(t, []string{"3.9.0"}, tags)
+	})
+
+	t.Run("Basic without credentials", func(t *testing.T) {
+		_, err := c.ListTags(context.Background(), Image{Registry: host, Repository: "arangodb/basic"}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("Basic with credentials", func(t *testing.T) {
+		tags, err := c.ListTags(context.Background(), Image{Registry: host, Repository: "arangodb/basic"}, keychain)
+		require.NoError(t, err)
+		require.Equal(t, []string{"3.9.1"}, tags)
+	})
+}
@@ -0,0 +1,122 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package registry
+
+import (
+	"strings"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// DockerHubRegistry is the registry used for images without explicit registry
+	DockerHubRegistry = "registry-1.docker.io"
+
+	dockerHubDomain = "docker.io"
+)
+
+// Image is a parsed image reference
+type Image struct {
+	// Registry host (with optional port)
+	Registry string
+	// Repository path inside the registry
+	Repository string
+	// Tag of the image, empty when not set
+	Tag string
+	// Digest of the image, empty when not set
+	Digest string
+}
+
+// String returns the image reference, with registry omitted for Docker Hub images
+func (i Image) String() string {
+	var b strings.Builder
+
+	if i.Registry != DockerHubRegistry {
+		b.WriteString(i.Registry)
+		b.WriteString("/")
+		b.WriteString(i.Repository)
+	} else {
+		b.WriteString(strings.TrimPrefix(i.Repository, "library/"))
+	}
+
+	if i.Tag != "" {
+		b.WriteString(":")
+		b.WriteString(i.Tag)
+	}
+
+	if i.Digest != "" {
+		b.WriteString("@")
+		b.WriteString(i.Digest)
+	}
+
+	return b.String()
+}
+
+// WithTag returns the image reference with the given tag and without digest
+func (i Image) WithTag(tag string) Image {
+	i.Tag = tag
+	i.Digest = ""
+	return i
+}
+
+// ParseImage parses the image reference in the docker format
+func ParseImage(image string) (Image, error) {
+	var r Image
+
+	name := image
+
+	if id := strings.Index(name, "@"); id >= 0 {
+		r.Digest = name[id+1:]
+		name = name[:id]
+	}
+
+	if id := strings.LastIndex(name, ":"); id >= 0 && !strings.Contains(name[id+1:], "/") {
+		r.Tag = name[id+1:]
+		name = name[:id]
+	}
+
+	if name == "" {
+		return Image{}, errors.Newf("Invalid image '%s': missing repository", image)
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		r.Registry = parts[0]
+		r.Repository = parts[1]
+	} else {
+		r.Registry = DockerHubRegistry
+		r.Repository = name
+	}
+
+	if r.Registry == dockerHubDomain {
+		r.Registry = DockerHubRegistry
+	}
+
+	if r.Registry == DockerHubRegistry && !strings.Contains(r.Repository, "/") {
+		r.Repository = "library/" + r.Repository
+	}
+
+	if r.Repository == "" || strings.HasSuffix(r.Repository, "/") {
+		return Image{}, errors.Newf("Invalid image '%s': invalid repository", image)
+	}
+
+	return r, nil
+}
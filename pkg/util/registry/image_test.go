@@ -0,0 +1,58 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseImage(t *testing.T) {
+	cases := map[string]Image{
+		"arangodb":                            {Registry: DockerHubRegistry, Repository: "library/arangodb"},
+		"arangodb/enterprise:3.9.1":           {Registry: DockerHubRegistry, Repository: "arangodb/enterprise", Tag: "3.9.1"},
+		"docker.io/arangodb/arangodb:3.9.1":   {Registry: DockerHubRegistry, Repository: "arangodb/arangodb", Tag: "3.9.1"},
+		"localhost:5000/arangodb:3.9.1":       {Registry: "localhost:5000", Repository: "arangodb", Tag: "3.9.1"},
+		"quay.io/arangodb/arangodb@sha256:aa": {Registry: "quay.io", Repository: "arangodb/arangodb", Digest: "sha256:aa"},
+	}
+
+	for image, expected := range cases {
+		t.Run(image, func(t *testing.T) {
+			i, err := ParseImage(image)
+			require.NoError(t, err)
+			require.Equal(t, expected, i)
+		})
+	}
+
+	_, err := ParseImage(":3.9.1")
+	require.Error(t, err)
+}
+
+func Test_Image_String(t *testing.T) {
+	i, err := ParseImage("arangodb:3.9.1")
+	require.NoError(t, err)
+	require.Equal(t, "arangodb:3.9.2", i.WithTag("3.9.2").String())
+
+	i, err = ParseImage("quay.io/arangodb/arangodb@sha256:aa")
+	require.NoError(t, err)
+	require.Equal(t, "quay.io/arangodb/arangodb:3.9.2", i.WithTag("3.9.2").String())
+}
@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package shard
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// DefaultVirtualNodes defines number of points on the ring per member.
+const DefaultVirtualNodes = 128
+
+// Ring assigns keys to members using consistent hashing.
+// Adding or removing a member moves only keys owned by that member.
+type Ring interface {
+	// Owner returns the member owning the key or empty string when the ring has no members.
+	Owner(key string) string
+	// Members returns sorted list of members.
+	Members() []string
+}
+
+// NewRing creates a ring with given members.
+func NewRing(members ...string) Ring {
+	unique := map[string]bool{}
+	r := &ring{}
+
+	for _, m := range members {
+		if m == "" || unique[m] {
+			continue
+		}
+		unique[m] = true
+
+		r.members = append(r.members, m)
+		for i := 0; i < DefaultVirtualNodes; i++ {
+			r.points = append(r.points, point{hash: hash(fmt.Sprintf("%s#%d", m, i)), member: m})
+		}
+	}
+
+	sort.Strings(r.members)
+	sort.Slice(r.points, func(i, j int) bool {
+		if r.points[i].hash == r.points[j].hash {
+			return r.points[i].member < r.points[j].member
+		}
+		return r.points[i].hash < r.points[j].hash
+	})
+
+	return r
+}
+
+type point struct {
+	hash   uint64
+	member string
+}
+
+type ring struct {
+	members []string
+	points  []point
+}
+
+func (r *ring) Owner(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+
+	h := hash(key)
+	id := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i].hash >= h
+	})
+	if id == len(r.points) {
+		id = 0
+	}
+
+	return r.points[id].member
+}
+
+func (r *ring) Members() []string {
+	return r.members
+}
+
+func hash(s string) uint64 {
+	h := sha256.Sum256([]byte(s))
+	return binary.BigEndian.Uint64(h[:8])
+}
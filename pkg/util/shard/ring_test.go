@@ -0,0 +1,84 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func keys(n int) []string {
+	r := make([]string, n)
+	for i := range r {
+		r[i] = fmt.Sprintf("namespace-%d/deployment-%d", i%7, i)
+	}
+	return r
+}
+
+func Test_Ring_Empty(t *testing.T) {
+	require.Equal(t, "", NewRing().Owner("a/b"))
+	require.Equal(t, "", NewRing("").Owner("a/b"))
+}
+
+func Test_Ring_Deterministic(t *testing.T) {
+	a := NewRing("a", "b", "c")
+	b := NewRing("c", "a", "b", "a")
+
+	require.Equal(t, []string{"a", "b", "c"}, b.Members())
+
+	for _, k := range keys(1000) {
+		require.Equal(t, a.Owner(k), b.Owner(k))
+	}
+}
+
+func Test_Ring_Balanced(t *testing.T) {
+	r := NewRing("a", "b", "c", "d")
+
+	counts := map[string]int{}
+	for _, k := range keys(10000) {
+		counts[r.Owner(k)]++
+	}
+
+	require.Len(t, counts, 4)
+	for m, c := range counts {
+		require.InDelta(t, 2500, c, 750, m)
+	}
+}
+
+func Test_Ring_MinimalMovement(t *testing.T) {
+	before := NewRing("a", "b", "c")
+	after := NewRing("a", "b", "c", "d")
+
+	for _, k := range keys(10000) {
+		if o := after.Owner(k); o != "d" {
+			require.Equal(t, before.Owner(k), o, k)
+		}
+	}
+
+	removed := NewRing("a", "c")
+	for _, k := range keys(10000) {
+		if o := before.Owner(k); o != "b" {
+			require.Equal(t, o, removed.Owner(k), k)
+		}
+	}
+}
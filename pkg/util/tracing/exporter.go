@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tracing
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// exportInterval is the maximum time spans are kept before the export
+	exportInterval = 5 * time.Second
+	// exportBatchSize is the maximum number of spans exported in one request
+	exportBatchSize = 512
+	// maxQueueSize is the number of queued spans after which new spans are dropped
+	maxQueueSize = 4096
+	// shutdownTimeout is the time given to the replaced provider to export queued spans
+	shutdownTimeout = 10 * time.Second
+
+	instrumentationScope = "github.com/arangodb/kube-arangodb"
+)
+
+var (
+	providerLock   sync.RWMutex
+	globalProvider *sdktrace.TracerProvider
+	globalTracer   trace.Tracer
+)
+
+func getTracer() trace.Tracer {
+	providerLock.RLock()
+	defer providerLock.RUnlock()
+
+	return globalTracer
+}
+
+// Enable enables tracing. Spans are exported in batches to the OTLP/HTTP endpoint of the collector,
+// e.g. http://otel-collector:4318. The previously enabled provider is shut down.
+func Enable(endpoint, serviceName string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid OTLP endpoint %s", endpoint)
+	}
+
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(u.Host),
+		otlptracehttp.WithURLPath(strings.TrimSuffix(u.Path, "/") + "/v1/traces"),
+	}
+	switch u.Scheme {
+	case "http":
+		opts = append(opts, otlptracehttp.WithInsecure())
+	case "https":
+	default:
+		return errors.Newf("Unsupported scheme of the OTLP endpoint %s", endpoint)
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter,
+			sdktrace.WithBatchTimeout(exportInterval),
+			sdktrace.WithMaxExportBatchSize(exportBatchSize),
+			sdktrace.WithMaxQueueSize(maxQueueSize)),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+
+	providerLock.Lock()
+	previous := globalProvider
+	globalProvider, globalTracer = provider, provider.Tracer(instrumentationScope)
+	providerLock.Unlock()
+
+	if previous != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := previous.Shutdown(ctx); err != nil {
+			log.Warn().Err(err).Msg("Unable to shut down the previous trace provider")
+		}
+	}
+
+	return nil
+}
+
+// Shutdown disables tracing and exports the spans which are not exported yet
+func Shutdown(ctx context.Context) error {
+	providerLock.Lock()
+	provider := globalProvider
+	globalProvider, globalTracer = nil, nil
+	providerLock.Unlock()
+
+	if provider == nil {
+		return nil
+	}
+
+	return provider.Shutdown(ctx)
+}
+
+// IsEnabled returns true if tracing is enabled
+func IsEnabled() bool {
+	return getTracer() != nil
+}
@@ -0,0 +1,120 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+// Package tracing provides tracing of the operator operations exported with the OpenTelemetry SDK
+// to the OpenTelemetry collector with the OTLP/HTTP protocol.
+// Tracing is disabled until Enable is called, spans are nil and all operations on them are no-op.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Kind of the span
+type Kind = trace.SpanKind
+
+const (
+	// KindInternal is used for spans of internal operations
+	KindInternal = trace.SpanKindInternal
+	// KindClient is used for spans of outgoing requests
+	KindClient = trace.SpanKindClient
+)
+
+// Attribute is a key value pair describing the span
+type Attribute = attribute.KeyValue
+
+// String returns the attribute with the given key and value
+func String(key, value string) Attribute {
+	return attribute.String(key, value)
+}
+
+// Int returns the attribute with the given key and value
+func Int(key string, value int) Attribute {
+	return attribute.Int(key, value)
+}
+
+// Span is a single traced operation
+type Span struct {
+	span trace.Span
+}
+
+// SetAttributes adds attributes to the span
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+
+	s.span.SetAttributes(attrs...)
+}
+
+// SetError marks the span as failed if the error is not nil
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End finishes the span and queues it for the export. Only the first call has effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.span.End()
+}
+
+// FromContext returns the span stored in the context or nil
+func FromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+
+	s := trace.SpanFromContext(ctx)
+	if !s.SpanContext().IsValid() {
+		return nil
+	}
+
+	return &Span{span: s}
+}
+
+// Start starts the internal span with the given name. The span is a child of the span stored in the context.
+// When tracing is disabled the context is returned as is and the span is nil.
+func Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	return StartWithKind(ctx, name, KindInternal, attrs...)
+}
+
+// StartWithKind starts the span with the given name and kind
+func StartWithKind(ctx context.Context, name string, kind Kind, attrs ...Attribute) (context.Context, *Span) {
+	tracer := getTracer()
+	if tracer == nil {
+		return ctx, nil
+	}
+
+	ctx, s := tracer.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+
+	return ctx, &Span{span: s}
+}
@@ -0,0 +1,179 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	collectorTrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	otlpTrace "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type testCollector struct {
+	*httptest.Server
+
+	lock  sync.Mutex
+	spans []*otlpTrace.Span
+	attrs map[string]string
+}
+
+func newTestCollector(t *testing.T) *testCollector {
+	c := &testCollector{attrs: map[string]string{}}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var req collectorTrace.ExportTraceServiceRequest
+		require.NoError(t, proto.Unmarshal(data, &req))
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		for _, rs := range req.ResourceSpans {
+			for _, a := range rs.GetResource().GetAttributes() {
+				c.attrs[a.Key] = a.GetValue().GetStringValue()
+			}
+			for _, ss := range rs.ScopeSpans {
+				c.spans = append(c.spans, ss.Spans...)
+			}
+		}
+	}))
+	return c
+}
+
+func (c *testCollector) getSpans() []*otlpTrace.Span {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.spans
+}
+
+func Test_Tracing_Disabled(t *testing.T) {
+	ctx, span := Start(context.Background(), "test")
+	require.Nil(t, span)
+	require.Nil(t, FromContext(ctx))
+	require.False(t, IsEnabled())
+
+	// Operations on disabled spans are no-op
+	span.SetAttributes(String("key", "value"))
+	span.SetError(errors.Newf("error"))
+	span.End()
+}
+
+func Test_Tracing_InvalidEndpoint(t *testing.T) {
+	require.Error(t, Enable("otel-collector:4318", "operator"))
+	require.False(t, IsEnabled())
+}
+
+func Test_Tracing_Export(t *testing.T) {
+	var traceParent string
+
+	collector := newTestCollector(t)
+	defer collector.Close()
+
+	arangod := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get("traceparent")
+	}))
+	defer arangod.Close()
+
+	require.NoError(t, Enable(collector.URL, "operator"))
+	require.True(t, IsEnabled())
+
+	ctx, root := Start(context.Background(), "InspectDeployment", String("deployment", "example"))
+	require.NotNil(t, root)
+
+	actionCtx, action := Start(ctx, "Action RotateMember")
+	action.SetError(errors.Newf("failed"))
+
+	client := &http.Client{Transport: WrapTransport(http.DefaultTransport)}
+	req, err := http.NewRequestWithContext(actionCtx, http.MethodGet, arangod.URL+"/_api/version", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	action.End()
+	root.End()
+	root.End()
+
+	// Queued spans are exported on shutdown
+	require.NoError(t, Shutdown(context.Background()))
+	require.False(t, IsEnabled())
+
+	require.Equal(t, "operator", collector.attrs["service.name"])
+	spans := collector.getSpans()
+	require.Len(t, spans, 3)
+
+	request, actionSpan, rootSpan := spans[0], spans[1], spans[2]
+
+	require.Equal(t, "InspectDeployment", rootSpan.Name)
+	require.Empty(t, rootSpan.ParentSpanId)
+	require.Len(t, rootSpan.TraceId, 16)
+	require.Equal(t, otlpTrace.Status_STATUS_CODE_UNSET, rootSpan.GetStatus().GetCode())
+
+	require.Equal(t, rootSpan.TraceId, actionSpan.TraceId)
+	require.Equal(t, rootSpan.SpanId, actionSpan.ParentSpanId)
+	require.Equal(t, otlpTrace.Status_STATUS_CODE_ERROR, actionSpan.GetStatus().GetCode())
+	require.Equal(t, "failed", actionSpan.GetStatus().GetMessage())
+
+	require.Equal(t, "HTTP GET", request.Name)
+	require.Equal(t, otlpTrace.Span_SPAN_KIND_CLIENT, request.Kind)
+	require.Equal(t, actionSpan.SpanId, request.ParentSpanId)
+	require.Equal(t, "00-"+hex.EncodeToString(request.TraceId)+"-"+hex.EncodeToString(request.SpanId)+"-01", traceParent)
+}
+
+func Test_Tracing_Enable_ShutsDownPrevious(t *testing.T) {
+	first := newTestCollector(t)
+	defer first.Close()
+
+	second := newTestCollector(t)
+	defer second.Close()
+
+	require.NoError(t, Enable(first.URL, "operator"))
+
+	_, span := Start(context.Background(), "first")
+	span.End()
+
+	// Spans of the replaced provider are exported when the provider is replaced
+	require.NoError(t, Enable(second.URL, "operator"))
+	require.Len(t, first.getSpans(), 1)
+
+	_, span = Start(context.Background(), "second")
+	span.End()
+
+	require.NoError(t, Shutdown(context.Background()))
+	require.Len(t, first.getSpans(), 1)
+	require.Len(t, second.getSpans(), 1)
+	require.Equal(t, "second", second.getSpans()[0].Name)
+}
@@ -0,0 +1,63 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// WrapTransport returns the round tripper which creates client spans for requests sent in the traced context
+// and propagates the trace with the W3C traceparent header. Tracing state is checked on each request.
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &roundTripper{rt: rt}
+}
+
+type roundTripper struct {
+	rt http.RoundTripper
+}
+
+func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if FromContext(req.Context()) == nil {
+		// Only requests of traced operations are traced
+		return r.rt.RoundTrip(req)
+	}
+
+	ctx, span := StartWithKind(req.Context(), "HTTP "+req.Method, KindClient,
+		String("http.method", req.Method),
+		String("http.target", req.URL.Path),
+		String("net.peer.name", req.URL.Host))
+	defer span.End()
+
+	req = req.Clone(ctx)
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil {
+		span.SetError(err)
+		return nil, err
+	}
+
+	span.SetAttributes(Int("http.status_code", resp.StatusCode))
+
+	return resp, nil
+}
@@ -0,0 +1,245 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// DefaultAuthPath is the default mount path of the Kubernetes auth method
+	DefaultAuthPath = "kubernetes"
+
+	// ServiceAccountTokenFile is the file with the token of the operator service account
+	ServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	// cacheTTL defines how long values read from Vault are reused
+	cacheTTL = time.Minute
+)
+
+// Config holds the settings used to connect to Vault
+type Config struct {
+	// Address of the Vault server
+	Address string
+	// AuthPath is the mount path of the Kubernetes auth method
+	AuthPath string
+	// Role is the Vault role used to log in with the service account token
+	Role string
+	// TokenFile is the file with the service account token, ServiceAccountTokenFile when empty
+	TokenFile string
+}
+
+// Client reads single fields of secrets from the KV secrets engine of Vault.
+type Client interface {
+	// Config returns the configuration of the client
+	Config() Config
+	// Read returns the value of the field of the secret kept in the given path.
+	// Values are cached for a short time, so the client can be used on every inspection.
+	Read(ctx context.Context, path, key string) (string, error)
+}
+
+// NewClient creates a client which logs in to Vault with the Kubernetes auth method
+func NewClient(config Config) Client {
+	if config.AuthPath == "" {
+		config.AuthPath = DefaultAuthPath
+	}
+	if config.TokenFile == "" {
+		config.TokenFile = ServiceAccountTokenFile
+	}
+
+	return &client{
+		config: config,
+		http:   &http.Client{Timeout: 10 * time.Second},
+		cache:  map[string]cachedValue{},
+	}
+}
+
+type cachedValue struct {
+	value   string
+	expires time.Time
+}
+
+type client struct {
+	lock sync.Mutex
+
+	config Config
+	http   *http.Client
+
+	token        string
+	tokenExpires time.Time
+
+	cache map[string]cachedValue
+}
+
+func (c *client) Config() Config {
+	return c.config
+}
+
+// Read does not hold the lock during requests to Vault, so slow responses do not block reads of cached values.
+func (c *client) Read(ctx context.Context, path, key string) (string, error) {
+	id := fmt.Sprintf("%s#%s", path, key)
+
+	if v, ok := c.getCached(id); ok {
+		return v, nil
+	}
+
+	token, err := c.login(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var resp struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, path, token, nil, &resp); err != nil {
+		return "", errors.Wrapf(err, "Unable to read secret %s", path)
+	}
+
+	data := resp.Data
+	// Secrets of the KV version 2 engine are nested in the data field
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		data = nested
+	}
+
+	value, ok := data[key].(string)
+	if !ok {
+		return "", errors.Newf("Field %s is missing in secret %s", key, path)
+	}
+
+	c.lock.Lock()
+	c.cache[id] = cachedValue{value: value, expires: time.Now().Add(cacheTTL)}
+	c.lock.Unlock()
+
+	return value, nil
+}
+
+func (c *client) getCached(id string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if v, ok := c.cache[id]; ok && time.Now().Before(v.expires) {
+		return v.value, true
+	}
+
+	return "", false
+}
+
+// getToken returns the current Vault token, when it is set and not expired.
+// Zero expiration time means that the token does not expire.
+func (c *client) getToken() (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.token != "" && (c.tokenExpires.IsZero() || time.Now().Before(c.tokenExpires)) {
+		return c.token, true
+	}
+
+	return "", false
+}
+
+// login returns the Vault token, new token is requested when the current one expires
+func (c *client) login(ctx context.Context) (string, error) {
+	if token, ok := c.getToken(); ok {
+		return token, nil
+	}
+
+	jwt, err := ioutil.ReadFile(c.config.TokenFile)
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to read service account token")
+	}
+
+	var resp struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+		} `json:"auth"`
+	}
+
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", c.config.AuthPath), "", map[string]string{
+		"role": c.config.Role,
+		"jwt":  strings.TrimSpace(string(jwt)),
+	}, &resp); err != nil {
+		return "", errors.Wrapf(err, "Unable to log in to Vault")
+	}
+
+	if resp.Auth.ClientToken == "" {
+		return "", errors.Newf("Vault login response does not contain token")
+	}
+
+	var expires time.Time
+	if resp.Auth.LeaseDuration > 0 {
+		// Token is renewed in the middle of its lease
+		expires = time.Now().Add(time.Duration(resp.Auth.LeaseDuration) * time.Second / 2)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.token = resp.Auth.ClientToken
+	c.tokenExpires = expires
+
+	return c.token, nil
+}
+
+func (c *client) do(ctx context.Context, method, path, token string, body interface{}, out interface{}) error {
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(c.config.Address, "/"), strings.TrimPrefix(path, "/")), reader)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Newf("Unexpected response code %d", resp.StatusCode)
+	}
+
+	return errors.WithStack(json.NewDecoder(resp.Body).Decode(out))
+}
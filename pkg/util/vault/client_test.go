@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_Read(t *testing.T) {
+	logins, reads := 0, 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/kubernetes/login":
+			logins++
+			var req map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, "arangodb", req["role"])
+			require.Equal(t, "sa-token", req["jwt"])
+			w.Write([]byte(`{"auth":{"client_token":"vault-token","lease_duration":3600}}`))
+		case "/v1/secret/data/arangodb":
+			reads++
+			require.Equal(t, "vault-token", r.Header.Get("X-Vault-Token"))
+			w.Write([]byte(`{"data":{"data":{"token":"jwt-secret"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("sa-token\n"), 0600))
+
+	c := NewClient(Config{Address: server.URL, Role: "arangodb", TokenFile: tokenFile})
+
+	v, err := c.Read(context.Background(), "secret/data/arangodb", "token")
+	require.NoError(t, err)
+	require.Equal(t, "jwt-secret", v)
+
+	// Value is taken from the cache
+	v, err = c.Read(context.Background(), "secret/data/arangodb", "token")
+	require.NoError(t, err)
+	require.Equal(t, "jwt-secret", v)
+	require.Equal(t, 1, logins)
+	require.Equal(t, 1, reads)
+
+	_, err = c.Read(context.Background(), "secret/data/arangodb", "missing")
+	require.Error(t, err)
+
+	_, err = c.Read(context.Background(), "secret/data/unknown", "token")
+	require.Error(t, err)
+	require.Equal(t, 1, logins)
+}
+
+func Test_Client_NonExpiringToken(t *testing.T) {
+	logins := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/kubernetes/login":
+			logins++
+			w.Write([]byte(`{"auth":{"client_token":"vault-token","lease_duration":0}}`))
+		default:
+			w.Write([]byte(`{"data":{"token":"jwt-secret"}}`))
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("sa-token"), 0600))
+
+	c := NewClient(Config{Address: server.URL, Role: "arangodb", TokenFile: tokenFile})
+
+	// Token with zero lease duration is reused by reads of not cached values
+	for _, path := range []string{"secret/a", "secret/b"} {
+		v, err := c.Read(context.Background(), path, "token")
+		require.NoError(t, err)
+		require.Equal(t, "jwt-secret", v)
+	}
+	require.Equal(t, 1, logins)
+}